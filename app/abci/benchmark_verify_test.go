@@ -0,0 +1,127 @@
+package abci
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/sedaprotocol/seda-chain/app/utils"
+	sedatypes "github.com/sedaprotocol/seda-chain/types"
+	batchingtypes "github.com/sedaprotocol/seda-chain/x/batching/types"
+)
+
+// benchStakingKeeper and benchBatchingKeeper are map-based keepers used
+// instead of mocks so that the benchmarks measure the verification itself.
+type benchStakingKeeper struct {
+	StakingKeeper
+	validators map[string]stakingtypes.Validator
+}
+
+func (k benchStakingKeeper) GetValidatorByConsAddr(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error) {
+	return k.validators[string(consAddr)], nil
+}
+
+type benchBatchingKeeper struct {
+	BatchingKeeper
+	treeEntries map[string]batchingtypes.ValidatorTreeEntry
+}
+
+func (k benchBatchingKeeper) GetValidatorTreeEntry(_ context.Context, _ uint64, valAddr sdk.ValAddress) (batchingtypes.ValidatorTreeEntry, error) {
+	return k.treeEntries[string(valAddr)], nil
+}
+
+type benchVote struct {
+	consAddr []byte
+	voteExt  VoteExtension
+}
+
+// setupVerificationBenchmark returns handlers and the signed vote
+// extensions of the given number of validators for a batch.
+func setupVerificationBenchmark(b *testing.B, numVals int) (*Handlers, batchingtypes.Batch, []benchVote) {
+	b.Helper()
+
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write([]byte("batch"))
+	batch := batchingtypes.Batch{
+		BatchNumber: 100,
+		BatchId:     hasher.Sum(nil),
+		BlockHeight: 100,
+	}
+
+	batchingKeeper := benchBatchingKeeper{treeEntries: make(map[string]batchingtypes.ValidatorTreeEntry)}
+	stakingKeeper := benchStakingKeeper{validators: make(map[string]stakingtypes.Validator)}
+	valAddrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+
+	votes := make([]benchVote, numVals)
+	for i := range votes {
+		privKey, err := crypto.GenerateKey()
+		require.NoError(b, err)
+		ethAddr, err := utils.PubKeyToEthAddress(crypto.FromECDSAPub(&privKey.PublicKey))
+		require.NoError(b, err)
+		signature, err := crypto.Sign(batch.BatchId, privKey)
+		require.NoError(b, err)
+
+		consAddr := bytes.Repeat([]byte{byte(i), byte(i >> 8)}, 10)
+		valAddr := sdk.ValAddress(consAddr)
+		valAddrStr, err := valAddrCodec.BytesToString(valAddr)
+		require.NoError(b, err)
+
+		stakingKeeper.validators[string(consAddr)] = stakingtypes.Validator{OperatorAddress: valAddrStr}
+		batchingKeeper.treeEntries[string(valAddr)] = batchingtypes.ValidatorTreeEntry{EthAddress: ethAddr}
+
+		votes[i] = benchVote{
+			consAddr: consAddr,
+			voteExt: NewVoteExtension(BatchSignature{
+				KeyIndex:  uint32(sedatypes.SEDAKeyIndexSecp256k1),
+				Signature: signature,
+			}),
+		}
+	}
+
+	h := NewHandlers(nil, nil, batchingKeeper, nil, stakingKeeper, nil, valAddrCodec, nil, nil, nil, log.NewNopLogger())
+	return h, batch, votes
+}
+
+// BenchmarkVerifyBatchSignatures measures the verification of the batch
+// signatures of a validator set across the three ABCI phases in which
+// they are verified (VerifyVoteExtension, PrepareProposal, and
+// ProcessProposal) with and without the signature cache.
+func BenchmarkVerifyBatchSignatures(b *testing.B) {
+	const numPhases = 3
+	for _, numVals := range []int{100, 500} {
+		for _, cached := range []bool{false, true} {
+			b.Run(fmt.Sprintf("validators=%d/cached=%t", numVals, cached), func(b *testing.B) {
+				h, batch, votes := setupVerificationBenchmark(b, numVals)
+				ctx := sdk.Context{}
+
+				b.ResetTimer()
+				for range b.N {
+					if cached {
+						h.sigCache = NewSignatureCache(DefaultSignatureCacheSize)
+					} else {
+						h.sigCache = nil
+					}
+					for range numPhases {
+						for _, vote := range votes {
+							err := h.verifyBatchSignatures(ctx, batch.BatchNumber, batch.BatchId, vote.voteExt, vote.consAddr)
+							if err != nil {
+								b.Fatal(err)
+							}
+						}
+					}
+				}
+			})
+		}
+	}
+}
@@ -9,10 +9,12 @@ import (
 
 	sedatypes "github.com/sedaprotocol/seda-chain/types"
 	batchingtypes "github.com/sedaprotocol/seda-chain/x/batching/types"
+	oracletypes "github.com/sedaprotocol/seda-chain/x/oracle/types"
 	pubkeytypes "github.com/sedaprotocol/seda-chain/x/pubkey/types"
 )
 
 type BatchingKeeper interface {
+	GetParams(ctx sdk.Context) (batchingtypes.Params, error)
 	GetBatchForHeight(ctx context.Context, height int64) (batchingtypes.Batch, error)
 	SetBatchSigSecp256k1(ctx context.Context, batchNum uint64, valAddr sdk.ValAddress, signature []byte) error
 	GetValidatorTreeEntry(ctx context.Context, batchNum uint64, valAddr sdk.ValAddress) (batchingtypes.ValidatorTreeEntry, error)
@@ -28,3 +30,15 @@ type StakingKeeper interface {
 	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
 	GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error)
 }
+
+type OracleKeeper interface {
+	GetParams(ctx context.Context) (oracletypes.Params, error)
+	ValidateObservations(ctx context.Context, payload []byte) (oracletypes.Observations, error)
+	UpdateMedianValues(ctx context.Context, totalPower int64, valObservations []oracletypes.ValidatorObservations) error
+}
+
+// Observer provides the node-local observations of the oracle feeds
+// attached to vote extensions.
+type Observer interface {
+	Observe(ctx context.Context, feedIDs []string) []oracletypes.Observation
+}
@@ -11,6 +11,7 @@ import (
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtcoretypes "github.com/cometbft/cometbft/types"
 
 	"cosmossdk.io/collections"
 	addresscodec "cosmossdk.io/core/address"
@@ -20,6 +21,8 @@ import (
 
 	"github.com/sedaprotocol/seda-chain/app/utils"
 	sedatypes "github.com/sedaprotocol/seda-chain/types"
+	batchingtypes "github.com/sedaprotocol/seda-chain/x/batching/types"
+	oracletypes "github.com/sedaprotocol/seda-chain/x/oracle/types"
 )
 
 const (
@@ -34,10 +37,9 @@ const (
 	// signatures.
 	BlockOffsetCollectPhase = -2
 
-	// MinVoteExtensionLength is the minimum size of vote extension in bytes.
-	MinVoteExtensionLength = 65
-	// MaxVoteExtensionLength is the maximum size of vote extension in bytes.
-	MaxVoteExtensionLength = 65 * 5
+	// MaxVoteExtensionLength is the maximum size of vote extension in bytes
+	// used when the corresponding batching module parameter is not set.
+	MaxVoteExtensionLength = batchingtypes.DefaultMaxVoteExtensionSize
 )
 
 type Handlers struct {
@@ -46,8 +48,12 @@ type Handlers struct {
 	batchingKeeper         BatchingKeeper
 	pubKeyKeeper           PubKeyKeeper
 	stakingKeeper          StakingKeeper
+	oracleKeeper           OracleKeeper
 	validatorAddressCodec  addresscodec.Codec
 	signer                 utils.SEDASigner
+	signerHealth           *utils.SignerHealth
+	observer               Observer
+	sigCache               *SignatureCache
 	logger                 log.Logger
 }
 
@@ -57,8 +63,11 @@ func NewHandlers(
 	bk BatchingKeeper,
 	pkk PubKeyKeeper,
 	sk StakingKeeper,
+	ok OracleKeeper,
 	vac addresscodec.Codec,
 	signer utils.SEDASigner,
+	signerHealth *utils.SignerHealth,
+	observer Observer,
 	logger log.Logger,
 ) *Handlers {
 	return &Handlers{
@@ -67,118 +76,182 @@ func NewHandlers(
 		batchingKeeper:         bk,
 		pubKeyKeeper:           pkk,
 		stakingKeeper:          sk,
+		oracleKeeper:           ok,
 		validatorAddressCodec:  vac,
 		signer:                 signer,
+		signerHealth:           signerHealth,
+		observer:               observer,
+		sigCache:               NewSignatureCache(DefaultSignatureCacheSize),
 		logger:                 logger,
 	}
 }
 
 // ExtendVoteHandler handles the ExtendVote ABCI to sign a batch created
-// from the previous block.
+// from the previous block and attach the validator's observations of
+// the oracle feeds.
 func (h *Handlers) ExtendVoteHandler() sdk.ExtendVoteHandler {
 	return func(ctx sdk.Context, _ *abcitypes.RequestExtendVote) (*abcitypes.ResponseExtendVote, error) {
 		h.logger.Debug("start extend vote handler", "height", ctx.BlockHeight())
 
+		voteExt := NewVoteExtension()
+
 		// Check if there is a batch to sign at this block height.
-		batch, err := h.batchingKeeper.GetBatchForHeight(ctx, ctx.BlockHeight()+BlockOffsetSignPhase)
+		batch, err := h.getBatchForPhase(ctx, BlockOffsetSignPhase)
 		if err != nil {
-			if errors.Is(err, collections.ErrNotFound) {
-				h.logger.Debug("no batch to sign", "height", ctx.BlockHeight())
-				return &abcitypes.ResponseExtendVote{}, nil
-			}
 			return nil, err
 		}
-
-		if !h.signer.IsLoaded() {
-			h.logger.Info("signer is not loaded, try reloading")
-			err := h.signer.ReloadIfMismatch(nil)
+		if batch != nil {
+			signature, err := h.signBatch(ctx, *batch)
 			if err != nil {
-				h.logger.Error("failed to load signer to sign batch", "err", err)
+				h.signerHealth.RecordSigningFailure(batch.BatchNumber, ctx.BlockHeight(), err)
 				return nil, err
 			}
-			h.logger.Info("signer has been reloaded successfully")
+			h.signerHealth.RecordSignedBatch(batch.BatchNumber, ctx.BlockHeight())
+
+			voteExt.BatchSignatures = append(voteExt.BatchSignatures, BatchSignature{
+				KeyIndex:  uint32(sedatypes.SEDAKeyIndexSecp256k1),
+				Signature: signature,
+			})
+			h.logger.Debug(
+				"submitting batch signature",
+				"signature", signature,
+				"batch_number", batch.BatchNumber,
+			)
+		} else {
+			h.logger.Debug("no batch to sign", "height", ctx.BlockHeight())
 		}
 
-		// Check if the validator was in the previous validator tree. If not,
-		// this means the validator has just joined the active set, so it skips
-		// signing this batch. The very first batch is signed by all validators.
-		if batch.BatchNumber != collections.DefaultSequenceStart {
-			_, err = h.batchingKeeper.GetValidatorTreeEntry(ctx, batch.BatchNumber-1, h.signer.GetValAddress())
-			if err != nil {
-				if errors.Is(err, collections.ErrNotFound) {
-					h.logger.Info("validator was not in the previous validator tree - not signing the batch")
-				} else {
-					h.logger.Error("unexpected error while checking previous validator tree entry", "err", err)
-				}
-				return nil, err
-			}
+		payload, err := h.observe(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if payload != nil {
+			voteExt.Payloads = append(voteExt.Payloads, VoteExtensionPayload{
+				Type: oracletypes.VoteExtensionPayloadType,
+				Data: payload,
+			})
+		}
+
+		if len(voteExt.BatchSignatures) == 0 && len(voteExt.Payloads) == 0 {
+			return &abcitypes.ResponseExtendVote{}, nil
+		}
+
+		bz, err := EncodeVoteExtension(voteExt)
+		if err != nil {
+			return nil, err
 		}
+		return &abcitypes.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// observe returns the encoded observations of the oracle feeds made by
+// the node or nil if the oracle is inactive or there is nothing to
+// report. Observations that would not pass validation are not reported.
+func (h *Handlers) observe(ctx sdk.Context) ([]byte, error) {
+	if h.observer == nil {
+		return nil, nil
+	}
+	params, err := h.getOracleParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.IsActive() {
+		return nil, nil
+	}
+
+	observations := oracletypes.Observations{
+		Observations: h.observer.Observe(ctx, params.FeedIds),
+	}
+	if len(observations.Observations) == 0 {
+		return nil, nil
+	}
+	if err := observations.Validate(params); err != nil {
+		h.logger.Error("not reporting invalid oracle observations", "err", err)
+		return nil, nil
+	}
+	return observations.Marshal()
+}
 
-		valKeys, err := h.pubKeyKeeper.GetValidatorKeys(ctx, h.signer.GetValAddress().String())
+// signBatch signs the given batch using the SEDA signer after checking
+// that the validator is eligible to sign it. The signer is reloaded if
+// it is not loaded or if its keys do not match the registered ones.
+func (h *Handlers) signBatch(ctx sdk.Context, batch batchingtypes.Batch) ([]byte, error) {
+	if !h.signer.IsLoaded() {
+		h.logger.Info("signer is not loaded, try reloading")
+		err := h.signer.ReloadIfMismatch(nil)
+		h.signerHealth.RecordReload(ctx.BlockHeight(), "signer is not loaded", err)
 		if err != nil {
+			h.logger.Error("failed to load signer to sign batch", "err", err)
 			return nil, err
 		}
+		h.logger.Info("signer has been reloaded successfully")
+	}
 
-		// Sign and reload the signer if the public key has changed.
-		signature, err := h.signer.Sign(batch.BatchId, sedatypes.SEDAKeyIndexSecp256k1)
+	// Check if the validator was in the previous validator tree. If not,
+	// this means the validator has just joined the active set, so it skips
+	// signing this batch. The very first batch is signed by all validators.
+	if batch.BatchNumber != collections.DefaultSequenceStart {
+		_, err := h.batchingKeeper.GetValidatorTreeEntry(ctx, batch.BatchNumber-1, h.signer.GetValAddress())
 		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				h.logger.Info("validator was not in the previous validator tree - not signing the batch")
+			} else {
+				h.logger.Error("unexpected error while checking previous validator tree entry", "err", err)
+			}
 			return nil, err
 		}
+	}
+
+	valKeys, err := h.pubKeyKeeper.GetValidatorKeys(ctx, h.signer.GetValAddress().String())
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign and reload the signer if the public key has changed.
+	signature, err := h.signer.Sign(batch.BatchId, sedatypes.SEDAKeyIndexSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+	if len(valKeys.IndexedPubKeys) == 0 || !utils.PubKeysMatch(h.signer.GetPublicKeys(), valKeys.IndexedPubKeys) {
 		err = h.signer.ReloadIfMismatch(valKeys.IndexedPubKeys)
+		h.signerHealth.RecordReload(ctx.BlockHeight(), "loaded keys do not match registered keys", err)
 		if err != nil {
 			h.logger.Error("failed to reload signer despite mismatch")
 		}
-
-		h.logger.Debug(
-			"submitting batch signature",
-			"signature", signature,
-			"batch_number", batch.BatchNumber,
-		)
-		return &abcitypes.ResponseExtendVote{VoteExtension: signature}, nil
 	}
+	return signature, nil
 }
 
 // VerifyVoteExtensionHandler handles the VerifyVoteExtension ABCI to
 // verify the batch signature included in the pre-commit vote against
-// the public key registered in the pubkey module.
+// the public key registered in the pubkey module and to validate the
+// attached oracle observations.
 func (h *Handlers) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
 	return func(ctx sdk.Context, req *abcitypes.RequestVerifyVoteExtension) (*abcitypes.ResponseVerifyVoteExtension, error) {
 		h.logger.Debug("start verify vote extension handler", "request", req)
 
-		batch, err := h.batchingKeeper.GetBatchForHeight(ctx, ctx.BlockHeight()+BlockOffsetSignPhase)
+		batch, err := h.getBatchForPhase(ctx, BlockOffsetSignPhase)
 		if err != nil {
-			if errors.Is(err, collections.ErrNotFound) {
-				if req.VoteExtension == nil {
-					return &abcitypes.ResponseVerifyVoteExtension{Status: abcitypes.ResponseVerifyVoteExtension_ACCEPT}, nil
-				}
-				h.logger.Error(
-					"received vote extension even though we're skipping batching",
-					"validator_address", hex.EncodeToString(req.ValidatorAddress),
-					"height", req.Height,
-					"vote_extension", hex.EncodeToString(req.VoteExtension),
-				)
-				return &abcitypes.ResponseVerifyVoteExtension{Status: abcitypes.ResponseVerifyVoteExtension_REJECT}, nil
-			}
 			return nil, err
 		}
 
-		err = h.verifyBatchSignatures(ctx, batch.BatchNumber, batch.BatchId, req.VoteExtension, req.ValidatorAddress)
+		err = h.verifyVoteExtension(ctx, batch, req.VoteExtension, req.ValidatorAddress)
 		if err != nil {
 			h.logger.Error(
-				"failed to verify batch signature",
+				"failed to verify vote extension",
 				"validator_address", hex.EncodeToString(req.ValidatorAddress),
 				"height", req.Height,
 				"vote_extension", hex.EncodeToString(req.VoteExtension),
 				"err", err,
 			)
+			recordRejectedVoteExtension(PhaseVerifyVoteExtension, err)
 			return &abcitypes.ResponseVerifyVoteExtension{Status: abcitypes.ResponseVerifyVoteExtension_REJECT}, err
 		}
 
 		h.logger.Debug(
-			"successfully verified signature",
+			"successfully verified vote extension",
 			"request", hex.EncodeToString(req.ValidatorAddress),
 			"height", req.Height,
-			"batch_number", batch.BatchNumber,
 		)
 		return &abcitypes.ResponseVerifyVoteExtension{Status: abcitypes.ResponseVerifyVoteExtension_ACCEPT}, nil
 	}
@@ -188,28 +261,24 @@ func (h *Handlers) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
 // a canonical set of vote extensions in the proposal.
 func (h *Handlers) PrepareProposalHandler() sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req *abcitypes.RequestPrepareProposal) (*abcitypes.ResponsePrepareProposal, error) {
-		// Check if there is a batch whose signatures must be collected
-		// at this block height.
-		var collectSigs bool
-		batch, err := h.batchingKeeper.GetBatchForHeight(ctx, ctx.BlockHeight()+BlockOffsetCollectPhase)
+		// Check if there is a batch whose signatures or oracle observations
+		// must be collected at this block height.
+		batch, collect, err := h.getCollectPhase(ctx)
 		if err != nil {
-			if !errors.Is(err, collections.ErrNotFound) {
-				return nil, err
-			}
-		} else {
-			collectSigs = true
+			return nil, err
 		}
 
 		var injection []byte
-		if IsVoteExtensionsEnabled(ctx) && collectSigs {
+		if IsVoteExtensionsEnabled(ctx) && collect {
 			for i, vote := range req.LocalLastCommit.Votes {
-				// Verify the signatures since they're not guaranteed to have passed VerifyVoteExtension.
-				if err := h.verifyBatchSignatures(ctx, batch.BatchNumber, batch.BatchId, vote.VoteExtension, vote.Validator.Address); err != nil {
+				// Verify the extensions since they're not guaranteed to have passed VerifyVoteExtension.
+				if err := h.verifyVoteExtension(ctx, batch, vote.VoteExtension, vote.Validator.Address); err != nil {
 					h.logger.Info(
 						"failed to validate vote extension - pruning vote",
 						"err", err,
 						"validator", vote.Validator.Address,
 					)
+					recordRejectedVoteExtension(PhasePrepareProposal, err)
 
 					// failed to validate this vote-extension, mark it as absent in the original commit
 					vote.BlockIdFlag = cmttypes.BlockIDFlagAbsent
@@ -231,7 +300,9 @@ func (h *Handlers) PrepareProposalHandler() sdk.PrepareProposalHandler {
 				return nil, err
 			}
 
-			injectionSize := int64(len(injection))
+			// Budget the injection against the block size limit in the
+			// same way as the transactions selected by the default handler.
+			injectionSize := cmtcoretypes.ComputeProtoSizeForTxs([]cmtcoretypes.Tx{injection})
 			if injectionSize > req.MaxTxBytes {
 				h.logger.Error(
 					"vote extension size exceeds block size limit",
@@ -268,13 +339,13 @@ func (h *Handlers) ProcessProposalHandler() sdk.ProcessProposalHandler {
 			return h.defaultProcessProposal(ctx, req)
 		}
 
-		batch, err := h.batchingKeeper.GetBatchForHeight(ctx, ctx.BlockHeight()+BlockOffsetCollectPhase)
+		batch, collect, err := h.getCollectPhase(ctx)
 		if err != nil {
-			if errors.Is(err, collections.ErrNotFound) {
-				return h.defaultProcessProposal(ctx, req)
-			}
 			return &abcitypes.ResponseProcessProposal{Status: abcitypes.ResponseProcessProposal_REJECT}, err
 		}
+		if !collect {
+			return h.defaultProcessProposal(ctx, req)
+		}
 
 		if len(req.Txs) == 0 {
 			h.logger.Error("proposal does not contain extended votes injection")
@@ -287,7 +358,7 @@ func (h *Handlers) ProcessProposalHandler() sdk.ProcessProposalHandler {
 			return &abcitypes.ResponseProcessProposal{Status: abcitypes.ResponseProcessProposal_REJECT}, err
 		}
 
-		// Validate vote extensions and batch signatures.
+		// Validate vote extensions, batch signatures, and observations.
 		err = ValidateVoteExtensions(ctx, h.stakingKeeper, extendedVotes)
 		if err != nil {
 			return &abcitypes.ResponseProcessProposal{Status: abcitypes.ResponseProcessProposal_REJECT}, err
@@ -296,9 +367,10 @@ func (h *Handlers) ProcessProposalHandler() sdk.ProcessProposalHandler {
 		for _, vote := range extendedVotes.Votes {
 			// Only consider extensions with pre-commit votes.
 			if vote.BlockIdFlag == cmttypes.BlockIDFlagCommit {
-				err = h.verifyBatchSignatures(ctx, batch.BatchNumber, batch.BatchId, vote.VoteExtension, vote.Validator.Address)
+				err = h.verifyVoteExtension(ctx, batch, vote.VoteExtension, vote.Validator.Address)
 				if err != nil {
 					h.logger.Error("proposal contains an invalid vote extension", "vote", vote)
+					recordRejectedVoteExtension(PhaseProcessProposal, err)
 					return &abcitypes.ResponseProcessProposal{Status: abcitypes.ResponseProcessProposal_REJECT}, err
 				}
 			}
@@ -310,8 +382,9 @@ func (h *Handlers) ProcessProposalHandler() sdk.ProcessProposalHandler {
 }
 
 // PreBlocker runs before BeginBlocker to extract the batch signatures
-// from the canonical set of vote extensions injected by the proposer
-// and store them.
+// and oracle observations from the canonical set of vote extensions
+// injected by the proposer. The batch signatures are stored, and the
+// observations are aggregated into median values.
 func (h *Handlers) PreBlocker() sdk.PreBlocker {
 	return func(ctx sdk.Context, req *abcitypes.RequestFinalizeBlock) (res *sdk.ResponsePreBlock, err error) {
 		res = new(sdk.ResponsePreBlock)
@@ -319,17 +392,20 @@ func (h *Handlers) PreBlocker() sdk.PreBlocker {
 			return res, nil
 		}
 
-		batch, err := h.batchingKeeper.GetBatchForHeight(ctx, ctx.BlockHeight()+BlockOffsetCollectPhase)
+		batch, collect, err := h.getCollectPhase(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !collect {
+			h.logger.Debug("no batch signatures or observations to collect", "height", ctx.BlockHeight())
+			return res, nil
+		}
+		oracleParams, err := h.getOracleParams(ctx)
 		if err != nil {
-			if errors.Is(err, collections.ErrNotFound) {
-				h.logger.Debug("no batch to collect signatures for", "height", ctx.BlockHeight())
-				return res, nil
-			}
 			return nil, err
 		}
-		batchNum := batch.BatchNumber
 
-		h.logger.Debug("begin pre-block logic for storing batch signatures", "batch_number", batchNum)
+		h.logger.Debug("begin pre-block logic for collecting vote extensions", "height", ctx.BlockHeight())
 
 		if len(req.Txs) == 0 {
 			h.logger.Error("proposal does not contain extended votes injection")
@@ -342,42 +418,163 @@ func (h *Handlers) PreBlocker() sdk.PreBlocker {
 			return nil, err
 		}
 
+		var totalPower int64
+		var valObservations []oracletypes.ValidatorObservations
 		for _, vote := range extendedVotes.Votes {
+			totalPower += vote.Validator.Power
+
 			// Skip votes that are absent (possibly pruned invalid votes)
 			// or have no vote extension (for new validators).
 			if vote.BlockIdFlag == cmttypes.BlockIDFlagAbsent || len(vote.VoteExtension) == 0 {
 				continue
 			}
 
-			validator, err := h.stakingKeeper.GetValidatorByConsAddr(ctx, vote.Validator.Address)
+			voteExt, err := DecodeVoteExtension(vote.VoteExtension)
 			if err != nil {
 				return nil, err
 			}
-			valAddr, err := h.validatorAddressCodec.StringToBytes(validator.OperatorAddress)
-			if err != nil {
-				return nil, err
+
+			if batch != nil && len(voteExt.BatchSignatures) != 0 {
+				validator, err := h.stakingKeeper.GetValidatorByConsAddr(ctx, vote.Validator.Address)
+				if err != nil {
+					return nil, err
+				}
+				valAddr, err := h.validatorAddressCodec.StringToBytes(validator.OperatorAddress)
+				if err != nil {
+					return nil, err
+				}
+				signature, err := voteExt.GetBatchSignature(sedatypes.SEDAKeyIndexSecp256k1)
+				if err != nil {
+					return nil, err
+				}
+				err = h.batchingKeeper.SetBatchSigSecp256k1(ctx, batch.BatchNumber, valAddr, signature)
+				if err != nil {
+					return nil, err
+				}
+				h.logger.Debug("stored batch signature", "batch_number", batch.BatchNumber, "validator", validator.OperatorAddress)
 			}
-			err = h.batchingKeeper.SetBatchSigSecp256k1(ctx, batchNum, valAddr, vote.VoteExtension)
+
+			if payload, ok := voteExt.GetPayload(oracletypes.VoteExtensionPayloadType); ok && oracleParams.IsActive() {
+				observations, err := h.oracleKeeper.ValidateObservations(ctx, payload)
+				if err != nil {
+					return nil, err
+				}
+				valObservations = append(valObservations, oracletypes.ValidatorObservations{
+					Power:        vote.Validator.Power,
+					Observations: observations.Observations,
+				})
+			}
+		}
+
+		if oracleParams.IsActive() {
+			err = h.oracleKeeper.UpdateMedianValues(ctx, totalPower, valObservations)
 			if err != nil {
 				return nil, err
 			}
-			h.logger.Debug("stored batch signature", "batch_number", batchNum, "validator", validator.OperatorAddress)
 		}
-
 		return res, nil
 	}
 }
 
-// verifyBatchSignature verifies the given signature of the batch ID
-// against the validator's public key registered at the key index
-// in the pubkey module. It returns an error unless the verification
-// succeeds.
-func (h *Handlers) verifyBatchSignatures(ctx sdk.Context, batchNum uint64, batchID, voteExtension, consAddr []byte) error {
-	if len(voteExtension) > MaxVoteExtensionLength {
-		h.logger.Error("vote extension exceeds max length", "len", len(voteExtension))
-		return ErrVoteExtensionTooLong
+// getBatchForPhase returns the batch created at the height corresponding
+// to the given phase offset or nil if there is no such batch.
+func (h *Handlers) getBatchForPhase(ctx sdk.Context, offset int64) (*batchingtypes.Batch, error) {
+	batch, err := h.batchingKeeper.GetBatchForHeight(ctx, ctx.BlockHeight()+offset)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &batch, nil
+}
+
+// getCollectPhase returns the batch whose signatures must be collected
+// at the current height, if any, and whether vote extensions must be
+// collected at all, which is the case if there is such a batch or if
+// the oracle is active.
+func (h *Handlers) getCollectPhase(ctx sdk.Context) (*batchingtypes.Batch, bool, error) {
+	batch, err := h.getBatchForPhase(ctx, BlockOffsetCollectPhase)
+	if err != nil {
+		return nil, false, err
+	}
+	oracleParams, err := h.getOracleParams(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return batch, batch != nil || oracleParams.IsActive(), nil
+}
+
+// getOracleParams returns the oracle module parameters. The oracle is
+// considered inactive if its parameters have not been initialized, which
+// is the case on a chain that has not run the upgrade adding the module.
+func (h *Handlers) getOracleParams(ctx sdk.Context) (oracletypes.Params, error) {
+	params, err := h.oracleKeeper.GetParams(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return oracletypes.Params{}, nil
+	}
+	return params, err
+}
+
+// verifyVoteExtension checks the size of the given vote extension
+// against the governance-set limit and decodes it before verifying its
+// batch signature if there is a batch to sign and validating its oracle
+// observations, if any. A vote extension must not carry a batch
+// signature if there is no batch to sign.
+func (h *Handlers) verifyVoteExtension(ctx sdk.Context, batch *batchingtypes.Batch, voteExtension, consAddr []byte) error {
+	maxSize, err := h.getMaxVoteExtensionSize(ctx)
+	if err != nil {
+		return err
+	}
+	if len(voteExtension) > maxSize {
+		h.logger.Error("vote extension exceeds max length", "len", len(voteExtension), "max", maxSize)
+		return ErrVoteExtensionTooLong.Wrapf("%d > %d", len(voteExtension), maxSize)
+	}
+
+	// Reject malformed vote extensions before any signature verification.
+	var voteExt VoteExtension
+	if len(voteExtension) != 0 {
+		voteExt, err = DecodeVoteExtension(voteExtension)
+		if err != nil {
+			return err
+		}
 	}
 
+	if batch != nil {
+		err = h.verifyBatchSignatures(ctx, batch.BatchNumber, batch.BatchId, voteExt, consAddr)
+		if err != nil {
+			return err
+		}
+	} else if len(voteExt.BatchSignatures) != 0 {
+		return ErrUnexpectedBatchSignature
+	}
+
+	if payload, ok := voteExt.GetPayload(oracletypes.VoteExtensionPayloadType); ok {
+		if _, err := h.oracleKeeper.ValidateObservations(ctx, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getMaxVoteExtensionSize returns the maximum size of a vote extension
+// in bytes set in the batching module parameters.
+func (h *Handlers) getMaxVoteExtensionSize(ctx sdk.Context) (int, error) {
+	params, err := h.batchingKeeper.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if params.MaxVoteExtensionSize == 0 {
+		return MaxVoteExtensionLength, nil
+	}
+	return int(params.MaxVoteExtensionSize), nil
+}
+
+// verifyBatchSignature verifies the signature of the batch ID in the
+// given vote extension against the validator's public key registered
+// at the key index in the pubkey module. It returns an error unless the
+// verification succeeds.
+func (h *Handlers) verifyBatchSignatures(ctx sdk.Context, batchNum uint64, batchID []byte, voteExt VoteExtension, consAddr []byte) error {
 	validator, err := h.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
 	if err != nil {
 		return err
@@ -402,22 +599,43 @@ func (h *Handlers) verifyBatchSignatures(ctx sdk.Context, batchNum uint64, batch
 		valEntry, err := h.batchingKeeper.GetValidatorTreeEntry(ctx, batchNum-1, valOper)
 		if err != nil {
 			if errors.Is(err, collections.ErrNotFound) {
-				if len(voteExtension) == 0 {
-					return nil
+				if len(voteExt.BatchSignatures) != 0 {
+					return ErrUnexpectedBatchSignature
 				}
-				return ErrUnexpectedBatchSignature
+				return nil
 			}
 			return err
 		}
 		expectedAddr = valEntry.EthAddress
 	}
 
-	if len(voteExtension) < MinVoteExtensionLength {
-		h.logger.Error("vote extension is too short", "len", len(voteExtension))
-		return ErrVoteExtensionTooShort
+	signature, err := voteExt.GetBatchSignature(sedatypes.SEDAKeyIndexSecp256k1)
+	if err != nil {
+		return err
+	}
+	if len(signature) != Secp256k1SignatureLength {
+		return ErrInvalidBatchSignature
+	}
+
+	sigAddr, err := h.recoverBatchSigner(batchID, consAddr, signature)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(expectedAddr, sigAddr) {
+		return ErrInvalidBatchSignature
+	}
+	return nil
+}
+
+// recoverBatchSigner returns the Ethereum address of the signer of the
+// given batch signature. Recovered addresses are cached so that the
+// recovery of a given signature is performed only once across the ABCI
+// phases.
+func (h *Handlers) recoverBatchSigner(batchID, consAddr, signature []byte) ([]byte, error) {
+	if sigAddr, ok := h.sigCache.Get(batchID, consAddr, signature); ok {
+		return sigAddr, nil
 	}
 
-	signature := voteExtension[:65]
 	r := new(big.Int).SetBytes(signature[:32])
 	s := new(big.Int).SetBytes(signature[32:64])
 	v := signature[64]
@@ -425,20 +643,18 @@ func (h *Handlers) verifyBatchSignatures(ctx sdk.Context, batchNum uint64, batch
 	// We require the signature to be in the low-s form, which is the default for
 	// the secp256k1 library used to sign the batch ID.
 	if !crypto.ValidateSignatureValues(v, r, s, true) {
-		return ErrInvalidBatchSignature
+		return nil, ErrInvalidBatchSignature
 	}
 
 	sigPubKey, err := crypto.Ecrecover(batchID, signature)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	sigAddr, err := utils.PubKeyToEthAddress(sigPubKey)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if !bytes.Equal(expectedAddr, sigAddr) {
-		return ErrInvalidBatchSignature
-	}
-	return nil
+	h.sigCache.Add(batchID, consAddr, signature, sigAddr)
+	return sigAddr, nil
 }
@@ -2,6 +2,7 @@ package abci_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -26,6 +27,7 @@ import (
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -40,6 +42,7 @@ import (
 	"github.com/sedaprotocol/seda-chain/app/utils"
 	sedatypes "github.com/sedaprotocol/seda-chain/types"
 	batchingtypes "github.com/sedaprotocol/seda-chain/x/batching/types"
+	oracletypes "github.com/sedaprotocol/seda-chain/x/oracle/types"
 	pubkeytypes "github.com/sedaprotocol/seda-chain/x/pubkey/types"
 )
 
@@ -52,6 +55,7 @@ type testValidator struct {
 	privKey     cmtsecp256k1.PrivKey
 	isNew       bool
 	signer      utils.SEDASigner
+	observer    *staticObserver
 	handlers    *abci.Handlers
 	ethAddr     []byte
 	sedaPubKeys []pubkeytypes.IndexedPubKey
@@ -91,7 +95,16 @@ type ABCITestSuite struct {
 	mockBatchingKeeper *testutil.MockBatchingKeeper
 	mockPubKeyKeeper   *testutil.MockPubKeyKeeper
 	mockStakingKeeper  *testutil.MockStakingKeeper
+	mockOracleKeeper   *testutil.MockOracleKeeper
 	mockTxVerifier     *testutil.MockTxVerifier
+
+	// batchingParams and oracleParams are the parameters returned by
+	// the mock batching and oracle keepers.
+	batchingParams batchingtypes.Params
+	oracleParams   oracletypes.Params
+	// oracleParamsErr is the error returned by the mock oracle keeper
+	// when the parameters are requested.
+	oracleParamsErr error
 }
 
 func (s *ABCITestSuite) SetupSuite() {
@@ -130,6 +143,13 @@ func (s *ABCITestSuite) SetupTest(mockBatchNumber uint64, isNewValidator []bool)
 		if isNewValidator != nil && isNewValidator[i] {
 			vals[i].isNew = true
 		}
+
+		// Each validator observes a slightly different value.
+		vals[i].observer = &staticObserver{
+			values: map[string]math.LegacyDec{
+				testFeedID: math.LegacyNewDec(100 + int64(i)),
+			},
+		}
 	}
 
 	hasher := sha3.NewLegacyKeccak256()
@@ -165,7 +185,26 @@ func (s *ABCITestSuite) SetupTest(mockBatchNumber uint64, isNewValidator []bool)
 	mockBatchingKeeper := testutil.NewMockBatchingKeeper(ctrl)
 	mockPubKeyKeeper := testutil.NewMockPubKeyKeeper(ctrl)
 	mockStakingKeeper := testutil.NewMockStakingKeeper(ctrl)
+	mockOracleKeeper := testutil.NewMockOracleKeeper(ctrl)
+
+	// The oracle is inactive unless a test sets the oracle parameters.
+	s.oracleParams = oracletypes.DefaultParams()
+	s.oracleParamsErr = nil
+	mockOracleKeeper.EXPECT().GetParams(gomock.Any()).DoAndReturn(func(_ context.Context) (oracletypes.Params, error) {
+		return s.oracleParams, s.oracleParamsErr
+	}).AnyTimes()
+	mockOracleKeeper.EXPECT().ValidateObservations(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, payload []byte) (oracletypes.Observations, error) {
+		var observations oracletypes.Observations
+		if err := observations.Unmarshal(payload); err != nil {
+			return oracletypes.Observations{}, err
+		}
+		return observations, observations.Validate(s.oracleParams)
+	}).AnyTimes()
 
+	s.batchingParams = batchingtypes.DefaultParams()
+	mockBatchingKeeper.EXPECT().GetParams(gomock.Any()).DoAndReturn(func(_ sdk.Context) (batchingtypes.Params, error) {
+		return s.batchingParams, nil
+	}).AnyTimes()
 	mockBatchingKeeper.EXPECT().GetBatchForHeight(gomock.Any(), mockBatch.BlockHeight).Return(mockBatch, nil).AnyTimes()
 	mockBatchingKeeper.EXPECT().GetBatchForHeight(gomock.Any(), mockBatch.BlockHeight+1).Return(batchingtypes.Batch{}, collections.ErrNotFound).AnyTimes()
 	for i, val := range s.vals {
@@ -190,6 +229,7 @@ func (s *ABCITestSuite) SetupTest(mockBatchNumber uint64, isNewValidator []bool)
 	s.mockBatchingKeeper = mockBatchingKeeper
 	s.mockPubKeyKeeper = mockPubKeyKeeper
 	s.mockStakingKeeper = mockStakingKeeper
+	s.mockOracleKeeper = mockOracleKeeper
 	s.mockTxVerifier = testutil.NewMockTxVerifier(ctrl)
 
 	s.mockTxVerifier.EXPECT().ProcessProposalVerifyTx(testutil.ValidTx).Return(testutil.NewMockTx(100), nil).AnyTimes()
@@ -207,8 +247,11 @@ func (s *ABCITestSuite) SetupTest(mockBatchNumber uint64, isNewValidator []bool)
 			mockBatchingKeeper,
 			mockPubKeyKeeper,
 			mockStakingKeeper,
+			mockOracleKeeper,
 			authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
 			val.signer,
+			utils.NewSignerHealth(utils.DefaultSignerHealthHistorySize),
+			val.observer,
 			logger,
 		)
 	}
@@ -297,7 +340,7 @@ func (s *ABCITestSuite) mockExtendedCommitInfo() (abcitypes.ExtendedCommitInfo,
 
 		cve := cmtproto.CanonicalVoteExtension{
 			Extension: val.voteExt,
-			Height:    101,
+			Height:    s.ctx.BlockHeight() - 1,
 			Round:     int64(0),
 			ChainId:   chainID,
 		}
@@ -376,6 +419,28 @@ func (v extendedVoteInfos) Swap(i, j int) {
 	v[i], v[j] = v[j], v[i]
 }
 
+func mustMarshalVoteExtension(ve abci.VoteExtension) []byte {
+	bz, err := ve.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// mustGetSecp256k1Signature returns the secp256k1 batch signature
+// carried in the given vote extension.
+func mustGetSecp256k1Signature(voteExt []byte) []byte {
+	ve, err := abci.DecodeVoteExtension(voteExt)
+	if err != nil {
+		panic(err)
+	}
+	sig, err := ve.GetBatchSignature(sedatypes.SEDAKeyIndexSecp256k1)
+	if err != nil {
+		panic(err)
+	}
+	return sig
+}
+
 func mustDecodeBase64(s string) []byte {
 	b, err := base64.StdEncoding.DecodeString(s)
 	if err != nil {
@@ -383,3 +448,20 @@ func mustDecodeBase64(s string) []byte {
 	}
 	return b
 }
+
+const testFeedID = "seda-usd"
+
+// staticObserver is an oracle observer that reports fixed values.
+type staticObserver struct {
+	values map[string]math.LegacyDec
+}
+
+func (o *staticObserver) Observe(_ context.Context, feedIDs []string) []oracletypes.Observation {
+	var observations []oracletypes.Observation
+	for _, feedID := range feedIDs {
+		if value, ok := o.values[feedID]; ok {
+			observations = append(observations, oracletypes.Observation{FeedId: feedID, Value: value})
+		}
+	}
+	return observations
+}
@@ -0,0 +1,101 @@
+package abci
+
+import (
+	"crypto/sha256"
+	"sync"
+)
+
+// DefaultSignatureCacheSize is the number of recovered batch signers
+// kept by the signature cache. It accommodates the signatures of a few
+// consecutive batches of a large validator set.
+const DefaultSignatureCacheSize = 4096
+
+// signatureCacheKey identifies a batch signature of a validator.
+type signatureCacheKey struct {
+	batchID  string
+	consAddr string
+	sigHash  [sha256.Size]byte
+}
+
+// SignatureCache is a bounded, node-local cache of the Ethereum addresses
+// recovered from batch signatures, which saves repeating the public key
+// recovery of a signature when the same vote extension is verified in
+// several ABCI phases. Since only the recovery result is cached, the
+// comparison against the expected signer is still performed against the
+// current state. The oldest entries are evicted first. It is safe for
+// concurrent use, and a nil cache caches nothing.
+type SignatureCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[signatureCacheKey][]byte
+	keys    []signatureCacheKey
+	next    int
+}
+
+// NewSignatureCache returns a signature cache that holds up to size
+// entries.
+func NewSignatureCache(size int) *SignatureCache {
+	if size <= 0 {
+		size = DefaultSignatureCacheSize
+	}
+	return &SignatureCache{
+		size:    size,
+		entries: make(map[signatureCacheKey][]byte, size),
+		keys:    make([]signatureCacheKey, 0, size),
+	}
+}
+
+func newSignatureCacheKey(batchID, consAddr, signature []byte) signatureCacheKey {
+	return signatureCacheKey{
+		batchID:  string(batchID),
+		consAddr: string(consAddr),
+		sigHash:  sha256.Sum256(signature),
+	}
+}
+
+// Get returns the signer address recovered from the given signature of
+// the batch by the validator with the given consensus address, if
+// cached.
+func (c *SignatureCache) Get(batchID, consAddr, signature []byte) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	addr, ok := c.entries[newSignatureCacheKey(batchID, consAddr, signature)]
+	return addr, ok
+}
+
+// Add caches the signer address recovered from the given signature of
+// the batch by the validator with the given consensus address.
+func (c *SignatureCache) Add(batchID, consAddr, signature, signerAddr []byte) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := newSignatureCacheKey(batchID, consAddr, signature)
+	if _, ok := c.entries[key]; ok {
+		return
+	}
+	if len(c.keys) < c.size {
+		c.keys = append(c.keys, key)
+	} else {
+		delete(c.entries, c.keys[c.next])
+		c.keys[c.next] = key
+		c.next = (c.next + 1) % c.size
+	}
+	c.entries[key] = signerAddr
+}
+
+// Len returns the number of cached entries.
+func (c *SignatureCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
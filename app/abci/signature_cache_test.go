@@ -0,0 +1,44 @@
+package abci_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sedaprotocol/seda-chain/app/abci"
+)
+
+func TestSignatureCache(t *testing.T) {
+	cache := abci.NewSignatureCache(2)
+	batchID := []byte("batch")
+
+	cache.Add(batchID, []byte("val1"), []byte("sig1"), []byte("addr1"))
+	cache.Add(batchID, []byte("val2"), []byte("sig2"), []byte("addr2"))
+
+	addr, found := cache.Get(batchID, []byte("val1"), []byte("sig1"))
+	require.True(t, found)
+	require.Equal(t, []byte("addr1"), addr)
+
+	// The key includes the batch ID, the consensus address, and the signature.
+	_, found = cache.Get([]byte("other"), []byte("val1"), []byte("sig1"))
+	require.False(t, found)
+	_, found = cache.Get(batchID, []byte("val2"), []byte("sig1"))
+	require.False(t, found)
+	_, found = cache.Get(batchID, []byte("val1"), []byte("sig2"))
+	require.False(t, found)
+
+	// The oldest entry is evicted once the cache is full.
+	cache.Add(batchID, []byte("val3"), []byte("sig3"), []byte("addr3"))
+	require.Equal(t, 2, cache.Len())
+	_, found = cache.Get(batchID, []byte("val1"), []byte("sig1"))
+	require.False(t, found)
+	addr, found = cache.Get(batchID, []byte("val3"), []byte("sig3"))
+	require.True(t, found)
+	require.Equal(t, []byte("addr3"), addr)
+
+	// A nil cache caches nothing.
+	var nilCache *abci.SignatureCache
+	nilCache.Add(batchID, []byte("val1"), []byte("sig1"), []byte("addr1"))
+	_, found = nilCache.Get(batchID, []byte("val1"), []byte("sig1"))
+	require.False(t, found)
+}
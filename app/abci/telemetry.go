@@ -0,0 +1,53 @@
+package abci
+
+import (
+	"errors"
+
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	oracletypes "github.com/sedaprotocol/seda-chain/x/oracle/types"
+)
+
+const (
+	TelemetryKeyVoteExtensionRejected = "seda_vote_extension_rejected"
+
+	TelemetryLabelPhase  = "phase"
+	TelemetryLabelReason = "reason"
+
+	// Phases in which vote extensions are rejected.
+	PhaseVerifyVoteExtension = "verify_vote_extension"
+	PhasePrepareProposal     = "prepare_proposal"
+	PhaseProcessProposal     = "process_proposal"
+)
+
+// recordRejectedVoteExtension increments the counter of vote extensions
+// rejected in the given phase, labeled with the reason of rejection.
+func recordRejectedVoteExtension(phase string, err error) {
+	telemetry.IncrCounterWithLabels(
+		[]string{TelemetryKeyVoteExtensionRejected},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(TelemetryLabelPhase, phase),
+			telemetry.NewLabel(TelemetryLabelReason, rejectionReason(err)),
+		},
+	)
+}
+
+// rejectionReason returns a low-cardinality description of the reason
+// for rejecting a vote extension.
+func rejectionReason(err error) string {
+	switch {
+	case errors.Is(err, ErrVoteExtensionTooLong):
+		return "too_long"
+	case errors.Is(err, ErrInvalidVoteExtension), errors.Is(err, ErrUnsupportedVoteExtensionVersion):
+		return "malformed"
+	case errors.Is(err, ErrInvalidBatchSignature), errors.Is(err, ErrMissingBatchSignature), errors.Is(err, ErrUnexpectedBatchSignature):
+		return "invalid_batch_signature"
+	case errors.Is(err, oracletypes.ErrInvalidObservations), errors.Is(err, oracletypes.ErrInactive):
+		return "invalid_observations"
+	default:
+		return "other"
+	}
+}
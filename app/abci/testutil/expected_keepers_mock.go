@@ -18,7 +18,8 @@ import (
 	types0 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	types1 "github.com/sedaprotocol/seda-chain/types"
 	types2 "github.com/sedaprotocol/seda-chain/x/batching/types"
-	types3 "github.com/sedaprotocol/seda-chain/x/pubkey/types"
+	types3 "github.com/sedaprotocol/seda-chain/x/oracle/types"
+	types4 "github.com/sedaprotocol/seda-chain/x/pubkey/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -61,6 +62,21 @@ func (mr *MockBatchingKeeperMockRecorder) GetBatchForHeight(ctx, height any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBatchForHeight", reflect.TypeOf((*MockBatchingKeeper)(nil).GetBatchForHeight), ctx, height)
 }
 
+// GetParams mocks base method.
+func (m *MockBatchingKeeper) GetParams(ctx types.Context) (types2.Params, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", ctx)
+	ret0, _ := ret[0].(types2.Params)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockBatchingKeeperMockRecorder) GetParams(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockBatchingKeeper)(nil).GetParams), ctx)
+}
+
 // GetValidatorTreeEntry mocks base method.
 func (m *MockBatchingKeeper) GetValidatorTreeEntry(ctx context.Context, batchNum uint64, valAddr types.ValAddress) (types2.ValidatorTreeEntry, error) {
 	m.ctrl.T.Helper()
@@ -130,10 +146,10 @@ func (mr *MockPubKeyKeeperMockRecorder) GetValidatorKeyAtIndex(ctx, validatorAdd
 }
 
 // GetValidatorKeys mocks base method.
-func (m *MockPubKeyKeeper) GetValidatorKeys(ctx context.Context, validatorAddr string) (types3.ValidatorPubKeys, error) {
+func (m *MockPubKeyKeeper) GetValidatorKeys(ctx context.Context, validatorAddr string) (types4.ValidatorPubKeys, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidatorKeys", ctx, validatorAddr)
-	ret0, _ := ret[0].(types3.ValidatorPubKeys)
+	ret0, _ := ret[0].(types4.ValidatorPubKeys)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -212,3 +228,109 @@ func (mr *MockStakingKeeperMockRecorder) GetValidatorByConsAddr(ctx, consAddr an
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidatorByConsAddr), ctx, consAddr)
 }
+
+// MockOracleKeeper is a mock of OracleKeeper interface.
+type MockOracleKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockOracleKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockOracleKeeperMockRecorder is the mock recorder for MockOracleKeeper.
+type MockOracleKeeperMockRecorder struct {
+	mock *MockOracleKeeper
+}
+
+// NewMockOracleKeeper creates a new mock instance.
+func NewMockOracleKeeper(ctrl *gomock.Controller) *MockOracleKeeper {
+	mock := &MockOracleKeeper{ctrl: ctrl}
+	mock.recorder = &MockOracleKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOracleKeeper) EXPECT() *MockOracleKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetParams mocks base method.
+func (m *MockOracleKeeper) GetParams(ctx context.Context) (types3.Params, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", ctx)
+	ret0, _ := ret[0].(types3.Params)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockOracleKeeperMockRecorder) GetParams(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockOracleKeeper)(nil).GetParams), ctx)
+}
+
+// UpdateMedianValues mocks base method.
+func (m *MockOracleKeeper) UpdateMedianValues(ctx context.Context, totalPower int64, valObservations []types3.ValidatorObservations) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMedianValues", ctx, totalPower, valObservations)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMedianValues indicates an expected call of UpdateMedianValues.
+func (mr *MockOracleKeeperMockRecorder) UpdateMedianValues(ctx, totalPower, valObservations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMedianValues", reflect.TypeOf((*MockOracleKeeper)(nil).UpdateMedianValues), ctx, totalPower, valObservations)
+}
+
+// ValidateObservations mocks base method.
+func (m *MockOracleKeeper) ValidateObservations(ctx context.Context, payload []byte) (types3.Observations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateObservations", ctx, payload)
+	ret0, _ := ret[0].(types3.Observations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateObservations indicates an expected call of ValidateObservations.
+func (mr *MockOracleKeeperMockRecorder) ValidateObservations(ctx, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateObservations", reflect.TypeOf((*MockOracleKeeper)(nil).ValidateObservations), ctx, payload)
+}
+
+// MockObserver is a mock of Observer interface.
+type MockObserver struct {
+	ctrl     *gomock.Controller
+	recorder *MockObserverMockRecorder
+	isgomock struct{}
+}
+
+// MockObserverMockRecorder is the mock recorder for MockObserver.
+type MockObserverMockRecorder struct {
+	mock *MockObserver
+}
+
+// NewMockObserver creates a new mock instance.
+func NewMockObserver(ctrl *gomock.Controller) *MockObserver {
+	mock := &MockObserver{ctrl: ctrl}
+	mock.recorder = &MockObserverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObserver) EXPECT() *MockObserverMockRecorder {
+	return m.recorder
+}
+
+// Observe mocks base method.
+func (m *MockObserver) Observe(ctx context.Context, feedIDs []string) []types3.Observation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Observe", ctx, feedIDs)
+	ret0, _ := ret[0].([]types3.Observation)
+	return ret0
+}
+
+// Observe indicates an expected call of Observe.
+func (mr *MockObserverMockRecorder) Observe(ctx, feedIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Observe", reflect.TypeOf((*MockObserver)(nil).Observe), ctx, feedIDs)
+}
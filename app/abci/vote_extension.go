@@ -0,0 +1,113 @@
+package abci
+
+import sedatypes "github.com/sedaprotocol/seda-chain/types"
+
+const (
+	// VoteExtensionVersion1 is the first version of the protobuf-encoded
+	// vote extension envelope.
+	VoteExtensionVersion1 uint32 = 1
+	// CurrentVoteExtensionVersion is the version of the vote extension
+	// envelope produced by ExtendVote.
+	CurrentVoteExtensionVersion = VoteExtensionVersion1
+
+	// Secp256k1SignatureLength is the length of a recoverable secp256k1
+	// signature in bytes.
+	Secp256k1SignatureLength = 65
+	// LegacyVoteExtensionLength is the length of the legacy vote extension
+	// format, which consists solely of a raw secp256k1 signature. It is
+	// accepted for backwards compatibility during an upgrade window.
+	LegacyVoteExtensionLength = Secp256k1SignatureLength
+)
+
+// NewVoteExtension returns a vote extension envelope of the current
+// version carrying the given batch signatures.
+func NewVoteExtension(sigs ...BatchSignature) VoteExtension {
+	return VoteExtension{
+		Version:         CurrentVoteExtensionVersion,
+		BatchSignatures: sigs,
+	}
+}
+
+// EncodeVoteExtension validates and encodes the given vote extension
+// envelope.
+func EncodeVoteExtension(ve VoteExtension) ([]byte, error) {
+	if err := ve.Validate(); err != nil {
+		return nil, err
+	}
+	return ve.Marshal()
+}
+
+// DecodeVoteExtension decodes a vote extension envelope. Since a valid
+// envelope may also be of the legacy length, an extension of the legacy
+// length is interpreted as a raw secp256k1 signature only if it is not a
+// valid envelope. It is then returned as a version 1 envelope.
+func DecodeVoteExtension(bz []byte) (VoteExtension, error) {
+	ve, err := decodeVoteExtensionEnvelope(bz)
+	if err != nil && len(bz) == LegacyVoteExtensionLength {
+		return NewVoteExtension(BatchSignature{
+			KeyIndex:  uint32(sedatypes.SEDAKeyIndexSecp256k1),
+			Signature: bz,
+		}), nil
+	}
+	return ve, err
+}
+
+func decodeVoteExtensionEnvelope(bz []byte) (VoteExtension, error) {
+	var ve VoteExtension
+	if err := ve.Unmarshal(bz); err != nil {
+		return VoteExtension{}, ErrInvalidVoteExtension.Wrap(err.Error())
+	}
+	if err := ve.Validate(); err != nil {
+		return VoteExtension{}, err
+	}
+	return ve, nil
+}
+
+// Validate performs basic validation of the vote extension envelope.
+func (ve VoteExtension) Validate() error {
+	if ve.Version != VoteExtensionVersion1 {
+		return ErrUnsupportedVoteExtensionVersion.Wrapf("version %d", ve.Version)
+	}
+
+	seen := make(map[uint32]struct{}, len(ve.BatchSignatures))
+	for _, sig := range ve.BatchSignatures {
+		if _, ok := seen[sig.KeyIndex]; ok {
+			return ErrInvalidVoteExtension.Wrapf("duplicate signature for key index %d", sig.KeyIndex)
+		}
+		seen[sig.KeyIndex] = struct{}{}
+	}
+
+	payloadTypes := make(map[string]struct{}, len(ve.Payloads))
+	for _, payload := range ve.Payloads {
+		if payload.Type == "" {
+			return ErrInvalidVoteExtension.Wrap("empty payload type")
+		}
+		if _, ok := payloadTypes[payload.Type]; ok {
+			return ErrInvalidVoteExtension.Wrapf("duplicate payload of type %s", payload.Type)
+		}
+		payloadTypes[payload.Type] = struct{}{}
+	}
+	return nil
+}
+
+// GetBatchSignature returns the batch signature for the given proving
+// scheme or an error if the vote extension does not contain one.
+func (ve VoteExtension) GetBatchSignature(index sedatypes.SEDAKeyIndex) ([]byte, error) {
+	for _, sig := range ve.BatchSignatures {
+		if sig.KeyIndex == uint32(index) {
+			return sig.Signature, nil
+		}
+	}
+	return nil, ErrMissingBatchSignature.Wrapf("key index %s", index)
+}
+
+// GetPayload returns the data of the payload of the given type and
+// whether it was found.
+func (ve VoteExtension) GetPayload(payloadType string) ([]byte, bool) {
+	for _, payload := range ve.Payloads {
+		if payload.Type == payloadType {
+			return payload.Data, true
+		}
+	}
+	return nil, false
+}
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	gomath "math"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
@@ -13,9 +15,13 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 
 	"github.com/sedaprotocol/seda-chain/app/abci"
 	"github.com/sedaprotocol/seda-chain/app/abci/testutil"
+	sedatypes "github.com/sedaprotocol/seda-chain/types"
+	batchingtypes "github.com/sedaprotocol/seda-chain/x/batching/types"
+	oracletypes "github.com/sedaprotocol/seda-chain/x/oracle/types"
 )
 
 func TestABCITestSuite(t *testing.T) {
@@ -28,6 +34,8 @@ func (s *ABCITestSuite) TestABCIHandlersVerifyVoteExtensionHandler() {
 		mockBatchNumber    uint64
 		isNewValidator     []bool
 		heightWithoutBatch bool
+		legacyVoteExt      bool
+		maxVoteExtSize     uint64
 		reqVoteExt         *abcitypes.RequestVerifyVoteExtension
 		expectedErr        string
 		shouldReject       bool
@@ -49,7 +57,21 @@ func (s *ABCITestSuite) TestABCIHandlersVerifyVoteExtensionHandler() {
 			name:            "new batch + short signature",
 			mockBatchNumber: 100,
 			reqVoteExt:      &abcitypes.RequestVerifyVoteExtension{VoteExtension: []byte("invalid")},
-			expectedErr:     "vote extension is too short",
+			expectedErr:     "invalid vote extension",
+			shouldReject:    true,
+		},
+		{
+			name:            "new batch + empty vote extension",
+			mockBatchNumber: 100,
+			reqVoteExt:      &abcitypes.RequestVerifyVoteExtension{VoteExtension: []byte{}},
+			expectedErr:     "vote extension is missing batch signature",
+			shouldReject:    true,
+		},
+		{
+			name:            "new batch + vote extension exceeding governance limit",
+			mockBatchNumber: 100,
+			maxVoteExtSize:  abci.LegacyVoteExtensionLength,
+			expectedErr:     "vote extension exceeds max length",
 			shouldReject:    true,
 		},
 		{
@@ -62,10 +84,42 @@ func (s *ABCITestSuite) TestABCIHandlersVerifyVoteExtensionHandler() {
 		{
 			name:            "new batch + invalid signature",
 			mockBatchNumber: 100,
-			reqVoteExt:      &abcitypes.RequestVerifyVoteExtension{VoteExtension: make([]byte, abci.MaxVoteExtensionLength)},
+			reqVoteExt:      &abcitypes.RequestVerifyVoteExtension{VoteExtension: make([]byte, abci.LegacyVoteExtensionLength)},
 			expectedErr:     "batch signature is invalid",
 			shouldReject:    true,
 		},
+		{
+			name:            "new batch + malformed vote extension",
+			mockBatchNumber: 100,
+			reqVoteExt:      &abcitypes.RequestVerifyVoteExtension{VoteExtension: make([]byte, abci.MaxVoteExtensionLength)},
+			expectedErr:     "invalid vote extension",
+			shouldReject:    true,
+		},
+		{
+			name:            "new batch + unsupported vote extension version",
+			mockBatchNumber: 100,
+			reqVoteExt: &abcitypes.RequestVerifyVoteExtension{VoteExtension: mustMarshalVoteExtension(abci.VoteExtension{
+				Version:         2,
+				BatchSignatures: []abci.BatchSignature{{KeyIndex: 0, Signature: make([]byte, 65)}},
+			})},
+			expectedErr:  "unsupported vote extension version",
+			shouldReject: true,
+		},
+		{
+			name:            "new batch + missing secp256k1 signature",
+			mockBatchNumber: 100,
+			reqVoteExt: &abcitypes.RequestVerifyVoteExtension{VoteExtension: mustMarshalVoteExtension(abci.VoteExtension{
+				Version:         abci.VoteExtensionVersion1,
+				BatchSignatures: []abci.BatchSignature{{KeyIndex: 1, Signature: make([]byte, 65)}},
+			})},
+			expectedErr:  "vote extension is missing batch signature",
+			shouldReject: true,
+		},
+		{
+			name:            "new batch + valid legacy signature",
+			mockBatchNumber: 100,
+			legacyVoteExt:   true,
+		},
 		{
 			name:               "no batch + signature",
 			heightWithoutBatch: true,
@@ -82,9 +136,16 @@ func (s *ABCITestSuite) TestABCIHandlersVerifyVoteExtensionHandler() {
 				s.incrementBlockHeight()
 			}
 
+			if tc.maxVoteExtSize != 0 {
+				s.batchingParams.MaxVoteExtensionSize = tc.maxVoteExtSize
+			}
+
 			// Validator 0 extends the vote.
 			if tc.reqVoteExt == nil {
 				s.validatorVotes(&s.vals[0])
+				if tc.legacyVoteExt {
+					s.vals[0].voteExt = mustGetSecp256k1Signature(s.vals[0].voteExt)
+				}
 			} else {
 				s.vals[0].voteExt = tc.reqVoteExt.VoteExtension
 			}
@@ -119,6 +180,7 @@ type honestTestCase struct {
 	// application and bypassing the VerifyVoteExtensionHandler.
 	voteExts           []*abcitypes.ResponseExtendVote
 	additionalTxBytes  [][]byte
+	maxTxBytes         int64
 	expectedPrepareErr string
 }
 
@@ -202,11 +264,22 @@ func (s *ABCITestSuite) TestABCIHandlersHonestProposer() {
 			voteExts:           []*abcitypes.ResponseExtendVote{nil, nil, {VoteExtension: []byte("this is not a valid vote extension")}},
 			expectedPrepareErr: "insufficient cumulative voting power received to verify vote extensions;",
 		},
+		{
+			name:               "injection exceeds block size limit",
+			mockBatchNumber:    100,
+			maxTxBytes:         512,
+			expectedPrepareErr: "injected vote extensions are too big",
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
 			s.SetupTest(tc.mockBatchNumber, tc.isNewValidator)
 
+			maxTxBytes := tc.maxTxBytes
+			if maxTxBytes == 0 {
+				maxTxBytes = 22020096
+			}
+
 			s.incrementBlockHeight()
 			if tc.heightWithoutBatch {
 				s.incrementBlockHeight()
@@ -248,7 +321,7 @@ func (s *ABCITestSuite) TestABCIHandlersHonestProposer() {
 			prepareRes, err := s.vals[0].handlers.PrepareProposalHandler()(
 				s.ctx, &abcitypes.RequestPrepareProposal{
 					LocalLastCommit: llc,
-					MaxTxBytes:      22020096,
+					MaxTxBytes:      maxTxBytes,
 					Height:          s.ctx.BlockHeight(),
 				})
 			if tc.expectedPrepareErr != "" {
@@ -281,7 +354,7 @@ func (s *ABCITestSuite) TestABCIHandlersHonestProposer() {
 					if tc.IsUnverifiedVoteExtension(i) || tc.IsNewValidator(i) {
 						continue
 					}
-					s.mockBatchingKeeper.EXPECT().SetBatchSigSecp256k1(gomock.Any(), s.mockBatch.BatchNumber, val.valAddr, val.voteExt).Return(nil).Times(len(s.vals))
+					s.mockBatchingKeeper.EXPECT().SetBatchSigSecp256k1(gomock.Any(), s.mockBatch.BatchNumber, val.valAddr, mustGetSecp256k1Signature(val.voteExt)).Return(nil).Times(len(s.vals))
 				}
 			}
 			for _, val := range s.vals {
@@ -329,7 +402,7 @@ func (s *ABCITestSuite) TestABCIHandlersMaliciousProposer() {
 			if bytes.Equal(val.consAddr.Bytes(), llc.Votes[2].Validator.Address) {
 				continue
 			}
-			s.mockBatchingKeeper.EXPECT().SetBatchSigSecp256k1(gomock.Any(), s.mockBatch.BatchNumber, val.valAddr, val.voteExt).Return(nil).Times(len(s.vals))
+			s.mockBatchingKeeper.EXPECT().SetBatchSigSecp256k1(gomock.Any(), s.mockBatch.BatchNumber, val.valAddr, mustGetSecp256k1Signature(val.voteExt)).Return(nil).Times(len(s.vals))
 		}
 		for _, val := range s.vals {
 			_, err := val.handlers.PreBlocker()(
@@ -525,3 +598,264 @@ func (s *ABCITestSuite) TestABCIHandlersMaliciousProposer() {
 		s.validatorsProcessProposal(prepareRes.Txs, "no injected extended votes tx", true)
 	})
 }
+
+func (s *ABCITestSuite) TestABCIHandlersOracleObservations() {
+	testCases := []struct {
+		name               string
+		heightWithoutBatch bool
+	}{
+		{
+			name: "observations with batch signatures",
+		},
+		{
+			name:               "observations without batch",
+			heightWithoutBatch: true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest(100, nil)
+			s.oracleParams = oracletypes.Params{
+				FeedIds:                 []string{testFeedID},
+				MinParticipationPercent: oracletypes.DefaultMinParticipationPercent,
+			}
+
+			s.incrementBlockHeight()
+			if tc.heightWithoutBatch {
+				s.incrementBlockHeight()
+			}
+
+			// ExtendVote and VerifyVoteExtension at H+1
+			s.validatorsVote()
+			for i, val := range s.vals {
+				voteExt, err := abci.DecodeVoteExtension(val.voteExt)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.heightWithoutBatch, len(voteExt.BatchSignatures) == 0)
+				_, found := voteExt.GetPayload(oracletypes.VoteExtensionPayloadType)
+				s.Require().True(found)
+
+				for _, otherVal := range s.vals {
+					if otherVal.consAddr.Equals(val.consAddr) {
+						continue
+					}
+					vvRes, err := otherVal.handlers.VerifyVoteExtensionHandler()(
+						s.ctx, &abcitypes.RequestVerifyVoteExtension{
+							Height:           s.ctx.BlockHeight(),
+							VoteExtension:    s.vals[i].voteExt,
+							ValidatorAddress: val.consAddr,
+						})
+					s.Require().NoError(err)
+					s.Require().Equal(abcitypes.ResponseVerifyVoteExtension_ACCEPT, vvRes.Status)
+				}
+			}
+
+			// PrepareProposal and ProcessProposal at H+2
+			s.incrementBlockHeight()
+
+			llc, info := s.mockExtendedCommitInfo()
+			s.ctx = s.ctx.WithCometInfo(info)
+
+			prepareRes, err := s.vals[0].handlers.PrepareProposalHandler()(
+				s.ctx, &abcitypes.RequestPrepareProposal{
+					LocalLastCommit: llc,
+					MaxTxBytes:      22020096,
+					Height:          s.ctx.BlockHeight(),
+				})
+			s.Require().NoError(err)
+			s.Require().Len(prepareRes.Txs, 1)
+
+			s.validatorsProcessProposal(prepareRes.Txs, "", false)
+
+			// PreBlocker at H+2
+			if !tc.heightWithoutBatch {
+				for _, val := range s.vals {
+					s.mockBatchingKeeper.EXPECT().SetBatchSigSecp256k1(gomock.Any(), s.mockBatch.BatchNumber, val.valAddr, mustGetSecp256k1Signature(val.voteExt)).Return(nil).Times(len(s.vals))
+				}
+			}
+			var totalPower int64
+			for _, vote := range llc.Votes {
+				totalPower += vote.Validator.Power
+			}
+			s.mockOracleKeeper.EXPECT().UpdateMedianValues(gomock.Any(), totalPower, gomock.Len(len(s.vals))).Return(nil).Times(len(s.vals))
+
+			for _, val := range s.vals {
+				_, err := val.handlers.PreBlocker()(
+					s.ctx, &abcitypes.RequestFinalizeBlock{
+						Txs:    prepareRes.Txs,
+						Height: s.ctx.BlockHeight(),
+					})
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Run("observation of unknown feed", func() {
+		s.SetupTest(100, nil)
+		s.oracleParams = oracletypes.Params{
+			FeedIds:                 []string{testFeedID},
+			MinParticipationPercent: oracletypes.DefaultMinParticipationPercent,
+		}
+		s.incrementBlockHeight()
+		s.incrementBlockHeight()
+
+		payload, err := (&oracletypes.Observations{
+			Observations: []oracletypes.Observation{{FeedId: "unknown", Value: math.LegacyOneDec()}},
+		}).Marshal()
+		s.Require().NoError(err)
+		voteExt := mustMarshalVoteExtension(abci.VoteExtension{
+			Version:  abci.VoteExtensionVersion1,
+			Payloads: []abci.VoteExtensionPayload{{Type: oracletypes.VoteExtensionPayloadType, Data: payload}},
+		})
+
+		vvRes, err := s.vals[1].handlers.VerifyVoteExtensionHandler()(
+			s.ctx, &abcitypes.RequestVerifyVoteExtension{
+				Height:           s.ctx.BlockHeight(),
+				VoteExtension:    voteExt,
+				ValidatorAddress: s.vals[0].consAddr,
+			})
+		s.Require().ErrorIs(err, oracletypes.ErrInvalidObservations)
+		s.Require().Equal(abcitypes.ResponseVerifyVoteExtension_REJECT, vvRes.Status)
+	})
+}
+
+// TestABCIHandlersMissingOracleParams checks that the oracle is treated
+// as inactive on a chain whose oracle store has not been initialized.
+func (s *ABCITestSuite) TestABCIHandlersMissingOracleParams() {
+	s.SetupTest(100, nil)
+	s.oracleParams = oracletypes.Params{}
+	s.oracleParamsErr = collections.ErrNotFound
+
+	// ExtendVote at H+1 signs the batch without observations.
+	s.incrementBlockHeight()
+	s.validatorsVote()
+	for _, val := range s.vals {
+		voteExt, err := abci.DecodeVoteExtension(val.voteExt)
+		s.Require().NoError(err)
+		s.Require().NotEmpty(voteExt.BatchSignatures)
+		_, found := voteExt.GetPayload(oracletypes.VoteExtensionPayloadType)
+		s.Require().False(found)
+	}
+
+	// PrepareProposal, ProcessProposal and PreBlocker at H+2
+	s.incrementBlockHeight()
+	llc, info := s.mockExtendedCommitInfo()
+	s.ctx = s.ctx.WithCometInfo(info)
+
+	prepareRes, err := s.vals[0].handlers.PrepareProposalHandler()(
+		s.ctx, &abcitypes.RequestPrepareProposal{
+			LocalLastCommit: llc,
+			MaxTxBytes:      22020096,
+			Height:          s.ctx.BlockHeight(),
+		})
+	s.Require().NoError(err)
+	s.Require().Len(prepareRes.Txs, 1)
+	s.validatorsProcessProposal(prepareRes.Txs, "", false)
+
+	for _, val := range s.vals {
+		s.mockBatchingKeeper.EXPECT().SetBatchSigSecp256k1(gomock.Any(), s.mockBatch.BatchNumber, val.valAddr, mustGetSecp256k1Signature(val.voteExt)).Return(nil).Times(len(s.vals))
+	}
+	for _, val := range s.vals {
+		_, err := val.handlers.PreBlocker()(
+			s.ctx, &abcitypes.RequestFinalizeBlock{
+				Txs:    prepareRes.Txs,
+				Height: s.ctx.BlockHeight(),
+			})
+		s.Require().NoError(err)
+	}
+
+	// No vote extensions are collected at a height without a batch.
+	s.incrementBlockHeight()
+	prepareRes, err = s.vals[0].handlers.PrepareProposalHandler()(
+		s.ctx, &abcitypes.RequestPrepareProposal{
+			MaxTxBytes: 22020096,
+			Height:     s.ctx.BlockHeight(),
+		})
+	s.Require().NoError(err)
+	s.Require().Empty(prepareRes.Txs)
+}
+
+func TestDecodeVoteExtension(t *testing.T) {
+	sig := bytes.Repeat([]byte{0x01}, abci.Secp256k1SignatureLength)
+
+	// Legacy raw signature
+	ve, err := abci.DecodeVoteExtension(sig)
+	require.NoError(t, err)
+	require.Equal(t, abci.VoteExtensionVersion1, ve.Version)
+	got, err := ve.GetBatchSignature(sedatypes.SEDAKeyIndexSecp256k1)
+	require.NoError(t, err)
+	require.Equal(t, sig, got)
+
+	// Envelope of the legacy length
+	bz, err := abci.EncodeVoteExtension(abci.VoteExtension{
+		Version:  abci.VoteExtensionVersion1,
+		Payloads: []abci.VoteExtensionPayload{{Type: oracletypes.VoteExtensionPayloadType, Data: bytes.Repeat([]byte{0x01}, 38)}},
+	})
+	require.NoError(t, err)
+	require.Len(t, bz, abci.LegacyVoteExtensionLength)
+	ve, err = abci.DecodeVoteExtension(bz)
+	require.NoError(t, err)
+	require.Empty(t, ve.BatchSignatures)
+	data, found := ve.GetPayload(oracletypes.VoteExtensionPayloadType)
+	require.True(t, found)
+	require.Equal(t, bytes.Repeat([]byte{0x01}, 38), data)
+
+	// Envelope with additional payload
+	bz, err = abci.EncodeVoteExtension(abci.VoteExtension{
+		Version:         abci.VoteExtensionVersion1,
+		BatchSignatures: []abci.BatchSignature{{KeyIndex: uint32(sedatypes.SEDAKeyIndexSecp256k1), Signature: sig}},
+		Payloads:        []abci.VoteExtensionPayload{{Type: "test", Data: []byte("data")}},
+	})
+	require.NoError(t, err)
+	ve, err = abci.DecodeVoteExtension(bz)
+	require.NoError(t, err)
+	got, err = ve.GetBatchSignature(sedatypes.SEDAKeyIndexSecp256k1)
+	require.NoError(t, err)
+	require.Equal(t, sig, got)
+	data, found = ve.GetPayload("test")
+	require.True(t, found)
+	require.Equal(t, []byte("data"), data)
+	_, found = ve.GetPayload("unknown")
+	require.False(t, found)
+
+	// Duplicate signatures
+	_, err = abci.EncodeVoteExtension(abci.NewVoteExtension(
+		abci.BatchSignature{KeyIndex: 0, Signature: sig},
+		abci.BatchSignature{KeyIndex: 0, Signature: sig},
+	))
+	require.ErrorIs(t, err, abci.ErrInvalidVoteExtension)
+
+	// Duplicate payload types
+	_, err = abci.EncodeVoteExtension(abci.VoteExtension{
+		Version:  abci.VoteExtensionVersion1,
+		Payloads: []abci.VoteExtensionPayload{{Type: "test"}, {Type: "test"}},
+	})
+	require.ErrorIs(t, err, abci.ErrInvalidVoteExtension)
+
+	// Malformed envelope
+	_, err = abci.DecodeVoteExtension([]byte("this is not a valid vote extension"))
+	require.ErrorIs(t, err, abci.ErrInvalidVoteExtension)
+}
+
+// TestMinVoteExtensionSize checks that the lower bound of the batching
+// MaxVoteExtensionSize parameter fits an envelope carrying a secp256k1
+// batch signature.
+func TestMinVoteExtensionSize(t *testing.T) {
+	sig := bytes.Repeat([]byte{0x01}, abci.Secp256k1SignatureLength)
+
+	bz, err := abci.EncodeVoteExtension(abci.NewVoteExtension(abci.BatchSignature{
+		KeyIndex:  uint32(sedatypes.SEDAKeyIndexSecp256k1),
+		Signature: sig,
+	}))
+	require.NoError(t, err)
+	require.Greater(t, len(bz), abci.LegacyVoteExtensionLength)
+	require.LessOrEqual(t, uint64(len(bz)), batchingtypes.MinVoteExtensionSize)
+
+	// The bound is the encoding with the largest version and key index.
+	largest := abci.VoteExtension{
+		Version:         gomath.MaxUint32,
+		BatchSignatures: []abci.BatchSignature{{KeyIndex: gomath.MaxUint32, Signature: sig}},
+	}
+	bz, err = largest.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(bz)), batchingtypes.MinVoteExtensionSize)
+}
@@ -149,6 +149,10 @@ import (
 	dataproxy "github.com/sedaprotocol/seda-chain/x/data-proxy"
 	dataproxykeeper "github.com/sedaprotocol/seda-chain/x/data-proxy/keeper"
 	dataproxytypes "github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+	"github.com/sedaprotocol/seda-chain/x/oracle"
+	oraclekeeper "github.com/sedaprotocol/seda-chain/x/oracle/keeper"
+	oracleobserver "github.com/sedaprotocol/seda-chain/x/oracle/observer"
+	oracletypes "github.com/sedaprotocol/seda-chain/x/oracle/types"
 	"github.com/sedaprotocol/seda-chain/x/pubkey"
 	pubkeykeeper "github.com/sedaprotocol/seda-chain/x/pubkey/keeper"
 	pubkeytypes "github.com/sedaprotocol/seda-chain/x/pubkey/types"
@@ -207,6 +211,7 @@ var (
 		tally.AppModuleBasic{},
 		dataproxy.AppModuleBasic{},
 		batching.AppModuleBasic{},
+		oracle.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -222,6 +227,7 @@ var (
 		icatypes.ModuleName:            nil,
 		wasmtypes.ModuleName:           {authtypes.Burner},
 		dataproxytypes.ModuleName:      {authtypes.Burner},
+		wasmstoragetypes.ModuleName:    nil,
 	}
 )
 
@@ -328,7 +334,7 @@ func NewApp(
 		capabilitytypes.StoreKey, ibcexported.StoreKey, ibctransfertypes.StoreKey, ibcfeetypes.StoreKey,
 		wasmtypes.StoreKey, icahosttypes.StoreKey, icacontrollertypes.StoreKey, packetforwardtypes.StoreKey,
 		crisistypes.StoreKey, wasmstoragetypes.StoreKey, dataproxytypes.StoreKey, pubkeytypes.StoreKey,
-		batchingtypes.StoreKey, tallytypes.StoreKey,
+		batchingtypes.StoreKey, tallytypes.StoreKey, oracletypes.StoreKey,
 	)
 
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -690,6 +696,12 @@ func NewApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	app.OracleKeeper = oraclekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[oracletypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Create evidence router, add batching evidence route, seal it, and set it in the keeper.
 	evidenceRouter := evidencetypes.NewRouter()
 	evidenceRouter.AddRoute(batchingtypes.RouteBatchDoubleSign, batchingkeeper.NewBatchDoubleSignHandler(app.BatchingKeeper))
@@ -811,6 +823,7 @@ func NewApp(
 		dataproxy.NewAppModule(appCodec, app.DataProxyKeeper),
 		pubkey.NewAppModule(appCodec, app.PubKeyKeeper),
 		batching.NewAppModule(appCodec, app.BatchingKeeper),
+		oracle.NewAppModule(appCodec, app.OracleKeeper),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, nil), // always be last to make sure that it checks for all invariants and not only part of them
 	)
 
@@ -870,6 +883,7 @@ func NewApp(
 		dataproxytypes.ModuleName,
 		pubkeytypes.ModuleName,
 		batchingtypes.ModuleName,
+		oracletypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
@@ -898,11 +912,14 @@ func NewApp(
 		wasmtypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		// custom modules
-		wasmstoragetypes.ModuleName,
+		// The tally module extends the expiration of the oracle programs it
+		// uses, so it must run before the wasm-storage module prunes them.
 		tallytypes.ModuleName,
+		wasmstoragetypes.ModuleName,
 		dataproxytypes.ModuleName,
 		pubkeytypes.ModuleName,
 		batchingtypes.ModuleName,
+		oracletypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after sdkstaking so that pools are
@@ -942,6 +959,7 @@ func NewApp(
 		tallytypes.ModuleName,
 		dataproxytypes.ModuleName,
 		batchingtypes.ModuleName,
+		oracletypes.ModuleName,
 	}
 	app.mm.SetOrderInitGenesis(genesisModuleOrder...)
 	app.mm.SetOrderExportGenesis(genesisModuleOrder...)
@@ -1003,8 +1021,9 @@ func NewApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
-	// Load SEDA signer.
+	// Load SEDA signer and oracle observer.
 	var signer utils.SEDASigner
+	var observer appabci.Observer
 	if utils.IsNodeStart(appOpts) {
 		sedaConfig, err := utils.ReadSEDAConfigFromAppOpts(appOpts)
 		if err != nil {
@@ -1020,8 +1039,10 @@ func NewApp(
 		} else {
 			signer = utils.LoadEmptySEDASigner(filepath.Join(homePath, sedaConfig.SEDAKeyFile))
 		}
+		observer = oracleobserver.NewObserver(sedaConfig.OracleDataProxyURL, app.Logger())
 	}
-	RegisterQueryServer(app.configurator.QueryServer(), NewQuerier(signer, app.PubKeyKeeper))
+	signerHealth := utils.NewSignerHealth(utils.DefaultSignerHealthHistorySize)
+	RegisterQueryServer(app.configurator.QueryServer(), NewQuerier(signer, signerHealth, app.PubKeyKeeper, app.BatchingKeeper))
 
 	// Since in prior versions -1 would be written to the config file and
 	// lead to the NoOpMempool being used. -1 doesn't make sense for the
@@ -1042,8 +1063,11 @@ func NewApp(
 		app.BatchingKeeper,
 		app.PubKeyKeeper,
 		app.StakingKeeper,
+		app.OracleKeeper,
 		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
 		signer,
+		signerHealth,
+		observer,
 		app.Logger(),
 	)
 	app.SetExtendVoteHandler(abciHandler.ExtendVoteHandler())
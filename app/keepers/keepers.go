@@ -30,6 +30,7 @@ import (
 
 	batchingkeeper "github.com/sedaprotocol/seda-chain/x/batching/keeper"
 	dataproxykeeper "github.com/sedaprotocol/seda-chain/x/data-proxy/keeper"
+	oraclekeeper "github.com/sedaprotocol/seda-chain/x/oracle/keeper"
 	pubkeykeeper "github.com/sedaprotocol/seda-chain/x/pubkey/keeper"
 	stakingkeeper "github.com/sedaprotocol/seda-chain/x/staking/keeper"
 	tallykeeper "github.com/sedaprotocol/seda-chain/x/tally/keeper"
@@ -79,4 +80,5 @@ type AppKeepers struct {
 	DataProxyKeeper   dataproxykeeper.Keeper
 	PubKeyKeeper      *pubkeykeeper.Keeper
 	BatchingKeeper    batchingkeeper.Keeper
+	OracleKeeper      oraclekeeper.Keeper
 }
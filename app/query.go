@@ -17,24 +17,36 @@ import (
 
 	"github.com/sedaprotocol/seda-chain/app/utils"
 	sedatypes "github.com/sedaprotocol/seda-chain/types"
+	batchingtypes "github.com/sedaprotocol/seda-chain/x/batching/types"
+	pubkeytypes "github.com/sedaprotocol/seda-chain/x/pubkey/types"
 )
 
 var _ QueryServer = Querier{}
 
 type Querier struct {
-	signer       utils.SEDASigner
-	pubKeyKeeper PubKeyKeeper
+	signer         utils.SEDASigner
+	signerHealth   *utils.SignerHealth
+	pubKeyKeeper   PubKeyKeeper
+	batchingKeeper BatchingKeeper
 }
 
 type PubKeyKeeper interface {
 	GetValidatorKeyAtIndex(ctx context.Context, validatorAddr sdk.ValAddress, index sedatypes.SEDAKeyIndex) ([]byte, error)
 	IsProvingSchemeActivated(ctx context.Context, index sedatypes.SEDAKeyIndex) (bool, error)
+	GetValidatorKeys(ctx context.Context, validatorAddr string) (result pubkeytypes.ValidatorPubKeys, err error)
 }
 
-func NewQuerier(signer utils.SEDASigner, pubKeyKeeper PubKeyKeeper) Querier {
+type BatchingKeeper interface {
+	GetLatestBatch(ctx context.Context) (batchingtypes.Batch, error)
+	GetValidatorTreeEntry(ctx context.Context, batchNum uint64, valAddr sdk.ValAddress) (batchingtypes.ValidatorTreeEntry, error)
+}
+
+func NewQuerier(signer utils.SEDASigner, signerHealth *utils.SignerHealth, pubKeyKeeper PubKeyKeeper, batchingKeeper BatchingKeeper) Querier {
 	return Querier{
-		signer:       signer,
-		pubKeyKeeper: pubKeyKeeper,
+		signer:         signer,
+		signerHealth:   signerHealth,
+		pubKeyKeeper:   pubKeyKeeper,
+		batchingKeeper: batchingKeeper,
 	}
 }
 
@@ -76,6 +88,65 @@ func (q Querier) SEDASignerStatus(ctx context.Context, _ *QuerySEDASignerStatusR
 	}, nil
 }
 
+func (q Querier) SEDASignerHealth(ctx context.Context, _ *QuerySEDASignerHealthRequest) (*QuerySEDASignerHealthResponse, error) {
+	if q.signer == nil || q.signerHealth == nil {
+		return nil, fmt.Errorf("signer is not available")
+	}
+
+	res := &QuerySEDASignerHealthResponse{
+		IsLoaded: q.signer.IsLoaded(),
+	}
+	if res.IsLoaded {
+		valAddr := q.signer.GetValAddress()
+		res.ValidatorAddress = valAddr.String()
+
+		valKeys, err := q.pubKeyKeeper.GetValidatorKeys(ctx, res.ValidatorAddress)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+		res.IsSynced = len(valKeys.IndexedPubKeys) != 0 && utils.PubKeysMatch(q.signer.GetPublicKeys(), valKeys.IndexedPubKeys)
+
+		batch, err := q.batchingKeeper.GetLatestBatch(ctx)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+		if err == nil {
+			res.LatestBatchNumber = batch.BatchNumber
+			_, err = q.batchingKeeper.GetValidatorTreeEntry(ctx, batch.BatchNumber, valAddr)
+			if err != nil && !errors.Is(err, collections.ErrNotFound) {
+				return nil, err
+			}
+			res.InValidatorTree = err == nil
+		}
+	}
+
+	res.TotalSignedBatches, res.TotalSigningFailures, res.TotalReloads = q.signerHealth.Totals()
+	for _, b := range q.signerHealth.SignedBatches() {
+		res.SignedBatches = append(res.SignedBatches, SignedBatch{
+			BatchNumber: b.BatchNumber,
+			BlockHeight: b.BlockHeight,
+			Time:        b.Time,
+		})
+	}
+	for _, f := range q.signerHealth.SigningFailures() {
+		res.SigningFailures = append(res.SigningFailures, SigningFailure{
+			BatchNumber: f.BatchNumber,
+			BlockHeight: f.BlockHeight,
+			Time:        f.Time,
+			Error:       f.Error,
+		})
+	}
+	for _, r := range q.signerHealth.Reloads() {
+		res.Reloads = append(res.Reloads, SignerReload{
+			BlockHeight: r.BlockHeight,
+			Time:        r.Time,
+			Reason:      r.Reason,
+			Error:       r.Error,
+		})
+	}
+	return res, nil
+}
+
 // GetSEDASignerStatus returns the command for querying the status of
 // the node's SEDA signer.
 func GetSEDASignerStatus() *cobra.Command {
@@ -101,3 +172,29 @@ func GetSEDASignerStatus() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetSEDASignerHealth returns the command for querying the batch signing
+// history and health of the node's SEDA signer.
+func GetSEDASignerHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seda-signer-health",
+		Short: "Query batch signing history and health of the node's SEDA signer",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := NewQueryClient(clientCtx)
+
+			res, err := queryClient.SEDASignerHealth(cmd.Context(), &QuerySEDASignerHealthRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -7,21 +7,26 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -207,46 +212,472 @@ func (m *SignerKey) GetIsSynced() bool {
 	return false
 }
 
+// QuerySEDASignerHealthRequest is request type for the Query/SEDASignerHealth
+// RPC method.
+type QuerySEDASignerHealthRequest struct {
+}
+
+func (m *QuerySEDASignerHealthRequest) Reset()         { *m = QuerySEDASignerHealthRequest{} }
+func (m *QuerySEDASignerHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySEDASignerHealthRequest) ProtoMessage()    {}
+func (*QuerySEDASignerHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74921f2d23a2b089, []int{3}
+}
+func (m *QuerySEDASignerHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySEDASignerHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySEDASignerHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySEDASignerHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySEDASignerHealthRequest.Merge(m, src)
+}
+func (m *QuerySEDASignerHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySEDASignerHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySEDASignerHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySEDASignerHealthRequest proto.InternalMessageInfo
+
+// QuerySEDASignerHealthResponse is response type for the Query/SEDASignerHealth
+// RPC method.
+type QuerySEDASignerHealthResponse struct {
+	// ValidatorAddress is the address of the validator loaded in the signer.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// IsLoaded indicates whether the signer is loaded and ready for signing.
+	IsLoaded bool `protobuf:"varint,2,opt,name=is_loaded,json=isLoaded,proto3" json:"is_loaded,omitempty"`
+	// IsSynced indicates whether the keys loaded in the SEDA signer match
+	// the keys registered in the pubkey module.
+	IsSynced bool `protobuf:"varint,3,opt,name=is_synced,json=isSynced,proto3" json:"is_synced,omitempty"`
+	// LatestBatchNumber is the number of the latest batch.
+	LatestBatchNumber uint64 `protobuf:"varint,4,opt,name=latest_batch_number,json=latestBatchNumber,proto3" json:"latest_batch_number,omitempty"`
+	// InValidatorTree indicates whether the validator is in the validator
+	// tree of the latest batch, which determines whether it is expected to
+	// sign the next batch.
+	InValidatorTree bool `protobuf:"varint,5,opt,name=in_validator_tree,json=inValidatorTree,proto3" json:"in_validator_tree,omitempty"`
+	// TotalSignedBatches is the number of batches signed since the node
+	// started.
+	TotalSignedBatches uint64 `protobuf:"varint,6,opt,name=total_signed_batches,json=totalSignedBatches,proto3" json:"total_signed_batches,omitempty"`
+	// TotalSigningFailures is the number of failed signing attempts since
+	// the node started.
+	TotalSigningFailures uint64 `protobuf:"varint,7,opt,name=total_signing_failures,json=totalSigningFailures,proto3" json:"total_signing_failures,omitempty"`
+	// TotalReloads is the number of signer reload attempts since the node
+	// started.
+	TotalReloads uint64 `protobuf:"varint,8,opt,name=total_reloads,json=totalReloads,proto3" json:"total_reloads,omitempty"`
+	// SignedBatches is the list of the most recent batches signed by the
+	// node, from oldest to newest.
+	SignedBatches []SignedBatch `protobuf:"bytes,9,rep,name=signed_batches,json=signedBatches,proto3" json:"signed_batches"`
+	// SigningFailures is the list of the most recent signing failures, from
+	// oldest to newest.
+	SigningFailures []SigningFailure `protobuf:"bytes,10,rep,name=signing_failures,json=signingFailures,proto3" json:"signing_failures"`
+	// Reloads is the list of the most recent signer reload attempts, from
+	// oldest to newest.
+	Reloads []SignerReload `protobuf:"bytes,11,rep,name=reloads,proto3" json:"reloads"`
+}
+
+func (m *QuerySEDASignerHealthResponse) Reset()         { *m = QuerySEDASignerHealthResponse{} }
+func (m *QuerySEDASignerHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySEDASignerHealthResponse) ProtoMessage()    {}
+func (*QuerySEDASignerHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74921f2d23a2b089, []int{4}
+}
+func (m *QuerySEDASignerHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySEDASignerHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySEDASignerHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySEDASignerHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySEDASignerHealthResponse.Merge(m, src)
+}
+func (m *QuerySEDASignerHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySEDASignerHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySEDASignerHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySEDASignerHealthResponse proto.InternalMessageInfo
+
+func (m *QuerySEDASignerHealthResponse) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QuerySEDASignerHealthResponse) GetIsLoaded() bool {
+	if m != nil {
+		return m.IsLoaded
+	}
+	return false
+}
+
+func (m *QuerySEDASignerHealthResponse) GetIsSynced() bool {
+	if m != nil {
+		return m.IsSynced
+	}
+	return false
+}
+
+func (m *QuerySEDASignerHealthResponse) GetLatestBatchNumber() uint64 {
+	if m != nil {
+		return m.LatestBatchNumber
+	}
+	return 0
+}
+
+func (m *QuerySEDASignerHealthResponse) GetInValidatorTree() bool {
+	if m != nil {
+		return m.InValidatorTree
+	}
+	return false
+}
+
+func (m *QuerySEDASignerHealthResponse) GetTotalSignedBatches() uint64 {
+	if m != nil {
+		return m.TotalSignedBatches
+	}
+	return 0
+}
+
+func (m *QuerySEDASignerHealthResponse) GetTotalSigningFailures() uint64 {
+	if m != nil {
+		return m.TotalSigningFailures
+	}
+	return 0
+}
+
+func (m *QuerySEDASignerHealthResponse) GetTotalReloads() uint64 {
+	if m != nil {
+		return m.TotalReloads
+	}
+	return 0
+}
+
+func (m *QuerySEDASignerHealthResponse) GetSignedBatches() []SignedBatch {
+	if m != nil {
+		return m.SignedBatches
+	}
+	return nil
+}
+
+func (m *QuerySEDASignerHealthResponse) GetSigningFailures() []SigningFailure {
+	if m != nil {
+		return m.SigningFailures
+	}
+	return nil
+}
+
+func (m *QuerySEDASignerHealthResponse) GetReloads() []SignerReload {
+	if m != nil {
+		return m.Reloads
+	}
+	return nil
+}
+
+// SignedBatch is a batch signed by the node's SEDA signer.
+type SignedBatch struct {
+	// BatchNumber is the number of the signed batch.
+	BatchNumber uint64 `protobuf:"varint,1,opt,name=batch_number,json=batchNumber,proto3" json:"batch_number,omitempty"`
+	// BlockHeight is the height at which the batch was signed.
+	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Time is the local time at which the batch was signed.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *SignedBatch) Reset()         { *m = SignedBatch{} }
+func (m *SignedBatch) String() string { return proto.CompactTextString(m) }
+func (*SignedBatch) ProtoMessage()    {}
+func (*SignedBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74921f2d23a2b089, []int{5}
+}
+func (m *SignedBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignedBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedBatch.Merge(m, src)
+}
+func (m *SignedBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedBatch proto.InternalMessageInfo
+
+func (m *SignedBatch) GetBatchNumber() uint64 {
+	if m != nil {
+		return m.BatchNumber
+	}
+	return 0
+}
+
+func (m *SignedBatch) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SignedBatch) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// SigningFailure is a failed attempt to sign a batch.
+type SigningFailure struct {
+	// BatchNumber is the number of the batch that failed to be signed.
+	BatchNumber uint64 `protobuf:"varint,1,opt,name=batch_number,json=batchNumber,proto3" json:"batch_number,omitempty"`
+	// BlockHeight is the height at which the signing was attempted.
+	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Time is the local time at which the signing was attempted.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// Error is the error that caused the failure.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SigningFailure) Reset()         { *m = SigningFailure{} }
+func (m *SigningFailure) String() string { return proto.CompactTextString(m) }
+func (*SigningFailure) ProtoMessage()    {}
+func (*SigningFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74921f2d23a2b089, []int{6}
+}
+func (m *SigningFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningFailure.Merge(m, src)
+}
+func (m *SigningFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningFailure proto.InternalMessageInfo
+
+func (m *SigningFailure) GetBatchNumber() uint64 {
+	if m != nil {
+		return m.BatchNumber
+	}
+	return 0
+}
+
+func (m *SigningFailure) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SigningFailure) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SigningFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// SignerReload is an attempt to reload the SEDA signer from its key file.
+type SignerReload struct {
+	// BlockHeight is the height at which the reload was attempted.
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Time is the local time at which the reload was attempted.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// Reason is the reason for the reload.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Error is the error that caused the reload to fail. It is empty if
+	// the reload succeeded.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SignerReload) Reset()         { *m = SignerReload{} }
+func (m *SignerReload) String() string { return proto.CompactTextString(m) }
+func (*SignerReload) ProtoMessage()    {}
+func (*SignerReload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74921f2d23a2b089, []int{7}
+}
+func (m *SignerReload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerReload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerReload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerReload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerReload.Merge(m, src)
+}
+func (m *SignerReload) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerReload) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerReload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerReload proto.InternalMessageInfo
+
+func (m *SignerReload) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SignerReload) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SignerReload) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SignerReload) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QuerySEDASignerStatusRequest)(nil), "sedachain.app.v1.QuerySEDASignerStatusRequest")
 	proto.RegisterType((*QuerySEDASignerStatusResponse)(nil), "sedachain.app.v1.QuerySEDASignerStatusResponse")
 	proto.RegisterType((*SignerKey)(nil), "sedachain.app.v1.SignerKey")
+	proto.RegisterType((*QuerySEDASignerHealthRequest)(nil), "sedachain.app.v1.QuerySEDASignerHealthRequest")
+	proto.RegisterType((*QuerySEDASignerHealthResponse)(nil), "sedachain.app.v1.QuerySEDASignerHealthResponse")
+	proto.RegisterType((*SignedBatch)(nil), "sedachain.app.v1.SignedBatch")
+	proto.RegisterType((*SigningFailure)(nil), "sedachain.app.v1.SigningFailure")
+	proto.RegisterType((*SignerReload)(nil), "sedachain.app.v1.SignerReload")
 }
 
 func init() { proto.RegisterFile("sedachain/app/v1/query.proto", fileDescriptor_74921f2d23a2b089) }
 
 var fileDescriptor_74921f2d23a2b089 = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0xa5, 0x04, 0xd5, 0x57, 0x21, 0x85, 0x53, 0x91, 0x4c, 0x9a, 0x58, 0x21, 0x43, 0x95,
-	0x25, 0xb6, 0x5a, 0x86, 0x2e, 0x0c, 0xa4, 0x82, 0x09, 0xa9, 0x2a, 0xb6, 0xc4, 0xc0, 0x62, 0x9d,
-	0xed, 0x93, 0x73, 0x6a, 0x7c, 0x77, 0xf5, 0x3b, 0x5b, 0x78, 0xe5, 0x17, 0x20, 0x31, 0x32, 0x33,
-	0xb2, 0xf5, 0x47, 0x20, 0x16, 0x2a, 0x58, 0x18, 0x51, 0xc2, 0x0f, 0x41, 0xbe, 0x6b, 0x2b, 0x14,
-	0x04, 0xea, 0x76, 0xef, 0x7d, 0xdf, 0xdd, 0xfb, 0xee, 0xfb, 0x1e, 0x1e, 0x02, 0xcb, 0x68, 0xba,
-	0xa0, 0x5c, 0x04, 0x54, 0xa9, 0xa0, 0x3e, 0x08, 0xce, 0x2b, 0x56, 0x36, 0xbe, 0x2a, 0xa5, 0x96,
-	0xa4, 0x7f, 0x83, 0xfa, 0x54, 0x29, 0xbf, 0x3e, 0x18, 0x0c, 0x73, 0x29, 0xf3, 0x25, 0x0b, 0xa8,
-	0xe2, 0x01, 0x15, 0x42, 0x6a, 0xaa, 0xb9, 0x14, 0x60, 0xf9, 0x83, 0x87, 0xa9, 0x84, 0x42, 0x42,
-	0x6c, 0xaa, 0xc0, 0x16, 0x16, 0x9a, 0x78, 0x78, 0xf8, 0xb2, 0x7d, 0x39, 0x7a, 0xfe, 0x6c, 0x1e,
-	0xf1, 0x5c, 0xb0, 0x32, 0xd2, 0x54, 0x57, 0x10, 0xb2, 0xf3, 0x8a, 0x81, 0x9e, 0x7c, 0x42, 0x78,
-	0xf4, 0x0f, 0x02, 0x28, 0x29, 0x80, 0x91, 0x13, 0x7c, 0xbf, 0xa6, 0x4b, 0x9e, 0x51, 0x2d, 0xcb,
-	0x98, 0x66, 0x59, 0xc9, 0x00, 0x5c, 0x34, 0x46, 0x53, 0xe7, 0xf8, 0xd1, 0xb7, 0x8b, 0xd9, 0xe8,
-	0x6a, 0xdc, 0xab, 0x6b, 0xce, 0xdc, 0x52, 0x22, 0x5d, 0x72, 0x91, 0x87, 0xfd, 0x7a, 0xa3, 0x4f,
-	0x9e, 0xe0, 0x1d, 0x30, 0x73, 0xe2, 0x33, 0xd6, 0x80, 0xdb, 0x1d, 0x6f, 0x4d, 0x77, 0x0e, 0xf7,
-	0xfc, 0xcd, 0x2f, 0xfb, 0x56, 0xcc, 0x0b, 0xd6, 0x84, 0x18, 0xae, 0x8f, 0x30, 0xf9, 0x8a, 0xb0,
-	0x73, 0x83, 0x90, 0x5d, 0xdc, 0xe3, 0x22, 0x63, 0x6f, 0x8c, 0x9e, 0x7b, 0xa1, 0x2d, 0xc8, 0x08,
-	0x63, 0x73, 0x88, 0x05, 0x2d, 0x98, 0xdb, 0x6d, 0xa5, 0x86, 0x8e, 0xe9, 0x9c, 0xd0, 0x82, 0x91,
-	0x23, 0xec, 0x72, 0xe3, 0x55, 0xcd, 0x45, 0x1e, 0x43, 0xba, 0x60, 0x05, 0x8b, 0x69, 0xaa, 0x79,
-	0xcd, 0xdc, 0xad, 0x31, 0x9a, 0x6e, 0x87, 0x0f, 0x38, 0x9c, 0x5a, 0x38, 0x32, 0xe8, 0xdc, 0x80,
-	0xe4, 0x08, 0x63, 0x55, 0x25, 0x4b, 0x9e, 0xb6, 0xca, 0xdd, 0x3b, 0xc6, 0x02, 0xf7, 0xcb, 0xc5,
-	0x6c, 0xf7, 0xca, 0x82, 0xb4, 0x6c, 0x94, 0x96, 0xfe, 0x69, 0x95, 0xb4, 0xaa, 0x1d, 0xcb, 0x6d,
-	0x65, 0xee, 0x61, 0x87, 0x43, 0x0c, 0x8d, 0x48, 0x59, 0xe6, 0xf6, 0xcc, 0x88, 0x6d, 0x0e, 0x91,
-	0xa9, 0x0f, 0x3f, 0x22, 0xdc, 0x33, 0x09, 0x90, 0x0f, 0x08, 0xf7, 0x37, 0x63, 0x20, 0xfe, 0xdf,
-	0xce, 0xfc, 0x2f, 0xd0, 0x41, 0x70, 0x6b, 0xbe, 0xcd, 0x77, 0xb2, 0xff, 0xf6, 0xfb, 0xaf, 0xf7,
-	0xdd, 0x31, 0xf1, 0x82, 0xf6, 0xe2, 0xcc, 0x2e, 0xa5, 0xaa, 0x92, 0x33, 0xd6, 0x98, 0x4e, 0x6c,
-	0xdd, 0x3f, 0x7e, 0xfa, 0x79, 0xe5, 0xa1, 0xcb, 0x95, 0x87, 0x7e, 0xae, 0x3c, 0xf4, 0x6e, 0xed,
-	0x75, 0x2e, 0xd7, 0x5e, 0xe7, 0xc7, 0xda, 0xeb, 0xbc, 0xde, 0xcf, 0xb9, 0x5e, 0x54, 0x89, 0x9f,
-	0xca, 0xc2, 0xdc, 0x30, 0x9b, 0x97, 0xca, 0xe5, 0x9f, 0x0f, 0x52, 0xa5, 0x92, 0xbb, 0x06, 0x78,
-	0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xab, 0xc0, 0xca, 0xa4, 0xfd, 0x02, 0x00, 0x00,
+	// 883 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe3, 0xcd, 0x26, 0xcd, 0xce, 0x26, 0x6d, 0x32, 0x2c, 0x95, 0x49, 0x13, 0x67, 0xbb,
+	0x88, 0x12, 0x21, 0xc5, 0xa6, 0x01, 0xa9, 0x1c, 0x10, 0x22, 0x2b, 0x40, 0x15, 0xa0, 0xa8, 0xf5,
+	0x56, 0x1c, 0xb8, 0x58, 0x63, 0xfb, 0xd5, 0x3b, 0x8a, 0xd7, 0xe3, 0xce, 0x8c, 0x57, 0xec, 0x95,
+	0x3b, 0x52, 0x25, 0x4e, 0x88, 0x1b, 0x07, 0x6e, 0xdc, 0xfa, 0x47, 0x54, 0xbd, 0x50, 0xc1, 0x85,
+	0x13, 0xa0, 0x84, 0x7f, 0x80, 0xff, 0x00, 0xf9, 0x8d, 0x37, 0x89, 0x37, 0x3f, 0x14, 0x0e, 0x88,
+	0x9b, 0xdf, 0xfb, 0xbe, 0xf7, 0xfc, 0x99, 0xf7, 0xc6, 0xcf, 0x64, 0x43, 0x41, 0xcc, 0xa2, 0x21,
+	0xe3, 0x99, 0xc7, 0xf2, 0xdc, 0x1b, 0xdf, 0xf5, 0x9e, 0x14, 0x20, 0x27, 0x6e, 0x2e, 0x85, 0x16,
+	0x74, 0xf5, 0x58, 0x75, 0x59, 0x9e, 0xbb, 0xe3, 0xbb, 0xeb, 0x1b, 0x89, 0x10, 0x49, 0x0a, 0x1e,
+	0xcb, 0xb9, 0xc7, 0xb2, 0x4c, 0x68, 0xa6, 0xb9, 0xc8, 0x94, 0x89, 0x5f, 0x7f, 0x2d, 0x12, 0x6a,
+	0x24, 0x54, 0x80, 0x96, 0x67, 0x8c, 0x4a, 0xea, 0x24, 0x22, 0x11, 0xc6, 0x5f, 0x3e, 0x55, 0xde,
+	0xad, 0xaa, 0x1c, 0x5a, 0x61, 0xf1, 0xd8, 0xd3, 0x7c, 0x04, 0x4a, 0xb3, 0x51, 0x6e, 0x02, 0x7a,
+	0x0e, 0xd9, 0x78, 0x58, 0x02, 0x0d, 0x3e, 0xfe, 0x68, 0x6f, 0xc0, 0x93, 0x0c, 0xe4, 0x40, 0x33,
+	0x5d, 0x28, 0x1f, 0x9e, 0x14, 0xa0, 0x74, 0xef, 0x27, 0x8b, 0x6c, 0x5e, 0x10, 0xa0, 0x72, 0x91,
+	0x29, 0xa0, 0xfb, 0x64, 0x6d, 0xcc, 0x52, 0x1e, 0x33, 0x2d, 0x64, 0xc0, 0xe2, 0x58, 0x82, 0x52,
+	0xb6, 0xd5, 0xb5, 0xb6, 0x5b, 0xfd, 0xdb, 0xbf, 0x3c, 0xdb, 0xd9, 0xac, 0x28, 0xbf, 0x98, 0xc6,
+	0xec, 0x99, 0x90, 0x81, 0x96, 0x3c, 0x4b, 0xfc, 0xd5, 0xf1, 0x8c, 0x9f, 0xbe, 0x4f, 0xda, 0x0a,
+	0xdf, 0x13, 0x1c, 0xc0, 0x44, 0xd9, 0x8d, 0xee, 0xfc, 0x76, 0x7b, 0xf7, 0x96, 0x3b, 0xdb, 0x29,
+	0xd7, 0xc0, 0x7c, 0x06, 0x13, 0x9f, 0xa8, 0xe9, 0xa3, 0xea, 0xfd, 0x6c, 0x91, 0xd6, 0xb1, 0x42,
+	0x3b, 0x64, 0x81, 0x67, 0x31, 0x7c, 0x85, 0x3c, 0x2b, 0xbe, 0x31, 0xe8, 0x26, 0x21, 0xf8, 0x10,
+	0x64, 0x6c, 0x04, 0x76, 0xa3, 0x44, 0xf5, 0x5b, 0xe8, 0xd9, 0x67, 0x23, 0xa0, 0xf7, 0x88, 0xcd,
+	0xb1, 0xc5, 0x63, 0x9e, 0x25, 0x81, 0x8a, 0x86, 0x30, 0x82, 0x80, 0x45, 0x9a, 0x8f, 0xc1, 0x9e,
+	0xef, 0x5a, 0xdb, 0x4b, 0xfe, 0xab, 0x5c, 0x3d, 0x30, 0xf2, 0x00, 0xd5, 0x3d, 0x14, 0xe9, 0x3d,
+	0x42, 0xf2, 0x22, 0x4c, 0x79, 0x54, 0x92, 0xdb, 0x4d, 0x6c, 0x81, 0xfd, 0xe2, 0xd9, 0x4e, 0xa7,
+	0x6a, 0x41, 0x24, 0x27, 0xb9, 0x16, 0xee, 0x83, 0x22, 0x2c, 0xa9, 0x5b, 0x26, 0xb6, 0xc4, 0xbc,
+	0x45, 0x5a, 0x5c, 0x05, 0x6a, 0x92, 0x45, 0x10, 0xdb, 0x0b, 0xf8, 0x8a, 0x25, 0xae, 0x06, 0x68,
+	0x9f, 0x33, 0xa1, 0xfb, 0xc0, 0x52, 0x3d, 0x9c, 0x4e, 0xe8, 0xef, 0xe6, 0x99, 0x09, 0x4d, 0x03,
+	0xfe, 0xa3, 0x09, 0x19, 0xdc, 0x54, 0xb0, 0x18, 0x62, 0x6c, 0x1f, 0xe2, 0x7e, 0x8e, 0x76, 0xfd,
+	0x2c, 0xf3, 0xf5, 0xb3, 0x50, 0x97, 0xbc, 0x92, 0x32, 0x0d, 0x4a, 0x07, 0x21, 0xd3, 0xd1, 0x30,
+	0xc8, 0x8a, 0x51, 0x08, 0x12, 0x5b, 0xd5, 0xf4, 0xd7, 0x8c, 0xd4, 0x2f, 0x95, 0x7d, 0x14, 0xe8,
+	0x5b, 0x64, 0x8d, 0x67, 0xc1, 0x09, 0xbc, 0x96, 0x00, 0x55, 0x83, 0x6e, 0xf0, 0xec, 0x18, 0xf8,
+	0x91, 0x04, 0xa0, 0x6f, 0x93, 0x8e, 0x16, 0x9a, 0xa5, 0x01, 0xde, 0x86, 0xd8, 0xbc, 0x01, 0x94,
+	0xbd, 0x88, 0xc5, 0x29, 0x6a, 0xd8, 0x9e, 0xb8, 0x6f, 0x14, 0xfa, 0x2e, 0xb9, 0x79, 0x92, 0x51,
+	0xce, 0xfa, 0x31, 0xe3, 0x69, 0x21, 0x41, 0xd9, 0xd7, 0x30, 0xa7, 0x73, 0x9c, 0xc3, 0xb3, 0xe4,
+	0x93, 0x4a, 0xa3, 0xaf, 0x93, 0x15, 0x93, 0x25, 0xa1, 0x6c, 0x81, 0xb2, 0x97, 0x30, 0x78, 0x19,
+	0x9d, 0xbe, 0xf1, 0xd1, 0x4f, 0xc9, 0xf5, 0x19, 0x8c, 0x16, 0xde, 0xe3, 0xcd, 0x0b, 0xee, 0xb1,
+	0x61, 0xea, 0x37, 0x9f, 0xff, 0xbe, 0x35, 0xe7, 0xaf, 0xa8, 0x1a, 0xe6, 0x43, 0xb2, 0x7a, 0x06,
+	0x90, 0x60, 0xb5, 0xee, 0xf9, 0xd5, 0x4e, 0x68, 0xab, 0x82, 0x37, 0xd4, 0xcc, 0x19, 0x3e, 0x20,
+	0xd7, 0xa6, 0xf4, 0x6d, 0xac, 0xe4, 0x5c, 0xf4, 0x7d, 0x99, 0x03, 0x55, 0x75, 0xa6, 0x49, 0xbd,
+	0x6f, 0x2c, 0xd2, 0x3e, 0xc5, 0x4d, 0x6f, 0x93, 0xe5, 0xda, 0x40, 0x2d, 0x6c, 0x49, 0x3b, 0x3c,
+	0x35, 0xca, 0x32, 0x24, 0x15, 0xd1, 0x41, 0x30, 0x04, 0x9e, 0x0c, 0x35, 0xde, 0x9b, 0x79, 0xbf,
+	0x8d, 0xbe, 0xfb, 0xe8, 0xa2, 0xef, 0x91, 0x66, 0xb9, 0x9e, 0xf0, 0xd6, 0xb4, 0x77, 0xd7, 0x5d,
+	0xb3, 0xbb, 0xdc, 0xe9, 0xee, 0x72, 0x1f, 0x4d, 0x77, 0x57, 0x7f, 0xa9, 0xc4, 0x79, 0xfa, 0xc7,
+	0x96, 0xe5, 0x63, 0x46, 0xef, 0x47, 0x8b, 0x5c, 0xaf, 0x9f, 0xfc, 0xff, 0x46, 0x2a, 0x57, 0x0f,
+	0x48, 0x29, 0xcc, 0xe5, 0x6e, 0xf9, 0xc6, 0xe8, 0x7d, 0x67, 0x91, 0xe5, 0xd3, 0x8d, 0x3d, 0xc3,
+	0x60, 0x5d, 0xcc, 0xd0, 0xf8, 0xd7, 0x0c, 0x37, 0xc9, 0xa2, 0x04, 0xa6, 0x44, 0x86, 0xfc, 0x2d,
+	0xbf, 0xb2, 0xce, 0x67, 0xdb, 0x7d, 0xd1, 0x20, 0x0b, 0xb8, 0x48, 0xe8, 0xf7, 0x16, 0x59, 0x9d,
+	0xdd, 0xf7, 0xd4, 0x3d, 0x7b, 0x45, 0x2e, 0xfb, 0x73, 0xac, 0x7b, 0x57, 0x8e, 0x37, 0x6b, 0xaa,
+	0x77, 0xe7, 0xeb, 0x5f, 0xff, 0xfa, 0xb6, 0xd1, 0xa5, 0x8e, 0x57, 0x26, 0xee, 0x98, 0x9f, 0x66,
+	0x5e, 0x84, 0x07, 0x30, 0x41, 0x8f, 0xf9, 0xb0, 0x25, 0xfd, 0xa1, 0x46, 0x67, 0x76, 0xdd, 0x15,
+	0xe8, 0x6a, 0x5b, 0xf3, 0x0a, 0x74, 0xf5, 0x25, 0xda, 0xdb, 0x41, 0xba, 0x37, 0xe9, 0x1b, 0x97,
+	0xd3, 0x05, 0x43, 0x4c, 0xeb, 0x7f, 0xf8, 0xfc, 0xd0, 0xb1, 0x5e, 0x1e, 0x3a, 0xd6, 0x9f, 0x87,
+	0x8e, 0xf5, 0xf4, 0xc8, 0x99, 0x7b, 0x79, 0xe4, 0xcc, 0xfd, 0x76, 0xe4, 0xcc, 0x7d, 0x79, 0x27,
+	0xe1, 0x7a, 0x58, 0x84, 0x6e, 0x24, 0x46, 0x98, 0x88, 0x73, 0x8c, 0x44, 0x7a, 0xba, 0x2e, 0xcb,
+	0xf3, 0x70, 0x11, 0x85, 0x77, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x68, 0x4f, 0x68, 0x42,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -263,6 +694,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// SEDASignerStatus returns the status of the node's SEDA signer.
 	SEDASignerStatus(ctx context.Context, in *QuerySEDASignerStatusRequest, opts ...grpc.CallOption) (*QuerySEDASignerStatusResponse, error)
+	// SEDASignerHealth returns the node-local batch signing history of the
+	// node's SEDA signer along with its on-chain key and validator tree
+	// status.
+	SEDASignerHealth(ctx context.Context, in *QuerySEDASignerHealthRequest, opts ...grpc.CallOption) (*QuerySEDASignerHealthResponse, error)
 }
 
 type queryClient struct {
@@ -282,10 +717,23 @@ func (c *queryClient) SEDASignerStatus(ctx context.Context, in *QuerySEDASignerS
 	return out, nil
 }
 
+func (c *queryClient) SEDASignerHealth(ctx context.Context, in *QuerySEDASignerHealthRequest, opts ...grpc.CallOption) (*QuerySEDASignerHealthResponse, error) {
+	out := new(QuerySEDASignerHealthResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.app.v1.Query/SEDASignerHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// SEDASignerStatus returns the status of the node's SEDA signer.
 	SEDASignerStatus(context.Context, *QuerySEDASignerStatusRequest) (*QuerySEDASignerStatusResponse, error)
+	// SEDASignerHealth returns the node-local batch signing history of the
+	// node's SEDA signer along with its on-chain key and validator tree
+	// status.
+	SEDASignerHealth(context.Context, *QuerySEDASignerHealthRequest) (*QuerySEDASignerHealthResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -295,6 +743,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) SEDASignerStatus(ctx context.Context, req *QuerySEDASignerStatusRequest) (*QuerySEDASignerStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SEDASignerStatus not implemented")
 }
+func (*UnimplementedQueryServer) SEDASignerHealth(ctx context.Context, req *QuerySEDASignerHealthRequest) (*QuerySEDASignerHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SEDASignerHealth not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -318,6 +769,24 @@ func _Query_SEDASignerStatus_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SEDASignerHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySEDASignerHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SEDASignerHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.app.v1.Query/SEDASignerHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SEDASignerHealth(ctx, req.(*QuerySEDASignerHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sedachain.app.v1.Query",
@@ -327,6 +796,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SEDASignerStatus",
 			Handler:    _Query_SEDASignerStatus_Handler,
 		},
+		{
+			MethodName: "SEDASignerHealth",
+			Handler:    _Query_SEDASignerHealth_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sedachain/app/v1/query.proto",
@@ -461,16 +934,300 @@ func (m *SignerKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySEDASignerHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QuerySEDASignerHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySEDASignerHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySEDASignerHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySEDASignerHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySEDASignerHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reloads) > 0 {
+		for iNdEx := len(m.Reloads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reloads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.SigningFailures) > 0 {
+		for iNdEx := len(m.SigningFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SignedBatches) > 0 {
+		for iNdEx := len(m.SignedBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignedBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TotalReloads != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalReloads))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TotalSigningFailures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSigningFailures))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalSignedBatches != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSignedBatches))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InValidatorTree {
+		i--
+		if m.InValidatorTree {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LatestBatchNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestBatchNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsSynced {
+		i--
+		if m.IsSynced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsLoaded {
+		i--
+		if m.IsLoaded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BatchNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BatchNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerReload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerReload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerReload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QuerySEDASignerStatusRequest) Size() (n int) {
 	if m == nil {
@@ -497,42 +1254,872 @@ func (m *QuerySEDASignerStatusResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *SignerKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.IndexName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsProvingSchemeActive {
+		n += 2
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsSynced {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySEDASignerHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySEDASignerHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsLoaded {
+		n += 2
+	}
+	if m.IsSynced {
+		n += 2
+	}
+	if m.LatestBatchNumber != 0 {
+		n += 1 + sovQuery(uint64(m.LatestBatchNumber))
+	}
+	if m.InValidatorTree {
+		n += 2
+	}
+	if m.TotalSignedBatches != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSignedBatches))
+	}
+	if m.TotalSigningFailures != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSigningFailures))
+	}
+	if m.TotalReloads != 0 {
+		n += 1 + sovQuery(uint64(m.TotalReloads))
+	}
+	if len(m.SignedBatches) > 0 {
+		for _, e := range m.SignedBatches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SigningFailures) > 0 {
+		for _, e := range m.SigningFailures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Reloads) > 0 {
+		for _, e := range m.Reloads {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignedBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNumber))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SigningFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNumber))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerReload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySEDASignerStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySEDASignerStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySEDASignerStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySEDASignerStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySEDASignerStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySEDASignerStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerKeys = append(m.SignerKeys, &SignerKey{})
+			if err := m.SignerKeys[len(m.SignerKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsProvingSchemeActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsProvingSchemeActive = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSynced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSynced = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySEDASignerHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySEDASignerHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySEDASignerHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySEDASignerHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySEDASignerHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySEDASignerHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLoaded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLoaded = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSynced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSynced = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBatchNumber", wireType)
+			}
+			m.LatestBatchNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestBatchNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InValidatorTree", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InValidatorTree = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSignedBatches", wireType)
+			}
+			m.TotalSignedBatches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSignedBatches |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSigningFailures", wireType)
+			}
+			m.TotalSigningFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSigningFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReloads", wireType)
+			}
+			m.TotalReloads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalReloads |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedBatches = append(m.SignedBatches, SignedBatch{})
+			if err := m.SignedBatches[len(m.SignedBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningFailures = append(m.SigningFailures, SigningFailure{})
+			if err := m.SigningFailures[len(m.SigningFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reloads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reloads = append(m.Reloads, SignerReload{})
+			if err := m.Reloads[len(m.Reloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *SignerKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovQuery(uint64(m.Index))
-	}
-	l = len(m.IndexName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IsProvingSchemeActive {
-		n += 2
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IsSynced {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QuerySEDASignerStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *SignedBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -555,12 +2142,83 @@ func (m *QuerySEDASignerStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySEDASignerStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignedBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySEDASignerStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignedBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNumber", wireType)
+			}
+			m.BatchNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -582,7 +2240,7 @@ func (m *QuerySEDASignerStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySEDASignerStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *SigningFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -605,17 +2263,55 @@ func (m *QuerySEDASignerStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySEDASignerStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigningFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySEDASignerStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNumber", wireType)
+			}
+			m.BatchNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -625,29 +2321,30 @@ func (m *QuerySEDASignerStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -657,25 +2354,23 @@ func (m *QuerySEDASignerStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignerKeys = append(m.SignerKeys, &SignerKey{})
-			if err := m.SignerKeys[len(m.SignerKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -698,7 +2393,7 @@ func (m *QuerySEDASignerStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerKey) Unmarshal(dAtA []byte) error {
+func (m *SignerReload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -721,17 +2416,17 @@ func (m *SignerKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerReload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerReload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Index = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -741,16 +2436,16 @@ func (m *SignerKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint32(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -760,29 +2455,30 @@ func (m *SignerKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsProvingSchemeActive", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -792,15 +2488,27 @@ func (m *SignerKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsProvingSchemeActive = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -828,28 +2536,8 @@ func (m *SignerKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsSynced", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsSynced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10,6 +10,7 @@ import (
 	v018 "github.com/sedaprotocol/seda-chain/app/upgrades/mainnet/v0.1.8"
 	v019 "github.com/sedaprotocol/seda-chain/app/upgrades/mainnet/v0.1.9"
 	v1 "github.com/sedaprotocol/seda-chain/app/upgrades/mainnet/v1"
+	v110 "github.com/sedaprotocol/seda-chain/app/upgrades/mainnet/v1.1.0"
 	v1rc4 "github.com/sedaprotocol/seda-chain/app/upgrades/testnet/v1.0.0-rc.4"
 	v1rc6 "github.com/sedaprotocol/seda-chain/app/upgrades/testnet/v1.0.0-rc.6"
 )
@@ -23,6 +24,7 @@ var Upgrades = []upgrades.Upgrade{
 	v017.Upgrade,
 	v018.Upgrade,
 	v019.Upgrade,
+	v110.Upgrade,
 }
 
 func (app *App) setupUpgrades() {
@@ -0,0 +1,109 @@
+package v110
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/sedaprotocol/seda-chain/app/keepers"
+	"github.com/sedaprotocol/seda-chain/app/upgrades"
+	oracletypes "github.com/sedaprotocol/seda-chain/x/oracle/types"
+)
+
+const (
+	UpgradeName = "v1.1.0"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added:   []string{oracletypes.StoreKey},
+		Deleted: []string{},
+	},
+}
+
+func CreateUpgradeHandler(
+	mm upgrades.ModuleManager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(context context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(context)
+
+		/*
+		 * migrations are run in module name alphabetical
+		 * ascending order, except x/auth which is run last
+		 *
+		 * This upgrade touches the following state:
+		 * - x/batching migrates from version 1 to 2, which sets
+		 *   the max vote extension size to its default if it is
+		 *   below the size of a vote extension envelope.
+		 * - x/data-proxy migrates from version 1 to 2, which
+		 *   sets the min bond, unbonding period, deregistration
+		 *   delay and key alias period to their defaults.
+		 * - x/oracle is added with a new store and initialized
+		 *   from its default genesis.
+		 * - x/wasm-storage migrates from version 1 to 2, which
+		 *   sets the rent, max prunes per block, upload session
+		 *   TTL and program grace period to their defaults and
+		 *   backfills the index of oracle programs by the time
+		 *   they were added.
+		 * After the migrations, the oracle parameters are set
+		 * if missing and the Core Contract history is seeded.
+		 */
+		migrations, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		// The oracle module is initialized by the module migrations, but
+		// its parameters are set here if they are still missing so that
+		// the ABCI handlers never find the oracle store empty. The
+		// default parameters observe no feeds, which leaves the oracle
+		// inactive until governance configures it.
+		if _, err := keepers.OracleKeeper.GetParams(ctx); err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return nil, err
+			}
+			if err := keepers.OracleKeeper.SetParams(ctx, oracletypes.DefaultParams()); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := seedCoreContractHistory(ctx, keepers); err != nil {
+			return nil, err
+		}
+
+		return migrations, nil
+	}
+}
+
+// seedCoreContractHistory records the Core Contract registered before the
+// registry history was introduced, so that the history includes the
+// active Core Contract.
+func seedCoreContractHistory(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	contractAddr, err := keepers.WasmStorageKeeper.GetCoreContractAddr(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if contractAddr == nil {
+		return nil
+	}
+
+	contractInfo := keepers.WasmKeeper.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return fmt.Errorf("core contract %s not found", contractAddr)
+	}
+	return keepers.WasmStorageKeeper.SeedCoreContractHistory(ctx, contractAddr, contractInfo.CodeID)
+}
@@ -32,6 +32,10 @@ seda-key-file = "{{ .SEDAConfig.SEDAKeyFile }}"
 
 # allow-unencrypted-seda-keys enables unencrypted use of the SEDA key file.
 allow-unencrypted-seda-keys = {{ .SEDAConfig.AllowUnencryptedSEDAKeys }}
+
+# oracle-data-proxy-url is the URL of the data proxy queried for observations
+# of the oracle feeds. Only the block time feed is observed if left empty.
+oracle-data-proxy-url = "{{ .SEDAConfig.OracleDataProxyURL }}"
 `
 )
 
@@ -39,6 +43,7 @@ const (
 	FlagEnableSEDASigner         = "seda.enable-seda-signer"
 	FlagSEDAKeyFile              = "seda.seda-key-file"
 	FlagAllowUnencryptedSEDAKeys = "seda.allow-unencrypted-seda-keys"
+	FlagOracleDataProxyURL       = "seda.oracle-data-proxy-url"
 )
 
 var defaultSEDAKeyFile = filepath.Join(tmcfg.DefaultConfigDir, "seda_keys.json")
@@ -61,6 +66,7 @@ type SEDAConfig struct {
 	EnableSEDASigner         bool   `mapstructure:"enable-seda-signer"`
 	SEDAKeyFile              string `mapstructure:"seda-key-file"`
 	AllowUnencryptedSEDAKeys bool   `mapstructure:"allow-unencrypted-seda-keys"`
+	OracleDataProxyURL       string `mapstructure:"oracle-data-proxy-url"`
 }
 
 func DefaultSEDAConfig() SEDAConfig {
@@ -94,6 +100,10 @@ func ReadSEDAConfigFromAppOpts(appOpts servertypes.AppOptions) (*SEDAConfig, err
 	}
 	config.AllowUnencryptedSEDAKeys = cast.ToBool(v)
 
+	// The data proxy URL is optional for compatibility with existing
+	// configuration files.
+	config.OracleDataProxyURL = cast.ToString(appOpts.Get(FlagOracleDataProxyURL))
+
 	return config, nil
 }
 
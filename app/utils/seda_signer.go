@@ -113,24 +113,30 @@ func (s *sedaKeys) Sign(input []byte, index sedatypes.SEDAKeyIndex) ([]byte, err
 // do not match the currently loaded ones. If no indexed public keys are
 // given, the signer is reloaded.
 func (s *sedaKeys) ReloadIfMismatch(pubKeys []pubkeytypes.IndexedPubKey) error {
-	if len(pubKeys) == 0 {
+	if len(pubKeys) == 0 || !PubKeysMatch(s.pubKeys, pubKeys) {
 		return s.reload()
 	}
-	for _, pubKey := range s.pubKeys {
+	return nil
+}
+
+// PubKeysMatch returns true if every loaded public key is found at the
+// same index among the given registered public keys.
+func PubKeysMatch(loaded, registered []pubkeytypes.IndexedPubKey) bool {
+	for _, pubKey := range loaded {
 		found := false
-		for _, pk := range pubKeys {
+		for _, pk := range registered {
 			if pk.Index == pubKey.Index {
 				if !bytes.Equal(pk.PubKey, pubKey.PubKey) {
-					return s.reload()
+					return false
 				}
 				found = true
 			}
 		}
 		if !found {
-			return s.reload()
+			return false
 		}
 	}
-	return nil
+	return true
 }
 
 // IsLoaded returns true if the signer is loaded and ready for signing.
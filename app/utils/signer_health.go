@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultSignerHealthHistorySize is the number of entries kept for
+// each type of signer event recorded by SignerHealth.
+const DefaultSignerHealthHistorySize = 100
+
+// SignedBatchRecord records a batch signed by the node's SEDA signer.
+type SignedBatchRecord struct {
+	BatchNumber uint64
+	BlockHeight int64
+	Time        time.Time
+}
+
+// SigningFailureRecord records a failed attempt to sign a batch.
+type SigningFailureRecord struct {
+	BatchNumber uint64
+	BlockHeight int64
+	Time        time.Time
+	Error       string
+}
+
+// SignerReloadRecord records an attempt to reload the SEDA signer
+// from its key file.
+type SignerReloadRecord struct {
+	BlockHeight int64
+	Time        time.Time
+	Reason      string
+	Error       string
+}
+
+// SignerHealth keeps a bounded, node-local history of the batch signing
+// activity of the SEDA signer for diagnostic purposes. It is not part of
+// the consensus state and is safe for concurrent use.
+type SignerHealth struct {
+	mu            sync.RWMutex
+	historySize   int
+	signedBatches []SignedBatchRecord
+	signingErrors []SigningFailureRecord
+	signerReloads []SignerReloadRecord
+	totalSigned   uint64
+	totalFailures uint64
+	totalReloads  uint64
+}
+
+// NewSignerHealth returns a SignerHealth that keeps up to historySize
+// entries of each type of event.
+func NewSignerHealth(historySize int) *SignerHealth {
+	if historySize <= 0 {
+		historySize = DefaultSignerHealthHistorySize
+	}
+	return &SignerHealth{
+		historySize: historySize,
+	}
+}
+
+// RecordSignedBatch records a successful batch signing.
+func (h *SignerHealth) RecordSignedBatch(batchNum uint64, height int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.signedBatches = appendBounded(h.signedBatches, SignedBatchRecord{
+		BatchNumber: batchNum,
+		BlockHeight: height,
+		Time:        time.Now().UTC(),
+	}, h.historySize)
+	h.totalSigned++
+}
+
+// RecordSigningFailure records a failed attempt to sign a batch.
+func (h *SignerHealth) RecordSigningFailure(batchNum uint64, height int64, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.signingErrors = appendBounded(h.signingErrors, SigningFailureRecord{
+		BatchNumber: batchNum,
+		BlockHeight: height,
+		Time:        time.Now().UTC(),
+		Error:       errString(err),
+	}, h.historySize)
+	h.totalFailures++
+}
+
+// RecordReload records an attempt to reload the signer. A nil error
+// indicates that the reload succeeded.
+func (h *SignerHealth) RecordReload(height int64, reason string, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.signerReloads = appendBounded(h.signerReloads, SignerReloadRecord{
+		BlockHeight: height,
+		Time:        time.Now().UTC(),
+		Reason:      reason,
+		Error:       errString(err),
+	}, h.historySize)
+	h.totalReloads++
+}
+
+// SignedBatches returns the most recent batch signings, from oldest
+// to newest.
+func (h *SignerHealth) SignedBatches() []SignedBatchRecord {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return append([]SignedBatchRecord(nil), h.signedBatches...)
+}
+
+// SigningFailures returns the most recent signing failures, from oldest
+// to newest.
+func (h *SignerHealth) SigningFailures() []SigningFailureRecord {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return append([]SigningFailureRecord(nil), h.signingErrors...)
+}
+
+// Reloads returns the most recent signer reload attempts, from oldest
+// to newest.
+func (h *SignerHealth) Reloads() []SignerReloadRecord {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return append([]SignerReloadRecord(nil), h.signerReloads...)
+}
+
+// Totals returns the number of signed batches, signing failures, and
+// reload attempts recorded since the node started.
+func (h *SignerHealth) Totals() (signed, failures, reloads uint64) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.totalSigned, h.totalFailures, h.totalReloads
+}
+
+// appendBounded appends the given entry and drops the oldest entries
+// so that at most size entries are kept.
+func appendBounded[T any](entries []T, entry T, size int) []T {
+	entries = append(entries, entry)
+	if len(entries) > size {
+		entries = append(entries[:0:0], entries[len(entries)-size:]...)
+	}
+	return entries
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
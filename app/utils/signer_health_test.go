@@ -0,0 +1,46 @@
+package utils_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sedaprotocol/seda-chain/app/utils"
+)
+
+func TestSignerHealth(t *testing.T) {
+	health := utils.NewSignerHealth(3)
+
+	for i := uint64(1); i <= 5; i++ {
+		health.RecordSignedBatch(i, int64(100+i))
+	}
+	health.RecordSigningFailure(6, 106, errors.New("validator was not in the previous validator tree"))
+	health.RecordReload(106, "signer is not loaded", nil)
+	health.RecordReload(107, "loaded keys do not match registered keys", errors.New("file not found"))
+
+	signed := health.SignedBatches()
+	require.Len(t, signed, 3)
+	require.Equal(t, uint64(3), signed[0].BatchNumber)
+	require.Equal(t, uint64(5), signed[2].BatchNumber)
+	require.Equal(t, int64(105), signed[2].BlockHeight)
+
+	failures := health.SigningFailures()
+	require.Len(t, failures, 1)
+	require.Equal(t, uint64(6), failures[0].BatchNumber)
+	require.Equal(t, "validator was not in the previous validator tree", failures[0].Error)
+
+	reloads := health.Reloads()
+	require.Len(t, reloads, 2)
+	require.Empty(t, reloads[0].Error)
+	require.Equal(t, "file not found", reloads[1].Error)
+
+	totalSigned, totalFailures, totalReloads := health.Totals()
+	require.Equal(t, uint64(5), totalSigned)
+	require.Equal(t, uint64(1), totalFailures)
+	require.Equal(t, uint64(2), totalReloads)
+
+	// Returned slices must not alias the internal history.
+	signed[0].BatchNumber = 0
+	require.Equal(t, uint64(3), health.SignedBatches()[0].BatchNumber)
+}
@@ -56,6 +56,7 @@ import (
 	"github.com/sedaprotocol/seda-chain/app/utils"
 	_ "github.com/sedaprotocol/seda-chain/client/docs/statik" // for swagger docs
 	"github.com/sedaprotocol/seda-chain/cmd/sedad/gentx"
+	wasmstoragecli "github.com/sedaprotocol/seda-chain/x/wasm-storage/client/cli"
 )
 
 // NewRootCmd creates a new root command for a Cosmos SDK application
@@ -217,6 +218,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig, basi
 		queryCommand(basicManager),
 		txCommand(basicManager),
 		keys.Commands(),
+		wasmstoragecli.GetAuditCmd(),
 	)
 }
 
@@ -291,6 +293,7 @@ func queryCommand(_ module.BasicManager) *cobra.Command {
 		authcmd.QueryTxCmd(),
 		server.QueryBlockResultsCmd(),
 		app.GetSEDASignerStatus(),
+		app.GetSEDASignerHealth(),
 	)
 
 	return cmd
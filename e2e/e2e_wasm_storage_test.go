@@ -17,8 +17,8 @@ import (
 )
 
 var (
-	oracleProgram = testwasms.BurnerWasmFileName()
-	tallyProgram  = testwasms.ReflectWasmFileName()
+	oracleProgram = testwasms.RandomNumberWasmFileName()
+	tallyProgram  = testwasms.SampleTallyWasmFileName()
 	coreWasm      = testwasms.CoreContractWasmFileName()
 )
 
@@ -15,6 +15,14 @@ func ReflectWasmFileName() string {
 	return "reflect.wasm"
 }
 
+func SampleTallyWasmFileName() string {
+	return "sample_tally.wasm"
+}
+
+func RandomNumberWasmFileName() string {
+	return "random-number.wasm"
+}
+
 func CoreContractWasmFileName() string {
 	return "core_contract.wasm"
 }
@@ -94,6 +102,23 @@ var TestWasms = [][]byte{
 	HelloWorldWasm(),
 }
 
+// SDKWasms lists the oracle programs built with the SEDA SDK.
+var SDKWasms = [][]byte{
+	SampleTallyWasm(),
+	SampleTallyWasm2(),
+	RandomStringTallyWasm(),
+	ChaosDrWasm(),
+	DataProxyWasm(),
+	HTTPHeavyWasm(),
+	LongHTTPWasm(),
+	MaxDrWasm(),
+	MaxResultWasm(),
+	MemoryWasm(),
+	MockAPIWasm(),
+	PriceFeedWasm(),
+	RandomNumberWasm(),
+}
+
 func CoreContractWasm() []byte {
 	return coreContract
 }
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/batching/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the MaxVoteExtensionSize parameter, which is zero in
+// the parameters stored before it was introduced, to its default value.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MaxVoteExtensionSize < types.MinVoteExtensionSize {
+		params.MaxVoteExtensionSize = types.DefaultMaxVoteExtensionSize
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return m.keeper.SetParams(ctx, params)
+}
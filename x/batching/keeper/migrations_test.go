@@ -0,0 +1,23 @@
+package keeper_test
+
+import (
+	"github.com/sedaprotocol/seda-chain/x/batching/keeper"
+	"github.com/sedaprotocol/seda-chain/x/batching/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	s.SetupTest()
+
+	// Parameters stored before MaxVoteExtensionSize was introduced.
+	params := types.DefaultParams()
+	params.MaxVoteExtensionSize = 0
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.Require().Error(params.Validate())
+
+	s.Require().NoError(keeper.NewMigrator(*s.keeper).Migrate1to2(s.ctx))
+
+	migrated, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), migrated)
+	s.Require().NoError(migrated.Validate())
+}
@@ -441,6 +441,9 @@ type Params struct {
 	// MaxBatchPrunePerBlock is the maximum number of batches to prune per
 	// block.
 	MaxBatchPrunePerBlock uint64 `protobuf:"varint,2,opt,name=max_batch_prune_per_block,json=maxBatchPrunePerBlock,proto3" json:"max_batch_prune_per_block,omitempty"`
+	// MaxVoteExtensionSize is the maximum size of a vote extension in bytes.
+	// Vote extensions exceeding it are rejected.
+	MaxVoteExtensionSize uint64 `protobuf:"varint,3,opt,name=max_vote_extension_size,json=maxVoteExtensionSize,proto3" json:"max_vote_extension_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -490,6 +493,13 @@ func (m *Params) GetMaxBatchPrunePerBlock() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxVoteExtensionSize() uint64 {
+	if m != nil {
+		return m.MaxVoteExtensionSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Batch)(nil), "sedachain.batching.v1.Batch")
 	proto.RegisterType((*DataResultTreeEntries)(nil), "sedachain.batching.v1.DataResultTreeEntries")
@@ -504,61 +514,64 @@ func init() {
 }
 
 var fileDescriptor_5b2a028024867de2 = []byte{
-	// 864 bytes of a gzipped FileDescriptorProto
+	// 901 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0xae, 0xd3, 0x34, 0x3f, 0x93, 0xa4, 0x0d, 0xd3, 0x1b, 0xe4, 0xde, 0x45, 0x1c, 0x22, 0xae,
-	0x14, 0x40, 0x49, 0x08, 0x11, 0x3f, 0x12, 0x6c, 0x30, 0x20, 0x51, 0xa1, 0x7b, 0x15, 0x0d, 0xe5,
-	0x2e, 0x58, 0x60, 0x4d, 0x3c, 0x23, 0xc7, 0x4a, 0xec, 0x31, 0x33, 0xe3, 0xd0, 0xbe, 0x05, 0x2f,
-	0xc0, 0x86, 0x67, 0xe0, 0x1d, 0x60, 0x79, 0xc5, 0x0a, 0x81, 0x64, 0xa1, 0x76, 0x97, 0x47, 0x60,
-	0x85, 0x7c, 0xc6, 0x71, 0xda, 0xb2, 0x66, 0xe5, 0x39, 0xdf, 0x77, 0xce, 0x99, 0x73, 0x66, 0xbe,
-	0x33, 0x46, 0x6f, 0x2a, 0xce, 0xa8, 0xbf, 0xa2, 0x61, 0x3c, 0x5d, 0x52, 0xed, 0xaf, 0xc2, 0x38,
-	0x98, 0x6e, 0x67, 0xe5, 0x7a, 0x92, 0x48, 0xa1, 0x05, 0xee, 0x95, 0x5e, 0x93, 0x92, 0xd9, 0xce,
-	0x9e, 0x5e, 0xf8, 0x42, 0x45, 0x42, 0x79, 0xe0, 0x34, 0x35, 0x86, 0x89, 0x78, 0xfa, 0x24, 0x10,
-	0x81, 0x30, 0x78, 0xbe, 0x32, 0xe8, 0xf0, 0xa7, 0x0a, 0x3a, 0x71, 0xf3, 0x04, 0xf8, 0x0d, 0xd4,
-	0x86, 0x4c, 0x5e, 0x9c, 0x46, 0x4b, 0x2e, 0x6d, 0x6b, 0x60, 0x8d, 0xaa, 0xa4, 0x05, 0xd8, 0x0b,
-	0x80, 0xc0, 0x65, 0x23, 0xfc, 0xb5, 0xb7, 0xe2, 0x61, 0xb0, 0xd2, 0x76, 0x65, 0x60, 0x8d, 0x8e,
-	0x49, 0x0b, 0xb0, 0x2f, 0x01, 0xc2, 0x1f, 0x22, 0xdb, 0x4f, 0xa5, 0xe4, 0xb1, 0xf6, 0x18, 0xd5,
-	0xd4, 0x93, 0x5c, 0xa5, 0x1b, 0xed, 0x49, 0x21, 0xb4, 0x7d, 0x3c, 0xb0, 0x46, 0x4d, 0xd2, 0x2b,
-	0xf8, 0xcf, 0xa9, 0xa6, 0x04, 0x58, 0x22, 0x84, 0xc6, 0x23, 0xd4, 0xfd, 0x4f, 0x40, 0x15, 0x02,
-	0x4e, 0xd9, 0x43, 0xcf, 0x67, 0xe8, 0x74, 0x4b, 0x37, 0x21, 0xa3, 0x5a, 0x48, 0xe3, 0x77, 0x02,
-	0x7e, 0x9d, 0x12, 0x05, 0xb7, 0x0b, 0xd4, 0x30, 0xfd, 0x84, 0xcc, 0xae, 0x0d, 0xac, 0x51, 0x9b,
-	0xd4, 0xc1, 0xbe, 0x64, 0xf8, 0x2d, 0xd4, 0x4d, 0xa4, 0xd8, 0x86, 0x71, 0xe0, 0x45, 0x5c, 0xd3,
-	0x3c, 0xbf, 0x5d, 0x07, 0x97, 0xb3, 0x02, 0x7f, 0x5e, 0xc0, 0xc3, 0x19, 0xea, 0x1d, 0x0a, 0xbd,
-	0x92, 0x9c, 0x7f, 0x11, 0x6b, 0x19, 0x72, 0x85, 0x6d, 0x54, 0xe7, 0x66, 0x69, 0x5b, 0x83, 0xe3,
-	0x3c, 0x7b, 0x61, 0x0e, 0x7f, 0xb5, 0x10, 0x7e, 0xb9, 0x2f, 0x65, 0x1f, 0x72, 0x83, 0xbf, 0x43,
-	0xaf, 0x1d, 0xca, 0xa6, 0x8c, 0x49, 0xae, 0x14, 0x1c, 0x72, 0xdb, 0x9d, 0xfd, 0x93, 0x39, 0xe3,
-	0x20, 0xd4, 0xab, 0x74, 0x39, 0xf1, 0x45, 0x54, 0xdc, 0x5b, 0xf1, 0x19, 0x2b, 0xb6, 0x9e, 0xea,
-	0x9b, 0x84, 0xab, 0xc9, 0x4b, 0xba, 0xf9, 0xd4, 0x04, 0x92, 0x6e, 0x99, 0xab, 0x40, 0xf0, 0xbb,
-	0xe8, 0xc9, 0x56, 0xe8, 0xbc, 0xa7, 0x44, 0xfc, 0xc0, 0xa5, 0x97, 0x70, 0xe9, 0xf3, 0xd8, 0x5c,
-	0x52, 0x87, 0x60, 0xc3, 0x2d, 0x72, 0x6a, 0x61, 0x18, 0xec, 0xa0, 0x16, 0xd7, 0xab, 0xb2, 0x96,
-	0x63, 0x38, 0x01, 0xc4, 0xf5, 0xaa, 0x48, 0x39, 0xfc, 0xd9, 0x42, 0x67, 0x20, 0x8e, 0xaf, 0xc3,
-	0x20, 0xa6, 0x3a, 0x95, 0x5c, 0xfd, 0xef, 0x6d, 0x4c, 0xd1, 0xb9, 0xe2, 0x7e, 0xf2, 0xde, 0xfb,
-	0x1f, 0xac, 0x67, 0x9e, 0xda, 0xef, 0x0b, 0x5d, 0xb4, 0x09, 0x2e, 0xa9, 0xb2, 0xa2, 0xe1, 0x5f,
-	0x55, 0x84, 0x0e, 0x57, 0x84, 0x5f, 0x47, 0x95, 0x90, 0x41, 0x41, 0x4d, 0xb7, 0xb6, 0xcb, 0x9c,
-	0x4a, 0xc8, 0x48, 0x25, 0x64, 0xb8, 0x8f, 0x4e, 0x98, 0xcc, 0xb5, 0x50, 0x01, 0xaa, 0xb9, 0xcb,
-	0x1c, 0x03, 0x90, 0x2a, 0x93, 0x97, 0x0c, 0x7f, 0x8c, 0xce, 0x98, 0xf4, 0x1e, 0xc8, 0x3b, 0x3f,
-	0x90, 0xaa, 0x7b, 0xbe, 0xcb, 0x9c, 0xc7, 0x14, 0xe9, 0x30, 0xe9, 0xde, 0x53, 0xfd, 0x33, 0x54,
-	0xdf, 0x72, 0xa9, 0x42, 0x11, 0x1b, 0xcd, 0xba, 0xad, 0x5d, 0xe6, 0xec, 0x21, 0xb2, 0x5f, 0xe0,
-	0xf9, 0xa3, 0xf9, 0x39, 0x81, 0x0d, 0xba, 0xbb, 0xcc, 0x79, 0x80, 0x3f, 0x9c, 0xa8, 0x4f, 0xd0,
-	0x99, 0x21, 0x75, 0x18, 0x71, 0xa5, 0x69, 0x94, 0x80, 0x9c, 0x8b, 0xc2, 0x1e, 0x51, 0xe4, 0x14,
-	0x80, 0xab, 0xbd, 0x8d, 0xdf, 0x46, 0x4d, 0x7e, 0x1d, 0x6a, 0xcf, 0x17, 0x8c, 0x83, 0xc6, 0x3b,
-	0x6e, 0x67, 0x97, 0x39, 0x07, 0x90, 0x34, 0xf2, 0xe5, 0x67, 0x82, 0x71, 0xfc, 0x02, 0x35, 0x02,
-	0xaa, 0xbc, 0x54, 0x71, 0x66, 0x37, 0xa0, 0x8d, 0xf9, 0x9f, 0x99, 0xd3, 0x33, 0xf7, 0xa7, 0xd8,
-	0x7a, 0x12, 0x8a, 0x69, 0x44, 0xf5, 0x6a, 0x72, 0x19, 0xeb, 0x5d, 0xe6, 0x94, 0xce, 0xbf, 0xff,
-	0x32, 0x46, 0xc5, 0x53, 0x73, 0x19, 0x6b, 0x52, 0x0f, 0xa8, 0xfa, 0x46, 0x71, 0x86, 0x87, 0xa8,
-	0x66, 0xa6, 0xd9, 0x6e, 0x82, 0x3e, 0xd0, 0x2e, 0x73, 0x0a, 0x84, 0x14, 0xdf, 0xbc, 0xbb, 0x84,
-	0xde, 0x2c, 0xa9, 0xbf, 0x2e, 0xc5, 0x84, 0x60, 0x6b, 0xe8, 0xee, 0x11, 0x45, 0x4e, 0x0b, 0x60,
-	0x2f, 0x96, 0x39, 0x6a, 0xe7, 0xef, 0xa0, 0x97, 0xd0, 0x9b, 0x8d, 0xa0, 0xcc, 0x6e, 0x41, 0x28,
-	0x1c, 0xe8, 0x7d, 0x9c, 0xb4, 0x72, 0x6b, 0x61, 0x0c, 0xfc, 0x0e, 0x6a, 0xfa, 0x22, 0x56, 0x3c,
-	0x56, 0xa9, 0xb2, 0xdb, 0x03, 0x6b, 0xd4, 0x30, 0x47, 0x52, 0x82, 0xe4, 0xb0, 0x1c, 0x7e, 0x8f,
-	0x6a, 0x0b, 0x2a, 0x69, 0xa4, 0xf0, 0x18, 0x9d, 0xc7, 0x69, 0xe4, 0xc1, 0x1b, 0xc2, 0x95, 0xa7,
-	0x85, 0xb7, 0xe6, 0x3c, 0x29, 0x9e, 0xc9, 0x6e, 0x9c, 0x46, 0xae, 0x61, 0xae, 0xc4, 0x57, 0x9c,
-	0x27, 0xf8, 0x23, 0x74, 0x11, 0xd1, 0x6b, 0xe3, 0xee, 0x25, 0x32, 0x8d, 0x79, 0x3e, 0x91, 0x46,
-	0x45, 0xa0, 0xc1, 0x2a, 0xe9, 0x45, 0xf4, 0x1a, 0x82, 0x16, 0x39, 0xbd, 0xe0, 0x46, 0x52, 0xee,
-	0xf3, 0xdf, 0x6e, 0xfb, 0xd6, 0xab, 0xdb, 0xbe, 0xf5, 0xf7, 0x6d, 0xdf, 0xfa, 0xf1, 0xae, 0x7f,
-	0xf4, 0xea, 0xae, 0x7f, 0xf4, 0xc7, 0x5d, 0xff, 0xe8, 0xdb, 0xf9, 0xbd, 0xe1, 0xca, 0x3b, 0x82,
-	0x27, 0xdc, 0x17, 0x1b, 0x30, 0xc6, 0xe6, 0x9f, 0x71, 0x7d, 0xf8, 0x6b, 0xc0, 0xb4, 0x2d, 0x6b,
-	0xe0, 0x35, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x45, 0xd9, 0xf2, 0x15, 0x58, 0x06, 0x00, 0x00,
+	0x14, 0xae, 0xd3, 0x34, 0x3f, 0x93, 0xa4, 0x0d, 0xd3, 0x06, 0xdc, 0xbb, 0x88, 0x43, 0xc4, 0x95,
+	0x02, 0x28, 0x09, 0x21, 0xba, 0x80, 0x04, 0x1b, 0x02, 0x57, 0xa2, 0x42, 0xf7, 0x2a, 0x9a, 0x5b,
+	0xba, 0x60, 0x81, 0x35, 0xf1, 0x1c, 0x25, 0x56, 0x62, 0x8f, 0x35, 0x33, 0x0e, 0xe9, 0x7d, 0x0a,
+	0x5e, 0x80, 0x0d, 0x2b, 0x1e, 0x80, 0x77, 0x80, 0x65, 0xc5, 0x0a, 0x81, 0x64, 0xa1, 0x76, 0x97,
+	0x47, 0x60, 0x85, 0x3c, 0xe3, 0x38, 0x6d, 0x59, 0xb3, 0xf2, 0x9c, 0xef, 0x3b, 0x67, 0xe6, 0x9c,
+	0x33, 0xdf, 0x19, 0xa3, 0x77, 0x24, 0x30, 0xea, 0x2d, 0xa8, 0x1f, 0x0e, 0x67, 0x54, 0x79, 0x0b,
+	0x3f, 0x9c, 0x0f, 0xd7, 0xa3, 0x7c, 0x3d, 0x88, 0x04, 0x57, 0x1c, 0xb7, 0x72, 0xaf, 0x41, 0xce,
+	0xac, 0x47, 0x4f, 0xce, 0x3d, 0x2e, 0x03, 0x2e, 0x5d, 0xed, 0x34, 0x34, 0x86, 0x89, 0x78, 0x72,
+	0x36, 0xe7, 0x73, 0x6e, 0xf0, 0x74, 0x65, 0xd0, 0xee, 0x8f, 0x05, 0x74, 0x34, 0x49, 0x37, 0xc0,
+	0x6f, 0xa3, 0xba, 0xde, 0xc9, 0x0d, 0xe3, 0x60, 0x06, 0xc2, 0xb6, 0x3a, 0x56, 0xaf, 0x48, 0x6a,
+	0x1a, 0x7b, 0xa9, 0x21, 0xed, 0xb2, 0xe2, 0xde, 0xd2, 0x5d, 0x80, 0x3f, 0x5f, 0x28, 0xbb, 0xd0,
+	0xb1, 0x7a, 0x87, 0xa4, 0xa6, 0xb1, 0xaf, 0x34, 0x84, 0x3f, 0x46, 0xb6, 0x17, 0x0b, 0x01, 0xa1,
+	0x72, 0x19, 0x55, 0xd4, 0x15, 0x20, 0xe3, 0x95, 0x72, 0x05, 0xe7, 0xca, 0x3e, 0xec, 0x58, 0xbd,
+	0x2a, 0x69, 0x65, 0xfc, 0x97, 0x54, 0x51, 0xa2, 0x59, 0xc2, 0xb9, 0xc2, 0x3d, 0xd4, 0xfc, 0x4f,
+	0x40, 0x51, 0x07, 0x1c, 0xb3, 0x87, 0x9e, 0x4f, 0xd1, 0xf1, 0x9a, 0xae, 0x7c, 0x46, 0x15, 0x17,
+	0xc6, 0xef, 0x48, 0xfb, 0x35, 0x72, 0x54, 0xbb, 0x9d, 0xa3, 0x8a, 0xa9, 0xc7, 0x67, 0x76, 0xa9,
+	0x63, 0xf5, 0xea, 0xa4, 0xac, 0xed, 0x0b, 0x86, 0xdf, 0x45, 0xcd, 0x48, 0xf0, 0xb5, 0x1f, 0xce,
+	0xdd, 0x00, 0x14, 0x4d, 0xf7, 0xb7, 0xcb, 0xda, 0xe5, 0x24, 0xc3, 0x5f, 0x64, 0x70, 0x77, 0x84,
+	0x5a, 0xfb, 0x44, 0x2f, 0x05, 0xc0, 0xf3, 0x50, 0x09, 0x1f, 0x24, 0xb6, 0x51, 0x19, 0xcc, 0xd2,
+	0xb6, 0x3a, 0x87, 0xe9, 0xee, 0x99, 0xd9, 0xfd, 0xd5, 0x42, 0xf8, 0x6a, 0x97, 0xca, 0x2e, 0xe4,
+	0x1a, 0x7f, 0x87, 0xde, 0xd8, 0xa7, 0x4d, 0x19, 0x13, 0x20, 0xa5, 0x6e, 0x72, 0x7d, 0x32, 0xfa,
+	0x27, 0x71, 0xfa, 0x73, 0x5f, 0x2d, 0xe2, 0xd9, 0xc0, 0xe3, 0x41, 0x76, 0x6f, 0xd9, 0xa7, 0x2f,
+	0xd9, 0x72, 0xa8, 0xae, 0x23, 0x90, 0x83, 0x2b, 0xba, 0xfa, 0xdc, 0x04, 0x92, 0x66, 0xbe, 0x57,
+	0x86, 0xe0, 0x0f, 0xd0, 0xd9, 0x9a, 0xab, 0xb4, 0xa6, 0x88, 0x7f, 0x0f, 0xc2, 0x8d, 0x40, 0x78,
+	0x10, 0x9a, 0x4b, 0x6a, 0x10, 0x6c, 0xb8, 0x69, 0x4a, 0x4d, 0x0d, 0x83, 0x1d, 0x54, 0x03, 0xb5,
+	0xc8, 0x73, 0x39, 0xd4, 0x1d, 0x40, 0xa0, 0x16, 0xd9, 0x96, 0xdd, 0x9f, 0x2c, 0x74, 0xa2, 0xc5,
+	0xf1, 0xca, 0x9f, 0x87, 0x54, 0xc5, 0x02, 0xe4, 0xff, 0x5e, 0xc6, 0x10, 0x9d, 0x4a, 0xf0, 0xa2,
+	0x0f, 0x9f, 0x7d, 0xb4, 0x1c, 0xb9, 0x72, 0x77, 0xae, 0xae, 0xa2, 0x4e, 0x70, 0x4e, 0xe5, 0x19,
+	0x75, 0xff, 0x2a, 0x22, 0xb4, 0xbf, 0x22, 0xfc, 0x26, 0x2a, 0xf8, 0x4c, 0x27, 0x54, 0x9d, 0x94,
+	0xb6, 0x89, 0x53, 0xf0, 0x19, 0x29, 0xf8, 0x0c, 0xb7, 0xd1, 0x11, 0x13, 0xa9, 0x16, 0x0a, 0x9a,
+	0xaa, 0x6e, 0x13, 0xc7, 0x00, 0xa4, 0xc8, 0xc4, 0x05, 0xc3, 0x9f, 0xa2, 0x13, 0x26, 0xdc, 0x07,
+	0xf2, 0x4e, 0x1b, 0x52, 0x9c, 0x9c, 0x6e, 0x13, 0xe7, 0x31, 0x45, 0x1a, 0x4c, 0x4c, 0xee, 0xa9,
+	0xfe, 0x29, 0x2a, 0xaf, 0x41, 0x48, 0x9f, 0x87, 0x46, 0xb3, 0x93, 0xda, 0x36, 0x71, 0x76, 0x10,
+	0xd9, 0x2d, 0xf0, 0xf8, 0xd1, 0xfc, 0x1c, 0xe9, 0x03, 0x9a, 0xdb, 0xc4, 0x79, 0x80, 0x3f, 0x9c,
+	0xa8, 0xcf, 0xd0, 0x89, 0x21, 0x95, 0x1f, 0x80, 0x54, 0x34, 0x88, 0xb4, 0x9c, 0xb3, 0xc4, 0x1e,
+	0x51, 0xe4, 0x58, 0x03, 0x97, 0x3b, 0x1b, 0xbf, 0x87, 0xaa, 0xb0, 0xf1, 0x95, 0xeb, 0x71, 0x06,
+	0x5a, 0xe3, 0x8d, 0x49, 0x63, 0x9b, 0x38, 0x7b, 0x90, 0x54, 0xd2, 0xe5, 0x17, 0x9c, 0x01, 0x7e,
+	0x89, 0x2a, 0x73, 0x2a, 0xdd, 0x58, 0x02, 0xb3, 0x2b, 0xba, 0x8c, 0xf1, 0x9f, 0x89, 0xd3, 0x32,
+	0xf7, 0x27, 0xd9, 0x72, 0xe0, 0xf3, 0x61, 0x40, 0xd5, 0x62, 0x70, 0x11, 0xaa, 0x6d, 0xe2, 0xe4,
+	0xce, 0xbf, 0xff, 0xd2, 0x47, 0xd9, 0x53, 0x73, 0x11, 0x2a, 0x52, 0x9e, 0x53, 0xf9, 0x8d, 0x04,
+	0x86, 0xbb, 0xa8, 0x64, 0xa6, 0xd9, 0xae, 0x6a, 0x7d, 0xa0, 0x6d, 0xe2, 0x64, 0x08, 0xc9, 0xbe,
+	0x69, 0x75, 0x11, 0xbd, 0x9e, 0x51, 0x6f, 0x99, 0x8b, 0x09, 0xe9, 0xa3, 0x75, 0x75, 0x8f, 0x28,
+	0x72, 0x9c, 0x01, 0x3b, 0xb1, 0x8c, 0x51, 0x3d, 0x7d, 0x07, 0xdd, 0x88, 0x5e, 0xaf, 0x38, 0x65,
+	0x76, 0x4d, 0x87, 0xea, 0x86, 0xde, 0xc7, 0x49, 0x2d, 0xb5, 0xa6, 0xc6, 0xc0, 0xef, 0xa3, 0xaa,
+	0xc7, 0x43, 0x09, 0xa1, 0x8c, 0xa5, 0x5d, 0xef, 0x58, 0xbd, 0x8a, 0x69, 0x49, 0x0e, 0x92, 0xfd,
+	0xb2, 0xfb, 0xb3, 0x85, 0x4a, 0x53, 0x2a, 0x68, 0x20, 0x71, 0x1f, 0x9d, 0x86, 0x71, 0xe0, 0xea,
+	0x47, 0x04, 0xa4, 0xab, 0xb8, 0xbb, 0x04, 0x88, 0xb2, 0x77, 0xb2, 0x19, 0xc6, 0xc1, 0xc4, 0x30,
+	0x97, 0xfc, 0x6b, 0x80, 0x08, 0x7f, 0x82, 0xce, 0x03, 0xba, 0x31, 0xee, 0x6e, 0x24, 0xe2, 0x10,
+	0xd2, 0x91, 0x34, 0x32, 0xd2, 0x22, 0x2c, 0x92, 0x56, 0x40, 0x37, 0x3a, 0x68, 0x9a, 0xd2, 0x53,
+	0x30, 0x9a, 0xc2, 0xcf, 0xd0, 0x5b, 0x69, 0xe4, 0x9a, 0x2b, 0x70, 0x61, 0xa3, 0x20, 0x4c, 0xc5,
+	0xe3, 0x4a, 0xff, 0x35, 0x18, 0x49, 0x92, 0xb3, 0x80, 0x6e, 0xae, 0xb8, 0x82, 0xe7, 0x3b, 0xf2,
+	0x95, 0xff, 0x1a, 0x26, 0x2f, 0x7e, 0xbb, 0x6d, 0x5b, 0x37, 0xb7, 0x6d, 0xeb, 0xef, 0xdb, 0xb6,
+	0xf5, 0xc3, 0x5d, 0xfb, 0xe0, 0xe6, 0xae, 0x7d, 0xf0, 0xc7, 0x5d, 0xfb, 0xe0, 0xdb, 0xf1, 0xbd,
+	0xa1, 0x4c, 0x3b, 0xa1, 0x9f, 0x7e, 0x8f, 0xaf, 0xb4, 0xd1, 0x37, 0xff, 0x9a, 0xcd, 0xfe, 0x6f,
+	0xa3, 0xa7, 0x74, 0x56, 0xd2, 0x5e, 0xe3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xaf, 0xad, 0x11,
+	0x6c, 0x90, 0x06, 0x00, 0x00,
 }
 
 func (m *Batch) Marshal() (dAtA []byte, err error) {
@@ -867,6 +880,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxVoteExtensionSize != 0 {
+		i = encodeVarintBatching(dAtA, i, uint64(m.MaxVoteExtensionSize))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.MaxBatchPrunePerBlock != 0 {
 		i = encodeVarintBatching(dAtA, i, uint64(m.MaxBatchPrunePerBlock))
 		i--
@@ -1042,6 +1060,9 @@ func (m *Params) Size() (n int) {
 	if m.MaxBatchPrunePerBlock != 0 {
 		n += 1 + sovBatching(uint64(m.MaxBatchPrunePerBlock))
 	}
+	if m.MaxVoteExtensionSize != 0 {
+		n += 1 + sovBatching(uint64(m.MaxVoteExtensionSize))
+	}
 	return n
 }
 
@@ -2083,6 +2104,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteExtensionSize", wireType)
+			}
+			m.MaxVoteExtensionSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoteExtensionSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBatching(dAtA[iNdEx:])
@@ -14,7 +14,8 @@ import (
 var validGenesisJSON = []byte(`{
       "params": {
         "num_batches_to_keep": 12,
-        "max_batch_prune_per_block": 5
+        "max_batch_prune_per_block": 5,
+        "max_vote_extension_size": 1024
       },
       "current_batch_number": "5",
       "batches": [
@@ -1,19 +1,52 @@
 package types
 
 import (
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
 	DefaultNumBatchesToKeep      = 10000
 	DefaultMaxBatchPrunePerBlock = 100
+	DefaultMaxVoteExtensionSize  = 1024
+
+	// MaxVoteExtensionSize is the upper bound of the MaxVoteExtensionSize
+	// parameter.
+	MaxVoteExtensionSize = 64 * 1024
+
+	// secp256k1SignatureLength is the length of a recoverable secp256k1
+	// batch signature in bytes.
+	secp256k1SignatureLength = 65
 )
 
+// MinVoteExtensionSize is the lower bound of the MaxVoteExtensionSize
+// parameter. It is the largest encoding of a vote extension envelope
+// carrying a single secp256k1 batch signature, so that the vote
+// extensions of honest validators are never rejected for their size.
+var MinVoteExtensionSize = uint64(voteExtensionEnvelopeSize(secp256k1SignatureLength))
+
+// voteExtensionEnvelopeSize returns the largest size of the protobuf
+// encoding of a vote extension envelope carrying a single batch signature
+// of the given length, assuming that the version and the key index take
+// their largest varint encodings.
+func voteExtensionEnvelopeSize(signatureLength int) int {
+	// BatchSignature: key_index = 1, signature = 2
+	batchSignature := protowire.SizeTag(1) + protowire.SizeVarint(math.MaxUint32) +
+		protowire.SizeTag(2) + protowire.SizeBytes(signatureLength)
+	// VoteExtension: version = 1, batch_signatures = 2
+	return protowire.SizeTag(1) + protowire.SizeVarint(math.MaxUint32) +
+		protowire.SizeTag(2) + protowire.SizeBytes(batchSignature)
+}
+
 // DefaultParams returns default batching module parameters.
 func DefaultParams() Params {
 	return Params{
 		NumBatchesToKeep:      DefaultNumBatchesToKeep,
 		MaxBatchPrunePerBlock: DefaultMaxBatchPrunePerBlock,
+		MaxVoteExtensionSize:  DefaultMaxVoteExtensionSize,
 	}
 }
 
@@ -22,5 +55,11 @@ func (p *Params) Validate() error {
 	if p.NumBatchesToKeep <= 3 {
 		return sdkerrors.ErrInvalidRequest.Wrapf("num batches to keep must be greater than 3: %d", p.NumBatchesToKeep)
 	}
+	if p.MaxVoteExtensionSize < MinVoteExtensionSize || p.MaxVoteExtensionSize > MaxVoteExtensionSize {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"max vote extension size must be between %d and %d: %d",
+			MinVoteExtensionSize, MaxVoteExtensionSize, p.MaxVoteExtensionSize,
+		)
+	}
 	return nil
 }
@@ -11,6 +11,12 @@ import (
 	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
 )
 
+const (
+	FlagAdminAddress        = "admin-address"
+	FlagPayoutAddress       = "payout-address"
+	FlagHasPendingFeeUpdate = "has-pending-fee-update"
+)
+
 // GetQueryCmd returns the CLI query commands for this module
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -22,6 +28,11 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetDataProxyConfig(),
+		GetDataProxyConfigs(),
+		GetPendingFeeUpdates(),
+		GetDataProxyUsage(),
+		GetDataProxyAccessLists(),
+		GetFeeExchangeRates(),
 		GetCmdQueryParams(),
 	)
 	return cmd
@@ -55,6 +66,173 @@ func GetDataProxyConfig() *cobra.Command {
 	return cmd
 }
 
+// GetDataProxyUsage returns the command for querying the cumulative usage of
+// a given data proxy.
+func GetDataProxyUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "data-proxy-usage <data_proxy_pubkey>",
+		Short: "Query the requests served, gas credited and fees earned by a data proxy by its hex encoded public key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDataProxyUsageRequest{
+				PubKey: args[0],
+			}
+			res, err := queryClient.DataProxyUsage(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDataProxyAccessLists returns the command for querying the governance
+// data proxy denylist and allowlist.
+func GetDataProxyAccessLists() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "data-proxy-access-lists",
+		Short: "Query the data proxy denylist and allowlist",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DataProxyAccessLists(cmd.Context(), &types.QueryDataProxyAccessListsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFeeExchangeRates returns the command for querying the rates at which
+// data proxy fees are converted to aseda.
+func GetFeeExchangeRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-exchange-rates",
+		Short: "Query the rates at which data proxy fees in other denominations are converted to aseda",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeExchangeRates(cmd.Context(), &types.QueryFeeExchangeRatesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDataProxyConfigs returns the command for listing the configs of
+// registered data proxies.
+func GetDataProxyConfigs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "data-proxy-configs",
+		Short: "List the configs of registered data proxies",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			adminAddress, err := cmd.Flags().GetString(FlagAdminAddress)
+			if err != nil {
+				return err
+			}
+			payoutAddress, err := cmd.Flags().GetString(FlagPayoutAddress)
+			if err != nil {
+				return err
+			}
+			hasPendingFeeUpdate, err := cmd.Flags().GetBool(FlagHasPendingFeeUpdate)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DataProxyConfigs(cmd.Context(), &types.QueryDataProxyConfigsRequest{
+				Pagination:          pageReq,
+				AdminAddress:        adminAddress,
+				PayoutAddress:       payoutAddress,
+				HasPendingFeeUpdate: hasPendingFeeUpdate,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagAdminAddress, "", "only list data proxies with the given admin address")
+	cmd.Flags().String(FlagPayoutAddress, "", "only list data proxies with the given payout address")
+	cmd.Flags().Bool(FlagHasPendingFeeUpdate, false, "only list data proxies with a pending fee update")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "data proxy configs")
+	return cmd
+}
+
+// GetPendingFeeUpdates returns the command for listing the pending fee
+// updates of data proxies.
+func GetPendingFeeUpdates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-fee-updates",
+		Short: "List the pending fee updates of data proxies in the order in which they come into effect",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingFeeUpdates(cmd.Context(), &types.QueryPendingFeeUpdatesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending fee updates")
+	return cmd
+}
+
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
@@ -2,9 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -18,6 +21,12 @@ const (
 	FlagNewPayoutAddress = "payout-address"
 	FlagNewFee           = "fee"
 	FlagFeeUpdateDelay   = "fee-delay"
+	FlagBond             = "bond"
+	FlagPayoutRecipients = "payout-recipients"
+	FlagFeeSchedule      = "fee-schedule"
+	FlagClearFeeSchedule = "clear-fee-schedule"
+	FlagSetRates         = "set"
+	FlagRemoveDenoms     = "remove"
 )
 
 // GetTxCmd returns the CLI transaction commands for this module
@@ -31,7 +40,14 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		RegisterDataProxy(),
 		EditDataProxy(),
+		CancelFeeUpdate(),
+		AmendFeeUpdate(),
 		TransferAdmin(),
+		BondDataProxy(),
+		UnbondDataProxy(),
+		DeregisterDataProxy(),
+		RotateDataProxyKey(),
+		UpdateFeeExchangeRates(),
 	)
 	return cmd
 }
@@ -63,12 +79,31 @@ func RegisterDataProxy() *cobra.Command {
 				Memo:          memo,
 			}
 
+			bondValue, _ := cmd.Flags().GetString(FlagBond)
+			if bondValue != "" {
+				bond, err := sdk.ParseCoinNormalized(bondValue)
+				if err != nil {
+					return err
+				}
+				msg.Bond = &bond
+			}
+
+			recipientsValue, _ := cmd.Flags().GetString(FlagPayoutRecipients)
+			if recipientsValue != "" {
+				msg.PayoutRecipients, err = parsePayoutRecipients(recipientsValue)
+				if err != nil {
+					return err
+				}
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagMemo, "", "Optionally add a description to the data proxy config")
+	cmd.Flags().String(FlagBond, "", "The amount to bond for this data proxy. Must be at least the minimum bond set in module params")
+	cmd.Flags().String(FlagPayoutRecipients, "", "Optionally split payouts as address=weight pairs, e.g. seda1...=0.7,seda1...=0.3. The first recipient must be the payout address")
 	return cmd
 }
 
@@ -107,6 +142,23 @@ func EditDataProxy() *cobra.Command {
 				msg.FeeUpdateDelay = feeUpdateDelay
 			}
 
+			recipientsValue, _ := cmd.Flags().GetString(FlagPayoutRecipients)
+			if recipientsValue != "" {
+				msg.NewPayoutRecipients, err = parsePayoutRecipients(recipientsValue)
+				if err != nil {
+					return err
+				}
+			}
+
+			feeScheduleValue, _ := cmd.Flags().GetString(FlagFeeSchedule)
+			if feeScheduleValue != "" {
+				msg.NewFeeSchedule, err = parseFeeSchedule(feeScheduleValue)
+				if err != nil {
+					return err
+				}
+			}
+			msg.ClearFeeSchedule, _ = cmd.Flags().GetBool(FlagClearFeeSchedule)
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -117,6 +169,81 @@ func EditDataProxy() *cobra.Command {
 	cmd.Flags().String(FlagNewPayoutAddress, types.DoNotModifyField, "The new payout address for this data proxy")
 	cmd.Flags().String(FlagNewFee, "", "The new fee to be scheduled for this data proxy")
 	cmd.Flags().Uint32(FlagFeeUpdateDelay, types.UseMinimumDelay, "Optionally specify in blocks a custom delay in fee update. Must be larger than minimum set in module params")
+	cmd.Flags().String(FlagPayoutRecipients, "", "The new payout split as address=weight pairs, e.g. seda1...=0.7,seda1...=0.3. The first recipient becomes the payout address")
+	cmd.Flags().String(FlagFeeSchedule, "", "The new fee schedule to be scheduled along with the fee, as program:<exec_program_id>=<fee> and memo:<tag>=<fee> entries separated by commas")
+	cmd.Flags().Bool(FlagClearFeeSchedule, false, "Schedule the removal of the fee schedule so that only the flat fee applies")
+
+	return cmd
+}
+
+func CancelFeeUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-fee-update [public_key_hex] --from [admin_address]",
+		Short: "Cancel the pending fee update of a data proxy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelFeeUpdate{
+				Sender: clientCtx.GetFromAddress().String(),
+				PubKey: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func AmendFeeUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend-fee-update [public_key_hex] --from [admin_address]",
+		Short: "Amend the pending fee update of a data proxy. Raises restart the minimum delay, while amendments not raising the current pricing take effect at the next block",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAmendFeeUpdate{
+				Sender: clientCtx.GetFromAddress().String(),
+				PubKey: args[0],
+			}
+
+			feeValue, _ := cmd.Flags().GetString(FlagNewFee)
+			if feeValue != "" {
+				fee, err := sdk.ParseCoinNormalized(feeValue)
+				if err != nil {
+					return err
+				}
+				msg.NewFee = &fee
+			}
+
+			feeScheduleValue, _ := cmd.Flags().GetString(FlagFeeSchedule)
+			if feeScheduleValue != "" {
+				msg.NewFeeSchedule, err = parseFeeSchedule(feeScheduleValue)
+				if err != nil {
+					return err
+				}
+			}
+			msg.ClearFeeSchedule, _ = cmd.Flags().GetBool(FlagClearFeeSchedule)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	cmd.Flags().String(FlagNewFee, "", "The amended fee of the pending update")
+	cmd.Flags().String(FlagFeeSchedule, "", "The amended fee schedule of the pending update, as program:<exec_program_id>=<fee> and memo:<tag>=<fee> entries separated by commas")
+	cmd.Flags().Bool(FlagClearFeeSchedule, false, "Amend the pending update to remove the fee schedule")
 
 	return cmd
 }
@@ -146,3 +273,224 @@ func TransferAdmin() *cobra.Command {
 
 	return cmd
 }
+
+func BondDataProxy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bond [public_key_hex] [amount] --from [admin_address]",
+		Short: "Add funds to the bond of a data proxy",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBondDataProxy{
+				Sender: clientCtx.GetFromAddress().String(),
+				PubKey: args[0],
+				Amount: amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func UnbondDataProxy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbond [public_key_hex] [amount] --from [admin_address]",
+		Short: "Unbond funds from the bond of a data proxy. The funds are returned to the admin after the unbonding period",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUnbondDataProxy{
+				Sender: clientCtx.GetFromAddress().String(),
+				PubKey: args[0],
+				Amount: amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func DeregisterDataProxy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister [public_key_hex] --from [admin_address]",
+		Short: "Deregister a data proxy. It stops being paid after the deregistration delay and its bond is unbonded",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDeregisterDataProxy{
+				Sender: clientCtx.GetFromAddress().String(),
+				PubKey: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func RotateDataProxyKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-key [public_key_hex] [new_public_key_hex] [signature_hex] [new_signature_hex] --from [admin_address]",
+		Short: "Rotate the public key of a data proxy using signatures by both keys generated with the data-proxy cli",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRotateDataProxyKey{
+				Sender:       clientCtx.GetFromAddress().String(),
+				PubKey:       args[0],
+				NewPubKey:    args[1],
+				Signature:    args[2],
+				NewSignature: args[3],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parsePayoutRecipients parses a comma-separated list of address=weight pairs.
+func UpdateFeeExchangeRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-fee-exchange-rates --set [denom=rate,...] --remove [denom,...] --from [exchange_rate_oracle]",
+		Short: "Set or remove the rates, in aseda per unit of a denomination, at which data proxy fees are converted to aseda",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateFeeExchangeRates{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+
+			ratesValue, _ := cmd.Flags().GetString(FlagSetRates)
+			if ratesValue != "" {
+				msg.SetRates, err = parseExchangeRates(ratesValue)
+				if err != nil {
+					return err
+				}
+			}
+
+			removeValue, _ := cmd.Flags().GetString(FlagRemoveDenoms)
+			if removeValue != "" {
+				for _, denom := range strings.Split(removeValue, ",") {
+					msg.RemoveDenoms = append(msg.RemoveDenoms, strings.TrimSpace(denom))
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagSetRates, "", "Exchange rates to set as denom=rate pairs, e.g. ibc/27394FB...=1000000000000")
+	cmd.Flags().String(FlagRemoveDenoms, "", "Comma-separated denominations whose exchange rates are removed")
+
+	return cmd
+}
+
+func parsePayoutRecipients(value string) ([]types.PayoutRecipient, error) {
+	pairs := strings.Split(value, ",")
+	recipients := make([]types.PayoutRecipient, 0, len(pairs))
+	for _, pair := range pairs {
+		address, weightValue, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found {
+			return nil, fmt.Errorf("invalid payout recipient %q, expected address=weight", pair)
+		}
+		weight, err := math.LegacyNewDecFromStr(weightValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight for payout recipient %s: %w", address, err)
+		}
+		recipients = append(recipients, types.PayoutRecipient{Address: address, Weight: weight})
+	}
+	return recipients, nil
+}
+
+// parseFeeSchedule parses a comma-separated list of program:<id>=<fee> and
+// memo:<tag>=<fee> entries.
+func parseFeeSchedule(value string) ([]types.FeeScheduleEntry, error) {
+	entries := strings.Split(value, ",")
+	schedule := make([]types.FeeScheduleEntry, 0, len(entries))
+	for _, entry := range entries {
+		key, feeValue, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found {
+			return nil, fmt.Errorf("invalid fee schedule entry %q, expected program:<id>=<fee> or memo:<tag>=<fee>", entry)
+		}
+		fee, err := sdk.ParseCoinNormalized(feeValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee for fee schedule entry %s: %w", key, err)
+		}
+
+		scheduleEntry := types.FeeScheduleEntry{Fee: fee}
+		switch kind, id, _ := strings.Cut(key, ":"); kind {
+		case "program":
+			scheduleEntry.ExecProgramID = id
+		case "memo":
+			scheduleEntry.MemoTag = id
+		default:
+			return nil, fmt.Errorf("invalid fee schedule entry %q, expected program:<id>=<fee> or memo:<tag>=<fee>", entry)
+		}
+		schedule = append(schedule, scheduleEntry)
+	}
+	return schedule, nil
+}
+
+// parseExchangeRates parses a comma-separated list of denom=rate pairs.
+func parseExchangeRates(value string) ([]types.FeeExchangeRate, error) {
+	pairs := strings.Split(value, ",")
+	rates := make([]types.FeeExchangeRate, 0, len(pairs))
+	for _, pair := range pairs {
+		denom, rateValue, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found {
+			return nil, fmt.Errorf("invalid exchange rate %q, expected denom=rate", pair)
+		}
+		rate, err := math.LegacyNewDecFromStr(rateValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rate for %s: %w", denom, err)
+		}
+		rates = append(rates, types.FeeExchangeRate{Denom: denom, Rate: rate})
+	}
+	return rates, nil
+}
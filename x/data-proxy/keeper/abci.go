@@ -9,7 +9,16 @@ import (
 )
 
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
-	return k.ProcessFeeUpdates(ctx)
+	if err := k.ProcessFeeUpdates(ctx); err != nil {
+		return err
+	}
+	if err := k.ProcessUnbondings(ctx); err != nil {
+		return err
+	}
+	if err := k.ProcessDeregistrations(ctx); err != nil {
+		return err
+	}
+	return k.ProcessKeyAliasExpirations(ctx)
 }
 
 func (k *Keeper) ProcessFeeUpdates(ctx sdk.Context) error {
@@ -26,6 +35,7 @@ func (k *Keeper) ProcessFeeUpdates(ctx sdk.Context) error {
 		}
 
 		proxyConfig.Fee = proxyConfig.FeeUpdate.NewFee
+		proxyConfig.FeeSchedule = proxyConfig.FeeUpdate.NewFeeSchedule
 		proxyConfig.FeeUpdate = nil
 
 		if err := k.SetDataProxyConfig(ctx, pubkey, proxyConfig); err != nil {
@@ -39,7 +49,8 @@ func (k *Keeper) ProcessFeeUpdates(ctx sdk.Context) error {
 		pubKeyHex := hex.EncodeToString(pubkey)
 		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeFeeUpdate,
 			sdk.NewAttribute(types.AttributePubKey, pubKeyHex),
-			sdk.NewAttribute(types.AttributeFee, proxyConfig.Fee.String())))
+			sdk.NewAttribute(types.AttributeFee, proxyConfig.Fee.String()),
+			sdk.NewAttribute(types.AttributeFeeSchedule, types.FormatFeeSchedule(proxyConfig.FeeSchedule))))
 	}
 	return nil
 }
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (k Keeper) DenyDataProxy(ctx context.Context, pubKey []byte) error {
+	return k.denylist.Set(ctx, pubKey)
+}
+
+func (k Keeper) IsDataProxyDenied(ctx context.Context, pubKey []byte) (bool, error) {
+	return k.denylist.Has(ctx, pubKey)
+}
+
+func (k Keeper) AllowDataProxy(ctx context.Context, pubKey []byte) error {
+	return k.allowlist.Set(ctx, pubKey)
+}
+
+func (k Keeper) IsDataProxyAllowlisted(ctx context.Context, pubKey []byte) (bool, error) {
+	return k.allowlist.Has(ctx, pubKey)
+}
+
+// IsDataProxyAllowed returns false if governance has denied the data proxy or,
+// when the allowlist is enabled, has not allowed it. Both the given public key
+// and the current key of a rotated data proxy are checked.
+func (k Keeper) IsDataProxyAllowed(ctx context.Context, pubKey []byte) (bool, error) {
+	resolved, err := k.resolvePubKey(ctx, pubKey)
+	if err != nil {
+		return false, err
+	}
+
+	for _, key := range [][]byte{pubKey, resolved} {
+		denied, err := k.denylist.Has(ctx, key)
+		if err != nil {
+			return false, err
+		}
+		if denied {
+			return false, nil
+		}
+	}
+
+	params, err := k.params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !params.AllowlistEnabled {
+		return true, nil
+	}
+
+	for _, key := range [][]byte{pubKey, resolved} {
+		allowed, err := k.allowlist.Has(ctx, key)
+		if err != nil {
+			return false, err
+		}
+		if allowed {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// updateAccessList adds and removes the given hex encoded public keys from
+// an access list and emits an event of the given type.
+func (k Keeper) updateAccessList(ctx sdk.Context, list collections.KeySet[[]byte], eventType string, add, remove []string) error {
+	for _, pubKey := range add {
+		pubKeyBytes, err := hex.DecodeString(pubKey)
+		if err != nil {
+			return err
+		}
+		if err := list.Set(ctx, pubKeyBytes); err != nil {
+			return err
+		}
+	}
+
+	for _, pubKey := range remove {
+		pubKeyBytes, err := hex.DecodeString(pubKey)
+		if err != nil {
+			return err
+		}
+		if err := list.Remove(ctx, pubKeyBytes); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType,
+		sdk.NewAttribute(types.AttributeAdded, strings.Join(add, ",")),
+		sdk.NewAttribute(types.AttributeRemoved, strings.Join(remove, ",")),
+	))
+
+	return nil
+}
+
+func (k Keeper) getAccessList(ctx context.Context, list collections.KeySet[[]byte]) ([][]byte, error) {
+	itr, err := list.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return itr.Keys()
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (s *KeeperTestSuite) TestAccessLists() {
+	pubKeyHex := "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3"
+	pubKey, err := hex.DecodeString(pubKeyHex)
+	s.Require().NoError(err)
+	newPubKeyHex := "034c0f86f0cb61f9ddb47c4ba0b2ca0470962b5a1c50bee3a563184979672195f4"
+	newPubKey, err := hex.DecodeString(newPubKeyHex)
+	s.Require().NoError(err)
+
+	s.Run("Only the authority can update the access lists", func() {
+		_, err := s.msgSrvr.UpdateProxyDenylist(s.ctx, &types.MsgUpdateProxyDenylist{
+			Authority: "seda1ucv5709wlf9jn84ynyjzyzeavwvurmdyxat26l",
+			Add:       []string{pubKeyHex},
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrorInvalidSigner)
+
+		_, err = s.msgSrvr.UpdateProxyAllowlist(s.ctx, &types.MsgUpdateProxyAllowlist{
+			Authority: "seda1ucv5709wlf9jn84ynyjzyzeavwvurmdyxat26l",
+			Add:       []string{pubKeyHex},
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrorInvalidSigner)
+	})
+
+	s.Run("Invalid updates are rejected", func() {
+		_, err := s.msgSrvr.UpdateProxyDenylist(s.ctx, &types.MsgUpdateProxyDenylist{
+			Authority: s.authority,
+		})
+		s.Require().ErrorIs(err, types.ErrEmptyUpdate)
+
+		_, err = s.msgSrvr.UpdateProxyDenylist(s.ctx, &types.MsgUpdateProxyDenylist{
+			Authority: s.authority,
+			Add:       []string{"not-hex"},
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+		_, err = s.msgSrvr.UpdateProxyDenylist(s.ctx, &types.MsgUpdateProxyDenylist{
+			Authority: s.authority,
+			Add:       []string{pubKeyHex},
+			Remove:    []string{pubKeyHex},
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	})
+
+	s.Run("Denied data proxies are not allowed", func() {
+		allowed, err := s.keeper.IsDataProxyAllowed(s.ctx, pubKey)
+		s.Require().NoError(err)
+		s.Require().True(allowed)
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err = s.msgSrvr.UpdateProxyDenylist(ctx, &types.MsgUpdateProxyDenylist{
+			Authority: s.authority,
+			Add:       []string{pubKeyHex},
+		})
+		s.Require().NoError(err)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeDenylistUpdate, events[0].Type)
+		added, found := events[0].GetAttribute(types.AttributeAdded)
+		s.Require().True(found)
+		s.Require().Equal(pubKeyHex, added.Value)
+
+		allowed, err = s.keeper.IsDataProxyAllowed(s.ctx, pubKey)
+		s.Require().NoError(err)
+		s.Require().False(allowed)
+
+		_, err = s.msgSrvr.UpdateProxyDenylist(s.ctx, &types.MsgUpdateProxyDenylist{
+			Authority: s.authority,
+			Remove:    []string{pubKeyHex},
+		})
+		s.Require().NoError(err)
+
+		allowed, err = s.keeper.IsDataProxyAllowed(s.ctx, pubKey)
+		s.Require().NoError(err)
+		s.Require().True(allowed)
+	})
+
+	s.Run("Only allowlisted data proxies are allowed in allowlist mode", func() {
+		params := types.DefaultParams()
+		params.AllowlistEnabled = true
+		s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+		allowed, err := s.keeper.IsDataProxyAllowed(s.ctx, pubKey)
+		s.Require().NoError(err)
+		s.Require().False(allowed)
+
+		_, err = s.msgSrvr.UpdateProxyAllowlist(s.ctx, &types.MsgUpdateProxyAllowlist{
+			Authority: s.authority,
+			Add:       []string{pubKeyHex},
+		})
+		s.Require().NoError(err)
+
+		allowed, err = s.keeper.IsDataProxyAllowed(s.ctx, pubKey)
+		s.Require().NoError(err)
+		s.Require().True(allowed)
+
+		// The denylist takes precedence over the allowlist.
+		s.Require().NoError(s.keeper.DenyDataProxy(s.ctx, pubKey))
+		allowed, err = s.keeper.IsDataProxyAllowed(s.ctx, pubKey)
+		s.Require().NoError(err)
+		s.Require().False(allowed)
+	})
+
+	s.Run("Access lists apply to rotated keys", func() {
+		s.Require().NoError(s.keeper.SetKeyAlias(s.ctx, pubKey, types.KeyAlias{NewPubKey: newPubKey, ExpirationHeight: 100}))
+		s.Require().NoError(s.keeper.DenyDataProxy(s.ctx, newPubKey))
+
+		allowed, err := s.keeper.IsDataProxyAllowed(s.ctx, pubKey)
+		s.Require().NoError(err)
+		s.Require().False(allowed)
+	})
+
+	s.Run("Query access lists", func() {
+		s.Require().NoError(s.keeper.DenyDataProxy(s.ctx, pubKey))
+		s.Require().NoError(s.keeper.AllowDataProxy(s.ctx, newPubKey))
+
+		res, err := s.queryClient.DataProxyAccessLists(s.ctx, &types.QueryDataProxyAccessListsRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]string{pubKeyHex}, res.Denylist)
+		s.Require().Equal([]string{newPubKeyHex}, res.Allowlist)
+		s.Require().False(res.AllowlistEnabled)
+	})
+}
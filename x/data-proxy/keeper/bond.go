@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (k Keeper) SetUnbonding(ctx sdk.Context, height int64, pubKey []byte) error {
+	return k.unbondingQueue.Set(ctx, collections.Join(height, pubKey))
+}
+
+func (k Keeper) RemoveUnbonding(ctx sdk.Context, height int64, pubKey []byte) error {
+	return k.unbondingQueue.Remove(ctx, collections.Join(height, pubKey))
+}
+
+func (k Keeper) HasUnbonding(ctx sdk.Context, height int64, pubKey []byte) (bool, error) {
+	return k.unbondingQueue.Has(ctx, collections.Join(height, pubKey))
+}
+
+func (k Keeper) GetUnbondingPubKeys(ctx sdk.Context, completionHeight int64) ([][]byte, error) {
+	itr, err := k.unbondingQueue.Iterate(ctx, collections.NewPrefixedPairRange[int64, []byte](completionHeight))
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := itr.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	pubkeys := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		pubkeys = append(pubkeys, key.K2())
+	}
+
+	return pubkeys, nil
+}
+
+// scheduleUnbonding moves the given amount from the bond of the data proxy
+// into an unbonding entry which completes after the unbonding period. Entries
+// completing at the same height are merged. The caller is responsible for
+// storing the updated proxy config.
+func (k Keeper) scheduleUnbonding(ctx sdk.Context, pubKey []byte, proxyConfig *types.ProxyConfig, recipient string, amount sdk.Coin) (int64, error) {
+	bond := proxyConfig.BondedAmount()
+	if bond.IsLT(amount) {
+		return 0, types.ErrInsufficientBond.Wrapf("bonded %s, unbonding %s", bond, amount)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	completionHeight := ctx.BlockHeight() + int64(params.UnbondingPeriod)
+
+	merged := false
+	for i, entry := range proxyConfig.UnbondingEntries {
+		if entry.CompletionHeight == completionHeight {
+			proxyConfig.UnbondingEntries[i].Amount = entry.Amount.Add(amount)
+			proxyConfig.UnbondingEntries[i].Recipient = recipient
+			merged = true
+			break
+		}
+	}
+	if !merged {
+		if len(proxyConfig.UnbondingEntries) >= types.MaxUnbondingEntries {
+			return 0, types.ErrMaxUnbondings.Wrapf("max %d entries", types.MaxUnbondingEntries)
+		}
+		proxyConfig.UnbondingEntries = append(proxyConfig.UnbondingEntries, types.UnbondingEntry{
+			Recipient:        recipient,
+			Amount:           amount,
+			CompletionHeight: completionHeight,
+		})
+
+		if err := k.SetUnbonding(ctx, completionHeight, pubKey); err != nil {
+			return 0, err
+		}
+	}
+
+	remaining := bond.Sub(amount)
+	proxyConfig.Bond = &remaining
+
+	return completionHeight, nil
+}
+
+// SlashDataProxy burns the given fraction of the bonded and unbonding funds
+// of a data proxy and returns the total amount burned. It is the entry point
+// for penalising a misbehaving data proxy, for example one that has served
+// signed conflicting responses for the same request.
+func (k Keeper) SlashDataProxy(ctx sdk.Context, pubKey []byte, fraction math.LegacyDec, reason string) (sdk.Coin, error) {
+	proxyConfig, err := k.GetDataProxyConfig(ctx, pubKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.Coin{}, sdkerrors.ErrNotFound.Wrapf("no data proxy registered for %s", hex.EncodeToString(pubKey))
+		}
+		return sdk.Coin{}, err
+	}
+
+	bond := proxyConfig.BondedAmount()
+	slashed := sdk.NewCoin(bond.Denom, fraction.MulInt(bond.Amount).TruncateInt())
+	remaining := bond.Sub(slashed)
+	proxyConfig.Bond = &remaining
+
+	entries := make([]types.UnbondingEntry, 0, len(proxyConfig.UnbondingEntries))
+	for _, entry := range proxyConfig.UnbondingEntries {
+		entrySlash := sdk.NewCoin(entry.Amount.Denom, fraction.MulInt(entry.Amount.Amount).TruncateInt())
+		slashed = slashed.Add(entrySlash)
+		entry.Amount = entry.Amount.Sub(entrySlash)
+
+		if entry.Amount.IsZero() {
+			if err := k.RemoveUnbonding(ctx, entry.CompletionHeight, pubKey); err != nil {
+				return sdk.Coin{}, err
+			}
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	proxyConfig.UnbondingEntries = entries
+
+	if slashed.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(slashed)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if len(entries) == 0 && proxyConfig.IsDeregistered(ctx.BlockHeight()) {
+		if err := k.removeDataProxy(ctx, pubKey); err != nil {
+			return sdk.Coin{}, err
+		}
+	} else if err := k.SetDataProxyConfig(ctx, pubKey, proxyConfig); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSlash,
+		sdk.NewAttribute(types.AttributePubKey, hex.EncodeToString(pubKey)),
+		sdk.NewAttribute(types.AttributeSlashFraction, fraction.String()),
+		sdk.NewAttribute(types.AttributeAmount, slashed.String()),
+		sdk.NewAttribute(types.AttributeBond, remaining.String()),
+		sdk.NewAttribute(types.AttributeReason, reason),
+	))
+
+	return slashed, nil
+}
+
+// ProcessUnbondings returns the funds of all unbonding entries completing at
+// the current height to their recipients.
+func (k Keeper) ProcessUnbondings(ctx sdk.Context) error {
+	blockHeight := ctx.BlockHeight()
+	pubkeys, err := k.GetUnbondingPubKeys(ctx, blockHeight)
+	if err != nil {
+		return err
+	}
+
+	for _, pubkey := range pubkeys {
+		proxyConfig, err := k.GetDataProxyConfig(ctx, pubkey)
+		if err != nil {
+			return err
+		}
+
+		pubKeyHex := hex.EncodeToString(pubkey)
+		entries := make([]types.UnbondingEntry, 0, len(proxyConfig.UnbondingEntries))
+		for _, entry := range proxyConfig.UnbondingEntries {
+			if entry.CompletionHeight != blockHeight {
+				entries = append(entries, entry)
+				continue
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(entry.Recipient)
+			if err != nil {
+				return err
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(entry.Amount)); err != nil {
+				return err
+			}
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCompleteUnbond,
+				sdk.NewAttribute(types.AttributePubKey, pubKeyHex),
+				sdk.NewAttribute(types.AttributeRecipient, entry.Recipient),
+				sdk.NewAttribute(types.AttributeAmount, entry.Amount.String()),
+				sdk.NewAttribute(types.AttributeCompletionHeight, fmt.Sprintf("%d", entry.CompletionHeight)),
+			))
+		}
+		proxyConfig.UnbondingEntries = entries
+
+		// A deregistered data proxy is removed once its last unbonding entry
+		// has been returned.
+		if len(entries) == 0 && proxyConfig.IsDeregistered(blockHeight) {
+			if err := k.removeDataProxy(ctx, pubkey); err != nil {
+				return err
+			}
+		} else if err := k.SetDataProxyConfig(ctx, pubkey, proxyConfig); err != nil {
+			return err
+		}
+
+		if err := k.RemoveUnbonding(ctx, blockHeight, pubkey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
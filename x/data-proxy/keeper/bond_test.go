@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (s *KeeperTestSuite) TestMsgServer_BondAndUnbond() {
+	pubKeyHex := "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3"
+	pubKeyBytes, err := hex.DecodeString(pubKeyHex)
+	s.Require().NoError(err)
+
+	admin := "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5"
+	adminAddr, err := sdk.AccAddressFromBech32(admin)
+	s.Require().NoError(err)
+
+	minBond := s.NewBondFromString(types.DefaultMinBond.String())
+	initialProxyConfig := types.ProxyConfig{
+		PayoutAddress: admin,
+		Fee:           s.NewFeeFromString("9"),
+		AdminAddress:  admin,
+		Bond:          minBond,
+	}
+
+	s.Run("Admin can add funds to the bond", func() {
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, initialProxyConfig))
+
+		amount := *s.NewBondFromString("5000")
+		s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), adminAddr, types.ModuleName, sdk.NewCoins(amount)).Return(nil)
+
+		_, err := s.msgSrvr.BondDataProxy(s.ctx, &types.MsgBondDataProxy{
+			Sender: admin,
+			PubKey: pubKeyHex,
+			Amount: amount,
+		})
+		s.Require().NoError(err)
+
+		proxyConfig, err := s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().Equal(minBond.Add(amount), *proxyConfig.Bond)
+	})
+
+	s.Run("Only the admin can bond", func() {
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, initialProxyConfig))
+
+		_, err := s.msgSrvr.BondDataProxy(s.ctx, &types.MsgBondDataProxy{
+			Sender: "seda1wyzxdtpl0c99c92n397r3drlhj09qfjvf6teyh",
+			PubKey: pubKeyHex,
+			Amount: *s.NewBondFromString("5000"),
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrorInvalidSigner)
+	})
+
+	s.Run("Unbonding below the minimum bond fails", func() {
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, initialProxyConfig))
+
+		_, err := s.msgSrvr.UnbondDataProxy(s.ctx, &types.MsgUnbondDataProxy{
+			Sender: admin,
+			PubKey: pubKeyHex,
+			Amount: *s.NewBondFromString("1"),
+		})
+		s.Require().ErrorIs(err, types.ErrInsufficientBond)
+	})
+
+	s.Run("Unbonded funds are returned after the unbonding period", func() {
+		extra := *s.NewBondFromString("5000")
+		proxyConfig := initialProxyConfig
+		bond := minBond.Add(extra)
+		proxyConfig.Bond = &bond
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, proxyConfig))
+
+		// Two unbondings in the same block are merged into one entry.
+		half := *s.NewBondFromString("2500")
+		for i := 0; i < 2; i++ {
+			res, err := s.msgSrvr.UnbondDataProxy(s.ctx, &types.MsgUnbondDataProxy{
+				Sender: admin,
+				PubKey: pubKeyHex,
+				Amount: half,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(s.ctx.BlockHeight()+int64(types.DefaultUnbondingPeriod), res.CompletionHeight)
+		}
+
+		proxyConfig, err := s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().Equal(*minBond, *proxyConfig.Bond)
+		s.Require().Len(proxyConfig.UnbondingEntries, 1)
+		s.Require().Equal(extra, proxyConfig.UnbondingEntries[0].Amount)
+
+		completionHeight := proxyConfig.UnbondingEntries[0].CompletionHeight
+		scheduled, err := s.keeper.HasUnbonding(s.ctx, completionHeight, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().True(scheduled)
+
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, adminAddr, sdk.NewCoins(extra)).Return(nil)
+
+		s.ctx = s.ctx.WithBlockHeight(completionHeight)
+		s.Require().NoError(s.keeper.EndBlock(s.ctx))
+
+		proxyConfig, err = s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().Empty(proxyConfig.UnbondingEntries)
+
+		scheduled, err = s.keeper.HasUnbonding(s.ctx, completionHeight, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().False(scheduled)
+	})
+
+	s.Run("Unbonding fails when the max number of entries is reached", func() {
+		proxyConfig := initialProxyConfig
+		bond := minBond.Add(*s.NewBondFromString("100"))
+		proxyConfig.Bond = &bond
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, proxyConfig))
+
+		for i := 0; i < types.MaxUnbondingEntries; i++ {
+			s.ctx = s.ctx.WithBlockHeight(int64(i + 1))
+			_, err := s.msgSrvr.UnbondDataProxy(s.ctx, &types.MsgUnbondDataProxy{
+				Sender: admin,
+				PubKey: pubKeyHex,
+				Amount: *s.NewBondFromString("1"),
+			})
+			s.Require().NoError(err)
+		}
+
+		s.ctx = s.ctx.WithBlockHeight(int64(types.MaxUnbondingEntries + 1))
+		_, err := s.msgSrvr.UnbondDataProxy(s.ctx, &types.MsgUnbondDataProxy{
+			Sender: admin,
+			PubKey: pubKeyHex,
+			Amount: *s.NewBondFromString("1"),
+		})
+		s.Require().ErrorIs(err, types.ErrMaxUnbondings)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgServer_SlashDataProxy() {
+	pubKeyHex := "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3"
+	pubKeyBytes, err := hex.DecodeString(pubKeyHex)
+	s.Require().NoError(err)
+
+	admin := "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5"
+	bond := *s.NewBondFromString("1000")
+	proxyConfig := types.ProxyConfig{
+		PayoutAddress: admin,
+		Fee:           s.NewFeeFromString("9"),
+		AdminAddress:  admin,
+		Bond:          &bond,
+		UnbondingEntries: []types.UnbondingEntry{
+			{Recipient: admin, Amount: *s.NewBondFromString("400"), CompletionHeight: 100},
+			{Recipient: admin, Amount: *s.NewBondFromString("1"), CompletionHeight: 200},
+		},
+	}
+
+	s.Run("Only the authority can slash", func() {
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, proxyConfig))
+
+		_, err := s.msgSrvr.SlashDataProxy(s.ctx, &types.MsgSlashDataProxy{
+			Authority:     admin,
+			PubKey:        pubKeyHex,
+			SlashFraction: math.LegacyNewDecWithPrec(5, 1),
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrorInvalidSigner)
+	})
+
+	s.Run("Slash fraction must be in range", func() {
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, proxyConfig))
+
+		_, err := s.msgSrvr.SlashDataProxy(s.ctx, &types.MsgSlashDataProxy{
+			Authority:     s.authority,
+			PubKey:        pubKeyHex,
+			SlashFraction: math.LegacyNewDec(2),
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	})
+
+	s.Run("Slashing burns bonded and unbonding funds", func() {
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, proxyConfig))
+		for _, entry := range proxyConfig.UnbondingEntries {
+			s.Require().NoError(s.keeper.SetUnbonding(s.ctx, entry.CompletionHeight, pubKeyBytes))
+		}
+
+		// 500 from the bond, 200 from the first entry and nothing from the
+		// second entry due to truncation.
+		slashed := *s.NewBondFromString("700")
+		s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(slashed)).Return(nil)
+
+		res, err := s.msgSrvr.SlashDataProxy(s.ctx, &types.MsgSlashDataProxy{
+			Authority:     s.authority,
+			PubKey:        pubKeyHex,
+			SlashFraction: math.LegacyNewDecWithPrec(5, 1),
+			Reason:        "conflicting responses",
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(slashed, res.SlashedAmount)
+
+		updated, err := s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().Equal(*s.NewBondFromString("500"), *updated.Bond)
+		s.Require().Len(updated.UnbondingEntries, 2)
+		s.Require().Equal(*s.NewBondFromString("200"), updated.UnbondingEntries[0].Amount)
+	})
+
+	s.Run("Fully slashed unbonding entries are removed from the queue", func() {
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, proxyConfig))
+		for _, entry := range proxyConfig.UnbondingEntries {
+			s.Require().NoError(s.keeper.SetUnbonding(s.ctx, entry.CompletionHeight, pubKeyBytes))
+		}
+
+		s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(*s.NewBondFromString("1401"))).Return(nil)
+
+		_, err := s.msgSrvr.SlashDataProxy(s.ctx, &types.MsgSlashDataProxy{
+			Authority:     s.authority,
+			PubKey:        pubKeyHex,
+			SlashFraction: math.LegacyOneDec(),
+		})
+		s.Require().NoError(err)
+
+		updated, err := s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().True(updated.Bond.IsZero())
+		s.Require().Empty(updated.UnbondingEntries)
+
+		for _, entry := range proxyConfig.UnbondingEntries {
+			scheduled, err := s.keeper.HasUnbonding(s.ctx, entry.CompletionHeight, pubKeyBytes)
+			s.Require().NoError(err)
+			s.Require().False(scheduled)
+		}
+	})
+}
@@ -102,3 +102,10 @@ func (s *KeeperTestSuite) NewFeeFromString(val string) *sdk.Coin {
 		Amount: s.NewIntFromString(val),
 	}
 }
+
+func (s *KeeperTestSuite) NewBondFromString(val string) *sdk.Coin {
+	return &sdk.Coin{
+		Denom:  params.DefaultBondDenom,
+		Amount: s.NewIntFromString(val),
+	}
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (k Keeper) SetDeregistration(ctx sdk.Context, height int64, pubKey []byte) error {
+	return k.deregistrationQueue.Set(ctx, collections.Join(height, pubKey))
+}
+
+func (k Keeper) HasDeregistration(ctx sdk.Context, height int64, pubKey []byte) (bool, error) {
+	return k.deregistrationQueue.Has(ctx, collections.Join(height, pubKey))
+}
+
+func (k Keeper) GetDeregistrationPubKeys(ctx sdk.Context, height int64) ([][]byte, error) {
+	itr, err := k.deregistrationQueue.Iterate(ctx, collections.NewPrefixedPairRange[int64, []byte](height))
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := itr.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	pubkeys := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		pubkeys = append(pubkeys, key.K2())
+	}
+
+	return pubkeys, nil
+}
+
+// GetActiveDataProxyConfig returns the config of a data proxy that is still
+// eligible for payment, resolving the previous public keys of rotated data
+// proxies. It returns ErrDeregistered if the data proxy has been deregistered.
+func (k Keeper) GetActiveDataProxyConfig(ctx context.Context, pubKey []byte) (types.ProxyConfig, error) {
+	resolved, err := k.resolvePubKey(ctx, pubKey)
+	if err != nil {
+		return types.ProxyConfig{}, err
+	}
+
+	proxyConfig, err := k.GetDataProxyConfig(ctx, resolved)
+	if err != nil {
+		return types.ProxyConfig{}, err
+	}
+
+	if proxyConfig.IsDeregistered(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return types.ProxyConfig{}, types.ErrDeregistered.Wrapf("deregistered at height %d", proxyConfig.DeregistrationHeight)
+	}
+
+	return proxyConfig, nil
+}
+
+// scheduleDeregistration cancels any pending fee update, starts unbonding the
+// full bond and schedules the data proxy to stop being paid after the
+// deregistration delay. The caller is responsible for storing the updated
+// proxy config.
+func (k Keeper) scheduleDeregistration(ctx sdk.Context, pubKey []byte, proxyConfig *types.ProxyConfig) (int64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if proxyConfig.FeeUpdate != nil {
+		if err := k.RemoveFeeUpdate(ctx, proxyConfig.FeeUpdate.UpdateHeight, pubKey); err != nil {
+			return 0, err
+		}
+		proxyConfig.FeeUpdate = nil
+	}
+
+	if bond := proxyConfig.BondedAmount(); bond.IsPositive() {
+		if _, err := k.scheduleUnbonding(ctx, pubKey, proxyConfig, proxyConfig.AdminAddress, bond); err != nil {
+			return 0, err
+		}
+	}
+
+	deregistrationHeight := ctx.BlockHeight() + int64(params.DeregistrationDelay)
+	proxyConfig.DeregistrationHeight = deregistrationHeight
+
+	if err := k.SetDeregistration(ctx, deregistrationHeight, pubKey); err != nil {
+		return 0, err
+	}
+
+	return deregistrationHeight, nil
+}
+
+// removeDataProxy deletes the config of a deregistered data proxy.
+func (k Keeper) removeDataProxy(ctx sdk.Context, pubKey []byte) error {
+	if err := k.RemoveDataProxyConfig(ctx, pubKey); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeRemoveProxy,
+		sdk.NewAttribute(types.AttributePubKey, hex.EncodeToString(pubKey)),
+	))
+
+	return nil
+}
+
+// ProcessDeregistrations removes the configs of data proxies whose
+// deregistration takes effect at the current height. Data proxies with funds
+// still unbonding are removed once their last unbonding entry completes.
+func (k Keeper) ProcessDeregistrations(ctx sdk.Context) error {
+	blockHeight := ctx.BlockHeight()
+	pubkeys, err := k.GetDeregistrationPubKeys(ctx, blockHeight)
+	if err != nil {
+		return err
+	}
+
+	for _, pubkey := range pubkeys {
+		proxyConfig, err := k.GetDataProxyConfig(ctx, pubkey)
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			// Already removed together with its last unbonding entry.
+		case err != nil:
+			return err
+		case len(proxyConfig.UnbondingEntries) == 0:
+			if err := k.removeDataProxy(ctx, pubkey); err != nil {
+				return err
+			}
+		}
+
+		if err := k.deregistrationQueue.Remove(ctx, collections.Join(blockHeight, pubkey)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (s *KeeperTestSuite) TestMsgServer_DeregisterDataProxy() {
+	pubKeyHex := "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3"
+	pubKeyBytes, err := hex.DecodeString(pubKeyHex)
+	s.Require().NoError(err)
+
+	admin := "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5"
+	adminAddr, err := sdk.AccAddressFromBech32(admin)
+	s.Require().NoError(err)
+
+	bond := s.NewBondFromString(types.DefaultMinBond.String())
+
+	s.Run("Deregistered data proxy stops being paid and is removed after unbonding", func() {
+		s.ctx = s.ctx.WithBlockHeight(100)
+		proxyConfig := types.ProxyConfig{
+			PayoutAddress: admin,
+			Fee:           s.NewFeeFromString("9"),
+			AdminAddress:  admin,
+			Bond:          bond,
+			FeeUpdate: &types.FeeUpdate{
+				NewFee:       s.NewFeeFromString("10"),
+				UpdateHeight: 200,
+			},
+		}
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, proxyConfig))
+		s.Require().NoError(s.keeper.SetFeeUpdate(s.ctx, 200, pubKeyBytes))
+
+		res, err := s.msgSrvr.DeregisterDataProxy(s.ctx, &types.MsgDeregisterDataProxy{
+			Sender: admin,
+			PubKey: pubKeyHex,
+		})
+		s.Require().NoError(err)
+		deregistrationHeight := s.ctx.BlockHeight() + int64(types.DefaultDeregistrationDelay)
+		s.Require().Equal(deregistrationHeight, res.DeregistrationHeight)
+
+		proxyConfig, err = s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().Nil(proxyConfig.FeeUpdate)
+		s.Require().True(proxyConfig.Bond.IsZero())
+		s.Require().Len(proxyConfig.UnbondingEntries, 1)
+		s.Require().Equal(*bond, proxyConfig.UnbondingEntries[0].Amount)
+
+		hasFeeUpdate, err := s.keeper.HasFeeUpdate(s.ctx, 200, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().False(hasFeeUpdate)
+
+		// Further changes are rejected.
+		_, err = s.msgSrvr.EditDataProxy(s.ctx, &types.MsgEditDataProxy{
+			Sender:           admin,
+			NewPayoutAddress: types.DoNotModifyField,
+			NewMemo:          "new memo",
+			PubKey:           pubKeyHex,
+		})
+		s.Require().ErrorIs(err, types.ErrDeregistered)
+
+		_, err = s.msgSrvr.DeregisterDataProxy(s.ctx, &types.MsgDeregisterDataProxy{
+			Sender: admin,
+			PubKey: pubKeyHex,
+		})
+		s.Require().ErrorIs(err, types.ErrDeregistered)
+
+		// Still paid until the deregistration height.
+		_, err = s.keeper.GetActiveDataProxyConfig(s.ctx.WithBlockHeight(deregistrationHeight-1), pubKeyBytes)
+		s.Require().NoError(err)
+
+		s.ctx = s.ctx.WithBlockHeight(deregistrationHeight)
+		_, err = s.keeper.GetActiveDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().ErrorIs(err, types.ErrDeregistered)
+
+		// The config is kept while the bond is unbonding.
+		s.Require().NoError(s.keeper.EndBlock(s.ctx))
+		found, err := s.keeper.HasDataProxy(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().True(found)
+
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, adminAddr, sdk.NewCoins(*bond)).Return(nil)
+
+		s.ctx = s.ctx.WithBlockHeight(proxyConfig.UnbondingEntries[0].CompletionHeight)
+		s.Require().NoError(s.keeper.EndBlock(s.ctx))
+		found, err = s.keeper.HasDataProxy(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().False(found)
+	})
+
+	s.Run("Data proxy without bond is removed at the deregistration height", func() {
+		proxyConfig := types.ProxyConfig{
+			PayoutAddress: admin,
+			Fee:           s.NewFeeFromString("9"),
+			AdminAddress:  admin,
+		}
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, proxyConfig))
+
+		res, err := s.msgSrvr.DeregisterDataProxy(s.ctx, &types.MsgDeregisterDataProxy{
+			Sender: admin,
+			PubKey: pubKeyHex,
+		})
+		s.Require().NoError(err)
+
+		s.ctx = s.ctx.WithBlockHeight(res.DeregistrationHeight)
+		s.Require().NoError(s.keeper.EndBlock(s.ctx))
+
+		found, err := s.keeper.HasDataProxy(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().False(found)
+
+		scheduled, err := s.keeper.HasDeregistration(s.ctx, res.DeregistrationHeight, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().False(scheduled)
+	})
+
+	s.Run("Only the admin can deregister", func() {
+		proxyConfig := types.ProxyConfig{
+			PayoutAddress: admin,
+			Fee:           s.NewFeeFromString("9"),
+			AdminAddress:  admin,
+		}
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, proxyConfig))
+
+		_, err := s.msgSrvr.DeregisterDataProxy(s.ctx, &types.MsgDeregisterDataProxy{
+			Sender: "seda1wyzxdtpl0c99c92n397r3drlhj09qfjvf6teyh",
+			PubKey: pubKeyHex,
+		})
+		s.Require().Error(err)
+	})
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	appparams "github.com/sedaprotocol/seda-chain/app/params"
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (k Keeper) SetFeeExchangeRate(ctx context.Context, denom string, rate math.LegacyDec) error {
+	return k.feeExchangeRates.Set(ctx, denom, rate)
+}
+
+func (k Keeper) RemoveFeeExchangeRate(ctx context.Context, denom string) error {
+	return k.feeExchangeRates.Remove(ctx, denom)
+}
+
+// GetFeeExchangeRate returns the amount of aseda per unit of the given
+// denomination, which is one for aseda itself.
+func (k Keeper) GetFeeExchangeRate(ctx context.Context, denom string) (math.LegacyDec, error) {
+	if denom == appparams.DefaultBondDenom {
+		return math.LegacyOneDec(), nil
+	}
+
+	rate, err := k.feeExchangeRates.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return math.LegacyDec{}, types.ErrNoExchangeRate.Wrap(denom)
+		}
+		return math.LegacyDec{}, err
+	}
+	return rate, nil
+}
+
+// ConvertFee converts a data proxy fee to aseda using the exchange rate table
+// and returns the converted fee along with the rate applied.
+func (k Keeper) ConvertFee(ctx context.Context, fee sdk.Coin) (sdk.Coin, math.LegacyDec, error) {
+	rate, err := k.GetFeeExchangeRate(ctx, fee.Denom)
+	if err != nil {
+		return sdk.Coin{}, math.LegacyDec{}, err
+	}
+	return types.ConvertFee(fee, rate), rate, nil
+}
+
+// validateFeeDenoms checks that the given fee and fee schedule are quoted in
+// denominations that can be converted to aseda.
+func (k Keeper) validateFeeDenoms(ctx context.Context, fee *sdk.Coin, schedule []types.FeeScheduleEntry) error {
+	fees := make([]sdk.Coin, 0, len(schedule)+1)
+	if fee != nil {
+		fees = append(fees, *fee)
+	}
+	for _, entry := range schedule {
+		fees = append(fees, entry.Fee)
+	}
+
+	for _, fee := range fees {
+		if _, err := k.GetFeeExchangeRate(ctx, fee.Denom); err != nil {
+			if errors.Is(err, types.ErrNoExchangeRate) {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid coin denomination: no exchange rate for %s", fee.Denom)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) getAllFeeExchangeRates(ctx context.Context) ([]types.FeeExchangeRate, error) {
+	rates := make([]types.FeeExchangeRate, 0)
+
+	itr, err := k.feeExchangeRates.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		kv, err := itr.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, types.FeeExchangeRate{
+			Denom: kv.Key,
+			Rate:  kv.Value,
+		})
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (s *KeeperTestSuite) TestFeeExchangeRates() {
+	admin := "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5"
+	oracle := "seda1wyzxdtpl0c99c92n397r3drlhj09qfjvf6teyh"
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	pubKeyHex := "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3"
+	pubKeyBytes, err := hex.DecodeString(pubKeyHex)
+	s.Require().NoError(err)
+
+	proxyConfig := types.ProxyConfig{
+		PayoutAddress: admin,
+		Fee:           s.NewFeeFromString("9"),
+		AdminAddress:  admin,
+	}
+
+	s.Run("Only the authority or the exchange rate oracle can update rates", func() {
+		msg := &types.MsgUpdateFeeExchangeRates{
+			Sender:   oracle,
+			SetRates: []types.FeeExchangeRate{{Denom: ibcDenom, Rate: math.LegacyNewDec(1000)}},
+		}
+		_, err := s.msgSrvr.UpdateFeeExchangeRates(s.ctx, msg)
+		s.Require().ErrorIs(err, sdkerrors.ErrorInvalidSigner)
+
+		params := types.DefaultParams()
+		params.ExchangeRateOracle = oracle
+		s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err = s.msgSrvr.UpdateFeeExchangeRates(ctx, msg)
+		s.Require().NoError(err)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeExchangeRates, events[0].Type)
+		added, found := events[0].GetAttribute(types.AttributeAdded)
+		s.Require().True(found)
+		s.Require().Equal(ibcDenom+"=1000.000000000000000000", added.Value)
+
+		_, err = s.msgSrvr.UpdateFeeExchangeRates(s.ctx, &types.MsgUpdateFeeExchangeRates{
+			Sender:       s.authority,
+			RemoveDenoms: []string{ibcDenom},
+		})
+		s.Require().NoError(err)
+
+		_, err = s.keeper.GetFeeExchangeRate(s.ctx, ibcDenom)
+		s.Require().ErrorIs(err, types.ErrNoExchangeRate)
+	})
+
+	s.Run("Invalid rates are rejected", func() {
+		for _, rate := range []types.FeeExchangeRate{
+			{Denom: ibcDenom, Rate: math.LegacyZeroDec()},
+			{Denom: ibcDenom, Rate: math.LegacyNewDec(-1)},
+			{Denom: "aseda", Rate: math.LegacyOneDec()},
+			{Denom: "1nvalid", Rate: math.LegacyOneDec()},
+		} {
+			_, err := s.msgSrvr.UpdateFeeExchangeRates(s.ctx, &types.MsgUpdateFeeExchangeRates{
+				Sender:   s.authority,
+				SetRates: []types.FeeExchangeRate{rate},
+			})
+			s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+		}
+
+		_, err := s.msgSrvr.UpdateFeeExchangeRates(s.ctx, &types.MsgUpdateFeeExchangeRates{
+			Sender: s.authority,
+		})
+		s.Require().ErrorIs(err, types.ErrEmptyUpdate)
+	})
+
+	s.Run("Fees can only be quoted in denominations with a rate", func() {
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, proxyConfig))
+
+		msg := &types.MsgEditDataProxy{
+			Sender:           admin,
+			NewPayoutAddress: types.DoNotModifyField,
+			NewMemo:          types.DoNotModifyField,
+			NewFee:           &sdk.Coin{Denom: ibcDenom, Amount: math.NewInt(5)},
+			PubKey:           pubKeyHex,
+		}
+		_, err := s.msgSrvr.EditDataProxy(s.ctx, msg)
+		s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+		s.Require().NoError(s.keeper.SetFeeExchangeRate(s.ctx, ibcDenom, math.LegacyNewDec(1000)))
+		_, err = s.msgSrvr.EditDataProxy(s.ctx, msg)
+		s.Require().NoError(err)
+
+		updated, err := s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().Equal(*msg.NewFee, *updated.FeeUpdate.NewFee)
+	})
+
+	s.Run("Fees are converted to aseda at the current rate", func() {
+		s.Require().NoError(s.keeper.SetFeeExchangeRate(s.ctx, ibcDenom, math.LegacyMustNewDecFromStr("1000.5")))
+
+		converted, rate, err := s.keeper.ConvertFee(s.ctx, sdk.NewCoin(ibcDenom, math.NewInt(3)))
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("1000.5"), rate)
+		s.Require().Equal(sdk.NewCoin("aseda", math.NewInt(3001)), converted)
+
+		converted, rate, err = s.keeper.ConvertFee(s.ctx, sdk.NewCoin("aseda", math.NewInt(3)))
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyOneDec(), rate)
+		s.Require().Equal(sdk.NewCoin("aseda", math.NewInt(3)), converted)
+
+		_, _, err = s.keeper.ConvertFee(s.ctx, sdk.NewCoin("uusdc", math.NewInt(3)))
+		s.Require().ErrorIs(err, types.ErrNoExchangeRate)
+	})
+
+	s.Run("Query fee exchange rates", func() {
+		s.Require().NoError(s.keeper.SetFeeExchangeRate(s.ctx, ibcDenom, math.LegacyNewDec(1000)))
+
+		res, err := s.queryClient.FeeExchangeRates(s.ctx, &types.QueryFeeExchangeRatesRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.FeeExchangeRate{{Denom: ibcDenom, Rate: math.LegacyNewDec(1000)}}, res.Rates)
+	})
+}
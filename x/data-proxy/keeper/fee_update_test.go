@@ -0,0 +1,245 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (s *KeeperTestSuite) TestMsgServer_CancelFeeUpdate() {
+	admin := "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5"
+	pubKeyHex := "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3"
+	pubKeyBytes, err := hex.DecodeString(pubKeyHex)
+	s.Require().NoError(err)
+
+	updateHeight := int64(100 + types.DefaultMinFeeUpdateDelay)
+	proxyConfig := types.ProxyConfig{
+		PayoutAddress: admin,
+		Fee:           s.NewFeeFromString("10"),
+		AdminAddress:  admin,
+		FeeUpdate: &types.FeeUpdate{
+			NewFee:       s.NewFeeFromString("20"),
+			UpdateHeight: updateHeight,
+		},
+	}
+
+	s.Run("Only the admin can cancel a fee update", func() {
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, proxyConfig))
+		s.Require().NoError(s.keeper.SetFeeUpdate(s.ctx, updateHeight, pubKeyBytes))
+
+		_, err := s.msgSrvr.CancelFeeUpdate(s.ctx, &types.MsgCancelFeeUpdate{
+			Sender: "seda1wyzxdtpl0c99c92n397r3drlhj09qfjvf6teyh",
+			PubKey: pubKeyHex,
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrorInvalidSigner)
+	})
+
+	s.Run("Cancelling requires a pending fee update", func() {
+		noUpdateConfig := proxyConfig
+		noUpdateConfig.FeeUpdate = nil
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, noUpdateConfig))
+
+		_, err := s.msgSrvr.CancelFeeUpdate(s.ctx, &types.MsgCancelFeeUpdate{
+			Sender: admin,
+			PubKey: pubKeyHex,
+		})
+		s.Require().ErrorIs(err, types.ErrNoPendingUpdate)
+	})
+
+	s.Run("Cancelled fee updates are not applied", func() {
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, proxyConfig))
+		s.Require().NoError(s.keeper.SetFeeUpdate(s.ctx, updateHeight, pubKeyBytes))
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := s.msgSrvr.CancelFeeUpdate(ctx, &types.MsgCancelFeeUpdate{
+			Sender: admin,
+			PubKey: pubKeyHex,
+		})
+		s.Require().NoError(err)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeCancelUpdate, events[0].Type)
+		newFee, found := events[0].GetAttribute(types.AttributeNewFee)
+		s.Require().True(found)
+		s.Require().Equal("20aseda", newFee.Value)
+
+		updated, err := s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().Nil(updated.FeeUpdate)
+
+		found, err = s.keeper.HasFeeUpdate(s.ctx, updateHeight, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().False(found)
+
+		s.Require().NoError(s.keeper.EndBlock(s.ctx.WithBlockHeight(updateHeight)))
+		updated, err = s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().Equal(proxyConfig.Fee, updated.Fee)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgServer_AmendFeeUpdate() {
+	admin := "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5"
+	pubKeyHex := "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3"
+	pubKeyBytes, err := hex.DecodeString(pubKeyHex)
+	s.Require().NoError(err)
+
+	// The fee update was scheduled at height 100 and is amended at height 110.
+	amendHeight := int64(110)
+	updateHeight := int64(100 + types.DefaultMinFeeUpdateDelay)
+	restartedHeight := amendHeight + int64(types.DefaultMinFeeUpdateDelay)
+	proxyConfig := types.ProxyConfig{
+		PayoutAddress: admin,
+		Fee:           s.NewFeeFromString("10"),
+		AdminAddress:  admin,
+		FeeSchedule: []types.FeeScheduleEntry{
+			{MemoTag: "premium", Fee: *s.NewFeeFromString("30")},
+		},
+		FeeUpdate: &types.FeeUpdate{
+			NewFee:       s.NewFeeFromString("20"),
+			UpdateHeight: updateHeight,
+			NewFeeSchedule: []types.FeeScheduleEntry{
+				{MemoTag: "premium", Fee: *s.NewFeeFromString("40")},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		msg          *types.MsgAmendFeeUpdate
+		wantErr      error
+		expFee       *sdk.Coin
+		expSchedule  []types.FeeScheduleEntry
+		expUpdateHgt int64
+	}{
+		{
+			name: "Only the admin can amend a fee update",
+			msg: &types.MsgAmendFeeUpdate{
+				Sender: "seda1wyzxdtpl0c99c92n397r3drlhj09qfjvf6teyh",
+				NewFee: s.NewFeeFromString("15"),
+			},
+			wantErr: sdkerrors.ErrorInvalidSigner,
+		},
+		{
+			name: "Empty amendment",
+			msg: &types.MsgAmendFeeUpdate{
+				Sender: admin,
+			},
+			wantErr: types.ErrEmptyUpdate,
+		},
+		{
+			name: "Raising the pending fee restarts the delay",
+			msg: &types.MsgAmendFeeUpdate{
+				Sender: admin,
+				NewFee: s.NewFeeFromString("25"),
+			},
+			expFee:       s.NewFeeFromString("25"),
+			expSchedule:  proxyConfig.FeeUpdate.NewFeeSchedule,
+			expUpdateHgt: restartedHeight,
+		},
+		{
+			name: "Raising a pending fee schedule entry restarts the delay",
+			msg: &types.MsgAmendFeeUpdate{
+				Sender: admin,
+				NewFeeSchedule: []types.FeeScheduleEntry{
+					{MemoTag: "premium", Fee: *s.NewFeeFromString("50")},
+				},
+			},
+			expFee: s.NewFeeFromString("20"),
+			expSchedule: []types.FeeScheduleEntry{
+				{MemoTag: "premium", Fee: *s.NewFeeFromString("50")},
+			},
+			expUpdateHgt: restartedHeight,
+		},
+		{
+			name: "Changing the fee schedule entries restarts the delay",
+			msg: &types.MsgAmendFeeUpdate{
+				Sender:           admin,
+				ClearFeeSchedule: true,
+			},
+			expFee:       s.NewFeeFromString("20"),
+			expSchedule:  nil,
+			expUpdateHgt: restartedHeight,
+		},
+		{
+			name: "Changing the fee denomination restarts the delay",
+			msg: &types.MsgAmendFeeUpdate{
+				Sender: admin,
+				NewFee: &sdk.Coin{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Amount: math.NewInt(1)},
+			},
+			expFee:       &sdk.Coin{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Amount: math.NewInt(1)},
+			expSchedule:  proxyConfig.FeeUpdate.NewFeeSchedule,
+			expUpdateHgt: restartedHeight,
+		},
+		{
+			name: "Lowering the pending fee above the current fee keeps the update height",
+			msg: &types.MsgAmendFeeUpdate{
+				Sender: admin,
+				NewFee: s.NewFeeFromString("15"),
+			},
+			expFee:       s.NewFeeFromString("15"),
+			expSchedule:  proxyConfig.FeeUpdate.NewFeeSchedule,
+			expUpdateHgt: updateHeight,
+		},
+		{
+			name: "Lowering the pricing below the current pricing applies at the next block",
+			msg: &types.MsgAmendFeeUpdate{
+				Sender: admin,
+				NewFee: s.NewFeeFromString("5"),
+				NewFeeSchedule: []types.FeeScheduleEntry{
+					{MemoTag: "premium", Fee: *s.NewFeeFromString("30")},
+				},
+			},
+			expFee: s.NewFeeFromString("5"),
+			expSchedule: []types.FeeScheduleEntry{
+				{MemoTag: "premium", Fee: *s.NewFeeFromString("30")},
+			},
+			expUpdateHgt: amendHeight + 1,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.ctx = s.ctx.WithBlockHeight(amendHeight)
+			s.Require().NoError(s.keeper.SetFeeExchangeRate(s.ctx, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", math.LegacyNewDec(10)))
+			s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, proxyConfig))
+			s.Require().NoError(s.keeper.SetFeeUpdate(s.ctx, updateHeight, pubKeyBytes))
+
+			tt.msg.PubKey = pubKeyHex
+			res, err := s.msgSrvr.AmendFeeUpdate(s.ctx, tt.msg)
+			if tt.wantErr != nil {
+				s.Require().ErrorIs(err, tt.wantErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tt.expUpdateHgt, res.FeeUpdateHeight)
+
+			updated, err := s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+			s.Require().NoError(err)
+			s.Require().Equal(tt.expFee, updated.FeeUpdate.NewFee)
+			s.Require().Equal(tt.expSchedule, updated.FeeUpdate.NewFeeSchedule)
+			s.Require().Equal(tt.expUpdateHgt, updated.FeeUpdate.UpdateHeight)
+			s.Require().Equal(proxyConfig.Fee, updated.Fee)
+
+			found, err := s.keeper.HasFeeUpdate(s.ctx, tt.expUpdateHgt, pubKeyBytes)
+			s.Require().NoError(err)
+			s.Require().True(found)
+			if tt.expUpdateHgt != updateHeight {
+				found, err = s.keeper.HasFeeUpdate(s.ctx, updateHeight, pubKeyBytes)
+				s.Require().NoError(err)
+				s.Require().False(found)
+			}
+
+			s.Require().NoError(s.keeper.EndBlock(s.ctx.WithBlockHeight(tt.expUpdateHgt)))
+			updated, err = s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+			s.Require().NoError(err)
+			s.Require().Equal(tt.expFee, updated.Fee)
+			s.Require().Nil(updated.FeeUpdate)
+		})
+	}
+}
@@ -23,6 +23,48 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 			panic(err)
 		}
 	}
+
+	for _, unbonding := range data.UnbondingQueue {
+		if err := k.SetUnbonding(ctx, unbonding.CompletionHeight, unbonding.DataProxyPubkey); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, deregistration := range data.DeregistrationQueue {
+		if err := k.SetDeregistration(ctx, deregistration.DeregistrationHeight, deregistration.DataProxyPubkey); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, keyAlias := range data.KeyAliases {
+		if err := k.SetKeyAlias(ctx, keyAlias.OldPubKey, keyAlias.Alias); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, usage := range data.ProxyUsages {
+		if err := k.SetDataProxyUsage(ctx, usage.DataProxyPubkey, usage.Usage); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, pubKey := range data.Denylist {
+		if err := k.DenyDataProxy(ctx, pubKey); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, pubKey := range data.Allowlist {
+		if err := k.AllowDataProxy(ctx, pubKey); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, rate := range data.FeeExchangeRates {
+		if err := k.SetFeeExchangeRate(ctx, rate.Denom, rate.Rate); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis extracts all data from store to genesis state.
@@ -47,6 +89,48 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 	}
 	gs.FeeUpdateQueue = feeUpdates
 
+	unbondings, err := k.getAllUnbondingRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+	gs.UnbondingQueue = unbondings
+
+	deregistrations, err := k.getAllDeregistrationRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+	gs.DeregistrationQueue = deregistrations
+
+	keyAliases, err := k.getAllKeyAliases(ctx)
+	if err != nil {
+		panic(err)
+	}
+	gs.KeyAliases = keyAliases
+
+	usages, err := k.getAllDataProxyUsages(ctx)
+	if err != nil {
+		panic(err)
+	}
+	gs.ProxyUsages = usages
+
+	denylist, err := k.getAccessList(ctx, k.denylist)
+	if err != nil {
+		panic(err)
+	}
+	gs.Denylist = denylist
+
+	allowlist, err := k.getAccessList(ctx, k.allowlist)
+	if err != nil {
+		panic(err)
+	}
+	gs.Allowlist = allowlist
+
+	rates, err := k.getAllFeeExchangeRates(ctx)
+	if err != nil {
+		panic(err)
+	}
+	gs.FeeExchangeRates = rates
+
 	return gs
 }
 
@@ -97,3 +181,99 @@ func (k Keeper) getAllFeeUpdateRecords(ctx sdk.Context) ([]types.FeeUpdateQueueR
 
 	return feeUpdates, nil
 }
+
+func (k Keeper) getAllUnbondingRecords(ctx sdk.Context) ([]types.UnbondingQueueRecord, error) {
+	unbondings := make([]types.UnbondingQueueRecord, 0)
+
+	itr, err := k.unbondingQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		key, err := itr.Key()
+		if err != nil {
+			return nil, err
+		}
+
+		unbondings = append(unbondings, types.UnbondingQueueRecord{
+			CompletionHeight: key.K1(),
+			DataProxyPubkey:  key.K2(),
+		})
+	}
+
+	return unbondings, nil
+}
+
+func (k Keeper) getAllDeregistrationRecords(ctx sdk.Context) ([]types.DeregistrationQueueRecord, error) {
+	deregistrations := make([]types.DeregistrationQueueRecord, 0)
+
+	itr, err := k.deregistrationQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		key, err := itr.Key()
+		if err != nil {
+			return nil, err
+		}
+
+		deregistrations = append(deregistrations, types.DeregistrationQueueRecord{
+			DeregistrationHeight: key.K1(),
+			DataProxyPubkey:      key.K2(),
+		})
+	}
+
+	return deregistrations, nil
+}
+
+func (k Keeper) getAllKeyAliases(ctx sdk.Context) ([]types.KeyAliasRecord, error) {
+	keyAliases := make([]types.KeyAliasRecord, 0)
+
+	itr, err := k.keyAliases.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		kv, err := itr.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		keyAliases = append(keyAliases, types.KeyAliasRecord{
+			OldPubKey: kv.Key,
+			Alias:     kv.Value,
+		})
+	}
+
+	return keyAliases, nil
+}
+
+func (k Keeper) getAllDataProxyUsages(ctx sdk.Context) ([]types.ProxyUsageRecord, error) {
+	usages := make([]types.ProxyUsageRecord, 0)
+
+	itr, err := k.proxyUsages.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		kv, err := itr.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		usages = append(usages, types.ProxyUsageRecord{
+			DataProxyPubkey: kv.Key,
+			Usage:           kv.Value,
+		})
+	}
+
+	return usages, nil
+}
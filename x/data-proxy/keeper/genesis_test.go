@@ -3,6 +3,10 @@ package keeper_test
 import (
 	"encoding/hex"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
 )
 
@@ -11,6 +15,8 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	s.Require().NoError(err)
 	pubkeyTwo, err := hex.DecodeString("02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3")
 	s.Require().NoError(err)
+	pubkeyThree, err := hex.DecodeString("020173bd90e73c5f8576b3141c53aa9959b10a1daf1bc9c0ccf0a942932c703dec")
+	s.Require().NoError(err)
 
 	genState := types.GenesisState{
 		Params: types.DefaultParams(),
@@ -36,6 +42,15 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 						NewFee:       s.NewFeeFromString("10000"),
 						UpdateHeight: 500,
 					},
+					Bond:                 s.NewBondFromString("10000"),
+					DeregistrationHeight: 700,
+					UnbondingEntries: []types.UnbondingEntry{
+						{
+							Recipient:        "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
+							Amount:           *s.NewBondFromString("2500"),
+							CompletionHeight: 800,
+						},
+					},
 				},
 			},
 		},
@@ -45,6 +60,42 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 				UpdateHeight:    500,
 			},
 		},
+		UnbondingQueue: []types.UnbondingQueueRecord{
+			{
+				DataProxyPubkey:  pubkeyTwo,
+				CompletionHeight: 800,
+			},
+		},
+		DeregistrationQueue: []types.DeregistrationQueueRecord{
+			{
+				DataProxyPubkey:      pubkeyTwo,
+				DeregistrationHeight: 700,
+			},
+		},
+		KeyAliases: []types.KeyAliasRecord{
+			{
+				OldPubKey: pubkeyThree,
+				Alias: types.KeyAlias{
+					NewPubKey:        pubkeyOne,
+					ExpirationHeight: 900,
+				},
+			},
+		},
+		ProxyUsages: []types.ProxyUsageRecord{
+			{
+				DataProxyPubkey: pubkeyOne,
+				Usage: types.ProxyUsage{
+					RequestsServed: 3,
+					GasUsed:        s.NewIntFromString("150000"),
+					FeesEarned:     sdk.NewCoins(*s.NewFeeFromString("15")),
+				},
+			},
+		},
+		Denylist:  [][]byte{pubkeyTwo},
+		Allowlist: [][]byte{pubkeyOne, pubkeyThree},
+		FeeExchangeRates: []types.FeeExchangeRate{
+			{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Rate: math.LegacyNewDec(1000)},
+		},
 	}
 
 	err = types.ValidateGenesis(genState)
@@ -59,4 +110,11 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	s.Require().Equal(genState.Params, exportedGenState.Params)
 	s.Require().ElementsMatch(genState.DataProxyConfigs, exportedGenState.DataProxyConfigs)
 	s.Require().ElementsMatch(genState.FeeUpdateQueue, exportedGenState.FeeUpdateQueue)
+	s.Require().ElementsMatch(genState.UnbondingQueue, exportedGenState.UnbondingQueue)
+	s.Require().ElementsMatch(genState.DeregistrationQueue, exportedGenState.DeregistrationQueue)
+	s.Require().ElementsMatch(genState.KeyAliases, exportedGenState.KeyAliases)
+	s.Require().ElementsMatch(genState.ProxyUsages, exportedGenState.ProxyUsages)
+	s.Require().ElementsMatch(genState.Denylist, exportedGenState.Denylist)
+	s.Require().ElementsMatch(genState.Allowlist, exportedGenState.Allowlist)
+	s.Require().ElementsMatch(genState.FeeExchangeRates, exportedGenState.FeeExchangeRates)
 }
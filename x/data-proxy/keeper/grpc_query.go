@@ -5,11 +5,15 @@ import (
 	"encoding/hex"
 	"errors"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
 )
@@ -21,6 +25,10 @@ type Querier struct {
 }
 
 func (q Querier) DataProxyConfig(ctx context.Context, req *types.QueryDataProxyConfigRequest) (*types.QueryDataProxyConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
 	pubKeyBytes, err := hex.DecodeString(req.PubKey)
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "invalid hex in pubkey: %s", req.PubKey)
@@ -38,6 +46,148 @@ func (q Querier) DataProxyConfig(ctx context.Context, req *types.QueryDataProxyC
 	return &types.QueryDataProxyConfigResponse{Config: &result}, nil
 }
 
+func (q Querier) DataProxyConfigs(ctx context.Context, req *types.QueryDataProxyConfigsRequest) (*types.QueryDataProxyConfigsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	configs, pageRes, err := query.CollectionFilteredPaginate(
+		ctx, q.dataProxyConfigs, req.Pagination,
+		func(_ []byte, config types.ProxyConfig) (bool, error) {
+			if req.AdminAddress != "" && config.AdminAddress != req.AdminAddress {
+				return false, nil
+			}
+			if req.PayoutAddress != "" && !config.HasPayoutRecipient(req.PayoutAddress) {
+				return false, nil
+			}
+			if req.HasPendingFeeUpdate && config.FeeUpdate == nil {
+				return false, nil
+			}
+			return true, nil
+		},
+		func(pubKey []byte, config types.ProxyConfig) (types.DataProxyConfigEntry, error) {
+			return types.DataProxyConfigEntry{
+				PubKey: hex.EncodeToString(pubKey),
+				Config: config,
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDataProxyConfigsResponse{
+		Configs:    configs,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q Querier) PendingFeeUpdates(ctx context.Context, req *types.QueryPendingFeeUpdatesRequest) (*types.QueryPendingFeeUpdatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	feeUpdates, pageRes, err := query.CollectionPaginate(
+		ctx, q.feeUpdateQueue, req.Pagination,
+		func(key collections.Pair[int64, []byte], _ collections.NoValue) (types.PendingFeeUpdate, error) {
+			config, err := q.GetDataProxyConfig(ctx, key.K2())
+			if err != nil {
+				return types.PendingFeeUpdate{}, err
+			}
+
+			feeUpdate := types.PendingFeeUpdate{
+				PubKey:       hex.EncodeToString(key.K2()),
+				UpdateHeight: key.K1(),
+				CurrentFee:   config.Fee,
+			}
+			if config.FeeUpdate != nil {
+				feeUpdate.NewFee = config.FeeUpdate.NewFee
+				feeUpdate.NewFeeSchedule = config.FeeUpdate.NewFeeSchedule
+			}
+			return feeUpdate, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingFeeUpdatesResponse{
+		FeeUpdates: feeUpdates,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q Querier) DataProxyUsage(ctx context.Context, req *types.QueryDataProxyUsageRequest) (*types.QueryDataProxyUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pubKeyBytes, err := hex.DecodeString(req.PubKey)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid hex in pubkey: %s", req.PubKey)
+	}
+
+	resolved, err := q.resolvePubKey(ctx, pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := q.proxyUsages.Get(ctx, resolved)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+
+		// Registered data proxies that have not been paid yet have no usage.
+		found, err := q.dataProxyConfigs.Has(ctx, resolved)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, sdkerrors.ErrNotFound.Wrapf("no data proxy registered for %s", req.PubKey)
+		}
+		usage = types.NewProxyUsage()
+	}
+
+	return &types.QueryDataProxyUsageResponse{Usage: usage}, nil
+}
+
+func (q Querier) DataProxyAccessLists(ctx context.Context, _ *types.QueryDataProxyAccessListsRequest) (*types.QueryDataProxyAccessListsResponse, error) {
+	denylist, err := q.getAccessList(ctx, q.denylist)
+	if err != nil {
+		return nil, err
+	}
+	allowlist, err := q.getAccessList(ctx, q.allowlist)
+	if err != nil {
+		return nil, err
+	}
+	params, err := q.params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryDataProxyAccessListsResponse{
+		Denylist:         make([]string, len(denylist)),
+		Allowlist:        make([]string, len(allowlist)),
+		AllowlistEnabled: params.AllowlistEnabled,
+	}
+	for i, pubKey := range denylist {
+		res.Denylist[i] = hex.EncodeToString(pubKey)
+	}
+	for i, pubKey := range allowlist {
+		res.Allowlist[i] = hex.EncodeToString(pubKey)
+	}
+	return res, nil
+}
+
+func (q Querier) FeeExchangeRates(ctx context.Context, _ *types.QueryFeeExchangeRatesRequest) (*types.QueryFeeExchangeRatesResponse, error) {
+	rates, err := q.getAllFeeExchangeRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFeeExchangeRatesResponse{Rates: rates}, nil
+}
+
 func (q Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	params, err := q.GetParams(ctx)
@@ -3,11 +3,16 @@ package keeper_test
 import (
 	"encoding/hex"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/keeper"
 	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
 )
 
@@ -75,3 +80,161 @@ func (s *KeeperTestSuite) TestQuerier_ProxyConfig() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQuerier_DataProxyConfigs() {
+	pubKeys := []string{
+		"02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3",
+		"021ce9f4d5a1c1cc1e5e5ee6fc2bb0e4a6ae3a1ea2fe8ec84d3d3e4e1b1ee2f2a1",
+		"03a7b1b1a2e0ad3e4dd3e5d9a8b8ec6f5b1d2c7e6f5a4b3c2d1e0f9a8b7c6d5e4f",
+		"03c4d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2",
+	}
+	configs := []types.ProxyConfig{
+		{
+			AdminAddress:  "admin1",
+			PayoutAddress: "pay1",
+			Fee:           s.NewFeeFromString("5"),
+		},
+		{
+			AdminAddress:  "admin1",
+			PayoutAddress: "pay2",
+			Fee:           s.NewFeeFromString("10"),
+			FeeUpdate: &types.FeeUpdate{
+				NewFee:       s.NewFeeFromString("20"),
+				UpdateHeight: 8,
+			},
+		},
+		{
+			AdminAddress:  "admin2",
+			PayoutAddress: "pay2",
+			Fee:           s.NewFeeFromString("15"),
+			FeeUpdate: &types.FeeUpdate{
+				NewFee:       s.NewFeeFromString("1"),
+				UpdateHeight: 6,
+			},
+		},
+		{
+			AdminAddress:  "admin3",
+			PayoutAddress: "pay3",
+			PayoutRecipients: []types.PayoutRecipient{
+				{Address: "pay3", Weight: math.LegacyMustNewDecFromStr("0.6")},
+				{Address: "pay1", Weight: math.LegacyMustNewDecFromStr("0.4")},
+			},
+			Fee: s.NewFeeFromString("25"),
+		},
+	}
+	// Subtests start from a fresh state.
+	registerProxies := func() {
+		for i, pubKeyHex := range pubKeys {
+			pubKey, err := hex.DecodeString(pubKeyHex)
+			s.Require().NoError(err)
+			s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, pubKey, configs[i]))
+			if configs[i].FeeUpdate != nil {
+				s.Require().NoError(s.keeper.SetFeeUpdate(s.ctx, configs[i].FeeUpdate.UpdateHeight, pubKey))
+			}
+		}
+	}
+
+	tests := []struct {
+		name    string
+		req     *types.QueryDataProxyConfigsRequest
+		wantIdx []int
+	}{
+		{
+			name:    "all data proxies",
+			req:     &types.QueryDataProxyConfigsRequest{},
+			wantIdx: []int{0, 1, 2, 3},
+		},
+		{
+			name:    "by admin address",
+			req:     &types.QueryDataProxyConfigsRequest{AdminAddress: "admin1"},
+			wantIdx: []int{0, 1},
+		},
+		{
+			name:    "by payout address",
+			req:     &types.QueryDataProxyConfigsRequest{PayoutAddress: "pay2"},
+			wantIdx: []int{1, 2},
+		},
+		{
+			name:    "by payout recipient",
+			req:     &types.QueryDataProxyConfigsRequest{PayoutAddress: "pay1"},
+			wantIdx: []int{0, 3},
+		},
+		{
+			name:    "with pending fee update",
+			req:     &types.QueryDataProxyConfigsRequest{HasPendingFeeUpdate: true},
+			wantIdx: []int{1, 2},
+		},
+		{
+			name:    "combined filters",
+			req:     &types.QueryDataProxyConfigsRequest{AdminAddress: "admin1", HasPendingFeeUpdate: true},
+			wantIdx: []int{1},
+		},
+		{
+			name:    "no match",
+			req:     &types.QueryDataProxyConfigsRequest{AdminAddress: "admin4"},
+			wantIdx: []int{},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			registerProxies()
+			res, err := s.queryClient.DataProxyConfigs(s.ctx, tt.req)
+			s.Require().NoError(err)
+			s.Require().Len(res.Configs, len(tt.wantIdx))
+			for i, idx := range tt.wantIdx {
+				s.Require().Equal(pubKeys[idx], res.Configs[i].PubKey)
+				s.Require().Equal(configs[idx], res.Configs[i].Config)
+			}
+		})
+	}
+
+	s.Run("pagination", func() {
+		registerProxies()
+		res, err := s.queryClient.DataProxyConfigs(s.ctx, &types.QueryDataProxyConfigsRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res.Configs, 2)
+		s.Require().Equal(uint64(4), res.Pagination.Total)
+
+		res, err = s.queryClient.DataProxyConfigs(s.ctx, &types.QueryDataProxyConfigsRequest{
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res.Configs, 2)
+		s.Require().Equal(pubKeys[2], res.Configs[0].PubKey)
+		s.Require().Equal(pubKeys[3], res.Configs[1].PubKey)
+	})
+
+	s.Run("nil request", func() {
+		querier := keeper.Querier{Keeper: *s.keeper}
+		_, err := querier.DataProxyConfigs(s.ctx, nil)
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+		_, err = querier.DataProxyConfig(s.ctx, nil)
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+		_, err = querier.PendingFeeUpdates(s.ctx, nil)
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+		_, err = querier.DataProxyUsage(s.ctx, nil)
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("pending fee updates", func() {
+		registerProxies()
+		res, err := s.queryClient.PendingFeeUpdates(s.ctx, &types.QueryPendingFeeUpdatesRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PendingFeeUpdate{
+			{
+				PubKey:       pubKeys[2],
+				UpdateHeight: 6,
+				CurrentFee:   configs[2].Fee,
+				NewFee:       configs[2].FeeUpdate.NewFee,
+			},
+			{
+				PubKey:       pubKeys[1],
+				UpdateHeight: 8,
+				CurrentFee:   configs[1].Fee,
+				NewFee:       configs[1].FeeUpdate.NewFee,
+			},
+		}, res.FeeUpdates)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,20 +21,36 @@ type Keeper struct {
 	// authority is the address capable of executing MsgUpdateParams. Typically, this should be the gov module address.
 	authority string
 
-	Schema           collections.Schema
-	dataProxyConfigs collections.Map[[]byte, types.ProxyConfig]
-	feeUpdateQueue   collections.KeySet[collections.Pair[int64, []byte]]
-	params           collections.Item[types.Params]
+	Schema              collections.Schema
+	dataProxyConfigs    collections.Map[[]byte, types.ProxyConfig]
+	feeUpdateQueue      collections.KeySet[collections.Pair[int64, []byte]]
+	unbondingQueue      collections.KeySet[collections.Pair[int64, []byte]]
+	deregistrationQueue collections.KeySet[collections.Pair[int64, []byte]]
+	keyAliases          collections.Map[[]byte, types.KeyAlias]
+	keyAliasQueue       collections.KeySet[collections.Pair[int64, []byte]]
+	proxyUsages         collections.Map[[]byte, types.ProxyUsage]
+	denylist            collections.KeySet[[]byte]
+	allowlist           collections.KeySet[[]byte]
+	feeExchangeRates    collections.Map[string, math.LegacyDec]
+	params              collections.Item[types.Params]
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService, bk types.BankKeeper, authority string) *Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
-		authority:        authority,
-		bankKeeper:       bk,
-		dataProxyConfigs: collections.NewMap(sb, types.DataProxyConfigPrefix, "configs", collections.BytesKey, codec.CollValue[types.ProxyConfig](cdc)),
-		feeUpdateQueue:   collections.NewKeySet(sb, types.FeeUpdatesPrefix, "fee_updates", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
-		params:           collections.NewItem(sb, types.ParamsPrefix, "params", codec.CollValue[types.Params](cdc)),
+		authority:           authority,
+		bankKeeper:          bk,
+		dataProxyConfigs:    collections.NewMap(sb, types.DataProxyConfigPrefix, "configs", collections.BytesKey, codec.CollValue[types.ProxyConfig](cdc)),
+		feeUpdateQueue:      collections.NewKeySet(sb, types.FeeUpdatesPrefix, "fee_updates", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		unbondingQueue:      collections.NewKeySet(sb, types.UnbondingQueuePrefix, "unbondings", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		deregistrationQueue: collections.NewKeySet(sb, types.DeregistrationQueuePrefix, "deregistrations", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		keyAliases:          collections.NewMap(sb, types.KeyAliasPrefix, "key_aliases", collections.BytesKey, codec.CollValue[types.KeyAlias](cdc)),
+		keyAliasQueue:       collections.NewKeySet(sb, types.KeyAliasQueuePrefix, "key_alias_expirations", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		proxyUsages:         collections.NewMap(sb, types.ProxyUsagePrefix, "proxy_usages", collections.BytesKey, codec.CollValue[types.ProxyUsage](cdc)),
+		denylist:            collections.NewKeySet(sb, types.DenylistPrefix, "denylist", collections.BytesKey),
+		allowlist:           collections.NewKeySet(sb, types.AllowlistPrefix, "allowlist", collections.BytesKey),
+		feeExchangeRates:    collections.NewMap(sb, types.FeeExchangeRatePrefix, "fee_exchange_rates", collections.StringKey, sdk.LegacyDecValue),
+		params:              collections.NewItem(sb, types.ParamsPrefix, "params", codec.CollValue[types.Params](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -57,6 +74,10 @@ func (k Keeper) SetDataProxyConfig(ctx context.Context, pubKey []byte, proxyConf
 	return k.dataProxyConfigs.Set(ctx, pubKey, proxyConfig)
 }
 
+func (k Keeper) RemoveDataProxyConfig(ctx context.Context, pubKey []byte) error {
+	return k.dataProxyConfigs.Remove(ctx, pubKey)
+}
+
 func (k Keeper) GetDataProxyConfig(ctx context.Context, pubKey []byte) (result types.ProxyConfig, err error) {
 	config, err := k.dataProxyConfigs.Get(ctx, pubKey)
 	if err != nil {
@@ -74,12 +95,21 @@ func (k Keeper) RemoveFeeUpdate(ctx sdk.Context, height int64, pubKey []byte) er
 	return k.feeUpdateQueue.Remove(ctx, collections.Join(height, pubKey))
 }
 
-func (k Keeper) scheduleFeeUpdate(ctx sdk.Context, pubKeyBytes []byte, proxyConfig types.ProxyConfig, newFee *sdk.Coin, updateDelay uint32) (int64, error) {
+func (k Keeper) scheduleFeeUpdate(ctx sdk.Context, pubKeyBytes []byte, proxyConfig types.ProxyConfig, newFee *sdk.Coin, newFeeSchedule []types.FeeScheduleEntry, updateDelay uint32) (int64, error) {
 	// Determine update height
 	updateHeight := ctx.BlockHeight() + int64(updateDelay)
 	feeUpdate := &types.FeeUpdate{
-		NewFee:       newFee,
-		UpdateHeight: updateHeight,
+		NewFee:         newFee,
+		UpdateHeight:   updateHeight,
+		NewFeeSchedule: newFeeSchedule,
+	}
+
+	// Delete previous pending update, if applicable
+	if proxyConfig.FeeUpdate != nil {
+		err := k.RemoveFeeUpdate(ctx, proxyConfig.FeeUpdate.UpdateHeight, pubKeyBytes)
+		if err != nil {
+			return 0, err
+		}
 	}
 
 	// Check if the max updates per block is reached
@@ -92,14 +122,6 @@ func (k Keeper) scheduleFeeUpdate(ctx sdk.Context, pubKeyBytes []byte, proxyConf
 		return 0, types.ErrMaxUpdatesReached
 	}
 
-	// Delete previous pending update, if applicable
-	if proxyConfig.FeeUpdate != nil {
-		err := k.RemoveFeeUpdate(ctx, proxyConfig.FeeUpdate.UpdateHeight, pubKeyBytes)
-		if err != nil {
-			return 0, err
-		}
-	}
-
 	// Schedule new update
 	proxyConfig.FeeUpdate = feeUpdate
 	err = k.SetFeeUpdate(ctx, updateHeight, pubKeyBytes)
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (k Keeper) SetKeyAlias(ctx sdk.Context, oldPubKey []byte, alias types.KeyAlias) error {
+	if err := k.keyAliases.Set(ctx, oldPubKey, alias); err != nil {
+		return err
+	}
+	return k.keyAliasQueue.Set(ctx, collections.Join(alias.ExpirationHeight, oldPubKey))
+}
+
+func (k Keeper) GetKeyAlias(ctx context.Context, oldPubKey []byte) (types.KeyAlias, error) {
+	return k.keyAliases.Get(ctx, oldPubKey)
+}
+
+func (k Keeper) HasKeyAlias(ctx context.Context, oldPubKey []byte) (bool, error) {
+	return k.keyAliases.Has(ctx, oldPubKey)
+}
+
+// resolvePubKey follows the aliases left behind by key rotations and returns
+// the current public key of the data proxy.
+func (k Keeper) resolvePubKey(ctx context.Context, pubKey []byte) ([]byte, error) {
+	for i := 0; i < types.MaxAliasHops; i++ {
+		alias, err := k.keyAliases.Get(ctx, pubKey)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return pubKey, nil
+			}
+			return nil, err
+		}
+		pubKey = alias.NewPubKey
+	}
+	return pubKey, nil
+}
+
+// rotateKey moves the config of a data proxy, its queued fee update and
+// unbonding entries, and its usage from the old to the new public key. The old key resolves
+// to the new key until the returned expiration height so that in-flight
+// requests referencing it are still paid.
+func (k Keeper) rotateKey(ctx sdk.Context, oldPubKey, newPubKey []byte, proxyConfig types.ProxyConfig) (int64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if proxyConfig.FeeUpdate != nil {
+		if err := k.RemoveFeeUpdate(ctx, proxyConfig.FeeUpdate.UpdateHeight, oldPubKey); err != nil {
+			return 0, err
+		}
+		if err := k.SetFeeUpdate(ctx, proxyConfig.FeeUpdate.UpdateHeight, newPubKey); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, entry := range proxyConfig.UnbondingEntries {
+		if err := k.RemoveUnbonding(ctx, entry.CompletionHeight, oldPubKey); err != nil {
+			return 0, err
+		}
+		if err := k.SetUnbonding(ctx, entry.CompletionHeight, newPubKey); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := k.moveDataProxyUsage(ctx, oldPubKey, newPubKey); err != nil {
+		return 0, err
+	}
+
+	if err := k.RemoveDataProxyConfig(ctx, oldPubKey); err != nil {
+		return 0, err
+	}
+	if err := k.SetDataProxyConfig(ctx, newPubKey, proxyConfig); err != nil {
+		return 0, err
+	}
+
+	expirationHeight := ctx.BlockHeight() + int64(params.KeyAliasPeriod)
+	err = k.SetKeyAlias(ctx, oldPubKey, types.KeyAlias{
+		NewPubKey:        newPubKey,
+		ExpirationHeight: expirationHeight,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return expirationHeight, nil
+}
+
+// ProcessKeyAliasExpirations removes the key aliases expiring at the current
+// height.
+func (k Keeper) ProcessKeyAliasExpirations(ctx sdk.Context) error {
+	blockHeight := ctx.BlockHeight()
+	itr, err := k.keyAliasQueue.Iterate(ctx, collections.NewPrefixedPairRange[int64, []byte](blockHeight))
+	if err != nil {
+		return err
+	}
+
+	keys, err := itr.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		oldPubKey := key.K2()
+		if err := k.keyAliases.Remove(ctx, oldPubKey); err != nil {
+			return err
+		}
+		if err := k.keyAliasQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeAliasExpired,
+			sdk.NewAttribute(types.AttributePubKey, hex.EncodeToString(oldPubKey)),
+			sdk.NewAttribute(types.AttributeExpirationHeight, fmt.Sprintf("%d", blockHeight)),
+		))
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (s *KeeperTestSuite) newProxyKey(seed string) (*ecdsa.PrivateKey, []byte) {
+	privKey, err := crypto.ToECDSA(crypto.Keccak256([]byte(seed)))
+	s.Require().NoError(err)
+	return privKey, crypto.CompressPubkey(&privKey.PublicKey)
+}
+
+func (s *KeeperTestSuite) signRotation(privKey *ecdsa.PrivateKey, config types.ProxyConfig, newPubKey []byte) string {
+	payloadHash := crypto.Keccak256(types.KeyRotationPayload(config, s.ctx.ChainID(), newPubKey))
+	signature, err := crypto.Sign(payloadHash, privKey)
+	s.Require().NoError(err)
+	// Drop the recovery ID.
+	return hex.EncodeToString(signature[:64])
+}
+
+func (s *KeeperTestSuite) TestMsgServer_RotateDataProxyKey() {
+	admin := "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5"
+	oldPrivKey, oldPubKey := s.newProxyKey("old")
+	newPrivKey, newPubKey := s.newProxyKey("new")
+
+	proxyConfig := types.ProxyConfig{
+		PayoutAddress: admin,
+		Fee:           s.NewFeeFromString("9"),
+		AdminAddress:  admin,
+		Bond:          s.NewBondFromString("100"),
+		FeeUpdate: &types.FeeUpdate{
+			NewFee:       s.NewFeeFromString("10"),
+			UpdateHeight: 200,
+		},
+		UnbondingEntries: []types.UnbondingEntry{
+			{Recipient: admin, Amount: *s.NewBondFromString("5"), CompletionHeight: 300},
+		},
+	}
+	setup := func() {
+		s.ctx = s.ctx.WithBlockHeight(100)
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, oldPubKey, proxyConfig))
+		s.Require().NoError(s.keeper.SetFeeUpdate(s.ctx, 200, oldPubKey))
+		s.Require().NoError(s.keeper.SetUnbonding(s.ctx, 300, oldPubKey))
+	}
+
+	s.Run("Rotation migrates the config and leaves an alias", func() {
+		setup()
+
+		res, err := s.msgSrvr.RotateDataProxyKey(s.ctx, &types.MsgRotateDataProxyKey{
+			Sender:       admin,
+			PubKey:       hex.EncodeToString(oldPubKey),
+			NewPubKey:    hex.EncodeToString(newPubKey),
+			Signature:    s.signRotation(oldPrivKey, proxyConfig, newPubKey),
+			NewSignature: s.signRotation(newPrivKey, proxyConfig, newPubKey),
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(s.ctx.BlockHeight()+int64(types.DefaultKeyAliasPeriod), res.AliasExpirationHeight)
+
+		found, err := s.keeper.HasDataProxy(s.ctx, oldPubKey)
+		s.Require().NoError(err)
+		s.Require().False(found)
+
+		migrated, err := s.keeper.GetDataProxyConfig(s.ctx, newPubKey)
+		s.Require().NoError(err)
+		s.Require().Equal(proxyConfig, migrated)
+
+		hasFeeUpdate, err := s.keeper.HasFeeUpdate(s.ctx, 200, newPubKey)
+		s.Require().NoError(err)
+		s.Require().True(hasFeeUpdate)
+		hasFeeUpdate, err = s.keeper.HasFeeUpdate(s.ctx, 200, oldPubKey)
+		s.Require().NoError(err)
+		s.Require().False(hasFeeUpdate)
+
+		hasUnbonding, err := s.keeper.HasUnbonding(s.ctx, 300, newPubKey)
+		s.Require().NoError(err)
+		s.Require().True(hasUnbonding)
+		hasUnbonding, err = s.keeper.HasUnbonding(s.ctx, 300, oldPubKey)
+		s.Require().NoError(err)
+		s.Require().False(hasUnbonding)
+
+		// In-flight requests referencing the old key are still resolved.
+		resolved, err := s.keeper.GetActiveDataProxyConfig(s.ctx, oldPubKey)
+		s.Require().NoError(err)
+		s.Require().Equal(proxyConfig, resolved)
+
+		// The old key cannot be registered again while the alias is active.
+		_, err = s.msgSrvr.RotateDataProxyKey(s.ctx, &types.MsgRotateDataProxyKey{
+			Sender:       admin,
+			PubKey:       hex.EncodeToString(newPubKey),
+			NewPubKey:    hex.EncodeToString(oldPubKey),
+			Signature:    s.signRotation(newPrivKey, proxyConfig, oldPubKey),
+			NewSignature: s.signRotation(oldPrivKey, proxyConfig, oldPubKey),
+		})
+		s.Require().ErrorIs(err, types.ErrAlreadyExists)
+
+		// The alias expires after the alias period.
+		s.ctx = s.ctx.WithBlockHeight(res.AliasExpirationHeight)
+		s.Require().NoError(s.keeper.ProcessKeyAliasExpirations(s.ctx))
+
+		hasAlias, err := s.keeper.HasKeyAlias(s.ctx, oldPubKey)
+		s.Require().NoError(err)
+		s.Require().False(hasAlias)
+	})
+
+	s.Run("Both keys must sign the rotation", func() {
+		setup()
+
+		_, err := s.msgSrvr.RotateDataProxyKey(s.ctx, &types.MsgRotateDataProxyKey{
+			Sender:       admin,
+			PubKey:       hex.EncodeToString(oldPubKey),
+			NewPubKey:    hex.EncodeToString(newPubKey),
+			Signature:    s.signRotation(oldPrivKey, proxyConfig, newPubKey),
+			NewSignature: s.signRotation(oldPrivKey, proxyConfig, newPubKey),
+		})
+		s.Require().ErrorIs(err, types.ErrInvalidSignature)
+	})
+
+	s.Run("Rotating to a registered key fails", func() {
+		setup()
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, newPubKey, proxyConfig))
+
+		_, err := s.msgSrvr.RotateDataProxyKey(s.ctx, &types.MsgRotateDataProxyKey{
+			Sender:       admin,
+			PubKey:       hex.EncodeToString(oldPubKey),
+			NewPubKey:    hex.EncodeToString(newPubKey),
+			Signature:    s.signRotation(oldPrivKey, proxyConfig, newPubKey),
+			NewSignature: s.signRotation(newPrivKey, proxyConfig, newPubKey),
+		})
+		s.Require().ErrorIs(err, types.ErrAlreadyExists)
+	})
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the parameters introduced with data proxy bonding,
+// deregistration and key rotation, which are unset in the parameters
+// stored before, to their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	if params.MinBond.Denom == "" {
+		params.MinBond = defaults.MinBond
+	}
+	if params.UnbondingPeriod == 0 {
+		params.UnbondingPeriod = defaults.UnbondingPeriod
+	}
+	if params.DeregistrationDelay == 0 {
+		params.DeregistrationDelay = defaults.DeregistrationDelay
+	}
+	if params.KeyAliasPeriod == 0 {
+		params.KeyAliasPeriod = defaults.KeyAliasPeriod
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return m.keeper.SetParams(ctx, params)
+}
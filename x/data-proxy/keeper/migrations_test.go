@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/keeper"
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	// Parameters stored before data proxy bonding, deregistration and key
+	// rotation were introduced.
+	params := types.DefaultParams()
+	params.MinBond = sdk.Coin{}
+	params.UnbondingPeriod = 0
+	params.DeregistrationDelay = 0
+	params.KeyAliasPeriod = 0
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.Require().Error(params.Validate())
+	s.Require().True(params.RequiredBond().IsZero())
+
+	s.Require().NoError(keeper.NewMigrator(*s.keeper).Migrate1to2(s.ctx))
+
+	migrated, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), migrated)
+	s.Require().Equal(migrated.MinBond, migrated.RequiredBond())
+}
@@ -5,12 +5,14 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/secp256k1"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -37,6 +39,10 @@ func (m msgServer) RegisterDataProxy(goCtx context.Context, msg *types.MsgRegist
 		return nil, err
 	}
 
+	if err := m.validateFeeDenoms(ctx, msg.Fee, nil); err != nil {
+		return nil, err
+	}
+
 	adminAddr, err := sdk.AccAddressFromBech32(msg.AdminAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", msg.AdminAddress)
@@ -58,6 +64,22 @@ func (m msgServer) RegisterDataProxy(goCtx context.Context, msg *types.MsgRegist
 		return nil, err
 	}
 
+	// Lock the bond in the module account.
+	minBond := params.RequiredBond()
+	bond := sdk.NewCoin(minBond.Denom, math.ZeroInt())
+	if msg.Bond != nil {
+		bond = *msg.Bond
+	}
+	if bond.IsLT(minBond) {
+		return nil, types.ErrInsufficientBond.Wrapf("minimum bond %s, got %s", minBond, bond)
+	}
+	if bond.IsPositive() {
+		err = m.bankKeeper.SendCoinsFromAccountToModule(ctx, adminAddr, types.ModuleName, sdk.NewCoins(bond))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	pubKeyBytes, err := hex.DecodeString(msg.PubKey)
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "invalid hex in pubkey: %s", msg.PubKey)
@@ -76,32 +98,30 @@ func (m msgServer) RegisterDataProxy(goCtx context.Context, msg *types.MsgRegist
 		return nil, types.ErrAlreadyExists
 	}
 
-	feeBytes := []byte(msg.Fee.String())
-	adminAddressBytes := []byte(msg.AdminAddress)
-	payoutAddressBytes := []byte(msg.PayoutAddress)
-	memoBytes := []byte(msg.Memo)
-	chainIDBytes := []byte(ctx.ChainID())
-
-	payloadSize := len(feeBytes) + len(adminAddressBytes) + len(payoutAddressBytes) + len(memoBytes) + len(chainIDBytes)
-
-	payload := make([]byte, 0, payloadSize)
+	// The previous key of a rotated data proxy cannot be reused while its
+	// alias is active.
+	found, err = m.HasKeyAlias(ctx, pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, types.ErrAlreadyExists.Wrap("public key is an alias of a rotated data proxy")
+	}
 
-	payload = append(payload, feeBytes...)
-	payload = append(payload, adminAddressBytes...)
-	payload = append(payload, payoutAddressBytes...)
-	payload = append(payload, memoBytes...)
-	payload = append(payload, chainIDBytes...)
+	payload := types.RegistrationPayload(msg.Fee.String(), msg.AdminAddress, msg.PayoutAddress, msg.Memo, ctx.ChainID())
 
 	if valid := secp256k1.VerifySignature(pubKeyBytes, crypto.Keccak256(payload), signatureBytes); !valid {
 		return nil, types.ErrInvalidSignature.Wrap("Invalid data proxy registration signature")
 	}
 
 	proxyConfig := types.ProxyConfig{
-		PayoutAddress: msg.PayoutAddress,
-		Fee:           msg.Fee,
-		Memo:          msg.Memo,
-		FeeUpdate:     nil,
-		AdminAddress:  msg.AdminAddress,
+		PayoutAddress:    msg.PayoutAddress,
+		Fee:              msg.Fee,
+		Memo:             msg.Memo,
+		FeeUpdate:        nil,
+		AdminAddress:     msg.AdminAddress,
+		Bond:             &bond,
+		PayoutRecipients: msg.PayoutRecipients,
 	}
 
 	err = proxyConfig.Validate()
@@ -126,6 +146,10 @@ func (m msgServer) EditDataProxy(goCtx context.Context, msg *types.MsgEditDataPr
 		return nil, err
 	}
 
+	if err := m.validateFeeDenoms(ctx, msg.NewFee, msg.NewFeeSchedule); err != nil {
+		return nil, err
+	}
+
 	pubKeyBytes, err := hex.DecodeString(msg.PubKey)
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "invalid hex in pubkey: %s", msg.PubKey)
@@ -143,13 +167,17 @@ func (m msgServer) EditDataProxy(goCtx context.Context, msg *types.MsgEditDataPr
 		return nil, sdkerrors.ErrorInvalidSigner
 	}
 
-	err = proxyConfig.UpdateBasic(msg.NewPayoutAddress, msg.NewMemo)
+	if proxyConfig.DeregistrationHeight != 0 {
+		return nil, types.ErrDeregistered
+	}
+
+	err = proxyConfig.UpdateBasic(msg.NewPayoutAddress, msg.NewMemo, msg.NewPayoutRecipients)
 	if err != nil {
 		return nil, err
 	}
 
-	// If there is no new fee we can terminate early
-	if msg.NewFee == nil {
+	// If there is no new fee or fee schedule we can terminate early
+	if !msg.HasFeeUpdate() {
 		err = m.SetDataProxyConfig(ctx, pubKeyBytes, proxyConfig)
 		if err != nil {
 			return nil, err
@@ -175,7 +203,18 @@ func (m msgServer) EditDataProxy(goCtx context.Context, msg *types.MsgEditDataPr
 		updateDelay = msg.FeeUpdateDelay
 	}
 
-	updateHeight, err := m.scheduleFeeUpdate(ctx, pubKeyBytes, proxyConfig, msg.NewFee, updateDelay)
+	// The parts of the pricing that are not updated carry over from the
+	// current config.
+	newFee := msg.NewFee
+	if newFee == nil {
+		newFee = proxyConfig.Fee
+	}
+	newFeeSchedule := proxyConfig.FeeSchedule
+	if len(msg.NewFeeSchedule) > 0 || msg.ClearFeeSchedule {
+		newFeeSchedule = msg.NewFeeSchedule
+	}
+
+	updateHeight, err := m.scheduleFeeUpdate(ctx, pubKeyBytes, proxyConfig, newFee, newFeeSchedule, updateDelay)
 	if err != nil {
 		return nil, err
 	}
@@ -196,6 +235,18 @@ func emitProxyConfigEvent(ctx sdk.Context, eventType string, pubKey string, prox
 		sdk.NewAttribute(types.AttributeAdminAddress, proxyConfig.AdminAddress),
 	)
 
+	if len(proxyConfig.PayoutRecipients) > 0 {
+		recipients := make([]string, len(proxyConfig.PayoutRecipients))
+		for i, recipient := range proxyConfig.PayoutRecipients {
+			recipients[i] = fmt.Sprintf("%s:%s", recipient.Address, recipient.Weight)
+		}
+		event.AppendAttributes(sdk.NewAttribute(types.AttributePayoutRecipients, strings.Join(recipients, ",")))
+	}
+
+	if len(proxyConfig.FeeSchedule) > 0 {
+		event.AppendAttributes(sdk.NewAttribute(types.AttributeFeeSchedule, types.FormatFeeSchedule(proxyConfig.FeeSchedule)))
+	}
+
 	if proxyConfig.FeeUpdate != nil {
 		event.AppendAttributes(
 			sdk.NewAttribute(types.AttributeNewFee, proxyConfig.FeeUpdate.String()),
@@ -206,6 +257,133 @@ func emitProxyConfigEvent(ctx sdk.Context, eventType string, pubKey string, prox
 	ctx.EventManager().EmitEvent(event)
 }
 
+// CancelFeeUpdate removes the pending fee update of a data proxy.
+func (m msgServer) CancelFeeUpdate(goCtx context.Context, msg *types.MsgCancelFeeUpdate) (*types.MsgCancelFeeUpdateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	pubKeyBytes, proxyConfig, err := m.getPendingFeeUpdate(ctx, msg.Sender, msg.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled := proxyConfig.FeeUpdate
+	err = m.RemoveFeeUpdate(ctx, cancelled.UpdateHeight, pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	proxyConfig.FeeUpdate = nil
+	err = m.SetDataProxyConfig(ctx, pubKeyBytes, proxyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCancelUpdate,
+		sdk.NewAttribute(types.AttributePubKey, msg.PubKey),
+		sdk.NewAttribute(types.AttributeNewFee, cancelled.NewFee.String()),
+		sdk.NewAttribute(types.AttributeFeeSchedule, types.FormatFeeSchedule(cancelled.NewFeeSchedule)),
+		sdk.NewAttribute(types.AttributeNewFeeHeight, fmt.Sprintf("%d", cancelled.UpdateHeight)),
+	))
+
+	return &types.MsgCancelFeeUpdateResponse{}, nil
+}
+
+// AmendFeeUpdate replaces the pricing of the pending fee update of a data
+// proxy. Fee rises are always announced at least the minimum fee update delay
+// ahead: an amendment raising any part of the pending pricing restarts the
+// delay, while an amendment not raising any part of the current pricing comes
+// into effect at the next block. Other amendments keep the pending update
+// height.
+func (m msgServer) AmendFeeUpdate(goCtx context.Context, msg *types.MsgAmendFeeUpdate) (*types.MsgAmendFeeUpdateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := m.validateFeeDenoms(ctx, msg.NewFee, msg.NewFeeSchedule); err != nil {
+		return nil, err
+	}
+
+	pubKeyBytes, proxyConfig, err := m.getPendingFeeUpdate(ctx, msg.Sender, msg.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	pending := proxyConfig.FeeUpdate
+
+	// The parts of the pricing that are not amended carry over from the
+	// pending update.
+	newFee := msg.NewFee
+	if newFee == nil {
+		newFee = pending.NewFee
+	}
+	newFeeSchedule := pending.NewFeeSchedule
+	if len(msg.NewFeeSchedule) > 0 || msg.ClearFeeSchedule {
+		newFeeSchedule = msg.NewFeeSchedule
+	}
+
+	updateHeight := pending.UpdateHeight
+	switch {
+	case types.PricingRaises(*pending.NewFee, pending.NewFeeSchedule, *newFee, newFeeSchedule):
+		minimumUpdateDelay, err := m.GetMinimumUpdateDelay(ctx)
+		if err != nil {
+			return nil, err
+		}
+		updateHeight = ctx.BlockHeight() + int64(minimumUpdateDelay)
+	case !types.PricingRaises(*proxyConfig.Fee, proxyConfig.FeeSchedule, *newFee, newFeeSchedule):
+		updateHeight = min(updateHeight, ctx.BlockHeight()+1)
+	}
+
+	//nolint:gosec // G115: The update height is never before the current height.
+	updateHeight, err = m.scheduleFeeUpdate(ctx, pubKeyBytes, proxyConfig, newFee, newFeeSchedule, uint32(updateHeight-ctx.BlockHeight()))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeAmendUpdate,
+		sdk.NewAttribute(types.AttributePubKey, msg.PubKey),
+		sdk.NewAttribute(types.AttributeNewFee, newFee.String()),
+		sdk.NewAttribute(types.AttributeFeeSchedule, types.FormatFeeSchedule(newFeeSchedule)),
+		sdk.NewAttribute(types.AttributeNewFeeHeight, fmt.Sprintf("%d", updateHeight)),
+	))
+
+	return &types.MsgAmendFeeUpdateResponse{
+		FeeUpdateHeight: updateHeight,
+	}, nil
+}
+
+// getPendingFeeUpdate returns the config of the data proxy with the given hex
+// encoded public key after checking that the sender is its admin and that it
+// has a pending fee update.
+func (m msgServer) getPendingFeeUpdate(ctx sdk.Context, sender, pubKey string) ([]byte, types.ProxyConfig, error) {
+	pubKeyBytes, err := hex.DecodeString(pubKey)
+	if err != nil {
+		return nil, types.ProxyConfig{}, errorsmod.Wrapf(err, "invalid hex in pubkey: %s", pubKey)
+	}
+
+	proxyConfig, err := m.GetDataProxyConfig(ctx, pubKeyBytes)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ProxyConfig{}, sdkerrors.ErrNotFound.Wrapf("no data proxy registered for %s", pubKey)
+		}
+		return nil, types.ProxyConfig{}, err
+	}
+
+	if sender != proxyConfig.AdminAddress {
+		return nil, types.ProxyConfig{}, sdkerrors.ErrorInvalidSigner
+	}
+
+	if proxyConfig.FeeUpdate == nil {
+		return nil, types.ProxyConfig{}, types.ErrNoPendingUpdate.Wrapf("data proxy %s", pubKey)
+	}
+
+	return pubKeyBytes, proxyConfig, nil
+}
+
 func (m msgServer) TransferAdmin(goCtx context.Context, msg *types.MsgTransferAdmin) (*types.MsgTransferAdminResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -245,6 +423,365 @@ func (m msgServer) TransferAdmin(goCtx context.Context, msg *types.MsgTransferAd
 	return &types.MsgTransferAdminResponse{}, nil
 }
 
+func (m msgServer) BondDataProxy(goCtx context.Context, msg *types.MsgBondDataProxy) (*types.MsgBondDataProxyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	pubKeyBytes, err := hex.DecodeString(msg.PubKey)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid hex in pubkey: %s", msg.PubKey)
+	}
+
+	proxyConfig, err := m.GetDataProxyConfig(ctx, pubKeyBytes)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("no data proxy registered for %s", msg.PubKey)
+		}
+		return nil, err
+	}
+
+	if msg.Sender != proxyConfig.AdminAddress {
+		return nil, sdkerrors.ErrorInvalidSigner
+	}
+
+	if proxyConfig.DeregistrationHeight != 0 {
+		return nil, types.ErrDeregistered
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", msg.Sender)
+	}
+
+	err = m.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, types.ModuleName, sdk.NewCoins(msg.Amount))
+	if err != nil {
+		return nil, err
+	}
+
+	bond := proxyConfig.BondedAmount().Add(msg.Amount)
+	proxyConfig.Bond = &bond
+
+	err = m.SetDataProxyConfig(ctx, pubKeyBytes, proxyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeBond,
+		sdk.NewAttribute(types.AttributePubKey, msg.PubKey),
+		sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeBond, bond.String()),
+	))
+
+	return &types.MsgBondDataProxyResponse{}, nil
+}
+
+func (m msgServer) UnbondDataProxy(goCtx context.Context, msg *types.MsgUnbondDataProxy) (*types.MsgUnbondDataProxyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	pubKeyBytes, err := hex.DecodeString(msg.PubKey)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid hex in pubkey: %s", msg.PubKey)
+	}
+
+	proxyConfig, err := m.GetDataProxyConfig(ctx, pubKeyBytes)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("no data proxy registered for %s", msg.PubKey)
+		}
+		return nil, err
+	}
+
+	if msg.Sender != proxyConfig.AdminAddress {
+		return nil, sdkerrors.ErrorInvalidSigner
+	}
+
+	if proxyConfig.DeregistrationHeight != 0 {
+		return nil, types.ErrDeregistered
+	}
+
+	// An active data proxy has to keep at least the minimum bond.
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bond := proxyConfig.BondedAmount()
+	minBond := params.RequiredBond()
+	if bond.IsGTE(msg.Amount) && bond.Sub(msg.Amount).IsLT(minBond) {
+		return nil, types.ErrInsufficientBond.Wrapf("remaining bond would fall below minimum bond %s", minBond)
+	}
+
+	completionHeight, err := m.scheduleUnbonding(ctx, pubKeyBytes, &proxyConfig, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.SetDataProxyConfig(ctx, pubKeyBytes, proxyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeUnbond,
+		sdk.NewAttribute(types.AttributePubKey, msg.PubKey),
+		sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeBond, proxyConfig.BondedAmount().String()),
+		sdk.NewAttribute(types.AttributeCompletionHeight, fmt.Sprintf("%d", completionHeight)),
+	))
+
+	return &types.MsgUnbondDataProxyResponse{
+		CompletionHeight: completionHeight,
+	}, nil
+}
+
+func (m msgServer) DeregisterDataProxy(goCtx context.Context, msg *types.MsgDeregisterDataProxy) (*types.MsgDeregisterDataProxyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	pubKeyBytes, err := hex.DecodeString(msg.PubKey)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid hex in pubkey: %s", msg.PubKey)
+	}
+
+	proxyConfig, err := m.GetDataProxyConfig(ctx, pubKeyBytes)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("no data proxy registered for %s", msg.PubKey)
+		}
+		return nil, err
+	}
+
+	if msg.Sender != proxyConfig.AdminAddress {
+		return nil, sdkerrors.ErrorInvalidSigner
+	}
+
+	if proxyConfig.DeregistrationHeight != 0 {
+		return nil, types.ErrDeregistered
+	}
+
+	deregistrationHeight, err := m.scheduleDeregistration(ctx, pubKeyBytes, &proxyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.SetDataProxyConfig(ctx, pubKeyBytes, proxyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDeregister,
+		sdk.NewAttribute(types.AttributePubKey, msg.PubKey),
+		sdk.NewAttribute(types.AttributeDeregistrationHeight, fmt.Sprintf("%d", deregistrationHeight)),
+	))
+
+	return &types.MsgDeregisterDataProxyResponse{
+		DeregistrationHeight: deregistrationHeight,
+	}, nil
+}
+
+func (m msgServer) RotateDataProxyKey(goCtx context.Context, msg *types.MsgRotateDataProxyKey) (*types.MsgRotateDataProxyKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	pubKeyBytes, err := hex.DecodeString(msg.PubKey)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid hex in pubkey: %s", msg.PubKey)
+	}
+
+	newPubKeyBytes, err := hex.DecodeString(msg.NewPubKey)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid hex in new pubkey: %s", msg.NewPubKey)
+	}
+
+	signatureBytes, err := hex.DecodeString(msg.Signature)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid hex in signature: %s", msg.Signature)
+	}
+
+	newSignatureBytes, err := hex.DecodeString(msg.NewSignature)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid hex in new signature: %s", msg.NewSignature)
+	}
+
+	proxyConfig, err := m.GetDataProxyConfig(ctx, pubKeyBytes)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("no data proxy registered for %s", msg.PubKey)
+		}
+		return nil, err
+	}
+
+	if msg.Sender != proxyConfig.AdminAddress {
+		return nil, sdkerrors.ErrorInvalidSigner
+	}
+
+	if proxyConfig.DeregistrationHeight != 0 {
+		return nil, types.ErrDeregistered
+	}
+
+	found, err := m.HasDataProxy(ctx, newPubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, types.ErrAlreadyExists
+	}
+
+	found, err = m.HasKeyAlias(ctx, newPubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, types.ErrAlreadyExists.Wrap("new public key is an alias of a rotated data proxy")
+	}
+
+	payloadHash := crypto.Keccak256(types.KeyRotationPayload(proxyConfig, ctx.ChainID(), newPubKeyBytes))
+	if valid := secp256k1.VerifySignature(pubKeyBytes, payloadHash, signatureBytes); !valid {
+		return nil, types.ErrInvalidSignature.Wrap("Invalid signature by current data proxy key")
+	}
+	if valid := secp256k1.VerifySignature(newPubKeyBytes, payloadHash, newSignatureBytes); !valid {
+		return nil, types.ErrInvalidSignature.Wrap("Invalid signature by new data proxy key")
+	}
+
+	expirationHeight, err := m.rotateKey(ctx, pubKeyBytes, newPubKeyBytes, proxyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeRotateKey,
+		sdk.NewAttribute(types.AttributePubKey, msg.PubKey),
+		sdk.NewAttribute(types.AttributeNewPubKey, msg.NewPubKey),
+		sdk.NewAttribute(types.AttributeExpirationHeight, fmt.Sprintf("%d", expirationHeight)),
+	))
+
+	return &types.MsgRotateDataProxyKeyResponse{
+		AliasExpirationHeight: expirationHeight,
+	}, nil
+}
+
+func (m msgServer) SlashDataProxy(goCtx context.Context, msg *types.MsgSlashDataProxy) (*types.MsgSlashDataProxyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", msg.Authority)
+	}
+	if m.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.ErrorInvalidSigner.Wrapf("unauthorized authority; expected %s, got %s", m.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	pubKeyBytes, err := hex.DecodeString(msg.PubKey)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid hex in pubkey: %s", msg.PubKey)
+	}
+
+	slashed, err := m.Keeper.SlashDataProxy(ctx, pubKeyBytes, msg.SlashFraction, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSlashDataProxyResponse{
+		SlashedAmount: slashed,
+	}, nil
+}
+
+func (m msgServer) UpdateProxyDenylist(goCtx context.Context, msg *types.MsgUpdateProxyDenylist) (*types.MsgUpdateProxyDenylistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", msg.Authority)
+	}
+	if m.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.ErrorInvalidSigner.Wrapf("unauthorized authority; expected %s, got %s", m.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := m.updateAccessList(ctx, m.denylist, types.EventTypeDenylistUpdate, msg.Add, msg.Remove); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateProxyDenylistResponse{}, nil
+}
+
+func (m msgServer) UpdateProxyAllowlist(goCtx context.Context, msg *types.MsgUpdateProxyAllowlist) (*types.MsgUpdateProxyAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", msg.Authority)
+	}
+	if m.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.ErrorInvalidSigner.Wrapf("unauthorized authority; expected %s, got %s", m.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := m.updateAccessList(ctx, m.allowlist, types.EventTypeAllowlistUpdate, msg.Add, msg.Remove); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateProxyAllowlistResponse{}, nil
+}
+
+// UpdateFeeExchangeRates sets and removes fee exchange rates. It can be
+// executed by the module authority or the exchange rate oracle.
+func (m msgServer) UpdateFeeExchangeRates(goCtx context.Context, msg *types.MsgUpdateFeeExchangeRates) (*types.MsgUpdateFeeExchangeRatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", msg.Sender)
+	}
+
+	params, err := m.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Sender != m.GetAuthority() && (params.ExchangeRateOracle == "" || msg.Sender != params.ExchangeRateOracle) {
+		return nil, sdkerrors.ErrorInvalidSigner.Wrapf("unauthorized sender; expected %s or the exchange rate oracle, got %s", m.GetAuthority(), msg.Sender)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	for _, rate := range msg.SetRates {
+		if err := m.SetFeeExchangeRate(ctx, rate.Denom, rate.Rate); err != nil {
+			return nil, err
+		}
+	}
+	for _, denom := range msg.RemoveDenoms {
+		if err := m.RemoveFeeExchangeRate(ctx, denom); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExchangeRates,
+		sdk.NewAttribute(types.AttributeSender, msg.Sender),
+		sdk.NewAttribute(types.AttributeAdded, types.FormatExchangeRates(msg.SetRates)),
+		sdk.NewAttribute(types.AttributeRemoved, strings.Join(msg.RemoveDenoms, ",")),
+	))
+
+	return &types.MsgUpdateFeeExchangeRatesResponse{}, nil
+}
+
 func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
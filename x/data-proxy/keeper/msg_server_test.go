@@ -6,6 +6,8 @@ import (
 
 	"go.uber.org/mock/gomock"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -15,6 +17,7 @@ import (
 
 func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 	fee := sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, types.DefaultRegistrationFee))
+	bond := s.NewBondFromString(types.DefaultMinBond.String())
 	adminAddr, err := sdk.AccAddressFromBech32("seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5")
 	s.Require().NoError(err)
 
@@ -34,6 +37,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 				Memo:          "",
 				PubKey:        "041b84c5567b126440995d3ed5aaba0565d71e1834604819ff9c17f5e9d5dd078f70beaf8f588b541507fed6a642c5ab42dfdf8120a7f639de5122d47a69a8e8d1",
 				Signature:     "6e8b21cf5fb2a87ea39d5320d37a47c3abdb70c41cafb0b6a499c0a7489ac04b22c9117bdd8f2a057818fd0baf5c1c529cb36f95037a28d20170dee66f322693",
+				Bond:          bond,
 			},
 			expected: &types.ProxyConfig{
 				PayoutAddress: "seda1wyzxdtpl0c99c92n397r3drlhj09qfjvf6teyh",
@@ -41,6 +45,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 				Memo:          "",
 				FeeUpdate:     nil,
 				AdminAddress:  "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
+				Bond:          bond,
 			},
 			wantErr: nil,
 		},
@@ -53,6 +58,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 				Memo:          "This is a sweet proxy",
 				PubKey:        "041b84c5567b126440995d3ed5aaba0565d71e1834604819ff9c17f5e9d5dd078f70beaf8f588b541507fed6a642c5ab42dfdf8120a7f639de5122d47a69a8e8d1",
 				Signature:     "a8fe95113f86e564d63b0214aa44c4bdf793d0276ff516347e8d9a253a37aec9412ebb64c1d7e4d65e924c1da8b7d2b1f8afcf695b2148d31e31d3967c89b73e",
+				Bond:          bond,
 			},
 			expected: &types.ProxyConfig{
 				PayoutAddress: "seda1wyzxdtpl0c99c92n397r3drlhj09qfjvf6teyh",
@@ -60,6 +66,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 				Memo:          "This is a sweet proxy",
 				FeeUpdate:     nil,
 				AdminAddress:  "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
+				Bond:          bond,
 			},
 			wantErr: nil,
 		},
@@ -72,6 +79,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 				Memo:          "",
 				PubKey:        "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3",
 				Signature:     "5076d9d98754505d2f6f94f5a44062b9e95c2c5cfe7f21c69270814dc947bd285f5ed64e595aa956004687a225263f2831252cb41379cab2e3505b90f3da2701",
+				Bond:          bond,
 			},
 			expected:  nil,
 			wantErr:   sdkerrors.ErrInvalidAddress,
@@ -86,6 +94,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 				Memo:          "",
 				PubKey:        "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3",
 				Signature:     "5076d9d98754505d2f6f94f5a44062b9e95c2c5cfe7f21c69270814dc947bd285f5ed64e595aa956004687a225263f2831252cb41379cab2e3505b90f3da2701",
+				Bond:          bond,
 			},
 			expected: nil,
 			wantErr:  types.ErrInvalidSignature,
@@ -99,6 +108,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 				Memo:          "",
 				PubKey:        "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4g3",
 				Signature:     "5076d9d98754505d2f6f94f5a44062b9e95c2c5cfe7f21c69270814dc947bd285f5ed64e595aa956004687a225263f2831252cb41379cab2e3505b90f3da2701",
+				Bond:          bond,
 			},
 			expected: nil,
 			wantErr:  hex.InvalidByteError(byte('g')),
@@ -112,6 +122,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 				Memo:          "",
 				PubKey:        "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4f3",
 				Signature:     "5076g9d98754505d2f6f94f5a44062b9e95c2c5cfe7f21c69270814dc947bd285f5ed64e595aa956004687a225263f2831252cb41379cab2e3505b90f3da2701",
+				Bond:          bond,
 			},
 			expected: nil,
 			wantErr:  hex.InvalidByteError(byte('g')),
@@ -125,6 +136,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 				Memo:          "",
 				PubKey:        "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4f3",
 				Signature:     "5076d9d98754505d2f6f94f5a44062b9e95c2c5cfe7f21c69270814dc947bd285f5ed64e595aa956004687a225263f2831252cb41379cab2e3505b90f3da2701",
+				Bond:          bond,
 			},
 			expected:  nil,
 			wantErr:   sdkerrors.ErrInvalidRequest,
@@ -142,6 +154,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 				Memo:      "",
 				PubKey:    "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4f3",
 				Signature: "5076d9d98754505d2f6f94f5a44062b9e95c2c5cfe7f21c69270814dc947bd285f5ed64e595aa956004687a225263f2831252cb41379cab2e3505b90f3da2701",
+				Bond:      bond,
 			},
 			expected:  nil,
 			wantErr:   sdkerrors.ErrInvalidRequest,
@@ -159,6 +172,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 				Memo:      strings.Repeat("a", types.MaxMemoLength+1),
 				PubKey:    "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4f3",
 				Signature: "5076d9d98754505d2f6f94f5a44062b9e95c2c5cfe7f21c69270814dc947bd285f5ed64e595aa956004687a225263f2831252cb41379cab2e3505b90f3da2701",
+				Bond:      bond,
 			},
 			expected:  nil,
 			wantErr:   sdkerrors.ErrInvalidRequest,
@@ -173,6 +187,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 				Memo:          "",
 				PubKey:        "041b84c5567b126440995d3ed5aaba0565d71e1834604819ff9c17f5e9d5dd078f70beaf8f588b541507fed6a642c5ab42dfdf8120a7f639de5122d47a69a8e8d1",
 				Signature:     "6e8b21cf5fb2a87ea39d5320d37a47c3abdb70c41cafb0b6a499c0a7489ac04b22c9117bdd8f2a057818fd0baf5c1c529cb36f95037a28d20170dee66f322693",
+				Bond:          bond,
 			},
 			expected: &types.ProxyConfig{
 				PayoutAddress: "seda1wyzxdtpl0c99c92n397r3drlhj09qfjvf6teyh",
@@ -180,6 +195,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 				Memo:          "",
 				FeeUpdate:     nil,
 				AdminAddress:  "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
+				Bond:          bond,
 			},
 			wantErr: sdkerrors.ErrInsufficientFunds,
 			mockSetup: func() {
@@ -196,6 +212,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 			} else {
 				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), adminAddr, types.ModuleName, fee).Return(nil)
 				s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, fee).Return(nil)
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), adminAddr, types.ModuleName, sdk.NewCoins(*bond)).Return(nil)
 			}
 
 			res, err := s.msgSrvr.RegisterDataProxy(s.ctx, tt.msg)
@@ -218,6 +235,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 	s.Run("Registering an already existing data proxy should fail", func() {
 		s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), adminAddr, types.ModuleName, fee).Return(nil).Times(2)
 		s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, fee).Return(nil).Times(2)
+		s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), adminAddr, types.ModuleName, sdk.NewCoins(*bond)).Return(nil).Times(2)
 
 		msg := &types.MsgRegisterDataProxy{
 			AdminAddress:  "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
@@ -226,6 +244,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 			Memo:          "",
 			PubKey:        "041b84c5567b126440995d3ed5aaba0565d71e1834604819ff9c17f5e9d5dd078f70beaf8f588b541507fed6a642c5ab42dfdf8120a7f639de5122d47a69a8e8d1",
 			Signature:     "32473a31c221d8cf9ce4f6269369c9ce2b0ce9139f3dc93bd9e020bd76186c06087dc8d5367c0b6e7175a108694f2abc89b9675bea8ff35360fb8dc7225f8870",
+			Bond:          bond,
 		}
 
 		_, err = s.msgSrvr.RegisterDataProxy(s.ctx, msg)
@@ -242,6 +261,7 @@ func (s *KeeperTestSuite) TestMsgServer_RegisterDataProxy() {
 			Memo:          "",
 			FeeUpdate:     nil,
 			AdminAddress:  "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
+			Bond:          bond,
 		}, &proxyConfig)
 
 		res, err := s.msgSrvr.RegisterDataProxy(s.ctx, msg)
@@ -285,6 +305,46 @@ func (s *KeeperTestSuite) TestMsgServer_EditDataProxy() {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "Update payout recipients",
+			msg: &types.MsgEditDataProxy{
+				Sender:           "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
+				NewPayoutAddress: types.DoNotModifyField,
+				NewMemo:          types.DoNotModifyField,
+				NewPayoutRecipients: []types.PayoutRecipient{
+					{Address: "seda1wyzxdtpl0c99c92n397r3drlhj09qfjvf6teyh", Weight: math.LegacyNewDecWithPrec(7, 1)},
+					{Address: "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5", Weight: math.LegacyNewDecWithPrec(3, 1)},
+				},
+				PubKey: "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3",
+			},
+			expected: &types.ProxyConfig{
+				PayoutAddress: "seda1wyzxdtpl0c99c92n397r3drlhj09qfjvf6teyh",
+				Fee:           s.NewFeeFromString("9"),
+				Memo:          "test",
+				FeeUpdate:     nil,
+				AdminAddress:  "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
+				PayoutRecipients: []types.PayoutRecipient{
+					{Address: "seda1wyzxdtpl0c99c92n397r3drlhj09qfjvf6teyh", Weight: math.LegacyNewDecWithPrec(7, 1)},
+					{Address: "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5", Weight: math.LegacyNewDecWithPrec(3, 1)},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Update payout recipients with weights not summing to one",
+			msg: &types.MsgEditDataProxy{
+				Sender:           "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
+				NewPayoutAddress: types.DoNotModifyField,
+				NewMemo:          types.DoNotModifyField,
+				NewPayoutRecipients: []types.PayoutRecipient{
+					{Address: "seda1wyzxdtpl0c99c92n397r3drlhj09qfjvf6teyh", Weight: math.LegacyNewDecWithPrec(7, 1)},
+					{Address: "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5", Weight: math.LegacyNewDecWithPrec(2, 1)},
+				},
+				PubKey: "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3",
+			},
+			expected: nil,
+			wantErr:  sdkerrors.ErrInvalidRequest,
+		},
 		{
 			name: "Update memo",
 			msg: &types.MsgEditDataProxy{
@@ -491,6 +551,8 @@ func (s *KeeperTestSuite) TestMsgServer_EditDataProxy() {
 		s.Require().NoError(err)
 		s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), adminAddr, types.ModuleName, fee).Return(nil)
 		s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, fee).Return(nil)
+		bond := s.NewBondFromString(types.DefaultMinBond.String())
+		s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), adminAddr, types.ModuleName, sdk.NewCoins(*bond)).Return(nil)
 
 		err = s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, initialProxyConfig)
 		s.Require().NoError(err)
@@ -523,6 +585,7 @@ func (s *KeeperTestSuite) TestMsgServer_EditDataProxy() {
 			Memo:          "",
 			PubKey:        "041b84c5567b126440995d3ed5aaba0565d71e1834604819ff9c17f5e9d5dd078f70beaf8f588b541507fed6a642c5ab42dfdf8120a7f639de5122d47a69a8e8d1",
 			Signature:     "6e8b21cf5fb2a87ea39d5320d37a47c3abdb70c41cafb0b6a499c0a7489ac04b22c9117bdd8f2a057818fd0baf5c1c529cb36f95037a28d20170dee66f322693",
+			Bond:          bond,
 		})
 		s.Require().NoError(err)
 		s.Require().NotNil(registerRes)
@@ -558,6 +621,99 @@ func (s *KeeperTestSuite) TestMsgServer_EditDataProxy() {
 		s.Require().NotNil(secondEditRes)
 	})
 
+	s.Run("Fee schedule changes go through the fee update queue", func() {
+		err = s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, initialProxyConfig)
+		s.Require().NoError(err)
+
+		schedule := []types.FeeScheduleEntry{
+			{ExecProgramID: "6c7a3ee6f3b5b8a4ba8bea3c2b9d5a8c2f1b7e1bb5c9a0f2d4e4c2a1b0e9f8d7", Fee: *s.NewFeeFromString("100")},
+			{MemoTag: "premium", Fee: *s.NewFeeFromString("50")},
+		}
+		res, err := s.msgSrvr.EditDataProxy(s.ctx, &types.MsgEditDataProxy{
+			Sender:           "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
+			NewPayoutAddress: types.DoNotModifyField,
+			NewMemo:          types.DoNotModifyField,
+			NewFeeSchedule:   schedule,
+			PubKey:           "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3",
+		})
+		s.Require().NoError(err)
+
+		proxyConfig, err := s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().Empty(proxyConfig.FeeSchedule)
+		s.Require().Equal(initialProxyConfig.Fee, proxyConfig.FeeUpdate.NewFee)
+		s.Require().Equal(schedule, proxyConfig.FeeUpdate.NewFeeSchedule)
+
+		s.ctx = s.ctx.WithBlockHeight(res.FeeUpdateHeight)
+		s.Require().NoError(s.keeper.EndBlock(s.ctx))
+
+		proxyConfig, err = s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().Nil(proxyConfig.FeeUpdate)
+		s.Require().Equal(schedule, proxyConfig.FeeSchedule)
+		s.Require().Equal(*s.NewFeeFromString("100"), proxyConfig.FeeFor(schedule[0].ExecProgramID, []byte("premium")))
+		s.Require().Equal(*s.NewFeeFromString("50"), proxyConfig.FeeFor("", []byte("premium-tier")))
+		s.Require().Equal(*initialProxyConfig.Fee, proxyConfig.FeeFor("", []byte("basic")))
+
+		// A fee update alone keeps the fee schedule, while clearing it removes it.
+		res, err = s.msgSrvr.EditDataProxy(s.ctx, &types.MsgEditDataProxy{
+			Sender:           "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
+			NewPayoutAddress: types.DoNotModifyField,
+			NewMemo:          types.DoNotModifyField,
+			NewFee:           s.NewFeeFromString("12"),
+			PubKey:           "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3",
+		})
+		s.Require().NoError(err)
+		proxyConfig, err = s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().Equal(schedule, proxyConfig.FeeUpdate.NewFeeSchedule)
+
+		_, err = s.msgSrvr.EditDataProxy(s.ctx, &types.MsgEditDataProxy{
+			Sender:           "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
+			NewPayoutAddress: types.DoNotModifyField,
+			NewMemo:          types.DoNotModifyField,
+			ClearFeeSchedule: true,
+			PubKey:           "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3",
+		})
+		s.Require().NoError(err)
+
+		s.ctx = s.ctx.WithBlockHeight(res.FeeUpdateHeight)
+		s.Require().NoError(s.keeper.EndBlock(s.ctx))
+
+		proxyConfig, err = s.keeper.GetDataProxyConfig(s.ctx, pubKeyBytes)
+		s.Require().NoError(err)
+		s.Require().Empty(proxyConfig.FeeSchedule)
+		s.Require().Equal(initialProxyConfig.Fee, proxyConfig.Fee)
+	})
+
+	s.Run("Invalid fee schedules are rejected", func() {
+		err = s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, initialProxyConfig)
+		s.Require().NoError(err)
+
+		_, err := s.msgSrvr.EditDataProxy(s.ctx, &types.MsgEditDataProxy{
+			Sender:           "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
+			NewPayoutAddress: types.DoNotModifyField,
+			NewMemo:          types.DoNotModifyField,
+			NewFeeSchedule: []types.FeeScheduleEntry{
+				{ExecProgramID: "abcd", MemoTag: "premium", Fee: *s.NewFeeFromString("100")},
+			},
+			PubKey: "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3",
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+		_, err = s.msgSrvr.EditDataProxy(s.ctx, &types.MsgEditDataProxy{
+			Sender:           "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5",
+			NewPayoutAddress: types.DoNotModifyField,
+			NewMemo:          types.DoNotModifyField,
+			NewFeeSchedule: []types.FeeScheduleEntry{
+				{MemoTag: "premium", Fee: *s.NewFeeFromString("100")},
+				{MemoTag: "premium", Fee: *s.NewFeeFromString("50")},
+			},
+			PubKey: "02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3",
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	})
+
 	s.Run("Transferring admin address should allow the new address to submit changes", func() {
 		err = s.keeper.SetDataProxyConfig(s.ctx, pubKeyBytes, initialProxyConfig)
 		s.Require().NoError(err)
@@ -597,9 +753,11 @@ func (s *KeeperTestSuite) TestMsgServer_UpdateParamsErrors() {
 			name: "invalid minimum update delay",
 			input: &types.MsgUpdateParams{
 				Authority: authority,
-				Params: types.Params{
-					MinFeeUpdateDelay: 0,
-				},
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.MinFeeUpdateDelay = 0
+					return params
+				}(),
 			},
 			wantErr: sdkerrors.ErrInvalidRequest,
 		},
@@ -667,9 +825,11 @@ func (s *KeeperTestSuite) TestMsgServer_UpdateParams() {
 		// Update params, increasing the minimum delay
 		_, err = s.msgSrvr.UpdateParams(s.ctx, &types.MsgUpdateParams{
 			Authority: authority,
-			Params: types.Params{
-				MinFeeUpdateDelay: types.DefaultMinFeeUpdateDelay + 100,
-			},
+			Params: func() types.Params {
+				params := types.DefaultParams()
+				params.MinFeeUpdateDelay = types.DefaultMinFeeUpdateDelay + 100
+				return params
+			}(),
 		})
 		s.Require().NoError(err)
 
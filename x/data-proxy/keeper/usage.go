@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (k Keeper) SetDataProxyUsage(ctx context.Context, pubKey []byte, usage types.ProxyUsage) error {
+	return k.proxyUsages.Set(ctx, pubKey, usage)
+}
+
+// GetDataProxyUsage returns the cumulative usage of a data proxy, which is
+// empty if the data proxy has not been paid yet.
+func (k Keeper) GetDataProxyUsage(ctx context.Context, pubKey []byte) (types.ProxyUsage, error) {
+	usage, err := k.proxyUsages.Get(ctx, pubKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.NewProxyUsage(), nil
+		}
+		return types.ProxyUsage{}, err
+	}
+	return usage, nil
+}
+
+// RecordDataProxyUsage adds the gas credited and fees earned by a data proxy
+// for serving a data request to its cumulative usage. Previous public keys of
+// rotated data proxies are resolved to their current key.
+func (k Keeper) RecordDataProxyUsage(ctx context.Context, pubKey []byte, gasUsed math.Int, fees sdk.Coins) error {
+	resolved, err := k.resolvePubKey(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+
+	usage, err := k.GetDataProxyUsage(ctx, resolved)
+	if err != nil {
+		return err
+	}
+
+	usage.RequestsServed++
+	usage.GasUsed = usage.GasUsed.Add(gasUsed)
+	usage.FeesEarned = usage.FeesEarned.Add(fees...)
+
+	return k.SetDataProxyUsage(ctx, resolved, usage)
+}
+
+// moveDataProxyUsage moves the cumulative usage of a rotated data proxy to its
+// new public key.
+func (k Keeper) moveDataProxyUsage(ctx context.Context, oldPubKey, newPubKey []byte) error {
+	usage, err := k.proxyUsages.Get(ctx, oldPubKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err := k.proxyUsages.Remove(ctx, oldPubKey); err != nil {
+		return err
+	}
+	return k.SetDataProxyUsage(ctx, newPubKey, usage)
+}
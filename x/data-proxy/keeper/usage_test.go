@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sedaprotocol/seda-chain/x/data-proxy/types"
+)
+
+func (s *KeeperTestSuite) TestRecordDataProxyUsage() {
+	admin := "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5"
+	oldPubKey, err := hex.DecodeString("02100efce2a783cc7a3fbf9c5d15d4cc6e263337651312f21a35d30c16cb38f4c3")
+	s.Require().NoError(err)
+	newPubKey, err := hex.DecodeString("034c0f86f0cb61f9ddb47c4ba0b2ca0470962b5a1c50bee3a563184979672195f4")
+	s.Require().NoError(err)
+
+	proxyConfig := types.ProxyConfig{
+		PayoutAddress: admin,
+		Fee:           s.NewFeeFromString("9"),
+		AdminAddress:  admin,
+	}
+
+	s.Run("Usage accumulates across requests", func() {
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, oldPubKey, proxyConfig))
+
+		res, err := s.queryClient.DataProxyUsage(s.ctx, &types.QueryDataProxyUsageRequest{PubKey: hex.EncodeToString(oldPubKey)})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(0), res.Usage.RequestsServed)
+		s.Require().True(res.Usage.GasUsed.IsZero())
+		s.Require().True(res.Usage.FeesEarned.IsZero())
+
+		s.Require().NoError(s.keeper.RecordDataProxyUsage(s.ctx, oldPubKey, s.NewIntFromString("100"), sdk.NewCoins(*s.NewFeeFromString("1000"))))
+		s.Require().NoError(s.keeper.RecordDataProxyUsage(s.ctx, oldPubKey, s.NewIntFromString("50"), sdk.NewCoins(*s.NewFeeFromString("500"))))
+
+		res, err = s.queryClient.DataProxyUsage(s.ctx, &types.QueryDataProxyUsageRequest{PubKey: hex.EncodeToString(oldPubKey)})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(2), res.Usage.RequestsServed)
+		s.Require().Equal(s.NewIntFromString("150"), res.Usage.GasUsed)
+		s.Require().Equal(sdk.NewCoins(*s.NewFeeFromString("1500")), res.Usage.FeesEarned)
+	})
+
+	s.Run("Usage follows rotated keys", func() {
+		s.Require().NoError(s.keeper.SetDataProxyConfig(s.ctx, newPubKey, proxyConfig))
+		s.Require().NoError(s.keeper.RecordDataProxyUsage(s.ctx, newPubKey, s.NewIntFromString("100"), sdk.NewCoins(*s.NewFeeFromString("1000"))))
+		s.Require().NoError(s.keeper.SetKeyAlias(s.ctx, oldPubKey, types.KeyAlias{NewPubKey: newPubKey, ExpirationHeight: 100}))
+
+		// Requests referencing the previous key are credited to the new key.
+		s.Require().NoError(s.keeper.RecordDataProxyUsage(s.ctx, oldPubKey, s.NewIntFromString("100"), sdk.NewCoins(*s.NewFeeFromString("1000"))))
+
+		usage, err := s.keeper.GetDataProxyUsage(s.ctx, newPubKey)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(2), usage.RequestsServed)
+		s.Require().Equal(s.NewIntFromString("200"), usage.GasUsed)
+
+		res, err := s.queryClient.DataProxyUsage(s.ctx, &types.QueryDataProxyUsageRequest{PubKey: hex.EncodeToString(oldPubKey)})
+		s.Require().NoError(err)
+		s.Require().Equal(usage, res.Usage)
+	})
+
+	s.Run("Querying an unknown data proxy fails", func() {
+		_, err := s.queryClient.DataProxyUsage(s.ctx, &types.QueryDataProxyUsageRequest{PubKey: hex.EncodeToString(oldPubKey)})
+		s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	})
+}
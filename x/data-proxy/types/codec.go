@@ -11,14 +11,30 @@ import (
 func RegisterLegacyCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterDataProxy{}, "sedachain/MsgRegisterDataProxy")
 	legacy.RegisterAminoMsg(cdc, &MsgEditDataProxy{}, "sedachain/MsgEditDataProxy")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelFeeUpdate{}, "sedachain/MsgCancelFeeUpdate")
+	legacy.RegisterAminoMsg(cdc, &MsgAmendFeeUpdate{}, "sedachain/MsgAmendFeeUpdate")
 	legacy.RegisterAminoMsg(cdc, &MsgTransferAdmin{}, "sedachain/MsgTransferAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgBondDataProxy{}, "sedachain/MsgBondDataProxy")
+	legacy.RegisterAminoMsg(cdc, &MsgUnbondDataProxy{}, "sedachain/MsgUnbondDataProxy")
+	legacy.RegisterAminoMsg(cdc, &MsgDeregisterDataProxy{}, "sedachain/MsgDeregisterDataProxy")
+	legacy.RegisterAminoMsg(cdc, &MsgRotateDataProxyKey{}, "sedachain/MsgRotateDataProxyKey")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRegisterDataProxy{},
 		&MsgEditDataProxy{},
+		&MsgCancelFeeUpdate{},
+		&MsgAmendFeeUpdate{},
 		&MsgTransferAdmin{},
+		&MsgBondDataProxy{},
+		&MsgUnbondDataProxy{},
+		&MsgDeregisterDataProxy{},
+		&MsgRotateDataProxyKey{},
+		&MsgSlashDataProxy{},
+		&MsgUpdateProxyDenylist{},
+		&MsgUpdateProxyAllowlist{},
+		&MsgUpdateFeeExchangeRates{},
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -34,6 +35,25 @@ type Params struct {
 	// registration_fee is the fee incurred for registering a data proxy.
 	// This fee is burned.
 	RegistrationFee github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=registration_fee,json=registrationFee,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"registration_fee"`
+	// min_bond is the minimum amount a data proxy must keep bonded in the
+	// module account. The bond can be slashed when the data proxy misbehaves.
+	MinBond github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=min_bond,json=minBond,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"min_bond"`
+	// unbonding_period is the number of blocks after which unbonded funds are
+	// returned to the data proxy admin.
+	UnbondingPeriod uint32 `protobuf:"varint,4,opt,name=unbonding_period,json=unbondingPeriod,proto3" json:"unbonding_period,omitempty"`
+	// deregistration_delay is the number of blocks after which a deregistered
+	// data proxy stops being paid.
+	DeregistrationDelay uint32 `protobuf:"varint,5,opt,name=deregistration_delay,json=deregistrationDelay,proto3" json:"deregistration_delay,omitempty"`
+	// key_alias_period is the number of blocks during which the previous public
+	// key of a rotated data proxy still resolves to its config.
+	KeyAliasPeriod uint32 `protobuf:"varint,6,opt,name=key_alias_period,json=keyAliasPeriod,proto3" json:"key_alias_period,omitempty"`
+	// allowlist_enabled restricts the data proxies paid by the tally module to
+	// those on the allowlist. Denied data proxies are never paid.
+	AllowlistEnabled bool `protobuf:"varint,7,opt,name=allowlist_enabled,json=allowlistEnabled,proto3" json:"allowlist_enabled,omitempty"`
+	// exchange_rate_oracle is an address that, in addition to the module
+	// authority, may update the fee exchange rates, for example an oracle
+	// relayer. Empty disables oracle updates.
+	ExchangeRateOracle string `protobuf:"bytes,8,opt,name=exchange_rate_oracle,json=exchangeRateOracle,proto3" json:"exchange_rate_oracle,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -83,12 +103,55 @@ func (m *Params) GetRegistrationFee() github_com_cosmos_cosmos_sdk_types.Coin {
 	return github_com_cosmos_cosmos_sdk_types.Coin{}
 }
 
+func (m *Params) GetMinBond() github_com_cosmos_cosmos_sdk_types.Coin {
+	if m != nil {
+		return m.MinBond
+	}
+	return github_com_cosmos_cosmos_sdk_types.Coin{}
+}
+
+func (m *Params) GetUnbondingPeriod() uint32 {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetDeregistrationDelay() uint32 {
+	if m != nil {
+		return m.DeregistrationDelay
+	}
+	return 0
+}
+
+func (m *Params) GetKeyAliasPeriod() uint32 {
+	if m != nil {
+		return m.KeyAliasPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetAllowlistEnabled() bool {
+	if m != nil {
+		return m.AllowlistEnabled
+	}
+	return false
+}
+
+func (m *Params) GetExchangeRateOracle() string {
+	if m != nil {
+		return m.ExchangeRateOracle
+	}
+	return ""
+}
+
 // ProxyConfig defines a data-proxy entry in the registry.
 type ProxyConfig struct {
 	// payout_address defines the address to which the data proxy fees should be
 	// transferred.
 	PayoutAddress string `protobuf:"bytes,1,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
-	// fee defines the amount in aseda this data-proxy charges when utilised.
+	// fee defines the amount this data-proxy charges when utilised, either in
+	// aseda or in a denomination with a fee exchange rate.
 	Fee *types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
 	// memo defines an optional string which is not used by the protocol.
 	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
@@ -97,6 +160,22 @@ type ProxyConfig struct {
 	// fee_update defines an upcoming fee change which will take effect at a
 	// future height.
 	FeeUpdate *FeeUpdate `protobuf:"bytes,5,opt,name=fee_update,json=feeUpdate,proto3" json:"fee_update,omitempty"`
+	// bond defines the amount in aseda this data proxy has bonded in the module
+	// account.
+	Bond *types.Coin `protobuf:"bytes,6,opt,name=bond,proto3" json:"bond,omitempty"`
+	// unbonding_entries defines the funds which are unbonding and will be
+	// returned at their completion heights. They remain slashable until then.
+	UnbondingEntries []UnbondingEntry `protobuf:"bytes,7,rep,name=unbonding_entries,json=unbondingEntries,proto3" json:"unbonding_entries"`
+	// deregistration_height defines the height from which the data proxy is no
+	// longer paid. Zero means the data proxy has not been deregistered.
+	DeregistrationHeight int64 `protobuf:"varint,8,opt,name=deregistration_height,json=deregistrationHeight,proto3" json:"deregistration_height,omitempty"`
+	// payout_recipients defines a weighted split of the data proxy fees. When
+	// set, the payout address is the address of the first recipient. When empty,
+	// the payout address receives all fees.
+	PayoutRecipients []PayoutRecipient `protobuf:"bytes,9,rep,name=payout_recipients,json=payoutRecipients,proto3" json:"payout_recipients"`
+	// fee_schedule defines fees charged instead of the flat fee for data
+	// requests with a matching exec program ID or memo tag.
+	FeeSchedule []FeeScheduleEntry `protobuf:"bytes,10,rep,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule"`
 }
 
 func (m *ProxyConfig) Reset()         { *m = ProxyConfig{} }
@@ -167,6 +246,156 @@ func (m *ProxyConfig) GetFeeUpdate() *FeeUpdate {
 	return nil
 }
 
+func (m *ProxyConfig) GetBond() *types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return nil
+}
+
+func (m *ProxyConfig) GetUnbondingEntries() []UnbondingEntry {
+	if m != nil {
+		return m.UnbondingEntries
+	}
+	return nil
+}
+
+func (m *ProxyConfig) GetDeregistrationHeight() int64 {
+	if m != nil {
+		return m.DeregistrationHeight
+	}
+	return 0
+}
+
+func (m *ProxyConfig) GetPayoutRecipients() []PayoutRecipient {
+	if m != nil {
+		return m.PayoutRecipients
+	}
+	return nil
+}
+
+func (m *ProxyConfig) GetFeeSchedule() []FeeScheduleEntry {
+	if m != nil {
+		return m.FeeSchedule
+	}
+	return nil
+}
+
+// FeeScheduleEntry defines the fee a data proxy charges for data requests
+// matching either an exec program ID or a memo tag.
+type FeeScheduleEntry struct {
+	// exec_program_id defines the hex encoded ID of the exec program the fee
+	// applies to.
+	ExecProgramID string `protobuf:"bytes,1,opt,name=exec_program_id,json=execProgramId,proto3" json:"exec_program_id,omitempty"`
+	// memo_tag defines the prefix of the data request memo the fee applies to.
+	MemoTag string `protobuf:"bytes,2,opt,name=memo_tag,json=memoTag,proto3" json:"memo_tag,omitempty"`
+	// fee defines the amount in aseda charged for matching data requests.
+	Fee github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=fee,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"fee"`
+}
+
+func (m *FeeScheduleEntry) Reset()         { *m = FeeScheduleEntry{} }
+func (m *FeeScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*FeeScheduleEntry) ProtoMessage()    {}
+func (*FeeScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce367ef351b38f5e, []int{2}
+}
+func (m *FeeScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeScheduleEntry.Merge(m, src)
+}
+func (m *FeeScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeScheduleEntry proto.InternalMessageInfo
+
+func (m *FeeScheduleEntry) GetExecProgramID() string {
+	if m != nil {
+		return m.ExecProgramID
+	}
+	return ""
+}
+
+func (m *FeeScheduleEntry) GetMemoTag() string {
+	if m != nil {
+		return m.MemoTag
+	}
+	return ""
+}
+
+func (m *FeeScheduleEntry) GetFee() github_com_cosmos_cosmos_sdk_types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return github_com_cosmos_cosmos_sdk_types.Coin{}
+}
+
+// PayoutRecipient defines an address receiving a share of the data proxy fees.
+type PayoutRecipient struct {
+	// address defines the address to which the share is transferred.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight defines the share of the fees. The weights of all recipients of a
+	// data proxy sum to one.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *PayoutRecipient) Reset()         { *m = PayoutRecipient{} }
+func (m *PayoutRecipient) String() string { return proto.CompactTextString(m) }
+func (*PayoutRecipient) ProtoMessage()    {}
+func (*PayoutRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce367ef351b38f5e, []int{3}
+}
+func (m *PayoutRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayoutRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayoutRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayoutRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutRecipient.Merge(m, src)
+}
+func (m *PayoutRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayoutRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutRecipient proto.InternalMessageInfo
+
+func (m *PayoutRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // FeeUpdate defines a new fee amount and the height at which it will take
 // effect.
 type FeeUpdate struct {
@@ -174,13 +403,16 @@ type FeeUpdate struct {
 	NewFee *types.Coin `protobuf:"bytes,1,opt,name=new_fee,json=newFee,proto3" json:"new_fee,omitempty"`
 	// update_height defines the height after which the new fee comes into effect.
 	UpdateHeight int64 `protobuf:"varint,2,opt,name=update_height,json=updateHeight,proto3" json:"update_height,omitempty"`
+	// new_fee_schedule defines the fee schedule replacing the current one when
+	// the update comes into effect.
+	NewFeeSchedule []FeeScheduleEntry `protobuf:"bytes,3,rep,name=new_fee_schedule,json=newFeeSchedule,proto3" json:"new_fee_schedule"`
 }
 
 func (m *FeeUpdate) Reset()         { *m = FeeUpdate{} }
 func (m *FeeUpdate) String() string { return proto.CompactTextString(m) }
 func (*FeeUpdate) ProtoMessage()    {}
 func (*FeeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce367ef351b38f5e, []int{2}
+	return fileDescriptor_ce367ef351b38f5e, []int{4}
 }
 func (m *FeeUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -223,10 +455,252 @@ func (m *FeeUpdate) GetUpdateHeight() int64 {
 	return 0
 }
 
+func (m *FeeUpdate) GetNewFeeSchedule() []FeeScheduleEntry {
+	if m != nil {
+		return m.NewFeeSchedule
+	}
+	return nil
+}
+
+// UnbondingEntry defines an amount of unbonding funds and the height at which
+// they will be returned.
+type UnbondingEntry struct {
+	// recipient defines the address to which the funds will be returned.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount defines the unbonding amount.
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// completion_height defines the height at which the funds are returned.
+	CompletionHeight int64 `protobuf:"varint,3,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+}
+
+func (m *UnbondingEntry) Reset()         { *m = UnbondingEntry{} }
+func (m *UnbondingEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingEntry) ProtoMessage()    {}
+func (*UnbondingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce367ef351b38f5e, []int{5}
+}
+func (m *UnbondingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingEntry.Merge(m, src)
+}
+func (m *UnbondingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingEntry proto.InternalMessageInfo
+
+func (m *UnbondingEntry) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *UnbondingEntry) GetAmount() github_com_cosmos_cosmos_sdk_types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return github_com_cosmos_cosmos_sdk_types.Coin{}
+}
+
+func (m *UnbondingEntry) GetCompletionHeight() int64 {
+	if m != nil {
+		return m.CompletionHeight
+	}
+	return 0
+}
+
+// KeyAlias maps a previous public key of a data proxy to its current public
+// key until the expiration height.
+type KeyAlias struct {
+	// new_pub_key defines the public key the alias resolves to.
+	NewPubKey []byte `protobuf:"bytes,1,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+	// expiration_height defines the height at which the alias is removed.
+	ExpirationHeight int64 `protobuf:"varint,2,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *KeyAlias) Reset()         { *m = KeyAlias{} }
+func (m *KeyAlias) String() string { return proto.CompactTextString(m) }
+func (*KeyAlias) ProtoMessage()    {}
+func (*KeyAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce367ef351b38f5e, []int{6}
+}
+func (m *KeyAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyAlias.Merge(m, src)
+}
+func (m *KeyAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyAlias proto.InternalMessageInfo
+
+func (m *KeyAlias) GetNewPubKey() []byte {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+func (m *KeyAlias) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+// ProxyUsage defines the cumulative usage of a data proxy across the data
+// requests it served in basic consensus.
+type ProxyUsage struct {
+	// requests_served defines the number of data requests for which the data
+	// proxy was paid.
+	RequestsServed uint64 `protobuf:"varint,1,opt,name=requests_served,json=requestsServed,proto3" json:"requests_served,omitempty"`
+	// gas_used defines the total gas credited to the data proxy.
+	GasUsed cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=gas_used,json=gasUsed,proto3,customtype=cosmossdk.io/math.Int" json:"gas_used"`
+	// fees_earned defines the total fees paid out to the data proxy.
+	FeesEarned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees_earned,json=feesEarned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_earned"`
+}
+
+func (m *ProxyUsage) Reset()         { *m = ProxyUsage{} }
+func (m *ProxyUsage) String() string { return proto.CompactTextString(m) }
+func (*ProxyUsage) ProtoMessage()    {}
+func (*ProxyUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce367ef351b38f5e, []int{7}
+}
+func (m *ProxyUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProxyUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProxyUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProxyUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyUsage.Merge(m, src)
+}
+func (m *ProxyUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProxyUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyUsage proto.InternalMessageInfo
+
+func (m *ProxyUsage) GetRequestsServed() uint64 {
+	if m != nil {
+		return m.RequestsServed
+	}
+	return 0
+}
+
+func (m *ProxyUsage) GetFeesEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesEarned
+	}
+	return nil
+}
+
+// FeeExchangeRate defines the rate at which data proxy fees quoted in a
+// denomination other than aseda are converted to aseda.
+type FeeExchangeRate struct {
+	// denom is the denomination of the fees, for example an IBC denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of aseda per unit of the denomination.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *FeeExchangeRate) Reset()         { *m = FeeExchangeRate{} }
+func (m *FeeExchangeRate) String() string { return proto.CompactTextString(m) }
+func (*FeeExchangeRate) ProtoMessage()    {}
+func (*FeeExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce367ef351b38f5e, []int{8}
+}
+func (m *FeeExchangeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeExchangeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeExchangeRate.Merge(m, src)
+}
+func (m *FeeExchangeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeExchangeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeExchangeRate proto.InternalMessageInfo
+
+func (m *FeeExchangeRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "sedachain.data_proxy.v1.Params")
 	proto.RegisterType((*ProxyConfig)(nil), "sedachain.data_proxy.v1.ProxyConfig")
+	proto.RegisterType((*FeeScheduleEntry)(nil), "sedachain.data_proxy.v1.FeeScheduleEntry")
+	proto.RegisterType((*PayoutRecipient)(nil), "sedachain.data_proxy.v1.PayoutRecipient")
 	proto.RegisterType((*FeeUpdate)(nil), "sedachain.data_proxy.v1.FeeUpdate")
+	proto.RegisterType((*UnbondingEntry)(nil), "sedachain.data_proxy.v1.UnbondingEntry")
+	proto.RegisterType((*KeyAlias)(nil), "sedachain.data_proxy.v1.KeyAlias")
+	proto.RegisterType((*ProxyUsage)(nil), "sedachain.data_proxy.v1.ProxyUsage")
+	proto.RegisterType((*FeeExchangeRate)(nil), "sedachain.data_proxy.v1.FeeExchangeRate")
 }
 
 func init() {
@@ -234,37 +708,76 @@ func init() {
 }
 
 var fileDescriptor_ce367ef351b38f5e = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0x6e, 0x13, 0x31,
-	0x10, 0x8e, 0x49, 0x08, 0x8a, 0xdb, 0xf0, 0x63, 0x45, 0x62, 0xdb, 0xc3, 0xa6, 0x0a, 0x07, 0x22,
-	0xa1, 0xac, 0x95, 0x22, 0x2e, 0x5c, 0x50, 0x53, 0x14, 0x71, 0xa3, 0x5a, 0xc4, 0x85, 0xcb, 0xca,
-	0x59, 0x4f, 0x36, 0x16, 0x5d, 0x7b, 0xb5, 0x76, 0xd2, 0xe6, 0x2d, 0x78, 0x04, 0x1e, 0x02, 0xf1,
-	0x0c, 0x3d, 0x56, 0x9c, 0x38, 0x55, 0x28, 0xb9, 0xf0, 0x06, 0x48, 0x9c, 0x90, 0xed, 0x4d, 0xc8,
-	0x05, 0xd1, 0xd3, 0xce, 0xcc, 0xf7, 0xad, 0xbe, 0xcf, 0xdf, 0x0c, 0xee, 0x6b, 0xe0, 0x2c, 0x9d,
-	0x31, 0x21, 0x29, 0x67, 0x86, 0x25, 0x45, 0xa9, 0x2e, 0x97, 0x74, 0x31, 0xdc, 0xe9, 0xa2, 0xa2,
-	0x54, 0x46, 0x91, 0xc7, 0x5b, 0x66, 0xb4, 0x83, 0x2d, 0x86, 0x87, 0x9d, 0x4c, 0x65, 0xca, 0x71,
-	0xa8, 0xad, 0x3c, 0xfd, 0xf0, 0x20, 0x55, 0x3a, 0x57, 0x3a, 0xf1, 0x80, 0x6f, 0x2a, 0x28, 0xf4,
-	0x1d, 0x9d, 0x30, 0x0d, 0x74, 0x31, 0x9c, 0x80, 0x61, 0x43, 0x9a, 0x2a, 0x21, 0x3d, 0xde, 0xfb,
-	0x8a, 0x70, 0xf3, 0x8c, 0x95, 0x2c, 0xd7, 0x84, 0xe2, 0x4e, 0x2e, 0x64, 0x32, 0x05, 0x48, 0xe6,
-	0x05, 0x67, 0x06, 0x12, 0x0e, 0xe7, 0x6c, 0x19, 0xa0, 0x23, 0xd4, 0x6f, 0xc7, 0x8f, 0x72, 0x21,
-	0xc7, 0x00, 0xef, 0x1d, 0xf2, 0xda, 0x02, 0x64, 0x8e, 0x1f, 0x96, 0x90, 0x09, 0x6d, 0x4a, 0x66,
-	0x84, 0x72, 0x7f, 0x06, 0x77, 0x8e, 0x50, 0x7f, 0xef, 0xf8, 0x20, 0xaa, 0x4c, 0x58, 0xd9, 0xa8,
-	0x92, 0x8d, 0x4e, 0x95, 0x90, 0x23, 0x7a, 0x75, 0xd3, 0xad, 0xfd, 0xbe, 0xe9, 0x3e, 0xcd, 0x84,
-	0x99, 0xcd, 0x27, 0x51, 0xaa, 0xf2, 0xca, 0x71, 0xf5, 0x19, 0x68, 0xfe, 0x91, 0x9a, 0x65, 0x01,
-	0xda, 0xfd, 0x10, 0x3f, 0xd8, 0xd5, 0x18, 0x03, 0xbc, 0x6c, 0xfc, 0xfc, 0xdc, 0x45, 0xbd, 0x5f,
-	0x08, 0xef, 0x9d, 0xd9, 0x58, 0x4e, 0x95, 0x9c, 0x8a, 0x8c, 0xbc, 0xc2, 0xf7, 0x0b, 0xb6, 0x54,
-	0x73, 0x93, 0x30, 0xce, 0x4b, 0xd0, 0xda, 0xf9, 0x6e, 0x8d, 0x82, 0x6f, 0x5f, 0x06, 0x9d, 0xca,
-	0xcd, 0x89, 0x47, 0xde, 0x99, 0x52, 0xc8, 0x2c, 0x6e, 0x7b, 0x7e, 0x35, 0x24, 0xcf, 0x70, 0xfd,
-	0x36, 0x0f, 0x88, 0x2d, 0x8b, 0x10, 0xdc, 0xc8, 0x21, 0x57, 0x41, 0xdd, 0x6a, 0xc4, 0xae, 0x26,
-	0x4f, 0x70, 0x9b, 0x71, 0x9b, 0xe0, 0xc6, 0x40, 0xc3, 0x81, 0xfb, 0x6e, 0xb8, 0x51, 0x39, 0xc1,
-	0xf8, 0x6f, 0xc0, 0xc1, 0x5d, 0x27, 0xd6, 0x8b, 0xfe, 0xb1, 0xee, 0x68, 0x1b, 0x78, 0xdc, 0x9a,
-	0x6e, 0xca, 0x1e, 0xc7, 0xad, 0xed, 0x9c, 0x1c, 0xe3, 0x7b, 0x12, 0x2e, 0x5c, 0xf4, 0xe8, 0x7f,
-	0xce, 0x9b, 0x12, 0x2e, 0xc6, 0x00, 0xd6, 0x68, 0xb5, 0xe0, 0x19, 0x88, 0x6c, 0x66, 0xdc, 0x9b,
-	0xeb, 0xf1, 0xbe, 0x1f, 0xbe, 0x71, 0xb3, 0xd1, 0xdb, 0xab, 0x55, 0x88, 0xae, 0x57, 0x21, 0xfa,
-	0xb1, 0x0a, 0xd1, 0xa7, 0x75, 0x58, 0xbb, 0x5e, 0x87, 0xb5, 0xef, 0xeb, 0xb0, 0xf6, 0xe1, 0xc5,
-	0xce, 0xe6, 0xac, 0x71, 0x77, 0x48, 0xa9, 0x3a, 0x77, 0xcd, 0xc0, 0xdf, 0xf7, 0xa5, 0xbb, 0xe9,
-	0x81, 0xbf, 0x70, 0xb7, 0xcc, 0x49, 0xd3, 0xf1, 0x9e, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x2b,
-	0x6e, 0x4c, 0x2c, 0x06, 0x03, 0x00, 0x00,
+	// 1098 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcb, 0x6f, 0x1b, 0x45,
+	0x18, 0xc0, 0xb3, 0xd8, 0xf5, 0xe3, 0x73, 0x1c, 0x3b, 0x83, 0x2b, 0xdc, 0x22, 0xd9, 0x91, 0x7b,
+	0xa8, 0xab, 0xca, 0x36, 0x4e, 0x05, 0x12, 0x5c, 0x50, 0xdc, 0x38, 0x22, 0x14, 0xa9, 0xd6, 0x86,
+	0x08, 0x51, 0x90, 0x56, 0xe3, 0xdd, 0x2f, 0xeb, 0x55, 0xbc, 0x33, 0x66, 0x67, 0x9d, 0xd8, 0xff,
+	0x45, 0xff, 0x02, 0xc4, 0x99, 0x73, 0xef, 0xdc, 0x50, 0x8f, 0x55, 0x4f, 0xc0, 0x21, 0xa0, 0xe4,
+	0x52, 0x89, 0xff, 0x80, 0x13, 0x9a, 0x87, 0x1f, 0x09, 0xca, 0x43, 0x55, 0x39, 0x79, 0xe7, 0x7b,
+	0xfb, 0x9b, 0xdf, 0x7c, 0x33, 0x50, 0x17, 0xe8, 0x51, 0x77, 0x40, 0x03, 0xd6, 0xf2, 0x68, 0x4c,
+	0x9d, 0x51, 0xc4, 0x27, 0xd3, 0xd6, 0x51, 0x7b, 0x69, 0xd5, 0x1c, 0x45, 0x3c, 0xe6, 0xe4, 0x83,
+	0xb9, 0x65, 0x73, 0x49, 0x77, 0xd4, 0xbe, 0x5b, 0xf2, 0xb9, 0xcf, 0x95, 0x4d, 0x4b, 0x7e, 0x69,
+	0xf3, 0xbb, 0x77, 0x5c, 0x2e, 0x42, 0x2e, 0x1c, 0xad, 0xd0, 0x0b, 0xa3, 0xaa, 0xe8, 0x55, 0xab,
+	0x4f, 0x05, 0xb6, 0x8e, 0xda, 0x7d, 0x8c, 0x69, 0xbb, 0xe5, 0xf2, 0x80, 0x69, 0x7d, 0xed, 0xc7,
+	0x24, 0xa4, 0x7a, 0x34, 0xa2, 0xa1, 0x20, 0x2d, 0x28, 0x85, 0x01, 0x73, 0x0e, 0x10, 0x9d, 0xf1,
+	0xc8, 0xa3, 0x31, 0x3a, 0x1e, 0x0e, 0xe9, 0xb4, 0x6c, 0x6d, 0x58, 0xf5, 0xbc, 0xbd, 0x1e, 0x06,
+	0x6c, 0x07, 0x71, 0x5f, 0x69, 0xb6, 0xa5, 0x82, 0x8c, 0xa1, 0x18, 0xa1, 0x1f, 0x88, 0x38, 0xa2,
+	0x71, 0xc0, 0x95, 0x67, 0xf9, 0xbd, 0x0d, 0xab, 0x9e, 0xdb, 0xbc, 0xd3, 0x34, 0x45, 0xc8, 0xb4,
+	0x4d, 0x93, 0xb6, 0xf9, 0x98, 0x07, 0xac, 0xd3, 0x7a, 0x79, 0x52, 0x5d, 0xf9, 0xe7, 0xa4, 0x7a,
+	0xdf, 0x0f, 0xe2, 0xc1, 0xb8, 0xdf, 0x74, 0x79, 0x68, 0x2a, 0x36, 0x3f, 0x0d, 0xe1, 0x1d, 0xb6,
+	0xe2, 0xe9, 0x08, 0x85, 0x72, 0xb0, 0x0b, 0xcb, 0x39, 0x76, 0x10, 0x09, 0x42, 0x46, 0xd6, 0xd9,
+	0xe7, 0xcc, 0x2b, 0x27, 0xde, 0x79, 0xba, 0x74, 0x18, 0xb0, 0x0e, 0x67, 0x1e, 0x79, 0x00, 0xc5,
+	0x31, 0x93, 0x49, 0x02, 0xe6, 0x3b, 0x23, 0x8c, 0x02, 0xee, 0x95, 0x93, 0xaa, 0x15, 0x85, 0xb9,
+	0xbc, 0xa7, 0xc4, 0xa4, 0x0d, 0x25, 0x0f, 0xcf, 0xb5, 0x42, 0x77, 0xee, 0x96, 0x32, 0x7f, 0xff,
+	0xbc, 0x4e, 0xf7, 0xae, 0x0e, 0xc5, 0x43, 0x9c, 0x3a, 0x74, 0x18, 0x50, 0x31, 0x8b, 0x9e, 0x52,
+	0xe6, 0x6b, 0x87, 0x38, 0xdd, 0x92, 0x62, 0x13, 0xfc, 0x21, 0xac, 0xd3, 0xe1, 0x90, 0x1f, 0x0f,
+	0x03, 0x11, 0x3b, 0xc8, 0x68, 0x7f, 0x88, 0x5e, 0x39, 0xbd, 0x61, 0xd5, 0x33, 0x76, 0x71, 0xae,
+	0xe8, 0x6a, 0x39, 0xf9, 0x12, 0x4a, 0x38, 0x71, 0x07, 0x94, 0xf9, 0xe8, 0x44, 0x72, 0x0b, 0x79,
+	0x44, 0xdd, 0x21, 0x96, 0x33, 0x1b, 0x56, 0x3d, 0xdb, 0x29, 0xbf, 0x7e, 0xd1, 0x28, 0x99, 0x56,
+	0x6d, 0x79, 0x5e, 0x84, 0x42, 0xec, 0xc5, 0x51, 0xc0, 0x7c, 0x9b, 0xcc, 0xbc, 0x6c, 0x1a, 0xe3,
+	0x53, 0xe5, 0xf3, 0x59, 0xf2, 0xcd, 0x4f, 0x55, 0xab, 0xf6, 0x26, 0x09, 0xb9, 0x9e, 0xc4, 0xef,
+	0x31, 0x67, 0x07, 0x81, 0x4f, 0x3e, 0x87, 0xb5, 0x11, 0x9d, 0xf2, 0x71, 0xec, 0x50, 0x1d, 0x41,
+	0xf1, 0x71, 0x55, 0xec, 0xbc, 0xb6, 0x37, 0x42, 0xf2, 0x10, 0x12, 0x37, 0x01, 0xc5, 0x96, 0x56,
+	0x84, 0x40, 0x32, 0xc4, 0x90, 0xab, 0x7d, 0xce, 0xda, 0xea, 0x9b, 0xdc, 0x83, 0x3c, 0xf5, 0x24,
+	0x01, 0xb3, 0x02, 0x92, 0x4a, 0xb9, 0xaa, 0x84, 0xb3, 0x2c, 0x5b, 0x00, 0x0b, 0x90, 0xd5, 0x46,
+	0xe4, 0x36, 0x6b, 0xcd, 0x4b, 0x8e, 0x55, 0x73, 0x0e, 0xb6, 0x9d, 0x3d, 0x98, 0x7d, 0x92, 0x06,
+	0x24, 0x15, 0x63, 0xa9, 0xeb, 0x2a, 0x55, 0x66, 0xe4, 0x19, 0xac, 0x2f, 0x78, 0x41, 0x16, 0x47,
+	0x01, 0x8a, 0x72, 0x7a, 0x23, 0x51, 0xcf, 0x6d, 0xde, 0xbf, 0x34, 0xf1, 0xfe, 0xcc, 0xa3, 0xcb,
+	0xe2, 0x68, 0xda, 0x49, 0x4a, 0x5a, 0xed, 0x05, 0x77, 0x5d, 0x1d, 0x86, 0x3c, 0x82, 0xdb, 0x17,
+	0x00, 0x1b, 0x60, 0xe0, 0x0f, 0x62, 0xb5, 0xaf, 0x09, 0xfb, 0x02, 0x7d, 0x5f, 0x28, 0x1d, 0xf9,
+	0x0e, 0xd6, 0xcd, 0x4e, 0x45, 0xe8, 0x06, 0xa3, 0x00, 0x59, 0x2c, 0xca, 0x59, 0x55, 0x50, 0xfd,
+	0xd2, 0x82, 0x7a, 0xca, 0xc3, 0x9e, 0x39, 0xcc, 0x2a, 0x1a, 0x9d, 0x17, 0x0b, 0x62, 0xc3, 0xaa,
+	0xec, 0xaf, 0x70, 0x07, 0xe8, 0x8d, 0x87, 0x58, 0x06, 0x15, 0xf7, 0xc1, 0x55, 0x1d, 0xde, 0x33,
+	0xb6, 0xcb, 0x7f, 0x35, 0x77, 0xb0, 0x90, 0xd7, 0x7e, 0xb5, 0xa0, 0x78, 0xd1, 0x8e, 0x7c, 0x0a,
+	0x05, 0x9c, 0xa0, 0x2b, 0x23, 0xf9, 0x11, 0x0d, 0x9d, 0xc0, 0x33, 0xc0, 0xad, 0x9f, 0x9e, 0x54,
+	0xf3, 0xdd, 0x09, 0xba, 0x3d, 0xad, 0xd9, 0xdd, 0xb6, 0xf3, 0xb8, 0xb4, 0xf4, 0xc8, 0x1d, 0xc8,
+	0x48, 0x60, 0x9c, 0x98, 0xfa, 0x0a, 0xb7, 0xac, 0x9d, 0x96, 0xeb, 0xaf, 0xa9, 0x4f, 0xbe, 0xd7,
+	0x10, 0xbe, 0xfb, 0xf1, 0x21, 0xc3, 0xd6, 0x9e, 0x5b, 0x50, 0xb8, 0xd0, 0x48, 0xb2, 0x09, 0xe9,
+	0x9b, 0x1e, 0x98, 0x99, 0x21, 0xd9, 0x85, 0xd4, 0xb1, 0xde, 0x67, 0x55, 0x7e, 0xa7, 0x2d, 0xab,
+	0xf9, 0xe3, 0xa4, 0xfa, 0xa1, 0x76, 0x13, 0xde, 0x61, 0x33, 0xe0, 0xad, 0x90, 0xc6, 0x83, 0xe6,
+	0x57, 0xe8, 0x53, 0x77, 0xba, 0x8d, 0xee, 0xeb, 0x17, 0x0d, 0x30, 0x51, 0xb7, 0xd1, 0xb5, 0x4d,
+	0x80, 0xda, 0x2f, 0x16, 0x64, 0xe7, 0x94, 0xcb, 0x62, 0x18, 0x1e, 0xab, 0x81, 0x6d, 0x5d, 0x47,
+	0x77, 0x8a, 0xe1, 0xb1, 0x1c, 0xbb, 0xf7, 0x20, 0x6f, 0xae, 0x85, 0xc1, 0xa2, 0xa6, 0x84, 0xbd,
+	0xaa, 0x85, 0x86, 0xb9, 0x6f, 0xa1, 0x68, 0x02, 0x2f, 0xd0, 0x48, 0xbc, 0x1d, 0x1a, 0x6b, 0x3a,
+	0xef, 0x9c, 0x8e, 0xdf, 0x2d, 0x58, 0x3b, 0x7f, 0x5c, 0xc8, 0x27, 0x90, 0x9d, 0xa3, 0x7d, 0x6d,
+	0x57, 0x17, 0xa6, 0xa4, 0x0f, 0x29, 0x1a, 0xf2, 0x31, 0x8b, 0xff, 0x87, 0xeb, 0xca, 0x44, 0x96,
+	0x63, 0xdb, 0xe5, 0xe1, 0x68, 0x88, 0xcb, 0xc7, 0x35, 0xa1, 0x5a, 0x56, 0x5c, 0x28, 0x74, 0xdb,
+	0x6a, 0xdf, 0x40, 0xe6, 0x89, 0x99, 0xfa, 0xa4, 0x02, 0x39, 0xd9, 0xc2, 0xd1, 0xb8, 0xef, 0x1c,
+	0xa2, 0xbe, 0x7d, 0x57, 0xed, 0x2c, 0xc3, 0xe3, 0xde, 0xb8, 0xff, 0x04, 0xa7, 0x32, 0x30, 0x4e,
+	0x46, 0xc1, 0xf9, 0x39, 0xa0, 0xf7, 0xa2, 0xb8, 0x50, 0x98, 0xc0, 0x7f, 0x5b, 0x00, 0x6a, 0x7a,
+	0xef, 0x0b, 0xea, 0x23, 0xb9, 0x0f, 0x85, 0x08, 0x7f, 0x18, 0xa3, 0x88, 0x85, 0x23, 0x30, 0x3a,
+	0x42, 0x7d, 0x98, 0x92, 0xf6, 0xda, 0x4c, 0xbc, 0xa7, 0xa4, 0x64, 0x07, 0x32, 0x3e, 0x15, 0xce,
+	0x58, 0xa0, 0x67, 0xd8, 0x7b, 0x68, 0xd8, 0xbb, 0xfd, 0x5f, 0xf6, 0x76, 0x59, 0xbc, 0x44, 0xdd,
+	0x2e, 0x8b, 0xed, 0xb4, 0x4f, 0xc5, 0xbe, 0x40, 0x8f, 0x0c, 0x41, 0x9e, 0x70, 0xe1, 0x20, 0x8d,
+	0x18, 0x7a, 0x06, 0x85, 0x2b, 0xda, 0xfd, 0x91, 0xcc, 0xf2, 0xf3, 0x9f, 0xd5, 0xfa, 0x0d, 0xdb,
+	0x2d, 0x6c, 0x39, 0xe6, 0x45, 0x57, 0x85, 0xaf, 0x31, 0x28, 0xec, 0x20, 0x76, 0x97, 0xae, 0x32,
+	0x52, 0x82, 0x5b, 0x1e, 0x32, 0x1e, 0x6a, 0x3c, 0x6c, 0xbd, 0x20, 0x5d, 0x48, 0xca, 0xdb, 0xf1,
+	0xed, 0x8f, 0x95, 0x72, 0xef, 0x3c, 0x7d, 0x79, 0x5a, 0xb1, 0x5e, 0x9d, 0x56, 0xac, 0xbf, 0x4e,
+	0x2b, 0xd6, 0xf3, 0xb3, 0xca, 0xca, 0xab, 0xb3, 0xca, 0xca, 0x6f, 0x67, 0x95, 0x95, 0x67, 0x1f,
+	0x2f, 0xd5, 0x2f, 0xb9, 0x57, 0x8f, 0x2d, 0x97, 0x0f, 0xd5, 0xa2, 0xa1, 0xdf, 0x80, 0x13, 0xf5,
+	0xee, 0x6b, 0xe8, 0x57, 0xa0, 0xfa, 0x4b, 0xfd, 0x94, 0xb2, 0x7b, 0xf4, 0x6f, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xdb, 0x4e, 0x98, 0xbc, 0x2a, 0x0a, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -292,14 +805,32 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.RegistrationFee.Equal(&that1.RegistrationFee) {
 		return false
 	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.MinBond.Equal(&that1.MinBond) {
+		return false
+	}
+	if this.UnbondingPeriod != that1.UnbondingPeriod {
+		return false
+	}
+	if this.DeregistrationDelay != that1.DeregistrationDelay {
+		return false
+	}
+	if this.KeyAliasPeriod != that1.KeyAliasPeriod {
+		return false
+	}
+	if this.AllowlistEnabled != that1.AllowlistEnabled {
+		return false
+	}
+	if this.ExchangeRateOracle != that1.ExchangeRateOracle {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -314,6 +845,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExchangeRateOracle) > 0 {
+		i -= len(m.ExchangeRateOracle)
+		copy(dAtA[i:], m.ExchangeRateOracle)
+		i = encodeVarintDataProxy(dAtA, i, uint64(len(m.ExchangeRateOracle)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.AllowlistEnabled {
+		i--
+		if m.AllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.KeyAliasPeriod != 0 {
+		i = encodeVarintDataProxy(dAtA, i, uint64(m.KeyAliasPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DeregistrationDelay != 0 {
+		i = encodeVarintDataProxy(dAtA, i, uint64(m.DeregistrationDelay))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UnbondingPeriod != 0 {
+		i = encodeVarintDataProxy(dAtA, i, uint64(m.UnbondingPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.MinBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDataProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.RegistrationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -352,6 +925,65 @@ func (m *ProxyConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeSchedule) > 0 {
+		for iNdEx := len(m.FeeSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PayoutRecipients) > 0 {
+		for iNdEx := len(m.PayoutRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayoutRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.DeregistrationHeight != 0 {
+		i = encodeVarintDataProxy(dAtA, i, uint64(m.DeregistrationHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.UnbondingEntries) > 0 {
+		for iNdEx := len(m.UnbondingEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Bond != nil {
+		{
+			size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDataProxy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.FeeUpdate != nil {
 		{
 			size, err := m.FeeUpdate.MarshalToSizedBuffer(dAtA[:i])
@@ -400,6 +1032,93 @@ func (m *ProxyConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDataProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MemoTag) > 0 {
+		i -= len(m.MemoTag)
+		copy(dAtA[i:], m.MemoTag)
+		i = encodeVarintDataProxy(dAtA, i, uint64(len(m.MemoTag)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExecProgramID) > 0 {
+		i -= len(m.ExecProgramID)
+		copy(dAtA[i:], m.ExecProgramID)
+		i = encodeVarintDataProxy(dAtA, i, uint64(len(m.ExecProgramID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayoutRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayoutRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayoutRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDataProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDataProxy(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FeeUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -420,6 +1139,20 @@ func (m *FeeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NewFeeSchedule) > 0 {
+		for iNdEx := len(m.NewFeeSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewFeeSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.UpdateHeight != 0 {
 		i = encodeVarintDataProxy(dAtA, i, uint64(m.UpdateHeight))
 		i--
@@ -440,83 +1173,1428 @@ func (m *FeeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDataProxy(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDataProxy(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UnbondingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UnbondingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		i = encodeVarintDataProxy(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDataProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDataProxy(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAlias) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MinFeeUpdateDelay != 0 {
-		n += 1 + sovDataProxy(uint64(m.MinFeeUpdateDelay))
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintDataProxy(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.RegistrationFee.Size()
-	n += 1 + l + sovDataProxy(uint64(l))
-	return n
+	if len(m.NewPubKey) > 0 {
+		i -= len(m.NewPubKey)
+		copy(dAtA[i:], m.NewPubKey)
+		i = encodeVarintDataProxy(dAtA, i, uint64(len(m.NewPubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ProxyConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProxyUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProxyUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProxyUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PayoutAddress)
-	if l > 0 {
-		n += 1 + l + sovDataProxy(uint64(l))
+	if len(m.FeesEarned) > 0 {
+		for iNdEx := len(m.FeesEarned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesEarned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Fee != nil {
-		l = m.Fee.Size()
-		n += 1 + l + sovDataProxy(uint64(l))
+	{
+		size := m.GasUsed.Size()
+		i -= size
+		if _, err := m.GasUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDataProxy(dAtA, i, uint64(size))
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovDataProxy(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.RequestsServed != 0 {
+		i = encodeVarintDataProxy(dAtA, i, uint64(m.RequestsServed))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.AdminAddress)
-	if l > 0 {
-		n += 1 + l + sovDataProxy(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.FeeUpdate != nil {
-		l = m.FeeUpdate.Size()
-		n += 1 + l + sovDataProxy(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *FeeExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDataProxy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDataProxy(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDataProxy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDataProxy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinFeeUpdateDelay != 0 {
+		n += 1 + sovDataProxy(uint64(m.MinFeeUpdateDelay))
+	}
+	l = m.RegistrationFee.Size()
+	n += 1 + l + sovDataProxy(uint64(l))
+	l = m.MinBond.Size()
+	n += 1 + l + sovDataProxy(uint64(l))
+	if m.UnbondingPeriod != 0 {
+		n += 1 + sovDataProxy(uint64(m.UnbondingPeriod))
+	}
+	if m.DeregistrationDelay != 0 {
+		n += 1 + sovDataProxy(uint64(m.DeregistrationDelay))
+	}
+	if m.KeyAliasPeriod != 0 {
+		n += 1 + sovDataProxy(uint64(m.KeyAliasPeriod))
+	}
+	if m.AllowlistEnabled {
+		n += 2
+	}
+	l = len(m.ExchangeRateOracle)
+	if l > 0 {
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *ProxyConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	l = len(m.AdminAddress)
+	if l > 0 {
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	if m.FeeUpdate != nil {
+		l = m.FeeUpdate.Size()
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	if m.Bond != nil {
+		l = m.Bond.Size()
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	if len(m.UnbondingEntries) > 0 {
+		for _, e := range m.UnbondingEntries {
+			l = e.Size()
+			n += 1 + l + sovDataProxy(uint64(l))
+		}
+	}
+	if m.DeregistrationHeight != 0 {
+		n += 1 + sovDataProxy(uint64(m.DeregistrationHeight))
+	}
+	if len(m.PayoutRecipients) > 0 {
+		for _, e := range m.PayoutRecipients {
+			l = e.Size()
+			n += 1 + l + sovDataProxy(uint64(l))
+		}
+	}
+	if len(m.FeeSchedule) > 0 {
+		for _, e := range m.FeeSchedule {
+			l = e.Size()
+			n += 1 + l + sovDataProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExecProgramID)
+	if l > 0 {
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	l = len(m.MemoTag)
+	if l > 0 {
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovDataProxy(uint64(l))
+	return n
+}
+
+func (m *PayoutRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovDataProxy(uint64(l))
+	return n
+}
+
+func (m *FeeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewFee != nil {
+		l = m.NewFee.Size()
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	if m.UpdateHeight != 0 {
+		n += 1 + sovDataProxy(uint64(m.UpdateHeight))
+	}
+	if len(m.NewFeeSchedule) > 0 {
+		for _, e := range m.NewFeeSchedule {
+			l = e.Size()
+			n += 1 + l + sovDataProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDataProxy(uint64(l))
+	if m.CompletionHeight != 0 {
+		n += 1 + sovDataProxy(uint64(m.CompletionHeight))
+	}
+	return n
+}
+
+func (m *KeyAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewPubKey)
+	if l > 0 {
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovDataProxy(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *ProxyUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestsServed != 0 {
+		n += 1 + sovDataProxy(uint64(m.RequestsServed))
+	}
+	l = m.GasUsed.Size()
+	n += 1 + l + sovDataProxy(uint64(l))
+	if len(m.FeesEarned) > 0 {
+		for _, e := range m.FeesEarned {
+			l = e.Size()
+			n += 1 + l + sovDataProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDataProxy(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovDataProxy(uint64(l))
+	return n
+}
+
+func sovDataProxy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDataProxy(x uint64) (n int) {
+	return sovDataProxy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeUpdateDelay", wireType)
+			}
+			m.MinFeeUpdateDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFeeUpdateDelay |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			m.UnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingPeriod |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeregistrationDelay", wireType)
+			}
+			m.DeregistrationDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeregistrationDelay |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAliasPeriod", wireType)
+			}
+			m.KeyAliasPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyAliasPeriod |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowlistEnabled = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateOracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateOracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProxyConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fee == nil {
+				m.Fee = &types.Coin{}
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeUpdate == nil {
+				m.FeeUpdate = &FeeUpdate{}
+			}
+			if err := m.FeeUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bond == nil {
+				m.Bond = &types.Coin{}
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingEntries = append(m.UnbondingEntries, UnbondingEntry{})
+			if err := m.UnbondingEntries[len(m.UnbondingEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeregistrationHeight", wireType)
+			}
+			m.DeregistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeregistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutRecipients = append(m.PayoutRecipients, PayoutRecipient{})
+			if err := m.PayoutRecipients[len(m.PayoutRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSchedule = append(m.FeeSchedule, FeeScheduleEntry{})
+			if err := m.FeeSchedule[len(m.FeeSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeScheduleEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeScheduleEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecProgramID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecProgramID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemoTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayoutRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayoutRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayoutRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewFee == nil {
+				m.NewFee = &types.Coin{}
+			}
+			if err := m.NewFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateHeight", wireType)
+			}
+			m.UpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFeeSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFeeSchedule = append(m.NewFeeSchedule, FeeScheduleEntry{})
+			if err := m.NewFeeSchedule[len(m.NewFeeSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *FeeUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NewFee != nil {
-		l = m.NewFee.Size()
-		n += 1 + l + sovDataProxy(uint64(l))
-	}
-	if m.UpdateHeight != 0 {
-		n += 1 + sovDataProxy(uint64(m.UpdateHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDataProxy(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDataProxy(x uint64) (n int) {
-	return sovDataProxy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -539,17 +2617,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeUpdateDelay", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			m.MinFeeUpdateDelay = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataProxy
@@ -559,14 +2637,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinFeeUpdateDelay |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -593,10 +2684,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
+			}
+			m.CompletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDataProxy(dAtA[iNdEx:])
@@ -618,7 +2728,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProxyConfig) Unmarshal(dAtA []byte) error {
+func (m *KeyAlias) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -641,17 +2751,17 @@ func (m *ProxyConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProxyConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyAlias: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProxyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyAlias: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataProxy
@@ -661,29 +2771,31 @@ func (m *ProxyConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDataProxy
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDataProxy
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			m.NewPubKey = append(m.NewPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewPubKey == nil {
+				m.NewPubKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
 			}
-			var msglen int
+			m.ExpirationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataProxy
@@ -693,33 +2805,66 @@ func (m *ProxyConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExpirationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDataProxy
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDataProxy
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fee == nil {
-				m.Fee = &types.Coin{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProxyUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataProxy
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsServed", wireType)
+			}
+			m.RequestsServed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataProxy
@@ -729,27 +2874,14 @@ func (m *ProxyConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestsServed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDataProxy
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDataProxy
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -777,11 +2909,13 @@ func (m *ProxyConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdminAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.GasUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesEarned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -808,10 +2942,8 @@ func (m *ProxyConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeeUpdate == nil {
-				m.FeeUpdate = &FeeUpdate{}
-			}
-			if err := m.FeeUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeesEarned = append(m.FeesEarned, types.Coin{})
+			if err := m.FeesEarned[len(m.FeesEarned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -836,7 +2968,7 @@ func (m *ProxyConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeUpdate) Unmarshal(dAtA []byte) error {
+func (m *FeeExchangeRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -859,17 +2991,17 @@ func (m *FeeUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeExchangeRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataProxy
@@ -879,33 +3011,29 @@ func (m *FeeUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDataProxy
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDataProxy
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewFee == nil {
-				m.NewFee = &types.Coin{}
-			}
-			if err := m.NewFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			m.UpdateHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataProxy
@@ -915,11 +3043,26 @@ func (m *FeeUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDataProxy(dAtA[iNdEx:])
@@ -8,4 +8,9 @@ var (
 	ErrInvalidDelay      = errors.Register(ModuleName, 4, "invalid update delay")
 	ErrEmptyUpdate       = errors.Register(ModuleName, 5, "nothing to update")
 	ErrMaxUpdatesReached = errors.Register(ModuleName, 6, "max fee updates reached for block")
+	ErrInsufficientBond  = errors.Register(ModuleName, 7, "insufficient data proxy bond")
+	ErrMaxUnbondings     = errors.Register(ModuleName, 8, "max unbonding entries reached for data proxy")
+	ErrDeregistered      = errors.Register(ModuleName, 9, "data proxy has been deregistered")
+	ErrNoExchangeRate    = errors.Register(ModuleName, 10, "no fee exchange rate for denomination")
+	ErrNoPendingUpdate   = errors.Register(ModuleName, 11, "no pending fee update")
 )
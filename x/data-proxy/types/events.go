@@ -1,16 +1,43 @@
 package types
 
 const (
-	EventTypeRegisterProxy = "register_data_proxy"
-	EventTypeEditProxy     = "edit_data_proxy"
-	EventTypeFeeUpdate     = "fee_update"
-	EventTypeTransferAdmin = "transfer_admin"
+	EventTypeRegisterProxy   = "register_data_proxy"
+	EventTypeEditProxy       = "edit_data_proxy"
+	EventTypeFeeUpdate       = "fee_update"
+	EventTypeCancelUpdate    = "cancel_fee_update"
+	EventTypeAmendUpdate     = "amend_fee_update"
+	EventTypeTransferAdmin   = "transfer_admin"
+	EventTypeBond            = "bond_data_proxy"
+	EventTypeUnbond          = "unbond_data_proxy"
+	EventTypeCompleteUnbond  = "complete_unbonding_data_proxy"
+	EventTypeSlash           = "slash_data_proxy"
+	EventTypeDeregister      = "deregister_data_proxy"
+	EventTypeRemoveProxy     = "remove_data_proxy"
+	EventTypeRotateKey       = "rotate_data_proxy_key"
+	EventTypeAliasExpired    = "data_proxy_key_alias_expired"
+	EventTypeDenylistUpdate  = "update_data_proxy_denylist"
+	EventTypeAllowlistUpdate = "update_data_proxy_allowlist"
+	EventTypeExchangeRates   = "update_fee_exchange_rates"
 
-	AttributePubKey        = "pub_key"
-	AttributePayoutAddress = "payout_address"
-	AttributeFee           = "fee"
-	AttributeMemo          = "memo"
-	AttributeAdminAddress  = "admin_address"
-	AttributeNewFee        = "new_fee"
-	AttributeNewFeeHeight  = "new_fee_height"
+	AttributePubKey               = "pub_key"
+	AttributePayoutAddress        = "payout_address"
+	AttributePayoutRecipients     = "payout_recipients"
+	AttributeFee                  = "fee"
+	AttributeMemo                 = "memo"
+	AttributeAdminAddress         = "admin_address"
+	AttributeNewFee               = "new_fee"
+	AttributeNewFeeHeight         = "new_fee_height"
+	AttributeFeeSchedule          = "fee_schedule"
+	AttributeAmount               = "amount"
+	AttributeBond                 = "bond"
+	AttributeCompletionHeight     = "completion_height"
+	AttributeRecipient            = "recipient"
+	AttributeSlashFraction        = "slash_fraction"
+	AttributeReason               = "reason"
+	AttributeNewPubKey            = "new_pub_key"
+	AttributeDeregistrationHeight = "deregistration_height"
+	AttributeExpirationHeight     = "expiration_height"
+	AttributeAdded                = "added"
+	AttributeRemoved              = "removed"
+	AttributeSender               = "sender"
 )
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	appparams "github.com/sedaprotocol/seda-chain/app/params"
+)
+
+// Validate checks that the exchange rate is a positive rate for a valid
+// denomination other than aseda.
+func (r FeeExchangeRate) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid exchange rate denomination: %s", err)
+	}
+	if r.Denom == appparams.DefaultBondDenom {
+		return sdkerrors.ErrInvalidRequest.Wrapf("cannot set an exchange rate for %s", appparams.DefaultBondDenom)
+	}
+	if r.Rate.IsNil() || !r.Rate.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("exchange rate for %s must be positive", r.Denom)
+	}
+	return nil
+}
+
+// ConvertFee converts a fee to aseda at the given rate of aseda per unit of
+// the fee denomination, truncating the result.
+func ConvertFee(fee sdk.Coin, rate math.LegacyDec) sdk.Coin {
+	return sdk.NewCoin(appparams.DefaultBondDenom, rate.MulInt(fee.Amount).TruncateInt())
+}
+
+// FormatExchangeRates formats exchange rates for event attributes as a
+// comma-separated list of <denom>=<rate> entries.
+func FormatExchangeRates(rates []FeeExchangeRate) string {
+	entries := make([]string, len(rates))
+	for i, rate := range rates {
+		entries[i] = fmt.Sprintf("%s=%s", rate.Denom, rate.Rate)
+	}
+	return strings.Join(entries, ",")
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MaxFeeScheduleEntries = 32
+	MaxMemoTagLength      = 64
+)
+
+// ValidateFeeSchedule checks that each entry of the fee schedule is keyed by
+// exactly one of a hex encoded exec program ID or a memo tag, that no key is
+// repeated, and that the fees are valid coins. Whether the fee denominations
+// can be converted to aseda is checked against the exchange rate table when
+// the fee schedule is set.
+func ValidateFeeSchedule(schedule []FeeScheduleEntry) error {
+	if len(schedule) > MaxFeeScheduleEntries {
+		return sdkerrors.ErrInvalidRequest.Wrapf("too many fee schedule entries; got: %d, max: %d", len(schedule), MaxFeeScheduleEntries)
+	}
+
+	execProgramIDs := make(map[string]struct{}, len(schedule))
+	memoTags := make(map[string]struct{}, len(schedule))
+	for _, entry := range schedule {
+		switch {
+		case entry.ExecProgramID != "" && entry.MemoTag != "":
+			return sdkerrors.ErrInvalidRequest.Wrap("fee schedule entry cannot have both an exec program ID and a memo tag")
+		case entry.ExecProgramID != "":
+			if _, err := hex.DecodeString(entry.ExecProgramID); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid exec program ID in fee schedule: %s", entry.ExecProgramID)
+			}
+			if _, ok := execProgramIDs[entry.ExecProgramID]; ok {
+				return sdkerrors.ErrInvalidRequest.Wrapf("duplicate exec program ID in fee schedule: %s", entry.ExecProgramID)
+			}
+			execProgramIDs[entry.ExecProgramID] = struct{}{}
+		case entry.MemoTag != "":
+			if len(entry.MemoTag) > MaxMemoTagLength {
+				return sdkerrors.ErrInvalidRequest.Wrapf("memo tag too long; got: %d, max: %d", len(entry.MemoTag), MaxMemoTagLength)
+			}
+			if _, ok := memoTags[entry.MemoTag]; ok {
+				return sdkerrors.ErrInvalidRequest.Wrapf("duplicate memo tag in fee schedule: %s", entry.MemoTag)
+			}
+			memoTags[entry.MemoTag] = struct{}{}
+		default:
+			return sdkerrors.ErrInvalidRequest.Wrap("fee schedule entry must have an exec program ID or a memo tag")
+		}
+
+		if err := entry.Fee.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid fee in fee schedule: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// FeeFor returns the fee charged by the data proxy for a data request with the
+// given exec program ID and memo. A fee schedule entry for the exec program
+// takes precedence over memo tags, which are matched as prefixes of the memo
+// in schedule order. The flat fee applies when no entry matches.
+func (p *ProxyConfig) FeeFor(execProgramID string, memo []byte) sdk.Coin {
+	if execProgramID != "" {
+		for _, entry := range p.FeeSchedule {
+			if entry.ExecProgramID == execProgramID {
+				return entry.Fee
+			}
+		}
+	}
+
+	if len(memo) > 0 {
+		for _, entry := range p.FeeSchedule {
+			if entry.MemoTag != "" && bytes.HasPrefix(memo, []byte(entry.MemoTag)) {
+				return entry.Fee
+			}
+		}
+	}
+
+	return *p.Fee
+}
+
+// PricingRaises reports whether the pricing made of newFee and newSchedule can
+// charge more than the pricing made of oldFee and oldSchedule for any data
+// request. The fee schedules are only compared entry by entry when they have
+// the same keys in the same order and all fees share their denominations, so
+// any other change of the pricing is treated as a raise.
+func PricingRaises(oldFee sdk.Coin, oldSchedule []FeeScheduleEntry, newFee sdk.Coin, newSchedule []FeeScheduleEntry) bool {
+	if feeRaises(oldFee, newFee) || len(oldSchedule) != len(newSchedule) {
+		return true
+	}
+
+	for i, entry := range newSchedule {
+		if entry.ExecProgramID != oldSchedule[i].ExecProgramID || entry.MemoTag != oldSchedule[i].MemoTag {
+			return true
+		}
+		if feeRaises(oldSchedule[i].Fee, entry.Fee) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func feeRaises(oldFee, newFee sdk.Coin) bool {
+	return oldFee.Denom != newFee.Denom || newFee.Amount.GT(oldFee.Amount)
+}
+
+// FormatFeeSchedule formats the fee schedule for event attributes as a
+// comma-separated list of program:<id>=<fee> and memo:<tag>=<fee> entries.
+func FormatFeeSchedule(schedule []FeeScheduleEntry) string {
+	entries := make([]string, len(schedule))
+	for i, entry := range schedule {
+		if entry.ExecProgramID != "" {
+			entries[i] = fmt.Sprintf("program:%s=%s", entry.ExecProgramID, entry.Fee)
+		} else {
+			entries[i] = fmt.Sprintf("memo:%s=%s", entry.MemoTag, entry.Fee)
+		}
+	}
+	return strings.Join(entries, ",")
+}
@@ -2,16 +2,34 @@ package types
 
 import "fmt"
 
-func NewGenesisState(params Params, proxyConfigs []DataProxyConfig, feeUpdates []FeeUpdateQueueRecord) GenesisState {
+func NewGenesisState(
+	params Params,
+	proxyConfigs []DataProxyConfig,
+	feeUpdates []FeeUpdateQueueRecord,
+	unbondings []UnbondingQueueRecord,
+	deregistrations []DeregistrationQueueRecord,
+	keyAliases []KeyAliasRecord,
+	usages []ProxyUsageRecord,
+	denylist [][]byte,
+	allowlist [][]byte,
+	rates []FeeExchangeRate,
+) GenesisState {
 	return GenesisState{
-		Params:           params,
-		DataProxyConfigs: proxyConfigs,
-		FeeUpdateQueue:   feeUpdates,
+		Params:              params,
+		DataProxyConfigs:    proxyConfigs,
+		FeeUpdateQueue:      feeUpdates,
+		UnbondingQueue:      unbondings,
+		DeregistrationQueue: deregistrations,
+		KeyAliases:          keyAliases,
+		ProxyUsages:         usages,
+		Denylist:            denylist,
+		Allowlist:           allowlist,
+		FeeExchangeRates:    rates,
 	}
 }
 
 func DefaultGenesisState() *GenesisState {
-	state := NewGenesisState(DefaultParams(), []DataProxyConfig{}, []FeeUpdateQueueRecord{})
+	state := NewGenesisState(DefaultParams(), []DataProxyConfig{}, []FeeUpdateQueueRecord{}, []UnbondingQueueRecord{}, []DeregistrationQueueRecord{}, []KeyAliasRecord{}, []ProxyUsageRecord{}, [][]byte{}, [][]byte{}, []FeeExchangeRate{})
 	return &state
 }
 
@@ -35,6 +53,19 @@ func ValidateGenesis(data GenesisState) error {
 		if proxyConfig.Config.PayoutAddress == "" {
 			return fmt.Errorf("empty payout address")
 		}
+		if proxyConfig.Config.Bond != nil {
+			if err := ValidateBondAmount(*proxyConfig.Config.Bond); err != nil {
+				return err
+			}
+		}
+		for _, entry := range proxyConfig.Config.UnbondingEntries {
+			if entry.Recipient == "" {
+				return fmt.Errorf("empty unbonding recipient")
+			}
+			if err := ValidateBondAmount(entry.Amount); err != nil {
+				return err
+			}
+		}
 	}
 
 	for _, feeUpdate := range data.FeeUpdateQueue {
@@ -43,5 +74,52 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, unbonding := range data.UnbondingQueue {
+		if len(unbonding.DataProxyPubkey) == 0 {
+			return fmt.Errorf("empty public key in unbonding queue")
+		}
+	}
+
+	for _, deregistration := range data.DeregistrationQueue {
+		if len(deregistration.DataProxyPubkey) == 0 {
+			return fmt.Errorf("empty public key in deregistration queue")
+		}
+	}
+
+	for _, alias := range data.KeyAliases {
+		if len(alias.OldPubKey) == 0 || len(alias.Alias.NewPubKey) == 0 {
+			return fmt.Errorf("empty public key in key aliases")
+		}
+	}
+
+	for _, usage := range data.ProxyUsages {
+		if len(usage.DataProxyPubkey) == 0 {
+			return fmt.Errorf("empty public key in proxy usages")
+		}
+		if usage.Usage.GasUsed.IsNil() || usage.Usage.GasUsed.IsNegative() {
+			return fmt.Errorf("invalid gas used in proxy usages")
+		}
+		if err := usage.Usage.FeesEarned.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, pubKey := range append(append([][]byte{}, data.Denylist...), data.Allowlist...) {
+		if len(pubKey) == 0 {
+			return fmt.Errorf("empty public key in access lists")
+		}
+	}
+
+	denoms := make(map[string]struct{}, len(data.FeeExchangeRates))
+	for _, rate := range data.FeeExchangeRates {
+		if err := rate.Validate(); err != nil {
+			return err
+		}
+		if _, ok := denoms[rate.Denom]; ok {
+			return fmt.Errorf("duplicate fee exchange rate for %s", rate.Denom)
+		}
+		denoms[rate.Denom] = struct{}{}
+	}
+
 	return data.Params.Validate()
 }
@@ -25,9 +25,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines data_proxy module's genesis state.
 type GenesisState struct {
-	Params           Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	DataProxyConfigs []DataProxyConfig      `protobuf:"bytes,2,rep,name=data_proxy_configs,json=dataProxyConfigs,proto3" json:"data_proxy_configs"`
-	FeeUpdateQueue   []FeeUpdateQueueRecord `protobuf:"bytes,3,rep,name=fee_update_queue,json=feeUpdateQueue,proto3" json:"fee_update_queue"`
+	Params              Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	DataProxyConfigs    []DataProxyConfig           `protobuf:"bytes,2,rep,name=data_proxy_configs,json=dataProxyConfigs,proto3" json:"data_proxy_configs"`
+	FeeUpdateQueue      []FeeUpdateQueueRecord      `protobuf:"bytes,3,rep,name=fee_update_queue,json=feeUpdateQueue,proto3" json:"fee_update_queue"`
+	UnbondingQueue      []UnbondingQueueRecord      `protobuf:"bytes,4,rep,name=unbonding_queue,json=unbondingQueue,proto3" json:"unbonding_queue"`
+	DeregistrationQueue []DeregistrationQueueRecord `protobuf:"bytes,5,rep,name=deregistration_queue,json=deregistrationQueue,proto3" json:"deregistration_queue"`
+	KeyAliases          []KeyAliasRecord            `protobuf:"bytes,6,rep,name=key_aliases,json=keyAliases,proto3" json:"key_aliases"`
+	ProxyUsages         []ProxyUsageRecord          `protobuf:"bytes,7,rep,name=proxy_usages,json=proxyUsages,proto3" json:"proxy_usages"`
+	Denylist            [][]byte                    `protobuf:"bytes,8,rep,name=denylist,proto3" json:"denylist,omitempty"`
+	Allowlist           [][]byte                    `protobuf:"bytes,9,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	FeeExchangeRates    []FeeExchangeRate           `protobuf:"bytes,10,rep,name=fee_exchange_rates,json=feeExchangeRates,proto3" json:"fee_exchange_rates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +91,55 @@ func (m *GenesisState) GetFeeUpdateQueue() []FeeUpdateQueueRecord {
 	return nil
 }
 
+func (m *GenesisState) GetUnbondingQueue() []UnbondingQueueRecord {
+	if m != nil {
+		return m.UnbondingQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeregistrationQueue() []DeregistrationQueueRecord {
+	if m != nil {
+		return m.DeregistrationQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyAliases() []KeyAliasRecord {
+	if m != nil {
+		return m.KeyAliases
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProxyUsages() []ProxyUsageRecord {
+	if m != nil {
+		return m.ProxyUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenylist() [][]byte {
+	if m != nil {
+		return m.Denylist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowlist() [][]byte {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeExchangeRates() []FeeExchangeRate {
+	if m != nil {
+		return m.FeeExchangeRates
+	}
+	return nil
+}
+
 // DataProxyConfigs define the data proxy entries in the registry.
 type DataProxyConfig struct {
 	DataProxyPubkey []byte       `protobuf:"bytes,1,opt,name=data_proxy_pubkey,json=dataProxyPubkey,proto3" json:"data_proxy_pubkey,omitempty"`
@@ -190,10 +246,227 @@ func (m *FeeUpdateQueueRecord) GetUpdateHeight() int64 {
 	return 0
 }
 
+// UnbondingQueueRecord defines an entry in the data proxy unbonding queue.
+type UnbondingQueueRecord struct {
+	DataProxyPubkey  []byte `protobuf:"bytes,1,opt,name=data_proxy_pubkey,json=dataProxyPubkey,proto3" json:"data_proxy_pubkey,omitempty"`
+	CompletionHeight int64  `protobuf:"varint,2,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+}
+
+func (m *UnbondingQueueRecord) Reset()         { *m = UnbondingQueueRecord{} }
+func (m *UnbondingQueueRecord) String() string { return proto.CompactTextString(m) }
+func (*UnbondingQueueRecord) ProtoMessage()    {}
+func (*UnbondingQueueRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_614b9aebcf526c4f, []int{3}
+}
+func (m *UnbondingQueueRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingQueueRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingQueueRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingQueueRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingQueueRecord.Merge(m, src)
+}
+func (m *UnbondingQueueRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingQueueRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingQueueRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingQueueRecord proto.InternalMessageInfo
+
+func (m *UnbondingQueueRecord) GetDataProxyPubkey() []byte {
+	if m != nil {
+		return m.DataProxyPubkey
+	}
+	return nil
+}
+
+func (m *UnbondingQueueRecord) GetCompletionHeight() int64 {
+	if m != nil {
+		return m.CompletionHeight
+	}
+	return 0
+}
+
+// DeregistrationQueueRecord defines an entry in the data proxy deregistration
+// queue.
+type DeregistrationQueueRecord struct {
+	DataProxyPubkey      []byte `protobuf:"bytes,1,opt,name=data_proxy_pubkey,json=dataProxyPubkey,proto3" json:"data_proxy_pubkey,omitempty"`
+	DeregistrationHeight int64  `protobuf:"varint,2,opt,name=deregistration_height,json=deregistrationHeight,proto3" json:"deregistration_height,omitempty"`
+}
+
+func (m *DeregistrationQueueRecord) Reset()         { *m = DeregistrationQueueRecord{} }
+func (m *DeregistrationQueueRecord) String() string { return proto.CompactTextString(m) }
+func (*DeregistrationQueueRecord) ProtoMessage()    {}
+func (*DeregistrationQueueRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_614b9aebcf526c4f, []int{4}
+}
+func (m *DeregistrationQueueRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregistrationQueueRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregistrationQueueRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeregistrationQueueRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregistrationQueueRecord.Merge(m, src)
+}
+func (m *DeregistrationQueueRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregistrationQueueRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregistrationQueueRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregistrationQueueRecord proto.InternalMessageInfo
+
+func (m *DeregistrationQueueRecord) GetDataProxyPubkey() []byte {
+	if m != nil {
+		return m.DataProxyPubkey
+	}
+	return nil
+}
+
+func (m *DeregistrationQueueRecord) GetDeregistrationHeight() int64 {
+	if m != nil {
+		return m.DeregistrationHeight
+	}
+	return 0
+}
+
+// KeyAliasRecord defines a previous public key of a rotated data proxy.
+type KeyAliasRecord struct {
+	OldPubKey []byte   `protobuf:"bytes,1,opt,name=old_pub_key,json=oldPubKey,proto3" json:"old_pub_key,omitempty"`
+	Alias     KeyAlias `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias"`
+}
+
+func (m *KeyAliasRecord) Reset()         { *m = KeyAliasRecord{} }
+func (m *KeyAliasRecord) String() string { return proto.CompactTextString(m) }
+func (*KeyAliasRecord) ProtoMessage()    {}
+func (*KeyAliasRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_614b9aebcf526c4f, []int{5}
+}
+func (m *KeyAliasRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyAliasRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyAliasRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyAliasRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyAliasRecord.Merge(m, src)
+}
+func (m *KeyAliasRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyAliasRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyAliasRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyAliasRecord proto.InternalMessageInfo
+
+func (m *KeyAliasRecord) GetOldPubKey() []byte {
+	if m != nil {
+		return m.OldPubKey
+	}
+	return nil
+}
+
+func (m *KeyAliasRecord) GetAlias() KeyAlias {
+	if m != nil {
+		return m.Alias
+	}
+	return KeyAlias{}
+}
+
+// ProxyUsageRecord defines the cumulative usage of a data proxy.
+type ProxyUsageRecord struct {
+	DataProxyPubkey []byte     `protobuf:"bytes,1,opt,name=data_proxy_pubkey,json=dataProxyPubkey,proto3" json:"data_proxy_pubkey,omitempty"`
+	Usage           ProxyUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *ProxyUsageRecord) Reset()         { *m = ProxyUsageRecord{} }
+func (m *ProxyUsageRecord) String() string { return proto.CompactTextString(m) }
+func (*ProxyUsageRecord) ProtoMessage()    {}
+func (*ProxyUsageRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_614b9aebcf526c4f, []int{6}
+}
+func (m *ProxyUsageRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProxyUsageRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProxyUsageRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProxyUsageRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyUsageRecord.Merge(m, src)
+}
+func (m *ProxyUsageRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProxyUsageRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyUsageRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyUsageRecord proto.InternalMessageInfo
+
+func (m *ProxyUsageRecord) GetDataProxyPubkey() []byte {
+	if m != nil {
+		return m.DataProxyPubkey
+	}
+	return nil
+}
+
+func (m *ProxyUsageRecord) GetUsage() ProxyUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return ProxyUsage{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sedachain.data_proxy.v1.GenesisState")
 	proto.RegisterType((*DataProxyConfig)(nil), "sedachain.data_proxy.v1.DataProxyConfig")
 	proto.RegisterType((*FeeUpdateQueueRecord)(nil), "sedachain.data_proxy.v1.FeeUpdateQueueRecord")
+	proto.RegisterType((*UnbondingQueueRecord)(nil), "sedachain.data_proxy.v1.UnbondingQueueRecord")
+	proto.RegisterType((*DeregistrationQueueRecord)(nil), "sedachain.data_proxy.v1.DeregistrationQueueRecord")
+	proto.RegisterType((*KeyAliasRecord)(nil), "sedachain.data_proxy.v1.KeyAliasRecord")
+	proto.RegisterType((*ProxyUsageRecord)(nil), "sedachain.data_proxy.v1.ProxyUsageRecord")
 }
 
 func init() {
@@ -201,31 +474,49 @@ func init() {
 }
 
 var fileDescriptor_614b9aebcf526c4f = []byte{
-	// 380 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x4e, 0xea, 0x50,
-	0x10, 0xc6, 0x5b, 0xb8, 0x61, 0x71, 0xe0, 0x5e, 0xb8, 0x0d, 0x89, 0x0d, 0x8b, 0x42, 0x50, 0x93,
-	0xc6, 0x84, 0x36, 0x60, 0xdc, 0xe9, 0x06, 0x8d, 0xba, 0x13, 0x6b, 0xdc, 0x18, 0x4d, 0x73, 0x68,
-	0x87, 0xb6, 0x51, 0x38, 0xb5, 0x3d, 0x25, 0x10, 0xdf, 0xc0, 0x95, 0x8f, 0xc5, 0x92, 0xa5, 0x2b,
-	0x63, 0xe0, 0x45, 0x4c, 0xa7, 0x0d, 0x7f, 0x8c, 0x5d, 0xb8, 0xeb, 0x99, 0xfe, 0xe6, 0x9b, 0x6f,
-	0x26, 0x1f, 0xd9, 0x0f, 0xc1, 0xa6, 0x96, 0x4b, 0xbd, 0x91, 0x6e, 0x53, 0x4e, 0x4d, 0x3f, 0x60,
-	0x93, 0xa9, 0x3e, 0x6e, 0xeb, 0x0e, 0x8c, 0x20, 0xf4, 0x42, 0xcd, 0x0f, 0x18, 0x67, 0xd2, 0xce,
-	0x0a, 0xd3, 0xd6, 0x98, 0x36, 0x6e, 0xd7, 0xaa, 0x0e, 0x73, 0x18, 0x32, 0x7a, 0xfc, 0x95, 0xe0,
-	0x35, 0x35, 0x4b, 0x75, 0xa3, 0x19, 0xc9, 0xe6, 0x6b, 0x8e, 0x94, 0x2e, 0x92, 0x51, 0x37, 0x9c,
-	0x72, 0x90, 0x4e, 0x48, 0xc1, 0xa7, 0x01, 0x1d, 0x86, 0xb2, 0xd8, 0x10, 0xd5, 0x62, 0xa7, 0xae,
-	0x65, 0x8c, 0xd6, 0x7a, 0x88, 0x75, 0xff, 0xcc, 0x3e, 0xea, 0x82, 0x91, 0x36, 0x49, 0xf7, 0x44,
-	0x5a, 0x53, 0xa6, 0xc5, 0x46, 0x03, 0xcf, 0x09, 0xe5, 0x5c, 0x23, 0xaf, 0x16, 0x3b, 0x6a, 0xa6,
-	0xd4, 0x19, 0xe5, 0xb4, 0x17, 0x3f, 0x4e, 0xb1, 0x21, 0xd5, 0xac, 0xd8, 0xdb, 0xe5, 0x50, 0x7a,
-	0x20, 0x95, 0x01, 0x80, 0x19, 0xf9, 0x36, 0xe5, 0x60, 0x3e, 0x47, 0x10, 0x81, 0x9c, 0x47, 0xed,
-	0x56, 0xa6, 0xf6, 0x39, 0xc0, 0x2d, 0xf2, 0xd7, 0x31, 0x6e, 0x80, 0xc5, 0x02, 0x3b, 0x1d, 0xf0,
-	0x6f, 0xb0, 0xf5, 0xaf, 0xf9, 0x42, 0xca, 0xdf, 0x9c, 0x48, 0x07, 0xe4, 0xff, 0xc6, 0x3e, 0x7e,
-	0xd4, 0x7f, 0x84, 0x29, 0x5e, 0xa6, 0x64, 0x94, 0x57, 0xf6, 0x7a, 0x58, 0x96, 0x8e, 0x49, 0x21,
-	0x59, 0x58, 0xce, 0xe1, 0xe9, 0xf6, 0xb2, 0x4f, 0xb7, 0x9e, 0x60, 0xa4, 0x3d, 0x4d, 0x87, 0x54,
-	0x7f, 0xb2, 0xfa, 0x2b, 0x07, 0xbb, 0xe4, 0x6f, 0x7a, 0x1b, 0x17, 0x3c, 0xc7, 0xe5, 0x68, 0x24,
-	0x6f, 0x94, 0x92, 0xe2, 0x25, 0xd6, 0xba, 0x57, 0xb3, 0x85, 0x22, 0xce, 0x17, 0x8a, 0xf8, 0xb9,
-	0x50, 0xc4, 0xb7, 0xa5, 0x22, 0xcc, 0x97, 0x8a, 0xf0, 0xbe, 0x54, 0x84, 0xbb, 0x23, 0xc7, 0xe3,
-	0x6e, 0xd4, 0xd7, 0x2c, 0x36, 0xd4, 0x63, 0xeb, 0x18, 0x11, 0x8b, 0x3d, 0xe1, 0xa3, 0x95, 0xe4,
-	0x69, 0x82, 0x19, 0x6a, 0x25, 0x89, 0xe2, 0x53, 0x1f, 0xc2, 0x7e, 0x01, 0xb9, 0xc3, 0xaf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x05, 0x85, 0x87, 0x38, 0xcc, 0x02, 0x00, 0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x51, 0x4f, 0xd3, 0x5c,
+	0x18, 0xde, 0x18, 0xec, 0x83, 0x77, 0xfb, 0x60, 0x9c, 0x6f, 0x5f, 0xac, 0xc4, 0x14, 0x1c, 0x1a,
+	0xa7, 0x86, 0x2d, 0x40, 0xbc, 0x93, 0x18, 0x51, 0xd1, 0x84, 0x44, 0x67, 0x0d, 0x37, 0x06, 0xd3,
+	0x9c, 0xb5, 0xef, 0xba, 0x66, 0xa5, 0xa7, 0xf6, 0x9c, 0x22, 0x8d, 0x26, 0xfe, 0x05, 0xfd, 0x57,
+	0x5c, 0x72, 0xe9, 0x95, 0x31, 0xf0, 0x47, 0x4c, 0x4f, 0x0f, 0x1b, 0x1d, 0x54, 0xdc, 0x5d, 0xcf,
+	0xfb, 0x3e, 0xef, 0xf3, 0x9c, 0xb6, 0xcf, 0x93, 0x17, 0xee, 0x72, 0xb4, 0xa9, 0xd5, 0xa7, 0xae,
+	0xdf, 0xb6, 0xa9, 0xa0, 0x66, 0x10, 0xb2, 0xa3, 0xb8, 0x7d, 0xb8, 0xde, 0x76, 0xd0, 0x47, 0xee,
+	0xf2, 0x56, 0x10, 0x32, 0xc1, 0xc8, 0x8d, 0x21, 0xac, 0x35, 0x82, 0xb5, 0x0e, 0xd7, 0x97, 0xea,
+	0x0e, 0x73, 0x98, 0xc4, 0xb4, 0x93, 0xa7, 0x14, 0xbe, 0xd4, 0xcc, 0x63, 0xbd, 0x30, 0x2c, 0x91,
+	0x8d, 0xef, 0x65, 0xa8, 0xbe, 0x4c, 0xa5, 0xde, 0x09, 0x2a, 0x90, 0x6c, 0x41, 0x39, 0xa0, 0x21,
+	0x3d, 0xe0, 0x5a, 0x71, 0xa5, 0xd8, 0xac, 0x6c, 0x2c, 0xb7, 0x72, 0xa4, 0x5b, 0x1d, 0x09, 0xdb,
+	0x9e, 0x3e, 0xfe, 0xb9, 0x5c, 0x30, 0xd4, 0x10, 0xd9, 0x07, 0x32, 0x42, 0x99, 0x16, 0xf3, 0x7b,
+	0xae, 0xc3, 0xb5, 0xa9, 0x95, 0x52, 0xb3, 0xb2, 0xd1, 0xcc, 0xa5, 0x7a, 0x4e, 0x05, 0xed, 0x24,
+	0x87, 0x67, 0x72, 0x40, 0x71, 0xd6, 0xec, 0x6c, 0x99, 0x93, 0x0f, 0x50, 0xeb, 0x21, 0x9a, 0x51,
+	0x60, 0x53, 0x81, 0xe6, 0xc7, 0x08, 0x23, 0xd4, 0x4a, 0x92, 0x7b, 0x2d, 0x97, 0x7b, 0x07, 0x71,
+	0x4f, 0xe2, 0xdf, 0x26, 0x70, 0x03, 0x2d, 0x16, 0xda, 0x4a, 0x60, 0xbe, 0x97, 0xe9, 0x91, 0x7d,
+	0x58, 0x88, 0xfc, 0x2e, 0xf3, 0x6d, 0xd7, 0x77, 0x14, 0xfb, 0xf4, 0x35, 0xec, 0x7b, 0xe7, 0xf8,
+	0x2b, 0xd8, 0xa3, 0x4c, 0x8f, 0x0c, 0xa0, 0x6e, 0x63, 0x88, 0x8e, 0xcb, 0x45, 0x48, 0x85, 0xcb,
+	0x7c, 0x25, 0x31, 0x23, 0x25, 0x36, 0xf2, 0x3f, 0x4e, 0x66, 0xe8, 0xb2, 0xce, 0x7f, 0xf6, 0x65,
+	0x00, 0x79, 0x0d, 0x95, 0x01, 0xc6, 0x26, 0xf5, 0x5c, 0xca, 0x91, 0x6b, 0x65, 0xa9, 0x71, 0x2f,
+	0x57, 0x63, 0x17, 0xe3, 0xa7, 0x09, 0x34, 0x43, 0x0c, 0x03, 0x55, 0x45, 0x4e, 0x0c, 0xa8, 0xa6,
+	0xbf, 0x34, 0xe2, 0xd4, 0x41, 0xae, 0xfd, 0x23, 0x09, 0xef, 0xe7, 0x9b, 0x23, 0x79, 0xd8, 0x4b,
+	0xb0, 0x19, 0xca, 0x4a, 0x30, 0xac, 0x73, 0xb2, 0x04, 0xb3, 0x36, 0xfa, 0xb1, 0xe7, 0x72, 0xa1,
+	0xcd, 0xae, 0x94, 0x9a, 0x55, 0x63, 0x78, 0x26, 0xb7, 0x60, 0x8e, 0x7a, 0x1e, 0xfb, 0x24, 0x9b,
+	0x73, 0xb2, 0x39, 0x2a, 0x24, 0x2e, 0x4b, 0x7c, 0x80, 0x47, 0x56, 0x9f, 0xfa, 0x0e, 0x9a, 0x21,
+	0x15, 0xc8, 0x35, 0xb8, 0xc6, 0x65, 0x3b, 0x88, 0x2f, 0xd4, 0x84, 0x41, 0x05, 0x9e, 0xbb, 0xac,
+	0x97, 0x2d, 0xf3, 0xc6, 0x67, 0x58, 0x18, 0x33, 0x24, 0x79, 0x00, 0x8b, 0x17, 0x6c, 0x1d, 0x44,
+	0xdd, 0x01, 0xc6, 0x32, 0x20, 0x55, 0x63, 0x61, 0xe8, 0xd2, 0x8e, 0x2c, 0x93, 0xc7, 0x50, 0x4e,
+	0x7d, 0xaf, 0x4d, 0xc9, 0x04, 0xdd, 0xf9, 0xf3, 0x47, 0x4a, 0x15, 0x0c, 0x35, 0xd3, 0x70, 0xa0,
+	0x7e, 0x95, 0x63, 0x27, 0xba, 0xc1, 0x2a, 0xfc, 0xab, 0x22, 0xd2, 0x47, 0xd7, 0xe9, 0x0b, 0x79,
+	0x91, 0x92, 0x51, 0x4d, 0x8b, 0xaf, 0x64, 0xad, 0xc1, 0xa0, 0x7e, 0x95, 0x79, 0x27, 0x12, 0x7a,
+	0x08, 0x8b, 0x16, 0x3b, 0x08, 0x3c, 0x94, 0x76, 0xce, 0x88, 0xd5, 0x46, 0x0d, 0x25, 0xf8, 0x05,
+	0x6e, 0xe6, 0x5a, 0x79, 0x22, 0xd5, 0x4d, 0xf8, 0x7f, 0x2c, 0x48, 0x19, 0xe5, 0xb1, 0x94, 0x0d,
+	0x5f, 0x77, 0x3e, 0x6b, 0x72, 0xa2, 0x43, 0x85, 0x79, 0x76, 0xa2, 0x65, 0x8e, 0xc4, 0xe6, 0x98,
+	0x67, 0x77, 0xa2, 0xee, 0x2e, 0xc6, 0x64, 0x0b, 0x66, 0x64, 0x7c, 0xd4, 0x6f, 0xbc, 0x7d, 0x6d,
+	0x78, 0x94, 0xa1, 0xd2, 0xa9, 0xc6, 0x57, 0xa8, 0x8d, 0x87, 0x60, 0xa2, 0xb7, 0x7c, 0x02, 0x33,
+	0x32, 0x6b, 0x4a, 0x7e, 0xf5, 0x2f, 0xa2, 0x76, 0x7e, 0x01, 0x39, 0xb7, 0xfd, 0xe6, 0xf8, 0x54,
+	0x2f, 0x9e, 0x9c, 0xea, 0xc5, 0x5f, 0xa7, 0x7a, 0xf1, 0xdb, 0x99, 0x5e, 0x38, 0x39, 0xd3, 0x0b,
+	0x3f, 0xce, 0xf4, 0xc2, 0xfb, 0x47, 0x8e, 0x2b, 0xfa, 0x51, 0xb7, 0x65, 0xb1, 0x83, 0x76, 0xc2,
+	0x2a, 0x57, 0x81, 0xc5, 0x3c, 0x79, 0x58, 0x4b, 0xf7, 0xc6, 0x91, 0xdc, 0x15, 0x6b, 0xe9, 0xe6,
+	0x10, 0x71, 0x80, 0xbc, 0x5b, 0x96, 0xb8, 0xcd, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x47,
+	0x22, 0x1d, 0xb4, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -248,6 +539,94 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeExchangeRates) > 0 {
+		for iNdEx := len(m.FeeExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ProxyUsages) > 0 {
+		for iNdEx := len(m.ProxyUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProxyUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.KeyAliases) > 0 {
+		for iNdEx := len(m.KeyAliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DeregistrationQueue) > 0 {
+		for iNdEx := len(m.DeregistrationQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeregistrationQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UnbondingQueue) > 0 {
+		for iNdEx := len(m.UnbondingQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.FeeUpdateQueue) > 0 {
 		for iNdEx := len(m.FeeUpdateQueue) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -366,36 +745,228 @@ func (m *FeeUpdateQueueRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UnbondingQueueRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UnbondingQueueRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingQueueRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.DataProxyConfigs) > 0 {
-		for _, e := range m.DataProxyConfigs {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.CompletionHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.FeeUpdateQueue) > 0 {
-		for _, e := range m.FeeUpdateQueue {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.DataProxyPubkey) > 0 {
+		i -= len(m.DataProxyPubkey)
+		copy(dAtA[i:], m.DataProxyPubkey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DataProxyPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeregistrationQueueRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregistrationQueueRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregistrationQueueRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeregistrationHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DeregistrationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DataProxyPubkey) > 0 {
+		i -= len(m.DataProxyPubkey)
+		copy(dAtA[i:], m.DataProxyPubkey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DataProxyPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyAliasRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAliasRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAliasRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Alias.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OldPubKey) > 0 {
+		i -= len(m.OldPubKey)
+		copy(dAtA[i:], m.OldPubKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OldPubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProxyUsageRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProxyUsageRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProxyUsageRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DataProxyPubkey) > 0 {
+		i -= len(m.DataProxyPubkey)
+		copy(dAtA[i:], m.DataProxyPubkey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DataProxyPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DataProxyConfigs) > 0 {
+		for _, e := range m.DataProxyConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeUpdateQueue) > 0 {
+		for _, e := range m.FeeUpdateQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingQueue) > 0 {
+		for _, e := range m.UnbondingQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeregistrationQueue) > 0 {
+		for _, e := range m.DeregistrationQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyAliases) > 0 {
+		for _, e := range m.KeyAliases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProxyUsages) > 0 {
+		for _, e := range m.ProxyUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, b := range m.Denylist {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for _, b := range m.Allowlist {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeExchangeRates) > 0 {
+		for _, e := range m.FeeExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
 	return n
 }
@@ -430,16 +1001,583 @@ func (m *FeeUpdateQueueRecord) Size() (n int) {
 	if m.UpdateHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.UpdateHeight))
 	}
-	return n
-}
+	return n
+}
+
+func (m *UnbondingQueueRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataProxyPubkey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.CompletionHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.CompletionHeight))
+	}
+	return n
+}
+
+func (m *DeregistrationQueueRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataProxyPubkey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DeregistrationHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.DeregistrationHeight))
+	}
+	return n
+}
+
+func (m *KeyAliasRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldPubKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Alias.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ProxyUsageRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataProxyPubkey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Usage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProxyConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataProxyConfigs = append(m.DataProxyConfigs, DataProxyConfig{})
+			if err := m.DataProxyConfigs[len(m.DataProxyConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeUpdateQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeUpdateQueue = append(m.FeeUpdateQueue, FeeUpdateQueueRecord{})
+			if err := m.FeeUpdateQueue[len(m.FeeUpdateQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingQueue = append(m.UnbondingQueue, UnbondingQueueRecord{})
+			if err := m.UnbondingQueue[len(m.UnbondingQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeregistrationQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeregistrationQueue = append(m.DeregistrationQueue, DeregistrationQueueRecord{})
+			if err := m.DeregistrationQueue[len(m.DeregistrationQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyAliases = append(m.KeyAliases, KeyAliasRecord{})
+			if err := m.KeyAliases[len(m.KeyAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyUsages = append(m.ProxyUsages, ProxyUsageRecord{})
+			if err := m.ProxyUsages[len(m.ProxyUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, make([]byte, postIndex-iNdEx))
+			copy(m.Denylist[len(m.Denylist)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, make([]byte, postIndex-iNdEx))
+			copy(m.Allowlist[len(m.Allowlist)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeExchangeRates = append(m.FeeExchangeRates, FeeExchangeRate{})
+			if err := m.FeeExchangeRates[len(m.FeeExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataProxyConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataProxyConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataProxyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProxyPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataProxyPubkey = append(m.DataProxyPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataProxyPubkey == nil {
+				m.DataProxyPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &ProxyConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *FeeUpdateQueueRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -462,17 +1600,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeUpdateQueueRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeUpdateQueueRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProxyPubkey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -482,30 +1620,100 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataProxyPubkey = append(m.DataProxyPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataProxyPubkey == nil {
+				m.DataProxyPubkey = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateHeight", wireType)
+			}
+			m.UpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingQueueRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingQueueRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingQueueRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProxyConfigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProxyPubkey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -515,31 +1723,100 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataProxyConfigs = append(m.DataProxyConfigs, DataProxyConfig{})
-			if err := m.DataProxyConfigs[len(m.DataProxyConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataProxyPubkey = append(m.DataProxyPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataProxyPubkey == nil {
+				m.DataProxyPubkey = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
+			}
+			m.CompletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregistrationQueueRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregistrationQueueRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregistrationQueueRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeUpdateQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProxyPubkey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -549,26 +1826,45 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeUpdateQueue = append(m.FeeUpdateQueue, FeeUpdateQueueRecord{})
-			if err := m.FeeUpdateQueue[len(m.FeeUpdateQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataProxyPubkey = append(m.DataProxyPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataProxyPubkey == nil {
+				m.DataProxyPubkey = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeregistrationHeight", wireType)
+			}
+			m.DeregistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeregistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -590,7 +1886,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataProxyConfig) Unmarshal(dAtA []byte) error {
+func (m *KeyAliasRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -613,15 +1909,15 @@ func (m *DataProxyConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataProxyConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyAliasRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataProxyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyAliasRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProxyPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPubKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -648,14 +1944,14 @@ func (m *DataProxyConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataProxyPubkey = append(m.DataProxyPubkey[:0], dAtA[iNdEx:postIndex]...)
-			if m.DataProxyPubkey == nil {
-				m.DataProxyPubkey = []byte{}
+			m.OldPubKey = append(m.OldPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldPubKey == nil {
+				m.OldPubKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -682,10 +1978,7 @@ func (m *DataProxyConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &ProxyConfig{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Alias.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -710,7 +2003,7 @@ func (m *DataProxyConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeUpdateQueueRecord) Unmarshal(dAtA []byte) error {
+func (m *ProxyUsageRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -733,10 +2026,10 @@ func (m *FeeUpdateQueueRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeUpdateQueueRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProxyUsageRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeUpdateQueueRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProxyUsageRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -774,10 +2067,10 @@ func (m *FeeUpdateQueueRecord) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
-			m.UpdateHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -787,11 +2080,25 @@ func (m *FeeUpdateQueueRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
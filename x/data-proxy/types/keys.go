@@ -8,7 +8,15 @@ const (
 )
 
 var (
-	DataProxyConfigPrefix = collections.NewPrefix(0)
-	ParamsPrefix          = collections.NewPrefix(1)
-	FeeUpdatesPrefix      = collections.NewPrefix(2)
+	DataProxyConfigPrefix     = collections.NewPrefix(0)
+	ParamsPrefix              = collections.NewPrefix(1)
+	FeeUpdatesPrefix          = collections.NewPrefix(2)
+	UnbondingQueuePrefix      = collections.NewPrefix(3)
+	DeregistrationQueuePrefix = collections.NewPrefix(4)
+	KeyAliasPrefix            = collections.NewPrefix(5)
+	KeyAliasQueuePrefix       = collections.NewPrefix(6)
+	ProxyUsagePrefix          = collections.NewPrefix(7)
+	DenylistPrefix            = collections.NewPrefix(8)
+	AllowlistPrefix           = collections.NewPrefix(9)
+	FeeExchangeRatePrefix     = collections.NewPrefix(10)
 )
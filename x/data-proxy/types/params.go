@@ -10,25 +10,67 @@ import (
 )
 
 const (
-	MaxUpdatesPerBlock       int    = 25
-	DefaultMinFeeUpdateDelay uint32 = 73750 // Roughly 1 week with a ~8.2 sec block time
-	LowestFeeUpdateDelay     uint32 = 1
+	MaxUpdatesPerBlock         int    = 25
+	MaxUnbondingEntries        int    = 7
+	DefaultMinFeeUpdateDelay   uint32 = 73750 // Roughly 1 week with a ~8.2 sec block time
+	LowestFeeUpdateDelay       uint32 = 1
+	DefaultUnbondingPeriod     uint32 = 221250 // Roughly 3 weeks with a ~8.2 sec block time
+	LowestUnbondingPeriod      uint32 = 1
+	DefaultDeregistrationDelay uint32 = 73750 // Roughly 1 week with a ~8.2 sec block time
+	DefaultKeyAliasPeriod      uint32 = 10536 // Roughly 1 day with a ~8.2 sec block time
+	MaxAliasHops               int    = 8
 )
 
-var DefaultRegistrationFee = math.NewIntWithDecimal(50, 18) // (50)*10^(18) aseda
+var (
+	DefaultRegistrationFee = math.NewIntWithDecimal(50, 18)    // (50)*10^(18) aseda
+	DefaultMinBond         = math.NewIntWithDecimal(10000, 18) // (10000)*10^(18) aseda
+)
 
 // DefaultParams returns default data-proxy module parameters.
 func DefaultParams() Params {
 	return Params{
-		MinFeeUpdateDelay: DefaultMinFeeUpdateDelay,
-		RegistrationFee:   sdk.NewCoin(appparams.DefaultBondDenom, DefaultRegistrationFee),
+		MinFeeUpdateDelay:   DefaultMinFeeUpdateDelay,
+		RegistrationFee:     sdk.NewCoin(appparams.DefaultBondDenom, DefaultRegistrationFee),
+		MinBond:             sdk.NewCoin(appparams.DefaultBondDenom, DefaultMinBond),
+		UnbondingPeriod:     DefaultUnbondingPeriod,
+		DeregistrationDelay: DefaultDeregistrationDelay,
+		KeyAliasPeriod:      DefaultKeyAliasPeriod,
 	}
 }
 
+// RequiredBond returns the minimum bond of a data proxy, which is zero if
+// the MinBond parameter has not been set.
+func (p *Params) RequiredBond() sdk.Coin {
+	if p.MinBond.Denom == "" {
+		return sdk.NewCoin(appparams.DefaultBondDenom, math.ZeroInt())
+	}
+	return p.MinBond
+}
+
 // ValidateBasic performs basic validation on data-proxy module parameters.
 func (p *Params) Validate() error {
 	if p.MinFeeUpdateDelay < LowestFeeUpdateDelay {
 		return sdkerrors.ErrInvalidRequest.Wrapf("MinFeeUpdateDelay lower than %d < %d", p.MinFeeUpdateDelay, LowestFeeUpdateDelay)
 	}
+	if p.UnbondingPeriod < LowestUnbondingPeriod {
+		return sdkerrors.ErrInvalidRequest.Wrapf("UnbondingPeriod lower than %d < %d", p.UnbondingPeriod, LowestUnbondingPeriod)
+	}
+	if p.DeregistrationDelay == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("DeregistrationDelay must be positive")
+	}
+	if p.KeyAliasPeriod == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("KeyAliasPeriod must be positive")
+	}
+	if err := p.MinBond.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid MinBond: %s", err)
+	}
+	if p.MinBond.Denom != appparams.DefaultBondDenom {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid MinBond denomination: got %s, expected %s", p.MinBond.Denom, appparams.DefaultBondDenom)
+	}
+	if p.ExchangeRateOracle != "" {
+		if _, err := sdk.AccAddressFromBech32(p.ExchangeRateOracle); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid ExchangeRateOracle: %s", p.ExchangeRateOracle)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const MaxPayoutRecipients = 10
+
+// ValidatePayoutRecipients checks that the recipients have valid, unique
+// addresses and positive weights summing to exactly one.
+func ValidatePayoutRecipients(recipients []PayoutRecipient) error {
+	if len(recipients) > MaxPayoutRecipients {
+		return sdkerrors.ErrInvalidRequest.Wrapf("too many payout recipients; got: %d, max: %d", len(recipients), MaxPayoutRecipients)
+	}
+
+	seen := make(map[string]struct{}, len(recipients))
+	total := math.LegacyZeroDec()
+	for _, recipient := range recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid payout recipient address: %s", recipient.Address)
+		}
+		if _, ok := seen[recipient.Address]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate payout recipient: %s", recipient.Address)
+		}
+		seen[recipient.Address] = struct{}{}
+
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return sdkerrors.ErrInvalidRequest.Wrapf("payout recipient weight must be positive: %s", recipient.Address)
+		}
+		total = total.Add(recipient.Weight)
+	}
+
+	if len(recipients) > 0 && !total.Equal(math.LegacyOneDec()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("payout recipient weights must sum to 1, got %s", total)
+	}
+
+	return nil
+}
+
+// EffectivePayoutRecipients returns the weighted payout recipients of the data
+// proxy, defaulting to the payout address receiving all fees.
+func (p *ProxyConfig) EffectivePayoutRecipients() []PayoutRecipient {
+	if len(p.PayoutRecipients) == 0 {
+		return []PayoutRecipient{{Address: p.PayoutAddress, Weight: math.LegacyOneDec()}}
+	}
+	return p.PayoutRecipients
+}
+
+// HasPayoutRecipient returns true if the given address receives a share of
+// the fees paid to the data proxy.
+func (p *ProxyConfig) HasPayoutRecipient(address string) bool {
+	for _, recipient := range p.EffectivePayoutRecipients() {
+		if recipient.Address == address {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitPayout splits the given amount according to the weights of the
+// recipients. Each share is truncated and the remainder goes to the first
+// recipient, so the shares always sum to the given amount.
+func SplitPayout(amount math.Int, recipients []PayoutRecipient) []math.Int {
+	shares := make([]math.Int, len(recipients))
+	if len(recipients) == 0 {
+		return shares
+	}
+
+	remainder := amount
+	for i := 1; i < len(recipients); i++ {
+		shares[i] = recipients[i].Weight.MulInt(amount).TruncateInt()
+		remainder = remainder.Sub(shares[i])
+	}
+	shares[0] = remainder
+
+	return shares
+}
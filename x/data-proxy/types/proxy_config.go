@@ -1,6 +1,13 @@
 package types
 
-import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	appparams "github.com/sedaprotocol/seda-chain/app/params"
+)
 
 const (
 	MaxMemoLength    = 3000
@@ -21,12 +28,37 @@ func (p *ProxyConfig) Validate() error {
 		return err
 	}
 
+	if err := ValidatePayoutRecipients(p.PayoutRecipients); err != nil {
+		return err
+	}
+	if len(p.PayoutRecipients) > 0 && p.PayoutRecipients[0].Address != p.PayoutAddress {
+		return sdkerrors.ErrInvalidRequest.Wrap("first payout recipient must be the payout address")
+	}
+
+	if err := ValidateFeeSchedule(p.FeeSchedule); err != nil {
+		return err
+	}
+	if p.FeeUpdate != nil {
+		if err := ValidateFeeSchedule(p.FeeUpdate.NewFeeSchedule); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (p *ProxyConfig) UpdateBasic(payoutAddress string, memo string) error {
+// UpdateBasic applies the instant config updates. A new payout address
+// replaces any weighted payout split, while new payout recipients also update
+// the payout address to the first recipient.
+func (p *ProxyConfig) UpdateBasic(payoutAddress string, memo string, payoutRecipients []PayoutRecipient) error {
 	if payoutAddress != DoNotModifyField {
 		p.PayoutAddress = payoutAddress
+		p.PayoutRecipients = nil
+	}
+
+	if len(payoutRecipients) > 0 {
+		p.PayoutRecipients = payoutRecipients
+		p.PayoutAddress = payoutRecipients[0].Address
 	}
 
 	if memo != DoNotModifyField {
@@ -35,3 +67,48 @@ func (p *ProxyConfig) UpdateBasic(payoutAddress string, memo string) error {
 
 	return p.Validate()
 }
+
+// BondedAmount returns the bond of the data proxy, or a zero coin when the
+// data proxy has not bonded any funds.
+func (p *ProxyConfig) BondedAmount() sdk.Coin {
+	if p.Bond == nil {
+		return sdk.NewInt64Coin(appparams.DefaultBondDenom, 0)
+	}
+	return *p.Bond
+}
+
+// IsDeregistered returns true if the data proxy is no longer active at the
+// given height.
+func (p *ProxyConfig) IsDeregistered(height int64) bool {
+	return p.DeregistrationHeight != 0 && height >= p.DeregistrationHeight
+}
+
+// RegistrationPayload returns the payload a data proxy signs to register with
+// the given config values.
+func RegistrationPayload(fee, adminAddress, payoutAddress, memo, chainID string) []byte {
+	payload := make([]byte, 0, len(fee)+len(adminAddress)+len(payoutAddress)+len(memo)+len(chainID))
+
+	payload = append(payload, []byte(fee)...)
+	payload = append(payload, []byte(adminAddress)...)
+	payload = append(payload, []byte(payoutAddress)...)
+	payload = append(payload, []byte(memo)...)
+	payload = append(payload, []byte(chainID)...)
+
+	return payload
+}
+
+// KeyRotationPayload returns the payload both the current and the new public
+// key sign to rotate the key of a data proxy. It is the registration payload
+// of the current config followed by the new public key.
+func KeyRotationPayload(config ProxyConfig, chainID string, newPubKey []byte) []byte {
+	payload := RegistrationPayload(config.Fee.String(), config.AdminAddress, config.PayoutAddress, config.Memo, chainID)
+	return append(payload, newPubKey...)
+}
+
+// NewProxyUsage returns the usage of a data proxy that has not been paid yet.
+func NewProxyUsage() ProxyUsage {
+	return ProxyUsage{
+		GasUsed:    math.ZeroInt(),
+		FeesEarned: sdk.NewCoins(),
+	}
+}
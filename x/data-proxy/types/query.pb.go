@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -120,22 +122,33 @@ func (m *QueryDataProxyConfigResponse) GetConfig() *ProxyConfig {
 	return nil
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// The request message for QueryDataProxyConfigs RPC method.
+type QueryDataProxyConfigsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// admin_address, if set, only returns data proxies with the given admin
+	// address.
+	AdminAddress string `protobuf:"bytes,2,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
+	// payout_address, if set, only returns data proxies with the given payout
+	// address or that have it among their payout recipients.
+	PayoutAddress string `protobuf:"bytes,3,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
+	// has_pending_fee_update, if set, only returns data proxies with a pending
+	// fee update.
+	HasPendingFeeUpdate bool `protobuf:"varint,4,opt,name=has_pending_fee_update,json=hasPendingFeeUpdate,proto3" json:"has_pending_fee_update,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDataProxyConfigsRequest) Reset()         { *m = QueryDataProxyConfigsRequest{} }
+func (m *QueryDataProxyConfigsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataProxyConfigsRequest) ProtoMessage()    {}
+func (*QueryDataProxyConfigsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d79d61d1e1527bbf, []int{2}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDataProxyConfigsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDataProxyConfigsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDataProxyConfigsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -145,36 +158,64 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryDataProxyConfigsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataProxyConfigsRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryDataProxyConfigsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryDataProxyConfigsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataProxyConfigsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDataProxyConfigsRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params defines the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryDataProxyConfigsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDataProxyConfigsRequest) GetAdminAddress() string {
+	if m != nil {
+		return m.AdminAddress
+	}
+	return ""
+}
+
+func (m *QueryDataProxyConfigsRequest) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
+	}
+	return ""
+}
+
+func (m *QueryDataProxyConfigsRequest) GetHasPendingFeeUpdate() bool {
+	if m != nil {
+		return m.HasPendingFeeUpdate
+	}
+	return false
+}
+
+// The response message for QueryDataProxyConfigs RPC method.
+type QueryDataProxyConfigsResponse struct {
+	Configs    []DataProxyConfigEntry `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDataProxyConfigsResponse) Reset()         { *m = QueryDataProxyConfigsResponse{} }
+func (m *QueryDataProxyConfigsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataProxyConfigsResponse) ProtoMessage()    {}
+func (*QueryDataProxyConfigsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d79d61d1e1527bbf, []int{3}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDataProxyConfigsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDataProxyConfigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDataProxyConfigsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -184,374 +225,3136 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryDataProxyConfigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataProxyConfigsResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryDataProxyConfigsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryDataProxyConfigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataProxyConfigsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDataProxyConfigsResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryDataProxyConfigsResponse) GetConfigs() []DataProxyConfigEntry {
 	if m != nil {
-		return m.Params
+		return m.Configs
 	}
-	return Params{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryDataProxyConfigRequest)(nil), "sedachain.data_proxy.v1.QueryDataProxyConfigRequest")
-	proto.RegisterType((*QueryDataProxyConfigResponse)(nil), "sedachain.data_proxy.v1.QueryDataProxyConfigResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "sedachain.data_proxy.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "sedachain.data_proxy.v1.QueryParamsResponse")
+func (m *QueryDataProxyConfigsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("sedachain/data_proxy/v1/query.proto", fileDescriptor_d79d61d1e1527bbf)
+// DataProxyConfigEntry is a data proxy config along with the public key of
+// the data proxy.
+type DataProxyConfigEntry struct {
+	// A hex encoded string of the public key of the data proxy.
+	PubKey string      `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Config ProxyConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
 }
 
-var fileDescriptor_d79d61d1e1527bbf = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x4e, 0x4d, 0x49,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x49, 0x2c, 0x49, 0x8c, 0x2f, 0x28, 0xca, 0xaf, 0xa8,
-	0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x87, 0x2b, 0xd2, 0x43, 0x28, 0xd2, 0x2b, 0x33, 0x94, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf,
-	0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf,
-	0x2b, 0x86, 0x68, 0x93, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8,
-	0x06, 0x2e, 0x1b, 0x91, 0x8c, 0x06, 0xab, 0x54, 0x32, 0xe3, 0x92, 0x0e, 0x04, 0xb9, 0xc2, 0x25,
-	0xb1, 0x24, 0x31, 0x00, 0x24, 0xee, 0x9c, 0x9f, 0x97, 0x96, 0x99, 0x1e, 0x94, 0x5a, 0x58, 0x9a,
-	0x5a, 0x5c, 0x22, 0x24, 0xce, 0xc5, 0x5e, 0x50, 0x9a, 0x14, 0x9f, 0x9d, 0x5a, 0x29, 0xc1, 0xa8,
-	0xc0, 0xa8, 0xc1, 0x19, 0xc4, 0x56, 0x50, 0x9a, 0xe4, 0x9d, 0x5a, 0xa9, 0x14, 0xc3, 0x25, 0x83,
-	0x5d, 0x5f, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x0d, 0x17, 0x5b, 0x32, 0x58, 0x04, 0xac,
-	0x8f, 0xdb, 0x48, 0x45, 0x0f, 0x87, 0xff, 0xf4, 0x90, 0x75, 0x43, 0xf5, 0x28, 0x89, 0x70, 0x09,
-	0x81, 0x4d, 0x0f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x86, 0x3a, 0x46, 0x29, 0x84, 0x4b, 0x18, 0x45,
-	0x14, 0x6a, 0x95, 0x2d, 0x17, 0x5b, 0x01, 0x58, 0x04, 0x6a, 0x95, 0x3c, 0x6e, 0xab, 0xc0, 0xca,
-	0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x6a, 0x32, 0xba, 0xc8, 0xc4, 0xc5, 0x0a, 0x36,
-	0x56, 0x68, 0x17, 0x23, 0x17, 0x3f, 0x9a, 0x7f, 0x84, 0x4c, 0x70, 0x1a, 0x86, 0x27, 0xd8, 0xa4,
-	0x4c, 0x49, 0xd4, 0x05, 0xf1, 0x89, 0x92, 0x55, 0xd3, 0xe5, 0x27, 0x93, 0x99, 0x4c, 0x84, 0x8c,
-	0xf4, 0x41, 0xda, 0x75, 0x11, 0x11, 0xa8, 0x0b, 0x89, 0x40, 0x84, 0x51, 0xf1, 0x90, 0x80, 0xd2,
-	0xaf, 0x86, 0xc6, 0x4e, 0xad, 0x50, 0x17, 0x23, 0x17, 0x1b, 0xc4, 0x7f, 0x42, 0xda, 0xf8, 0x6d,
-	0x47, 0x09, 0x54, 0x29, 0x1d, 0xe2, 0x14, 0x43, 0x5d, 0xa8, 0x0a, 0x76, 0xa1, 0xbc, 0x90, 0x2c,
-	0x0e, 0x17, 0x42, 0xc2, 0xd4, 0xc9, 0xff, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
-	0xa2, 0x4c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xc1, 0x46, 0x80, 0x53,
-	0x61, 0x72, 0x7e, 0x0e, 0xb2, 0x79, 0x15, 0xc8, 0x26, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1,
-	0x81, 0xd5, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8b, 0x92, 0x18, 0xb5, 0x4b, 0x03, 0x00,
-	0x00,
+func (m *DataProxyConfigEntry) Reset()         { *m = DataProxyConfigEntry{} }
+func (m *DataProxyConfigEntry) String() string { return proto.CompactTextString(m) }
+func (*DataProxyConfigEntry) ProtoMessage()    {}
+func (*DataProxyConfigEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d79d61d1e1527bbf, []int{4}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// DataProxyConfig returns a data proxy config when given its public key as a
-	// hex encoded string.
-	DataProxyConfig(ctx context.Context, in *QueryDataProxyConfigRequest, opts ...grpc.CallOption) (*QueryDataProxyConfigResponse, error)
-	// Params returns the total set of data proxy parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *DataProxyConfigEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *DataProxyConfigEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataProxyConfigEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *DataProxyConfigEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataProxyConfigEntry.Merge(m, src)
+}
+func (m *DataProxyConfigEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataProxyConfigEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataProxyConfigEntry.DiscardUnknown(m)
 }
 
-func (c *queryClient) DataProxyConfig(ctx context.Context, in *QueryDataProxyConfigRequest, opts ...grpc.CallOption) (*QueryDataProxyConfigResponse, error) {
-	out := new(QueryDataProxyConfigResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Query/DataProxyConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DataProxyConfigEntry proto.InternalMessageInfo
+
+func (m *DataProxyConfigEntry) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DataProxyConfigEntry) GetConfig() ProxyConfig {
+	if m != nil {
+		return m.Config
 	}
-	return out, nil
+	return ProxyConfig{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// DataProxyConfig returns a data proxy config when given its public key as a
-	// hex encoded string.
-	DataProxyConfig(context.Context, *QueryDataProxyConfigRequest) (*QueryDataProxyConfigResponse, error)
-	// Params returns the total set of data proxy parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// The request message for QueryPendingFeeUpdates RPC method.
+type QueryPendingFeeUpdatesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryPendingFeeUpdatesRequest) Reset()         { *m = QueryPendingFeeUpdatesRequest{} }
+func (m *QueryPendingFeeUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingFeeUpdatesRequest) ProtoMessage()    {}
+func (*QueryPendingFeeUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d79d61d1e1527bbf, []int{5}
 }
-
-func (*UnimplementedQueryServer) DataProxyConfig(ctx context.Context, req *QueryDataProxyConfigRequest) (*QueryDataProxyConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DataProxyConfig not implemented")
+func (m *QueryPendingFeeUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPendingFeeUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingFeeUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryPendingFeeUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingFeeUpdatesRequest.Merge(m, src)
 }
-
-func _Query_DataProxyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDataProxyConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DataProxyConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.data_proxy.v1.Query/DataProxyConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DataProxyConfig(ctx, req.(*QueryDataProxyConfigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPendingFeeUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingFeeUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingFeeUpdatesRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.data_proxy.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryPendingFeeUpdatesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingFeeUpdatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sedachain.data_proxy.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "DataProxyConfig",
-			Handler:    _Query_DataProxyConfig_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sedachain/data_proxy/v1/query.proto",
+// The response message for QueryPendingFeeUpdates RPC method.
+type QueryPendingFeeUpdatesResponse struct {
+	FeeUpdates []PendingFeeUpdate  `protobuf:"bytes,1,rep,name=fee_updates,json=feeUpdates,proto3" json:"fee_updates"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDataProxyConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingFeeUpdatesResponse) Reset()         { *m = QueryPendingFeeUpdatesResponse{} }
+func (m *QueryPendingFeeUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingFeeUpdatesResponse) ProtoMessage()    {}
+func (*QueryPendingFeeUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d79d61d1e1527bbf, []int{6}
+}
+func (m *QueryPendingFeeUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingFeeUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingFeeUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryDataProxyConfigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingFeeUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingFeeUpdatesResponse.Merge(m, src)
+}
+func (m *QueryPendingFeeUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingFeeUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingFeeUpdatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDataProxyConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryPendingFeeUpdatesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingFeeUpdatesResponse) GetFeeUpdates() []PendingFeeUpdate {
+	if m != nil {
+		return m.FeeUpdates
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDataProxyConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingFeeUpdatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDataProxyConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PendingFeeUpdate is a fee update of a data proxy that has yet to come
+// into effect.
+type PendingFeeUpdate struct {
+	// A hex encoded string of the public key of the data proxy.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// update_height is the height after which the new fee comes into effect.
+	UpdateHeight int64 `protobuf:"varint,2,opt,name=update_height,json=updateHeight,proto3" json:"update_height,omitempty"`
+	// current_fee is the fee currently charged by the data proxy.
+	CurrentFee *types.Coin `protobuf:"bytes,3,opt,name=current_fee,json=currentFee,proto3" json:"current_fee,omitempty"`
+	// new_fee is the fee charged by the data proxy after the update.
+	NewFee *types.Coin `protobuf:"bytes,4,opt,name=new_fee,json=newFee,proto3" json:"new_fee,omitempty"`
+	// new_fee_schedule is the fee schedule of the data proxy after the update.
+	NewFeeSchedule []FeeScheduleEntry `protobuf:"bytes,5,rep,name=new_fee_schedule,json=newFeeSchedule,proto3" json:"new_fee_schedule"`
 }
 
-func (m *QueryDataProxyConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Config != nil {
-		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *PendingFeeUpdate) Reset()         { *m = PendingFeeUpdate{} }
+func (m *PendingFeeUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingFeeUpdate) ProtoMessage()    {}
+func (*PendingFeeUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d79d61d1e1527bbf, []int{7}
+}
+func (m *PendingFeeUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingFeeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingFeeUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PendingFeeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingFeeUpdate.Merge(m, src)
+}
+func (m *PendingFeeUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingFeeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingFeeUpdate.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PendingFeeUpdate proto.InternalMessageInfo
+
+func (m *PendingFeeUpdate) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingFeeUpdate) GetUpdateHeight() int64 {
+	if m != nil {
+		return m.UpdateHeight
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *PendingFeeUpdate) GetCurrentFee() *types.Coin {
+	if m != nil {
+		return m.CurrentFee
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingFeeUpdate) GetNewFee() *types.Coin {
+	if m != nil {
+		return m.NewFee
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingFeeUpdate) GetNewFeeSchedule() []FeeScheduleEntry {
+	if m != nil {
+		return m.NewFeeSchedule
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// The request message for QueryDataProxyUsage RPC method.
+type QueryDataProxyUsageRequest struct {
+	// A hex encoded string of the public key of the data proxy.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *QueryDataProxyUsageRequest) Reset()         { *m = QueryDataProxyUsageRequest{} }
+func (m *QueryDataProxyUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataProxyUsageRequest) ProtoMessage()    {}
+func (*QueryDataProxyUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d79d61d1e1527bbf, []int{8}
+}
+func (m *QueryDataProxyUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataProxyUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataProxyUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDataProxyUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataProxyUsageRequest.Merge(m, src)
+}
+func (m *QueryDataProxyUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataProxyUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataProxyUsageRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryDataProxyUsageRequest proto.InternalMessageInfo
+
+func (m *QueryDataProxyUsageRequest) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryDataProxyConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// The response message for QueryDataProxyUsage RPC method.
+type QueryDataProxyUsageResponse struct {
+	Usage ProxyUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *QueryDataProxyUsageResponse) Reset()         { *m = QueryDataProxyUsageResponse{} }
+func (m *QueryDataProxyUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataProxyUsageResponse) ProtoMessage()    {}
+func (*QueryDataProxyUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d79d61d1e1527bbf, []int{9}
+}
+func (m *QueryDataProxyUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataProxyUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataProxyUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDataProxyUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataProxyUsageResponse.Merge(m, src)
+}
+func (m *QueryDataProxyUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataProxyUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataProxyUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataProxyUsageResponse proto.InternalMessageInfo
+
+func (m *QueryDataProxyUsageResponse) GetUsage() ProxyUsage {
+	if m != nil {
+		return m.Usage
 	}
-	return n
+	return ProxyUsage{}
 }
 
-func (m *QueryDataProxyConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// The request message for QueryDataProxyAccessLists RPC method.
+type QueryDataProxyAccessListsRequest struct {
+}
+
+func (m *QueryDataProxyAccessListsRequest) Reset()         { *m = QueryDataProxyAccessListsRequest{} }
+func (m *QueryDataProxyAccessListsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataProxyAccessListsRequest) ProtoMessage()    {}
+func (*QueryDataProxyAccessListsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d79d61d1e1527bbf, []int{10}
+}
+func (m *QueryDataProxyAccessListsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataProxyAccessListsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataProxyAccessListsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDataProxyAccessListsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataProxyAccessListsRequest.Merge(m, src)
+}
+func (m *QueryDataProxyAccessListsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataProxyAccessListsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataProxyAccessListsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataProxyAccessListsRequest proto.InternalMessageInfo
+
+// The response message for QueryDataProxyAccessLists RPC method.
+type QueryDataProxyAccessListsResponse struct {
+	// Hex encoded public keys of the denied data proxies.
+	Denylist []string `protobuf:"bytes,1,rep,name=denylist,proto3" json:"denylist,omitempty"`
+	// Hex encoded public keys of the allowed data proxies.
+	Allowlist []string `protobuf:"bytes,2,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// allowlist_enabled indicates whether only allowed data proxies are paid.
+	AllowlistEnabled bool `protobuf:"varint,3,opt,name=allowlist_enabled,json=allowlistEnabled,proto3" json:"allowlist_enabled,omitempty"`
+}
+
+func (m *QueryDataProxyAccessListsResponse) Reset()         { *m = QueryDataProxyAccessListsResponse{} }
+func (m *QueryDataProxyAccessListsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataProxyAccessListsResponse) ProtoMessage()    {}
+func (*QueryDataProxyAccessListsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d79d61d1e1527bbf, []int{11}
+}
+func (m *QueryDataProxyAccessListsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataProxyAccessListsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataProxyAccessListsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataProxyAccessListsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataProxyAccessListsResponse.Merge(m, src)
+}
+func (m *QueryDataProxyAccessListsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataProxyAccessListsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataProxyAccessListsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataProxyAccessListsResponse proto.InternalMessageInfo
+
+func (m *QueryDataProxyAccessListsResponse) GetDenylist() []string {
+	if m != nil {
+		return m.Denylist
+	}
+	return nil
+}
+
+func (m *QueryDataProxyAccessListsResponse) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *QueryDataProxyAccessListsResponse) GetAllowlistEnabled() bool {
+	if m != nil {
+		return m.AllowlistEnabled
+	}
+	return false
+}
+
+// The request message for QueryFeeExchangeRates RPC method.
+type QueryFeeExchangeRatesRequest struct {
+}
+
+func (m *QueryFeeExchangeRatesRequest) Reset()         { *m = QueryFeeExchangeRatesRequest{} }
+func (m *QueryFeeExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeExchangeRatesRequest) ProtoMessage()    {}
+func (*QueryFeeExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d79d61d1e1527bbf, []int{12}
+}
+func (m *QueryFeeExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeExchangeRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeExchangeRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeExchangeRatesRequest.Merge(m, src)
+}
+func (m *QueryFeeExchangeRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeExchangeRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeExchangeRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeExchangeRatesRequest proto.InternalMessageInfo
+
+// The response message for QueryFeeExchangeRates RPC method.
+type QueryFeeExchangeRatesResponse struct {
+	Rates []FeeExchangeRate `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates"`
+}
+
+func (m *QueryFeeExchangeRatesResponse) Reset()         { *m = QueryFeeExchangeRatesResponse{} }
+func (m *QueryFeeExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeExchangeRatesResponse) ProtoMessage()    {}
+func (*QueryFeeExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d79d61d1e1527bbf, []int{13}
+}
+func (m *QueryFeeExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeExchangeRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeExchangeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeExchangeRatesResponse.Merge(m, src)
+}
+func (m *QueryFeeExchangeRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeExchangeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeExchangeRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeExchangeRatesResponse proto.InternalMessageInfo
+
+func (m *QueryFeeExchangeRatesResponse) GetRates() []FeeExchangeRate {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d79d61d1e1527bbf, []int{14}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d79d61d1e1527bbf, []int{15}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryDataProxyConfigRequest)(nil), "sedachain.data_proxy.v1.QueryDataProxyConfigRequest")
+	proto.RegisterType((*QueryDataProxyConfigResponse)(nil), "sedachain.data_proxy.v1.QueryDataProxyConfigResponse")
+	proto.RegisterType((*QueryDataProxyConfigsRequest)(nil), "sedachain.data_proxy.v1.QueryDataProxyConfigsRequest")
+	proto.RegisterType((*QueryDataProxyConfigsResponse)(nil), "sedachain.data_proxy.v1.QueryDataProxyConfigsResponse")
+	proto.RegisterType((*DataProxyConfigEntry)(nil), "sedachain.data_proxy.v1.DataProxyConfigEntry")
+	proto.RegisterType((*QueryPendingFeeUpdatesRequest)(nil), "sedachain.data_proxy.v1.QueryPendingFeeUpdatesRequest")
+	proto.RegisterType((*QueryPendingFeeUpdatesResponse)(nil), "sedachain.data_proxy.v1.QueryPendingFeeUpdatesResponse")
+	proto.RegisterType((*PendingFeeUpdate)(nil), "sedachain.data_proxy.v1.PendingFeeUpdate")
+	proto.RegisterType((*QueryDataProxyUsageRequest)(nil), "sedachain.data_proxy.v1.QueryDataProxyUsageRequest")
+	proto.RegisterType((*QueryDataProxyUsageResponse)(nil), "sedachain.data_proxy.v1.QueryDataProxyUsageResponse")
+	proto.RegisterType((*QueryDataProxyAccessListsRequest)(nil), "sedachain.data_proxy.v1.QueryDataProxyAccessListsRequest")
+	proto.RegisterType((*QueryDataProxyAccessListsResponse)(nil), "sedachain.data_proxy.v1.QueryDataProxyAccessListsResponse")
+	proto.RegisterType((*QueryFeeExchangeRatesRequest)(nil), "sedachain.data_proxy.v1.QueryFeeExchangeRatesRequest")
+	proto.RegisterType((*QueryFeeExchangeRatesResponse)(nil), "sedachain.data_proxy.v1.QueryFeeExchangeRatesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "sedachain.data_proxy.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "sedachain.data_proxy.v1.QueryParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("sedachain/data_proxy/v1/query.proto", fileDescriptor_d79d61d1e1527bbf)
+}
+
+var fileDescriptor_d79d61d1e1527bbf = []byte{
+	// 1069 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0xd3, 0x36, 0x6d, 0x5f, 0xb7, 0xa5, 0x3b, 0x5b, 0xb1, 0xc5, 0x74, 0xd3, 0xe2, 0x52,
+	0xc8, 0x6e, 0xb7, 0xb6, 0x92, 0xb6, 0x8b, 0xb6, 0x02, 0xa1, 0xfd, 0xd1, 0x80, 0x04, 0x88, 0x62,
+	0xd8, 0x03, 0x08, 0x61, 0x4d, 0xec, 0x57, 0xc7, 0x22, 0xb5, 0xbd, 0x19, 0xbb, 0x6d, 0x84, 0xb8,
+	0x70, 0xdc, 0x13, 0x12, 0x47, 0xfe, 0x0d, 0x04, 0x68, 0xf9, 0x07, 0xf6, 0xb8, 0x12, 0x17, 0x4e,
+	0x08, 0xb5, 0x48, 0x5c, 0xf9, 0x13, 0x90, 0x67, 0xc6, 0x89, 0x93, 0xd6, 0x4d, 0x82, 0xf6, 0x16,
+	0xbf, 0x79, 0xdf, 0x9b, 0x6f, 0xde, 0xfb, 0xe6, 0x9b, 0x16, 0xd6, 0x18, 0x3a, 0xd4, 0x6e, 0x50,
+	0xcf, 0x37, 0x1c, 0x1a, 0x51, 0x2b, 0x6c, 0x05, 0x27, 0x6d, 0xe3, 0xa8, 0x62, 0x3c, 0x8e, 0xb1,
+	0xd5, 0xd6, 0xc3, 0x56, 0x10, 0x05, 0xe4, 0x7a, 0x27, 0x49, 0xef, 0x26, 0xe9, 0x47, 0x15, 0x75,
+	0xd9, 0x0d, 0x02, 0xb7, 0x89, 0x06, 0x0d, 0x3d, 0x83, 0xfa, 0x7e, 0x10, 0xd1, 0xc8, 0x0b, 0x7c,
+	0x26, 0x60, 0xea, 0xa2, 0x1b, 0xb8, 0x01, 0xff, 0x69, 0x24, 0xbf, 0x64, 0xf4, 0x96, 0x1d, 0xb0,
+	0xc3, 0x80, 0x19, 0x75, 0xca, 0x50, 0xec, 0x62, 0x1c, 0x55, 0xea, 0x18, 0xd1, 0x8a, 0x11, 0x52,
+	0xd7, 0xf3, 0x79, 0x09, 0x99, 0x5b, 0xca, 0xe6, 0xa6, 0x59, 0x76, 0xe0, 0xa5, 0xeb, 0xe5, 0x3c,
+	0xf6, 0x19, 0x9a, 0x3c, 0x53, 0xbb, 0x03, 0xaf, 0x7e, 0x92, 0xec, 0xf5, 0x90, 0x46, 0x74, 0x3f,
+	0x89, 0x3f, 0x08, 0xfc, 0x03, 0xcf, 0x35, 0xf1, 0x71, 0x8c, 0x2c, 0x22, 0xd7, 0x61, 0x2a, 0x8c,
+	0xeb, 0xd6, 0xd7, 0xd8, 0x5e, 0x52, 0x56, 0x95, 0xf2, 0x8c, 0x59, 0x0c, 0xe3, 0xfa, 0x07, 0xd8,
+	0xd6, 0xbe, 0x84, 0xe5, 0x8b, 0x71, 0x2c, 0x0c, 0x7c, 0x86, 0xe4, 0x6d, 0x28, 0xda, 0x3c, 0xc2,
+	0x71, 0xb3, 0xd5, 0xd7, 0xf5, 0x9c, 0x5e, 0xe9, 0x59, 0xb4, 0xc4, 0x68, 0xff, 0x28, 0x17, 0x97,
+	0x67, 0x29, 0xaf, 0x1a, 0x40, 0xb7, 0x29, 0x72, 0x8b, 0x37, 0x74, 0xd1, 0x15, 0x3d, 0xe9, 0x8a,
+	0x2e, 0xe6, 0x24, 0x7b, 0xa3, 0xef, 0x53, 0x17, 0x25, 0xd6, 0xcc, 0x20, 0xc9, 0x1a, 0xcc, 0x51,
+	0xe7, 0xd0, 0xf3, 0x2d, 0xea, 0x38, 0x2d, 0x64, 0x6c, 0xa9, 0xc0, 0x4f, 0x79, 0x85, 0x07, 0xef,
+	0x89, 0x18, 0x59, 0x87, 0xf9, 0x90, 0xb6, 0x83, 0x38, 0xea, 0x64, 0x8d, 0xf3, 0xac, 0x39, 0x11,
+	0x4d, 0xd3, 0xb6, 0xe0, 0xe5, 0x06, 0x65, 0x56, 0x88, 0xbe, 0xe3, 0xf9, 0xae, 0x75, 0x80, 0x68,
+	0xc5, 0xa1, 0x43, 0x23, 0x5c, 0x9a, 0x58, 0x55, 0xca, 0xd3, 0xe6, 0xb5, 0x06, 0x65, 0xfb, 0x62,
+	0xb1, 0x86, 0xf8, 0x88, 0x2f, 0x69, 0xbf, 0x28, 0x70, 0x23, 0xe7, 0xa4, 0xb2, 0x93, 0x1f, 0xc1,
+	0x94, 0xe8, 0x0a, 0x5b, 0x52, 0x56, 0xc7, 0xcb, 0xb3, 0xd5, 0xcd, 0xdc, 0x56, 0xf6, 0xd5, 0xd8,
+	0xf3, 0xa3, 0x56, 0xfb, 0xfe, 0xc4, 0xb3, 0x3f, 0x57, 0xc6, 0xcc, 0xb4, 0x06, 0x79, 0xaf, 0xa7,
+	0x73, 0x05, 0xde, 0xb9, 0x37, 0x07, 0x76, 0x4e, 0x70, 0xc9, 0xb6, 0x4e, 0x63, 0xb0, 0x78, 0xd1,
+	0x7e, 0xb9, 0x92, 0x21, 0xf7, 0x3b, 0x92, 0x28, 0x0c, 0x2f, 0x09, 0x49, 0x3f, 0x15, 0x86, 0x2b,
+	0xbb, 0xd5, 0xdf, 0xc7, 0x17, 0x2d, 0x0c, 0xed, 0x37, 0x05, 0x4a, 0x79, 0x3b, 0xc9, 0xc1, 0xec,
+	0xc3, 0x6c, 0x77, 0xc6, 0xe9, 0x70, 0x6e, 0xe6, 0x1f, 0xaa, 0xaf, 0x90, 0x3c, 0x19, 0x1c, 0x74,
+	0x2a, 0xbf, 0xb8, 0xd9, 0xfc, 0x58, 0x80, 0x85, 0xfe, 0xfd, 0xf2, 0x07, 0xb3, 0x06, 0x73, 0xe2,
+	0x10, 0x56, 0x03, 0x3d, 0xb7, 0x11, 0xf1, 0x9d, 0xc7, 0xcd, 0x2b, 0x22, 0xf8, 0x3e, 0x8f, 0x91,
+	0x5d, 0x98, 0xb5, 0xe3, 0x56, 0x0b, 0xfd, 0x28, 0x51, 0x36, 0xbf, 0x01, 0xb3, 0xd5, 0x57, 0x7a,
+	0xc8, 0xa5, 0xb4, 0x1e, 0x04, 0x9e, 0x6f, 0x82, 0xcc, 0xae, 0x21, 0x92, 0x2a, 0x4c, 0xf9, 0x78,
+	0xcc, 0x71, 0x13, 0x83, 0x70, 0x45, 0x1f, 0x8f, 0x13, 0xcc, 0xe7, 0xb0, 0x20, 0x31, 0x16, 0xb3,
+	0x1b, 0xe8, 0xc4, 0x4d, 0x5c, 0x9a, 0x1c, 0xd0, 0xe2, 0x1a, 0xe2, 0xa7, 0x32, 0x37, 0xab, 0xfd,
+	0x79, 0x51, 0x32, 0x5d, 0xd2, 0x76, 0x40, 0xed, 0xbd, 0x72, 0x8f, 0x58, 0x57, 0x05, 0xf9, 0x96,
+	0xf7, 0x55, 0xbf, 0x55, 0x4a, 0x98, 0x94, 0xc3, 0xbb, 0x30, 0x19, 0x27, 0x01, 0x29, 0xba, 0xb5,
+	0xcb, 0xd5, 0xcd, 0xb1, 0x92, 0x9f, 0xc0, 0x69, 0x1a, 0xac, 0xf6, 0xd6, 0xbf, 0x67, 0xdb, 0xc8,
+	0xd8, 0x87, 0x1e, 0x8b, 0x52, 0x79, 0x6b, 0x4f, 0x14, 0x78, 0xed, 0x92, 0x24, 0x49, 0x45, 0x85,
+	0x69, 0x07, 0xfd, 0x76, 0xd3, 0x63, 0x11, 0x97, 0xe5, 0x8c, 0xd9, 0xf9, 0x26, 0xcb, 0x30, 0x43,
+	0x9b, 0xcd, 0xe0, 0x98, 0x2f, 0x16, 0xf8, 0x62, 0x37, 0x40, 0x36, 0xe0, 0x6a, 0xe7, 0xc3, 0x42,
+	0x9f, 0xd6, 0x9b, 0xe8, 0xf0, 0x59, 0x4f, 0x9b, 0x0b, 0x9d, 0x85, 0x3d, 0x11, 0xd7, 0x4a, 0xd2,
+	0xa4, 0x6b, 0x88, 0x7b, 0x27, 0x76, 0x83, 0xfa, 0x2e, 0x9a, 0x99, 0xbb, 0xa8, 0xa1, 0xbc, 0xac,
+	0xe7, 0xd7, 0x25, 0xcf, 0x87, 0x30, 0xd9, 0xca, 0xdc, 0x9d, 0xf2, 0x65, 0x83, 0xcd, 0x56, 0x48,
+	0xfb, 0xc6, 0xc1, 0xda, 0x22, 0x10, 0x71, 0x53, 0x69, 0x8b, 0x1e, 0x76, 0x36, 0xff, 0x0c, 0xae,
+	0xf5, 0x44, 0xe5, 0x96, 0xef, 0x40, 0x31, 0xe4, 0x11, 0x39, 0xa6, 0x95, 0xfc, 0x31, 0xf1, 0xb4,
+	0xd4, 0x7f, 0x04, 0xa8, 0xfa, 0xef, 0x0c, 0x4c, 0xf2, 0xb2, 0xe4, 0xa9, 0x02, 0x2f, 0xf5, 0xf9,
+	0x1f, 0xd9, 0xce, 0x2d, 0x76, 0xc9, 0x1b, 0xab, 0xee, 0x8c, 0x88, 0x12, 0x27, 0xd1, 0x76, 0xbf,
+	0xfb, 0xfd, 0xef, 0x1f, 0x0a, 0xdb, 0xa4, 0x6a, 0x24, 0xf0, 0xcd, 0xee, 0x6b, 0xbf, 0x29, 0x5e,
+	0xfb, 0x6e, 0x29, 0x4b, 0x98, 0xa7, 0xf1, 0x8d, 0xd4, 0xf5, 0xb7, 0xe4, 0x27, 0x05, 0x16, 0xfa,
+	0x1f, 0x1c, 0x32, 0x1a, 0x8f, 0xb4, 0xd1, 0xea, 0x9d, 0x51, 0x61, 0x92, 0x7f, 0x85, 0xf3, 0xdf,
+	0x20, 0x37, 0x87, 0xe5, 0xcf, 0xc8, 0xaf, 0x0a, 0x5c, 0x3d, 0xe7, 0xc7, 0x64, 0x00, 0x81, 0xbc,
+	0xa7, 0x42, 0x7d, 0x6b, 0x64, 0x9c, 0x64, 0x5e, 0xe5, 0xcc, 0x6f, 0x93, 0x5b, 0x39, 0xcc, 0xcf,
+	0xff, 0x05, 0xc0, 0xc8, 0xcf, 0x0a, 0xcc, 0xf7, 0x1a, 0x07, 0xd9, 0x1a, 0xb2, 0x71, 0x59, 0x77,
+	0x52, 0xb7, 0x47, 0x03, 0x49, 0xc6, 0x77, 0x39, 0xe3, 0x2d, 0x52, 0x19, 0xdc, 0x6b, 0xee, 0x45,
+	0x19, 0xa9, 0x3c, 0x55, 0x32, 0xef, 0x7c, 0xc6, 0x6c, 0xc8, 0xdd, 0x21, 0x99, 0x9c, 0x77, 0x31,
+	0x75, 0xf7, 0xff, 0x40, 0xe5, 0x51, 0x36, 0xf8, 0x51, 0xd6, 0xc9, 0x5a, 0xce, 0x51, 0x28, 0xc7,
+	0x58, 0x4d, 0xce, 0x31, 0xd1, 0x79, 0xbf, 0xfb, 0x0c, 0xd2, 0x79, 0x8e, 0x9b, 0x0d, 0xd2, 0x79,
+	0x9e, 0xc9, 0x0d, 0xd4, 0x79, 0xa2, 0x12, 0x94, 0x48, 0x8b, 0x3b, 0x1a, 0x79, 0xa2, 0x40, 0x51,
+	0xd8, 0x0f, 0xd9, 0x18, 0x20, 0xd2, 0xac, 0xe7, 0xa9, 0xb7, 0x87, 0x4b, 0x96, 0xc4, 0xd6, 0x39,
+	0xb1, 0x15, 0x72, 0x23, 0x4f, 0xc6, 0xc2, 0x00, 0x3f, 0x7e, 0x76, 0x5a, 0x52, 0x9e, 0x9f, 0x96,
+	0x94, 0xbf, 0x4e, 0x4b, 0xca, 0xf7, 0x67, 0xa5, 0xb1, 0xe7, 0x67, 0xa5, 0xb1, 0x3f, 0xce, 0x4a,
+	0x63, 0x5f, 0xec, 0xb8, 0x5e, 0xd4, 0x88, 0xeb, 0xba, 0x1d, 0x1c, 0xf2, 0x12, 0xfc, 0x3f, 0x0a,
+	0x3b, 0x68, 0x66, 0xeb, 0x9d, 0x64, 0x2b, 0x46, 0xed, 0x10, 0x59, 0xbd, 0xc8, 0xf3, 0xb6, 0xfe,
+	0x0b, 0x00, 0x00, 0xff, 0xff, 0xef, 0x37, 0x28, 0xf5, 0x63, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// DataProxyConfig returns a data proxy config when given its public key as a
+	// hex encoded string.
+	DataProxyConfig(ctx context.Context, in *QueryDataProxyConfigRequest, opts ...grpc.CallOption) (*QueryDataProxyConfigResponse, error)
+	// DataProxyConfigs returns the configs of the registered data proxies,
+	// optionally filtered by admin address, payout address, or whether they
+	// have a pending fee update.
+	DataProxyConfigs(ctx context.Context, in *QueryDataProxyConfigsRequest, opts ...grpc.CallOption) (*QueryDataProxyConfigsResponse, error)
+	// PendingFeeUpdates returns the pending fee updates of data proxies in
+	// the order in which they come into effect.
+	PendingFeeUpdates(ctx context.Context, in *QueryPendingFeeUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingFeeUpdatesResponse, error)
+	// DataProxyUsage returns the cumulative number of requests served, gas
+	// credited and fees earned by a data proxy when given its public key as a
+	// hex encoded string.
+	DataProxyUsage(ctx context.Context, in *QueryDataProxyUsageRequest, opts ...grpc.CallOption) (*QueryDataProxyUsageResponse, error)
+	// DataProxyAccessLists returns the data proxies denied and allowed by
+	// governance.
+	DataProxyAccessLists(ctx context.Context, in *QueryDataProxyAccessListsRequest, opts ...grpc.CallOption) (*QueryDataProxyAccessListsResponse, error)
+	// FeeExchangeRates returns the rates at which data proxy fees in other
+	// denominations are converted to aseda.
+	FeeExchangeRates(ctx context.Context, in *QueryFeeExchangeRatesRequest, opts ...grpc.CallOption) (*QueryFeeExchangeRatesResponse, error)
+	// Params returns the total set of data proxy parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) DataProxyConfig(ctx context.Context, in *QueryDataProxyConfigRequest, opts ...grpc.CallOption) (*QueryDataProxyConfigResponse, error) {
+	out := new(QueryDataProxyConfigResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Query/DataProxyConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DataProxyConfigs(ctx context.Context, in *QueryDataProxyConfigsRequest, opts ...grpc.CallOption) (*QueryDataProxyConfigsResponse, error) {
+	out := new(QueryDataProxyConfigsResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Query/DataProxyConfigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingFeeUpdates(ctx context.Context, in *QueryPendingFeeUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingFeeUpdatesResponse, error) {
+	out := new(QueryPendingFeeUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Query/PendingFeeUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DataProxyUsage(ctx context.Context, in *QueryDataProxyUsageRequest, opts ...grpc.CallOption) (*QueryDataProxyUsageResponse, error) {
+	out := new(QueryDataProxyUsageResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Query/DataProxyUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DataProxyAccessLists(ctx context.Context, in *QueryDataProxyAccessListsRequest, opts ...grpc.CallOption) (*QueryDataProxyAccessListsResponse, error) {
+	out := new(QueryDataProxyAccessListsResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Query/DataProxyAccessLists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeExchangeRates(ctx context.Context, in *QueryFeeExchangeRatesRequest, opts ...grpc.CallOption) (*QueryFeeExchangeRatesResponse, error) {
+	out := new(QueryFeeExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Query/FeeExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// DataProxyConfig returns a data proxy config when given its public key as a
+	// hex encoded string.
+	DataProxyConfig(context.Context, *QueryDataProxyConfigRequest) (*QueryDataProxyConfigResponse, error)
+	// DataProxyConfigs returns the configs of the registered data proxies,
+	// optionally filtered by admin address, payout address, or whether they
+	// have a pending fee update.
+	DataProxyConfigs(context.Context, *QueryDataProxyConfigsRequest) (*QueryDataProxyConfigsResponse, error)
+	// PendingFeeUpdates returns the pending fee updates of data proxies in
+	// the order in which they come into effect.
+	PendingFeeUpdates(context.Context, *QueryPendingFeeUpdatesRequest) (*QueryPendingFeeUpdatesResponse, error)
+	// DataProxyUsage returns the cumulative number of requests served, gas
+	// credited and fees earned by a data proxy when given its public key as a
+	// hex encoded string.
+	DataProxyUsage(context.Context, *QueryDataProxyUsageRequest) (*QueryDataProxyUsageResponse, error)
+	// DataProxyAccessLists returns the data proxies denied and allowed by
+	// governance.
+	DataProxyAccessLists(context.Context, *QueryDataProxyAccessListsRequest) (*QueryDataProxyAccessListsResponse, error)
+	// FeeExchangeRates returns the rates at which data proxy fees in other
+	// denominations are converted to aseda.
+	FeeExchangeRates(context.Context, *QueryFeeExchangeRatesRequest) (*QueryFeeExchangeRatesResponse, error)
+	// Params returns the total set of data proxy parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) DataProxyConfig(ctx context.Context, req *QueryDataProxyConfigRequest) (*QueryDataProxyConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataProxyConfig not implemented")
+}
+func (*UnimplementedQueryServer) DataProxyConfigs(ctx context.Context, req *QueryDataProxyConfigsRequest) (*QueryDataProxyConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataProxyConfigs not implemented")
+}
+func (*UnimplementedQueryServer) PendingFeeUpdates(ctx context.Context, req *QueryPendingFeeUpdatesRequest) (*QueryPendingFeeUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingFeeUpdates not implemented")
+}
+func (*UnimplementedQueryServer) DataProxyUsage(ctx context.Context, req *QueryDataProxyUsageRequest) (*QueryDataProxyUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataProxyUsage not implemented")
+}
+func (*UnimplementedQueryServer) DataProxyAccessLists(ctx context.Context, req *QueryDataProxyAccessListsRequest) (*QueryDataProxyAccessListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataProxyAccessLists not implemented")
+}
+func (*UnimplementedQueryServer) FeeExchangeRates(ctx context.Context, req *QueryFeeExchangeRatesRequest) (*QueryFeeExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeExchangeRates not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_DataProxyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataProxyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataProxyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Query/DataProxyConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataProxyConfig(ctx, req.(*QueryDataProxyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataProxyConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataProxyConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataProxyConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Query/DataProxyConfigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataProxyConfigs(ctx, req.(*QueryDataProxyConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingFeeUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingFeeUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingFeeUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Query/PendingFeeUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingFeeUpdates(ctx, req.(*QueryPendingFeeUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataProxyUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataProxyUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataProxyUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Query/DataProxyUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataProxyUsage(ctx, req.(*QueryDataProxyUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataProxyAccessLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataProxyAccessListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataProxyAccessLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Query/DataProxyAccessLists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataProxyAccessLists(ctx, req.(*QueryDataProxyAccessListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Query/FeeExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeExchangeRates(ctx, req.(*QueryFeeExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sedachain.data_proxy.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DataProxyConfig",
+			Handler:    _Query_DataProxyConfig_Handler,
+		},
+		{
+			MethodName: "DataProxyConfigs",
+			Handler:    _Query_DataProxyConfigs_Handler,
+		},
+		{
+			MethodName: "PendingFeeUpdates",
+			Handler:    _Query_PendingFeeUpdates_Handler,
+		},
+		{
+			MethodName: "DataProxyUsage",
+			Handler:    _Query_DataProxyUsage_Handler,
+		},
+		{
+			MethodName: "DataProxyAccessLists",
+			Handler:    _Query_DataProxyAccessLists_Handler,
+		},
+		{
+			MethodName: "FeeExchangeRates",
+			Handler:    _Query_FeeExchangeRates_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sedachain/data_proxy/v1/query.proto",
+}
+
+func (m *QueryDataProxyConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataProxyConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataProxyConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataProxyConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataProxyConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataProxyConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataProxyConfigsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataProxyConfigsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataProxyConfigsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasPendingFeeUpdate {
+		i--
+		if m.HasPendingFeeUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PayoutAddress) > 0 {
+		i -= len(m.PayoutAddress)
+		copy(dAtA[i:], m.PayoutAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PayoutAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AdminAddress) > 0 {
+		i -= len(m.AdminAddress)
+		copy(dAtA[i:], m.AdminAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataProxyConfigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataProxyConfigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataProxyConfigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Configs) > 0 {
+		for iNdEx := len(m.Configs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Configs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataProxyConfigEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataProxyConfigEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataProxyConfigEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingFeeUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingFeeUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingFeeUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingFeeUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingFeeUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingFeeUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeeUpdates) > 0 {
+		for iNdEx := len(m.FeeUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingFeeUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingFeeUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingFeeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewFeeSchedule) > 0 {
+		for iNdEx := len(m.NewFeeSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewFeeSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NewFee != nil {
+		{
+			size, err := m.NewFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CurrentFee != nil {
+		{
+			size, err := m.CurrentFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UpdateHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpdateHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataProxyUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataProxyUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataProxyUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataProxyUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataProxyUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataProxyUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataProxyAccessListsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataProxyAccessListsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataProxyAccessListsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataProxyAccessListsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataProxyAccessListsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataProxyAccessListsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowlistEnabled {
+		i--
+		if m.AllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDataProxyConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataProxyConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataProxyConfigsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AdminAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HasPendingFeeUpdate {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDataProxyConfigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Configs) > 0 {
+		for _, e := range m.Configs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DataProxyConfigEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingFeeUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingFeeUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeUpdates) > 0 {
+		for _, e := range m.FeeUpdates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingFeeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UpdateHeight != 0 {
+		n += 1 + sovQuery(uint64(m.UpdateHeight))
+	}
+	if m.CurrentFee != nil {
+		l = m.CurrentFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NewFee != nil {
+		l = m.NewFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.NewFeeSchedule) > 0 {
+		for _, e := range m.NewFeeSchedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDataProxyUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataProxyUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDataProxyAccessListsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDataProxyAccessListsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AllowlistEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryFeeExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDataProxyConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataProxyConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataProxyConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataProxyConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataProxyConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataProxyConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &ProxyConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataProxyConfigsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataProxyConfigsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataProxyConfigsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPendingFeeUpdate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasPendingFeeUpdate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataProxyConfigsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataProxyConfigsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataProxyConfigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Configs = append(m.Configs, DataProxyConfigEntry{})
+			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataProxyConfigEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataProxyConfigEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataProxyConfigEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingFeeUpdatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingFeeUpdatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingFeeUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingFeeUpdatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingFeeUpdatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingFeeUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeUpdates = append(m.FeeUpdates, PendingFeeUpdate{})
+			if err := m.FeeUpdates[len(m.FeeUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingFeeUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingFeeUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingFeeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateHeight", wireType)
+			}
+			m.UpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentFee == nil {
+				m.CurrentFee = &types.Coin{}
+			}
+			if err := m.CurrentFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewFee == nil {
+				m.NewFee = &types.Coin{}
+			}
+			if err := m.NewFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFeeSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFeeSchedule = append(m.NewFeeSchedule, FeeScheduleEntry{})
+			if err := m.NewFeeSchedule[len(m.NewFeeSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataProxyUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataProxyUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataProxyUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *QueryDataProxyUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataProxyUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataProxyUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryDataProxyAccessListsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataProxyAccessListsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataProxyAccessListsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryDataProxyConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDataProxyAccessListsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -574,15 +3377,15 @@ func (m *QueryDataProxyConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataProxyConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataProxyAccessListsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataProxyConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataProxyAccessListsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -610,8 +3413,60 @@ func (m *QueryDataProxyConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowlistEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -633,7 +3488,7 @@ func (m *QueryDataProxyConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataProxyConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -656,15 +3511,65 @@ func (m *QueryDataProxyConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataProxyConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeExchangeRatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataProxyConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -691,10 +3596,8 @@ func (m *QueryDataProxyConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &ProxyConfig{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rates = append(m.Rates, FeeExchangeRate{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
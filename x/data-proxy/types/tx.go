@@ -1,6 +1,10 @@
 package types
 
 import (
+	"encoding/hex"
+
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -26,13 +30,30 @@ func (m *MsgRegisterDataProxy) ValidateBasic() error {
 	if err := ValidateMemo(m.Memo); err != nil {
 		return err
 	}
-	if m.Fee.Denom != appparams.DefaultBondDenom {
-		return sdkerrors.ErrInvalidRequest.Wrapf("invalid coin denomination: got %s, expected %s", m.Fee.Denom, appparams.DefaultBondDenom)
+	if err := m.Fee.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid fee: %s", err)
+	}
+	if m.Bond != nil {
+		if err := ValidateBondAmount(*m.Bond); err != nil {
+			return err
+		}
+	}
+	if err := ValidatePayoutRecipients(m.PayoutRecipients); err != nil {
+		return err
+	}
+	if len(m.PayoutRecipients) > 0 && m.PayoutRecipients[0].Address != m.PayoutAddress {
+		return sdkerrors.ErrInvalidRequest.Wrap("first payout recipient must be the payout address")
 	}
 
 	return nil
 }
 
+// HasFeeUpdate returns true if the message schedules a change of the flat fee
+// or the fee schedule.
+func (m *MsgEditDataProxy) HasFeeUpdate() bool {
+	return m.NewFee != nil || len(m.NewFeeSchedule) > 0 || m.ClearFeeSchedule
+}
+
 func (m *MsgEditDataProxy) ValidateBasic() error {
 	if m.PubKey == "" {
 		return sdkerrors.ErrInvalidRequest.Wrap("empty public key")
@@ -44,11 +65,33 @@ func (m *MsgEditDataProxy) ValidateBasic() error {
 	hasNewPayoutAddress := m.NewPayoutAddress != DoNotModifyField
 	hasNewMemo := m.NewMemo != DoNotModifyField
 	hasNewFee := m.NewFee != nil
+	hasNewPayoutRecipients := len(m.NewPayoutRecipients) > 0
+	hasNewFeeSchedule := len(m.NewFeeSchedule) > 0
 
-	if !hasNewPayoutAddress && !hasNewMemo && !hasNewFee {
+	if !hasNewPayoutAddress && !hasNewMemo && !hasNewFee && !hasNewPayoutRecipients && !hasNewFeeSchedule && !m.ClearFeeSchedule {
 		return ErrEmptyUpdate
 	}
 
+	if hasNewFeeSchedule && m.ClearFeeSchedule {
+		return sdkerrors.ErrInvalidRequest.Wrap("cannot set a new fee schedule and clear the fee schedule")
+	}
+
+	if hasNewFeeSchedule {
+		if err := ValidateFeeSchedule(m.NewFeeSchedule); err != nil {
+			return err
+		}
+	}
+
+	if hasNewPayoutAddress && hasNewPayoutRecipients {
+		return sdkerrors.ErrInvalidRequest.Wrap("cannot set both a new payout address and new payout recipients")
+	}
+
+	if hasNewPayoutRecipients {
+		if err := ValidatePayoutRecipients(m.NewPayoutRecipients); err != nil {
+			return err
+		}
+	}
+
 	if hasNewPayoutAddress {
 		if _, err := sdk.AccAddressFromBech32(m.NewPayoutAddress); err != nil {
 			return sdkerrors.ErrInvalidAddress.Wrapf("invalid new payout address: %s", m.NewPayoutAddress)
@@ -56,8 +99,8 @@ func (m *MsgEditDataProxy) ValidateBasic() error {
 	}
 
 	if hasNewFee {
-		if m.NewFee.Denom != appparams.DefaultBondDenom {
-			return sdkerrors.ErrInvalidRequest.Wrapf("invalid coin denomination: got %s, expected %s", m.NewFee.Denom, appparams.DefaultBondDenom)
+		if err := m.NewFee.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid fee: %s", err)
 		}
 	}
 
@@ -70,6 +113,47 @@ func (m *MsgEditDataProxy) ValidateBasic() error {
 	return nil
 }
 
+func (m *MsgCancelFeeUpdate) Validate() error {
+	if m.Sender == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty sender")
+	}
+	if m.PubKey == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty public key")
+	}
+
+	return nil
+}
+
+func (m *MsgAmendFeeUpdate) Validate() error {
+	if m.Sender == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty sender")
+	}
+	if m.PubKey == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty public key")
+	}
+
+	hasNewFeeSchedule := len(m.NewFeeSchedule) > 0
+	if m.NewFee == nil && !hasNewFeeSchedule && !m.ClearFeeSchedule {
+		return ErrEmptyUpdate
+	}
+	if hasNewFeeSchedule && m.ClearFeeSchedule {
+		return sdkerrors.ErrInvalidRequest.Wrap("cannot set a new fee schedule and clear the fee schedule")
+	}
+
+	if m.NewFee != nil {
+		if err := m.NewFee.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid fee: %s", err)
+		}
+	}
+	if hasNewFeeSchedule {
+		if err := ValidateFeeSchedule(m.NewFeeSchedule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *MsgTransferAdmin) Validate() error {
 	if m.Sender == "" {
 		return sdkerrors.ErrInvalidRequest.Wrap("empty sender")
@@ -85,6 +169,146 @@ func (m *MsgTransferAdmin) Validate() error {
 	return nil
 }
 
+func (m *MsgBondDataProxy) Validate() error {
+	if m.Sender == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty sender")
+	}
+	if m.PubKey == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty public key")
+	}
+	if !m.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("bond amount must be positive")
+	}
+
+	return ValidateBondAmount(m.Amount)
+}
+
+func (m *MsgUnbondDataProxy) Validate() error {
+	if m.Sender == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty sender")
+	}
+	if m.PubKey == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty public key")
+	}
+	if !m.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("unbond amount must be positive")
+	}
+
+	return ValidateBondAmount(m.Amount)
+}
+
+func (m *MsgDeregisterDataProxy) Validate() error {
+	if m.Sender == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty sender")
+	}
+	if m.PubKey == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty public key")
+	}
+
+	return nil
+}
+
+func (m *MsgRotateDataProxyKey) Validate() error {
+	if m.Sender == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty sender")
+	}
+	if m.PubKey == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty public key")
+	}
+	if m.NewPubKey == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty new public key")
+	}
+	if m.PubKey == m.NewPubKey {
+		return sdkerrors.ErrInvalidRequest.Wrap("new public key must differ from the current one")
+	}
+	if m.Signature == "" || m.NewSignature == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty signature")
+	}
+
+	return nil
+}
+
+func (m *MsgSlashDataProxy) Validate() error {
+	if m.PubKey == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty public key")
+	}
+	if m.SlashFraction.IsNil() || !m.SlashFraction.IsPositive() || m.SlashFraction.GT(math.LegacyOneDec()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("slash fraction must be in (0, 1], got %s", m.SlashFraction)
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateProxyDenylist) Validate() error {
+	return validateAccessListUpdate(m.Add, m.Remove)
+}
+
+func (m *MsgUpdateProxyAllowlist) Validate() error {
+	return validateAccessListUpdate(m.Add, m.Remove)
+}
+
+func (m *MsgUpdateFeeExchangeRates) Validate() error {
+	if len(m.SetRates) == 0 && len(m.RemoveDenoms) == 0 {
+		return ErrEmptyUpdate
+	}
+
+	denoms := make(map[string]struct{}, len(m.SetRates)+len(m.RemoveDenoms))
+	for _, rate := range m.SetRates {
+		if err := rate.Validate(); err != nil {
+			return err
+		}
+		if _, ok := denoms[rate.Denom]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denomination: %s", rate.Denom)
+		}
+		denoms[rate.Denom] = struct{}{}
+	}
+	for _, denom := range m.RemoveDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid denomination: %s", err)
+		}
+		if _, ok := denoms[denom]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denomination: %s", denom)
+		}
+		denoms[denom] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateAccessListUpdate checks that an access list update is not empty and
+// that its public keys are valid hex strings listed only once.
+func validateAccessListUpdate(add, remove []string) error {
+	if len(add) == 0 && len(remove) == 0 {
+		return ErrEmptyUpdate
+	}
+
+	seen := make(map[string]struct{}, len(add)+len(remove))
+	for _, pubKey := range append(append([]string{}, add...), remove...) {
+		if _, err := hex.DecodeString(pubKey); err != nil || pubKey == "" {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid public key: %s", pubKey)
+		}
+		if _, ok := seen[pubKey]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate public key: %s", pubKey)
+		}
+		seen[pubKey] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBondAmount checks that the given coin is a valid amount of the bond
+// denomination.
+func ValidateBondAmount(amount sdk.Coin) error {
+	if err := amount.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid bond amount: %s", err)
+	}
+	if amount.Denom != appparams.DefaultBondDenom {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid coin denomination: got %s, expected %s", amount.Denom, appparams.DefaultBondDenom)
+	}
+
+	return nil
+}
+
 func (m *MsgUpdateParams) Validate() error {
 	return m.Params.Validate()
 }
@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -49,6 +51,12 @@ type MsgRegisterDataProxy struct {
 	// hex encoded bytes as the expected flow already uses hex encoded bytes to go
 	// from the CLI to the browser where the transaction is signed.
 	Signature string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	// bond defines the amount in aseda to bond for this data proxy. It must be at
+	// least the minimum bond configured in the params.
+	Bond *types.Coin `protobuf:"bytes,7,opt,name=bond,proto3" json:"bond,omitempty"`
+	// payout_recipients optionally splits the fees across multiple addresses.
+	// The first recipient must be the payout address.
+	PayoutRecipients []PayoutRecipient `protobuf:"bytes,8,rep,name=payout_recipients,json=payoutRecipients,proto3" json:"payout_recipients"`
 }
 
 func (m *MsgRegisterDataProxy) Reset()         { *m = MsgRegisterDataProxy{} }
@@ -126,6 +134,20 @@ func (m *MsgRegisterDataProxy) GetSignature() string {
 	return ""
 }
 
+func (m *MsgRegisterDataProxy) GetBond() *types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return nil
+}
+
+func (m *MsgRegisterDataProxy) GetPayoutRecipients() []PayoutRecipient {
+	if m != nil {
+		return m.PayoutRecipients
+	}
+	return nil
+}
+
 // No response required.
 type MsgRegisterDataProxyResponse struct {
 }
@@ -175,6 +197,15 @@ type MsgEditDataProxy struct {
 	// hex encoded bytes as the expected flow is users sending updates from the
 	// browser
 	PubKey string `protobuf:"bytes,6,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// new_payout_recipients replaces the weighted payout split. An empty list
+	// leaves it unchanged. Cannot be combined with a new payout address.
+	NewPayoutRecipients []PayoutRecipient `protobuf:"bytes,7,rep,name=new_payout_recipients,json=newPayoutRecipients,proto3" json:"new_payout_recipients"`
+	// new_fee_schedule schedules a replacement of the fee schedule through the
+	// same delayed update as a new fee. An empty list leaves it unchanged.
+	NewFeeSchedule []FeeScheduleEntry `protobuf:"bytes,8,rep,name=new_fee_schedule,json=newFeeSchedule,proto3" json:"new_fee_schedule"`
+	// clear_fee_schedule schedules the removal of the fee schedule, so that only
+	// the flat fee applies. Cannot be combined with a new fee schedule.
+	ClearFeeSchedule bool `protobuf:"varint,9,opt,name=clear_fee_schedule,json=clearFeeSchedule,proto3" json:"clear_fee_schedule,omitempty"`
 }
 
 func (m *MsgEditDataProxy) Reset()         { *m = MsgEditDataProxy{} }
@@ -252,6 +283,27 @@ func (m *MsgEditDataProxy) GetPubKey() string {
 	return ""
 }
 
+func (m *MsgEditDataProxy) GetNewPayoutRecipients() []PayoutRecipient {
+	if m != nil {
+		return m.NewPayoutRecipients
+	}
+	return nil
+}
+
+func (m *MsgEditDataProxy) GetNewFeeSchedule() []FeeScheduleEntry {
+	if m != nil {
+		return m.NewFeeSchedule
+	}
+	return nil
+}
+
+func (m *MsgEditDataProxy) GetClearFeeSchedule() bool {
+	if m != nil {
+		return m.ClearFeeSchedule
+	}
+	return false
+}
+
 // Allow transferring the admin role to a different address.
 type MsgTransferAdmin struct {
 	Sender          string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -397,26 +449,26 @@ func (m *MsgEditDataProxyResponse) GetFeeUpdateHeight() int64 {
 	return 0
 }
 
-// The request message for the UpdateParams method.
-type MsgUpdateParams struct {
-	// authority is the address that controls the module (defaults to x/gov unless
-	// overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// Allow the admin to cancel the pending fee update of a data proxy.
+type MsgCancelFeeUpdate struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// hex encoded bytes as the expected flow is users sending updates from the
+	// browser
+	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgCancelFeeUpdate) Reset()         { *m = MsgCancelFeeUpdate{} }
+func (m *MsgCancelFeeUpdate) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelFeeUpdate) ProtoMessage()    {}
+func (*MsgCancelFeeUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_40160e30cd70b841, []int{6}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelFeeUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelFeeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelFeeUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -426,48 +478,48 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgCancelFeeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelFeeUpdate.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgCancelFeeUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgCancelFeeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelFeeUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelFeeUpdate proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgCancelFeeUpdate) GetSender() string {
 	if m != nil {
-		return m.Authority
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgCancelFeeUpdate) GetPubKey() string {
 	if m != nil {
-		return m.Params
+		return m.PubKey
 	}
-	return Params{}
+	return ""
 }
 
 // No response required.
-type MsgUpdateParamsResponse struct {
+type MsgCancelFeeUpdateResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCancelFeeUpdateResponse) Reset()         { *m = MsgCancelFeeUpdateResponse{} }
+func (m *MsgCancelFeeUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelFeeUpdateResponse) ProtoMessage()    {}
+func (*MsgCancelFeeUpdateResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_40160e30cd70b841, []int{7}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelFeeUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelFeeUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelFeeUpdateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -477,759 +529,5746 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgCancelFeeUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelFeeUpdateResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgCancelFeeUpdateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgCancelFeeUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelFeeUpdateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelFeeUpdateResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRegisterDataProxy)(nil), "sedachain.data_proxy.v1.MsgRegisterDataProxy")
-	proto.RegisterType((*MsgRegisterDataProxyResponse)(nil), "sedachain.data_proxy.v1.MsgRegisterDataProxyResponse")
-	proto.RegisterType((*MsgEditDataProxy)(nil), "sedachain.data_proxy.v1.MsgEditDataProxy")
-	proto.RegisterType((*MsgTransferAdmin)(nil), "sedachain.data_proxy.v1.MsgTransferAdmin")
-	proto.RegisterType((*MsgTransferAdminResponse)(nil), "sedachain.data_proxy.v1.MsgTransferAdminResponse")
-	proto.RegisterType((*MsgEditDataProxyResponse)(nil), "sedachain.data_proxy.v1.MsgEditDataProxyResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "sedachain.data_proxy.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sedachain.data_proxy.v1.MsgUpdateParamsResponse")
+// Allow the admin to amend the pending fee update of a data proxy. An
+// amendment raising any part of the pending pricing restarts the minimum fee
+// update delay, while an amendment not raising any part of the current pricing
+// comes into effect at the next block.
+type MsgAmendFeeUpdate struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// hex encoded bytes as the expected flow is users sending updates from the
+	// browser
+	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// new_fee replaces the fee of the pending update. Leaving it empty keeps the
+	// fee of the pending update.
+	NewFee *types.Coin `protobuf:"bytes,3,opt,name=new_fee,json=newFee,proto3" json:"new_fee,omitempty"`
+	// new_fee_schedule replaces the fee schedule of the pending update. An empty
+	// list keeps the fee schedule of the pending update.
+	NewFeeSchedule []FeeScheduleEntry `protobuf:"bytes,4,rep,name=new_fee_schedule,json=newFeeSchedule,proto3" json:"new_fee_schedule"`
+	// clear_fee_schedule amends the pending update to remove the fee schedule.
+	// Cannot be combined with a new fee schedule.
+	ClearFeeSchedule bool `protobuf:"varint,5,opt,name=clear_fee_schedule,json=clearFeeSchedule,proto3" json:"clear_fee_schedule,omitempty"`
 }
 
-func init() { proto.RegisterFile("sedachain/data_proxy/v1/tx.proto", fileDescriptor_40160e30cd70b841) }
-
-var fileDescriptor_40160e30cd70b841 = []byte{
-	// 800 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0xc7, 0xe3, 0x26, 0x37, 0x97, 0x4e, 0x6f, 0xda, 0x66, 0x54, 0x94, 0x34, 0xba, 0xb8, 0x51,
-	0x56, 0x21, 0x52, 0xec, 0xa6, 0xa8, 0x2c, 0x2a, 0x55, 0xa8, 0xa1, 0x54, 0xa0, 0x2a, 0xa2, 0x32,
-	0xb0, 0x61, 0x63, 0x4d, 0xe2, 0x13, 0xc7, 0x50, 0x7b, 0x2c, 0xcf, 0xa4, 0xa9, 0xb7, 0x2c, 0x59,
-	0xb1, 0x44, 0x3c, 0x01, 0xcb, 0x2c, 0x78, 0x88, 0x8a, 0x0d, 0x15, 0x2b, 0x16, 0x08, 0xa1, 0x76,
-	0x91, 0x87, 0x60, 0x83, 0x66, 0xec, 0x24, 0x76, 0xc9, 0x47, 0xe9, 0x26, 0xb2, 0xcf, 0xf9, 0x9f,
-	0x0f, 0xff, 0xce, 0x99, 0x0c, 0xaa, 0x32, 0xb0, 0x48, 0x6f, 0x40, 0x1c, 0x4f, 0xb7, 0x08, 0x27,
-	0xa6, 0x1f, 0xd0, 0xdb, 0x50, 0xbf, 0x69, 0xe9, 0xfc, 0x56, 0xf3, 0x03, 0xca, 0x29, 0x2e, 0xcd,
-	0x14, 0xda, 0x5c, 0xa1, 0xdd, 0xb4, 0x2a, 0x7b, 0x36, 0xb5, 0xa9, 0xd4, 0xe8, 0xe2, 0x29, 0x92,
-	0x57, 0xf6, 0x7b, 0x94, 0xb9, 0x94, 0x99, 0x91, 0x23, 0x7a, 0x89, 0x5d, 0x6a, 0xf4, 0xa6, 0x77,
-	0x09, 0x03, 0xfd, 0xa6, 0xd5, 0x05, 0x4e, 0x5a, 0x7a, 0x8f, 0x3a, 0x5e, 0xec, 0x2f, 0xc5, 0x7e,
-	0x97, 0xd9, 0xa2, 0x03, 0x97, 0xd9, 0xb1, 0xa3, 0xbe, 0xac, 0xc9, 0x44, 0x43, 0x91, 0xb2, 0x48,
-	0x5c, 0xc7, 0xa3, 0xba, 0xfc, 0x8d, 0x4c, 0xb5, 0x3f, 0x37, 0xd0, 0x5e, 0x87, 0xd9, 0x06, 0xd8,
-	0x0e, 0xe3, 0x10, 0x9c, 0x13, 0x4e, 0xae, 0x44, 0x04, 0x3e, 0x45, 0x05, 0x62, 0xb9, 0x8e, 0x67,
-	0x12, 0xcb, 0x0a, 0x80, 0xb1, 0xb2, 0x52, 0x55, 0xea, 0x9b, 0xed, 0xf2, 0xef, 0xbf, 0x34, 0xf7,
-	0xe2, 0xbe, 0xcf, 0x22, 0xcf, 0x17, 0x3c, 0x70, 0x3c, 0xdb, 0x78, 0x23, 0xe5, 0xb1, 0x0d, 0x7f,
-	0x84, 0xb6, 0x7d, 0x12, 0xd2, 0x21, 0x9f, 0xc5, 0x6f, 0xac, 0x89, 0x2f, 0x44, 0xfa, 0x69, 0x82,
-	0x36, 0xca, 0xf6, 0x01, 0xca, 0xd9, 0xaa, 0x52, 0xdf, 0x3a, 0xda, 0xd7, 0xe2, 0x10, 0x01, 0x47,
-	0x8b, 0xe1, 0x68, 0x1f, 0x53, 0xc7, 0x6b, 0xbf, 0xfb, 0xd3, 0x64, 0xdc, 0xd8, 0xba, 0x06, 0x9b,
-	0xf4, 0x42, 0x53, 0xe0, 0xfa, 0x79, 0x32, 0x6e, 0x28, 0x86, 0x08, 0xc6, 0x18, 0xe5, 0x5c, 0x70,
-	0x69, 0x39, 0x27, 0x4a, 0x1b, 0xf2, 0x19, 0x97, 0xd0, 0x6b, 0x7f, 0xd8, 0x35, 0xbf, 0x85, 0xb0,
-	0xfc, 0x4a, 0x9a, 0xf3, 0xfe, 0xb0, 0x7b, 0x09, 0x21, 0x7e, 0x8b, 0x36, 0x99, 0x63, 0x7b, 0x84,
-	0x0f, 0x03, 0x28, 0xe7, 0xa5, 0x6b, 0x6e, 0x38, 0x39, 0xfe, 0x6e, 0x32, 0x6e, 0xa4, 0x89, 0x7c,
-	0x3f, 0x19, 0x37, 0xd4, 0x39, 0xfb, 0x45, 0x14, 0x6b, 0x2a, 0x7a, 0xbb, 0xc8, 0x6e, 0x00, 0xf3,
-	0xa9, 0xc7, 0xa0, 0xf6, 0xcf, 0x06, 0xda, 0xed, 0x30, 0xfb, 0x13, 0xcb, 0xe1, 0x73, 0xf4, 0x87,
-	0x28, 0xcf, 0xc0, 0xb3, 0x20, 0x58, 0xcb, 0x3c, 0xd6, 0xe1, 0x4b, 0x84, 0x3d, 0x18, 0x99, 0x0b,
-	0x89, 0xbf, 0xb7, 0x2c, 0x5a, 0x80, 0xca, 0x18, 0xbb, 0x1e, 0x8c, 0xae, 0x52, 0xe4, 0xab, 0xe8,
-	0x1d, 0x91, 0x4c, 0x92, 0xcb, 0xca, 0x14, 0xaf, 0x22, 0xa6, 0xaf, 0x3d, 0x18, 0x75, 0x04, 0xc3,
-	0xcf, 0x90, 0x78, 0x34, 0xc5, 0x7c, 0x72, 0x2f, 0x9a, 0x4f, 0xc6, 0xc8, 0x7b, 0x30, 0xba, 0x00,
-	0xc0, 0x3a, 0xda, 0xed, 0x03, 0x98, 0x43, 0xdf, 0x22, 0x1c, 0x4c, 0x0b, 0xae, 0x49, 0x34, 0x97,
-	0x42, 0x54, 0x34, 0x63, 0x6c, 0xf7, 0x01, 0xbe, 0x92, 0xde, 0x73, 0xe1, 0xc4, 0xea, 0x7c, 0x7e,
-	0xf9, 0x64, 0x73, 0xf1, 0x18, 0x4f, 0x1a, 0x62, 0x50, 0x31, 0x17, 0x31, 0xa1, 0x4a, 0x6a, 0x42,
-	0x29, 0xd0, 0xb5, 0xdf, 0x14, 0x49, 0xff, 0xcb, 0x80, 0x78, 0xac, 0x0f, 0xc1, 0x99, 0x98, 0xee,
-	0x0b, 0xe8, 0x9f, 0xa3, 0xa2, 0xc0, 0x91, 0x3e, 0x2e, 0xeb, 0xd6, 0x7d, 0xc7, 0x83, 0xd1, 0x59,
-	0xf2, 0xc4, 0x24, 0x16, 0x33, 0x9b, 0x5c, 0xcc, 0x35, 0x5f, 0x94, 0x6a, 0xbe, 0x56, 0x41, 0xe5,
-	0xa7, 0xb6, 0xd9, 0xae, 0x5d, 0x48, 0x5f, 0x8a, 0xc0, 0xd4, 0x87, 0x1b, 0xa8, 0x98, 0x18, 0xc3,
-	0x00, 0x1c, 0x7b, 0xc0, 0xe5, 0xf7, 0x67, 0x8d, 0x9d, 0xd9, 0x00, 0x3e, 0x95, 0xe6, 0xda, 0x8f,
-	0x0a, 0xda, 0xe9, 0x30, 0x3b, 0xb2, 0x5d, 0x91, 0x80, 0xb8, 0x0c, 0x7f, 0x88, 0x36, 0xc9, 0x90,
-	0x0f, 0x68, 0xe0, 0xf0, 0x70, 0x2d, 0xb7, 0xb9, 0x14, 0x9f, 0xa2, 0xbc, 0x2f, 0x33, 0x48, 0x5e,
-	0x5b, 0x47, 0x07, 0xda, 0x92, 0xff, 0x53, 0x2d, 0x2a, 0xd4, 0xce, 0xdd, 0xfd, 0x75, 0x90, 0x31,
-	0xe2, 0xa0, 0x93, 0x6d, 0x81, 0x66, 0x9e, 0xae, 0xb6, 0x8f, 0x4a, 0x4f, 0x3a, 0x9b, 0x7e, 0xe1,
-	0xd1, 0xaf, 0x59, 0x94, 0xed, 0x30, 0x1b, 0x87, 0xa8, 0xf8, 0xdf, 0x3f, 0xbb, 0xe6, 0xd2, 0xb2,
-	0x8b, 0x4e, 0x6f, 0xe5, 0xf8, 0x7f, 0xc9, 0x67, 0x90, 0x5d, 0x54, 0x48, 0x1f, 0xf4, 0xf7, 0x57,
-	0xe5, 0x49, 0x49, 0x2b, 0xad, 0x67, 0x4b, 0x93, 0xe5, 0xd2, 0x9b, 0xbd, 0xb2, 0x5c, 0x4a, 0xba,
-	0xba, 0xdc, 0xc2, 0xf5, 0xc2, 0xdf, 0xa0, 0x37, 0xa9, 0x95, 0xa8, 0xaf, 0x4a, 0x91, 0x54, 0x56,
-	0x0e, 0x9f, 0xab, 0x9c, 0xd6, 0x6a, 0x7f, 0x7e, 0xf7, 0xa0, 0x2a, 0xf7, 0x0f, 0xaa, 0xf2, 0xf7,
-	0x83, 0xaa, 0xfc, 0xf0, 0xa8, 0x66, 0xee, 0x1f, 0xd5, 0xcc, 0x1f, 0x8f, 0x6a, 0xe6, 0xeb, 0x63,
-	0xdb, 0xe1, 0x83, 0x61, 0x57, 0xeb, 0x51, 0x57, 0x17, 0x59, 0xe5, 0x2d, 0xd7, 0xa3, 0xd7, 0xf2,
-	0xa5, 0x19, 0x9d, 0x9a, 0x5b, 0x79, 0x33, 0x36, 0xa3, 0x7b, 0x92, 0x87, 0x3e, 0xb0, 0x6e, 0x5e,
-	0xea, 0x3e, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0x44, 0x4a, 0x5c, 0x80, 0xf1, 0x07, 0x00, 0x00,
+func (m *MsgAmendFeeUpdate) Reset()         { *m = MsgAmendFeeUpdate{} }
+func (m *MsgAmendFeeUpdate) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendFeeUpdate) ProtoMessage()    {}
+func (*MsgAmendFeeUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{8}
+}
+func (m *MsgAmendFeeUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendFeeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendFeeUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendFeeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendFeeUpdate.Merge(m, src)
+}
+func (m *MsgAmendFeeUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendFeeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendFeeUpdate.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgAmendFeeUpdate proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Registers a new data proxy entry in the registry.
-	RegisterDataProxy(ctx context.Context, in *MsgRegisterDataProxy, opts ...grpc.CallOption) (*MsgRegisterDataProxyResponse, error)
-	// Edits an existing data proxy.
-	EditDataProxy(ctx context.Context, in *MsgEditDataProxy, opts ...grpc.CallOption) (*MsgEditDataProxyResponse, error)
-	// Transfers the admin address of a data proxy
-	TransferAdmin(ctx context.Context, in *MsgTransferAdmin, opts ...grpc.CallOption) (*MsgTransferAdminResponse, error)
-	// Used to update the modules parameters through governance.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgAmendFeeUpdate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgAmendFeeUpdate) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAmendFeeUpdate) GetNewFee() *types.Coin {
+	if m != nil {
+		return m.NewFee
+	}
+	return nil
 }
 
-func (c *msgClient) RegisterDataProxy(ctx context.Context, in *MsgRegisterDataProxy, opts ...grpc.CallOption) (*MsgRegisterDataProxyResponse, error) {
-	out := new(MsgRegisterDataProxyResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/RegisterDataProxy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAmendFeeUpdate) GetNewFeeSchedule() []FeeScheduleEntry {
+	if m != nil {
+		return m.NewFeeSchedule
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) EditDataProxy(ctx context.Context, in *MsgEditDataProxy, opts ...grpc.CallOption) (*MsgEditDataProxyResponse, error) {
-	out := new(MsgEditDataProxyResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/EditDataProxy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAmendFeeUpdate) GetClearFeeSchedule() bool {
+	if m != nil {
+		return m.ClearFeeSchedule
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) TransferAdmin(ctx context.Context, in *MsgTransferAdmin, opts ...grpc.CallOption) (*MsgTransferAdminResponse, error) {
-	out := new(MsgTransferAdminResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/TransferAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Returns the height at which the amended fee update comes into effect.
+type MsgAmendFeeUpdateResponse struct {
+	FeeUpdateHeight int64 `protobuf:"varint,1,opt,name=fee_update_height,json=feeUpdateHeight,proto3" json:"fee_update_height,omitempty"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAmendFeeUpdateResponse) Reset()         { *m = MsgAmendFeeUpdateResponse{} }
+func (m *MsgAmendFeeUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendFeeUpdateResponse) ProtoMessage()    {}
+func (*MsgAmendFeeUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{9}
+}
+func (m *MsgAmendFeeUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendFeeUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendFeeUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAmendFeeUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendFeeUpdateResponse.Merge(m, src)
+}
+func (m *MsgAmendFeeUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendFeeUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendFeeUpdateResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Registers a new data proxy entry in the registry.
-	RegisterDataProxy(context.Context, *MsgRegisterDataProxy) (*MsgRegisterDataProxyResponse, error)
-	// Edits an existing data proxy.
-	EditDataProxy(context.Context, *MsgEditDataProxy) (*MsgEditDataProxyResponse, error)
-	// Transfers the admin address of a data proxy
-	TransferAdmin(context.Context, *MsgTransferAdmin) (*MsgTransferAdminResponse, error)
-	// Used to update the modules parameters through governance.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+var xxx_messageInfo_MsgAmendFeeUpdateResponse proto.InternalMessageInfo
+
+func (m *MsgAmendFeeUpdateResponse) GetFeeUpdateHeight() int64 {
+	if m != nil {
+		return m.FeeUpdateHeight
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// Allow the admin to add funds to the bond of a data proxy.
+type MsgBondDataProxy struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// hex encoded bytes as the expected flow is users sending updates from the
+	// browser
+	PubKey string                                  `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
 }
 
-func (*UnimplementedMsgServer) RegisterDataProxy(ctx context.Context, req *MsgRegisterDataProxy) (*MsgRegisterDataProxyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterDataProxy not implemented")
+func (m *MsgBondDataProxy) Reset()         { *m = MsgBondDataProxy{} }
+func (m *MsgBondDataProxy) String() string { return proto.CompactTextString(m) }
+func (*MsgBondDataProxy) ProtoMessage()    {}
+func (*MsgBondDataProxy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{10}
 }
-func (*UnimplementedMsgServer) EditDataProxy(ctx context.Context, req *MsgEditDataProxy) (*MsgEditDataProxyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditDataProxy not implemented")
+func (m *MsgBondDataProxy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) TransferAdmin(ctx context.Context, req *MsgTransferAdmin) (*MsgTransferAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferAdmin not implemented")
+func (m *MsgBondDataProxy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondDataProxy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgBondDataProxy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondDataProxy.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgBondDataProxy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondDataProxy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondDataProxy.DiscardUnknown(m)
 }
 
-func _Msg_RegisterDataProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterDataProxy)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterDataProxy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.data_proxy.v1.Msg/RegisterDataProxy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterDataProxy(ctx, req.(*MsgRegisterDataProxy))
+var xxx_messageInfo_MsgBondDataProxy proto.InternalMessageInfo
+
+func (m *MsgBondDataProxy) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_EditDataProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditDataProxy)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditDataProxy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.data_proxy.v1.Msg/EditDataProxy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditDataProxy(ctx, req.(*MsgEditDataProxy))
+func (m *MsgBondDataProxy) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_TransferAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferAdmin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TransferAdmin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.data_proxy.v1.Msg/TransferAdmin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TransferAdmin(ctx, req.(*MsgTransferAdmin))
+func (m *MsgBondDataProxy) GetAmount() github_com_cosmos_cosmos_sdk_types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return github_com_cosmos_cosmos_sdk_types.Coin{}
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+// No response required.
+type MsgBondDataProxyResponse struct {
+}
+
+func (m *MsgBondDataProxyResponse) Reset()         { *m = MsgBondDataProxyResponse{} }
+func (m *MsgBondDataProxyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBondDataProxyResponse) ProtoMessage()    {}
+func (*MsgBondDataProxyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{11}
+}
+func (m *MsgBondDataProxyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondDataProxyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondDataProxyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.data_proxy.v1.Msg/UpdateParams",
+}
+func (m *MsgBondDataProxyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondDataProxyResponse.Merge(m, src)
+}
+func (m *MsgBondDataProxyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondDataProxyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondDataProxyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBondDataProxyResponse proto.InternalMessageInfo
+
+// Allow the admin to unbond funds from the bond of a data proxy.
+type MsgUnbondDataProxy struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// hex encoded bytes as the expected flow is users sending updates from the
+	// browser
+	PubKey string                                  `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+}
+
+func (m *MsgUnbondDataProxy) Reset()         { *m = MsgUnbondDataProxy{} }
+func (m *MsgUnbondDataProxy) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondDataProxy) ProtoMessage()    {}
+func (*MsgUnbondDataProxy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{12}
+}
+func (m *MsgUnbondDataProxy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondDataProxy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondDataProxy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MsgUnbondDataProxy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondDataProxy.Merge(m, src)
+}
+func (m *MsgUnbondDataProxy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondDataProxy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondDataProxy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondDataProxy proto.InternalMessageInfo
+
+func (m *MsgUnbondDataProxy) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sedachain.data_proxy.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterDataProxy",
-			Handler:    _Msg_RegisterDataProxy_Handler,
-		},
-		{
-			MethodName: "EditDataProxy",
-			Handler:    _Msg_EditDataProxy_Handler,
-		},
-		{
-			MethodName: "TransferAdmin",
-			Handler:    _Msg_TransferAdmin_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sedachain/data_proxy/v1/tx.proto",
+func (m *MsgUnbondDataProxy) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
 }
 
-func (m *MsgRegisterDataProxy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnbondDataProxy) GetAmount() github_com_cosmos_cosmos_sdk_types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return github_com_cosmos_cosmos_sdk_types.Coin{}
 }
 
-func (m *MsgRegisterDataProxy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Returns the height at which the unbonded funds will be returned.
+type MsgUnbondDataProxyResponse struct {
+	CompletionHeight int64 `protobuf:"varint,1,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
 }
 
-func (m *MsgRegisterDataProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x2a
+func (m *MsgUnbondDataProxyResponse) Reset()         { *m = MsgUnbondDataProxyResponse{} }
+func (m *MsgUnbondDataProxyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondDataProxyResponse) ProtoMessage()    {}
+func (*MsgUnbondDataProxyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{13}
+}
+func (m *MsgUnbondDataProxyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondDataProxyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondDataProxyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Memo) > 0 {
-		i -= len(m.Memo)
-		copy(dAtA[i:], m.Memo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *MsgUnbondDataProxyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondDataProxyResponse.Merge(m, src)
+}
+func (m *MsgUnbondDataProxyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondDataProxyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondDataProxyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondDataProxyResponse proto.InternalMessageInfo
+
+func (m *MsgUnbondDataProxyResponse) GetCompletionHeight() int64 {
+	if m != nil {
+		return m.CompletionHeight
 	}
-	if m.Fee != nil {
-		{
-			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	return 0
+}
+
+// Allow the admin to retire a data proxy. Its bond is unbonded and it stops
+// being paid after the deregistration delay.
+type MsgDeregisterDataProxy struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// hex encoded bytes as the expected flow is users sending updates from the
+	// browser
+	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *MsgDeregisterDataProxy) Reset()         { *m = MsgDeregisterDataProxy{} }
+func (m *MsgDeregisterDataProxy) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterDataProxy) ProtoMessage()    {}
+func (*MsgDeregisterDataProxy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{14}
+}
+func (m *MsgDeregisterDataProxy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterDataProxy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterDataProxy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PayoutAddress) > 0 {
-		i -= len(m.PayoutAddress)
-		copy(dAtA[i:], m.PayoutAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PayoutAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.AdminAddress) > 0 {
-		i -= len(m.AdminAddress)
-		copy(dAtA[i:], m.AdminAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AdminAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgDeregisterDataProxy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterDataProxy.Merge(m, src)
+}
+func (m *MsgDeregisterDataProxy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterDataProxy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterDataProxy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterDataProxy proto.InternalMessageInfo
+
+func (m *MsgDeregisterDataProxy) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRegisterDataProxyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeregisterDataProxy) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRegisterDataProxyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Returns the height from which the data proxy is no longer paid.
+type MsgDeregisterDataProxyResponse struct {
+	DeregistrationHeight int64 `protobuf:"varint,1,opt,name=deregistration_height,json=deregistrationHeight,proto3" json:"deregistration_height,omitempty"`
 }
 
-func (m *MsgRegisterDataProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgDeregisterDataProxyResponse) Reset()         { *m = MsgDeregisterDataProxyResponse{} }
+func (m *MsgDeregisterDataProxyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterDataProxyResponse) ProtoMessage()    {}
+func (*MsgDeregisterDataProxyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{15}
+}
+func (m *MsgDeregisterDataProxyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterDataProxyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterDataProxyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterDataProxyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterDataProxyResponse.Merge(m, src)
+}
+func (m *MsgDeregisterDataProxyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterDataProxyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterDataProxyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgEditDataProxy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeregisterDataProxyResponse proto.InternalMessageInfo
+
+func (m *MsgDeregisterDataProxyResponse) GetDeregistrationHeight() int64 {
+	if m != nil {
+		return m.DeregistrationHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgEditDataProxy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Allow the admin to rotate the public key of a data proxy. Both keys sign
+// the registration payload of the current config followed by the new public
+// key.
+type MsgRotateDataProxyKey struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// hex encoded current public key of the data proxy.
+	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// hex encoded new public key of the data proxy.
+	NewPubKey string `protobuf:"bytes,3,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+	// hex encoded signature by the current public key.
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// hex encoded signature by the new public key.
+	NewSignature string `protobuf:"bytes,5,opt,name=new_signature,json=newSignature,proto3" json:"new_signature,omitempty"`
 }
 
-func (m *MsgEditDataProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.FeeUpdateDelay != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FeeUpdateDelay))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.NewFee != nil {
-		{
-			size, err := m.NewFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgRotateDataProxyKey) Reset()         { *m = MsgRotateDataProxyKey{} }
+func (m *MsgRotateDataProxyKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDataProxyKey) ProtoMessage()    {}
+func (*MsgRotateDataProxyKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{16}
+}
+func (m *MsgRotateDataProxyKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateDataProxyKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDataProxyKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.NewMemo) > 0 {
-		i -= len(m.NewMemo)
-		copy(dAtA[i:], m.NewMemo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewMemo)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NewPayoutAddress) > 0 {
-		i -= len(m.NewPayoutAddress)
-		copy(dAtA[i:], m.NewPayoutAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewPayoutAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgTransferAdmin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRotateDataProxyKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDataProxyKey.Merge(m, src)
 }
-
-func (m *MsgTransferAdmin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRotateDataProxyKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDataProxyKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDataProxyKey.DiscardUnknown(m)
 }
 
-func (m *MsgTransferAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NewAdminAddress) > 0 {
-		i -= len(m.NewAdminAddress)
-		copy(dAtA[i:], m.NewAdminAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdminAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgRotateDataProxyKey proto.InternalMessageInfo
+
+func (m *MsgRotateDataProxyKey) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgTransferAdminResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRotateDataProxyKey) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgTransferAdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRotateDataProxyKey) GetNewPubKey() string {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return ""
 }
 
-func (m *MsgTransferAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRotateDataProxyKey) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
 }
 
-func (m *MsgEditDataProxyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRotateDataProxyKey) GetNewSignature() string {
+	if m != nil {
+		return m.NewSignature
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgEditDataProxyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Returns the height at which the alias of the previous key expires.
+type MsgRotateDataProxyKeyResponse struct {
+	AliasExpirationHeight int64 `protobuf:"varint,1,opt,name=alias_expiration_height,json=aliasExpirationHeight,proto3" json:"alias_expiration_height,omitempty"`
 }
 
-func (m *MsgEditDataProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FeeUpdateHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FeeUpdateHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgRotateDataProxyKeyResponse) Reset()         { *m = MsgRotateDataProxyKeyResponse{} }
+func (m *MsgRotateDataProxyKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDataProxyKeyResponse) ProtoMessage()    {}
+func (*MsgRotateDataProxyKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{17}
+}
+func (m *MsgRotateDataProxyKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateDataProxyKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDataProxyKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRotateDataProxyKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDataProxyKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateDataProxyKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDataProxyKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDataProxyKeyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRotateDataProxyKeyResponse proto.InternalMessageInfo
+
+func (m *MsgRotateDataProxyKeyResponse) GetAliasExpirationHeight() int64 {
+	if m != nil {
+		return m.AliasExpirationHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The request message for the SlashDataProxy method.
+type MsgSlashDataProxy struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// hex encoded public key of the data proxy to slash.
+	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// slash_fraction defines the fraction of the bonded and unbonding funds of
+	// the data proxy to burn.
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// reason describes the misbehaviour, for example a reference to signed
+	// conflicting responses served by the data proxy.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSlashDataProxy) Reset()         { *m = MsgSlashDataProxy{} }
+func (m *MsgSlashDataProxy) String() string { return proto.CompactTextString(m) }
+func (*MsgSlashDataProxy) ProtoMessage()    {}
+func (*MsgSlashDataProxy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{18}
+}
+func (m *MsgSlashDataProxy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSlashDataProxy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSlashDataProxy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSlashDataProxy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSlashDataProxy.Merge(m, src)
+}
+func (m *MsgSlashDataProxy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSlashDataProxy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSlashDataProxy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSlashDataProxy proto.InternalMessageInfo
+
+func (m *MsgSlashDataProxy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSlashDataProxy) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSlashDataProxy) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Returns the amount that was burned.
+type MsgSlashDataProxyResponse struct {
+	SlashedAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=slashed_amount,json=slashedAmount,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"slashed_amount"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSlashDataProxyResponse) Reset()         { *m = MsgSlashDataProxyResponse{} }
+func (m *MsgSlashDataProxyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSlashDataProxyResponse) ProtoMessage()    {}
+func (*MsgSlashDataProxyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{19}
 }
-func (m *MsgRegisterDataProxy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AdminAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PayoutAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSlashDataProxyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSlashDataProxyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSlashDataProxyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Fee != nil {
-		l = m.Fee.Size()
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSlashDataProxyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSlashDataProxyResponse.Merge(m, src)
+}
+func (m *MsgSlashDataProxyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSlashDataProxyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSlashDataProxyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSlashDataProxyResponse proto.InternalMessageInfo
+
+func (m *MsgSlashDataProxyResponse) GetSlashedAmount() github_com_cosmos_cosmos_sdk_types.Coin {
+	if m != nil {
+		return m.SlashedAmount
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return github_com_cosmos_cosmos_sdk_types.Coin{}
+}
+
+// The request message for the UpdateProxyDenylist method.
+type MsgUpdateProxyDenylist struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// hex encoded public keys of the data proxies to deny.
+	Add []string `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	// hex encoded public keys of the data proxies to remove from the denylist.
+	Remove []string `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *MsgUpdateProxyDenylist) Reset()         { *m = MsgUpdateProxyDenylist{} }
+func (m *MsgUpdateProxyDenylist) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProxyDenylist) ProtoMessage()    {}
+func (*MsgUpdateProxyDenylist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{20}
+}
+func (m *MsgUpdateProxyDenylist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProxyDenylist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProxyDenylist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateProxyDenylist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProxyDenylist.Merge(m, src)
+}
+func (m *MsgUpdateProxyDenylist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProxyDenylist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProxyDenylist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateProxyDenylist proto.InternalMessageInfo
+
+func (m *MsgUpdateProxyDenylist) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgUpdateProxyDenylist) GetAdd() []string {
+	if m != nil {
+		return m.Add
 	}
-	return n
+	return nil
 }
 
-func (m *MsgRegisterDataProxyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateProxyDenylist) GetRemove() []string {
+	if m != nil {
+		return m.Remove
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgEditDataProxy) Size() (n int) {
-	if m == nil {
-		return 0
+// No response required.
+type MsgUpdateProxyDenylistResponse struct {
+}
+
+func (m *MsgUpdateProxyDenylistResponse) Reset()         { *m = MsgUpdateProxyDenylistResponse{} }
+func (m *MsgUpdateProxyDenylistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProxyDenylistResponse) ProtoMessage()    {}
+func (*MsgUpdateProxyDenylistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{21}
+}
+func (m *MsgUpdateProxyDenylistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProxyDenylistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProxyDenylistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateProxyDenylistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProxyDenylistResponse.Merge(m, src)
+}
+func (m *MsgUpdateProxyDenylistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProxyDenylistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProxyDenylistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateProxyDenylistResponse proto.InternalMessageInfo
+
+// The request message for the UpdateProxyAllowlist method.
+type MsgUpdateProxyAllowlist struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// hex encoded public keys of the data proxies to allow.
+	Add []string `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	// hex encoded public keys of the data proxies to remove from the allowlist.
+	Remove []string `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *MsgUpdateProxyAllowlist) Reset()         { *m = MsgUpdateProxyAllowlist{} }
+func (m *MsgUpdateProxyAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProxyAllowlist) ProtoMessage()    {}
+func (*MsgUpdateProxyAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{22}
+}
+func (m *MsgUpdateProxyAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProxyAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProxyAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.NewPayoutAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateProxyAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProxyAllowlist.Merge(m, src)
+}
+func (m *MsgUpdateProxyAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProxyAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProxyAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateProxyAllowlist proto.InternalMessageInfo
+
+func (m *MsgUpdateProxyAllowlist) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = len(m.NewMemo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgUpdateProxyAllowlist) GetAdd() []string {
+	if m != nil {
+		return m.Add
 	}
-	if m.NewFee != nil {
-		l = m.NewFee.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *MsgUpdateProxyAllowlist) GetRemove() []string {
+	if m != nil {
+		return m.Remove
 	}
-	if m.FeeUpdateDelay != 0 {
-		n += 1 + sovTx(uint64(m.FeeUpdateDelay))
+	return nil
+}
+
+// No response required.
+type MsgUpdateProxyAllowlistResponse struct {
+}
+
+func (m *MsgUpdateProxyAllowlistResponse) Reset()         { *m = MsgUpdateProxyAllowlistResponse{} }
+func (m *MsgUpdateProxyAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProxyAllowlistResponse) ProtoMessage()    {}
+func (*MsgUpdateProxyAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{23}
+}
+func (m *MsgUpdateProxyAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProxyAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProxyAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateProxyAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProxyAllowlistResponse.Merge(m, src)
+}
+func (m *MsgUpdateProxyAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProxyAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProxyAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateProxyAllowlistResponse proto.InternalMessageInfo
+
+// The request message for the UpdateFeeExchangeRates method.
+type MsgUpdateFeeExchangeRates struct {
+	// sender is either the module authority or the exchange rate oracle set in
+	// the module parameters.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// rates to set, replacing the current rates of their denominations.
+	SetRates []FeeExchangeRate `protobuf:"bytes,2,rep,name=set_rates,json=setRates,proto3" json:"set_rates"`
+	// denominations whose rates are removed.
+	RemoveDenoms []string `protobuf:"bytes,3,rep,name=remove_denoms,json=removeDenoms,proto3" json:"remove_denoms,omitempty"`
+}
+
+func (m *MsgUpdateFeeExchangeRates) Reset()         { *m = MsgUpdateFeeExchangeRates{} }
+func (m *MsgUpdateFeeExchangeRates) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeExchangeRates) ProtoMessage()    {}
+func (*MsgUpdateFeeExchangeRates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{24}
+}
+func (m *MsgUpdateFeeExchangeRates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeExchangeRates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeExchangeRates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUpdateFeeExchangeRates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeExchangeRates.Merge(m, src)
+}
+func (m *MsgUpdateFeeExchangeRates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeExchangeRates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeExchangeRates.DiscardUnknown(m)
 }
 
-func (m *MsgTransferAdmin) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateFeeExchangeRates proto.InternalMessageInfo
+
+func (m *MsgUpdateFeeExchangeRates) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgUpdateFeeExchangeRates) GetSetRates() []FeeExchangeRate {
+	if m != nil {
+		return m.SetRates
 	}
-	l = len(m.NewAdminAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *MsgUpdateFeeExchangeRates) GetRemoveDenoms() []string {
+	if m != nil {
+		return m.RemoveDenoms
 	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// No response required.
+type MsgUpdateFeeExchangeRatesResponse struct {
+}
+
+func (m *MsgUpdateFeeExchangeRatesResponse) Reset()         { *m = MsgUpdateFeeExchangeRatesResponse{} }
+func (m *MsgUpdateFeeExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeExchangeRatesResponse) ProtoMessage()    {}
+func (*MsgUpdateFeeExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{25}
+}
+func (m *MsgUpdateFeeExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeExchangeRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUpdateFeeExchangeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeExchangeRatesResponse.Merge(m, src)
+}
+func (m *MsgUpdateFeeExchangeRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeExchangeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeExchangeRatesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTransferAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateFeeExchangeRatesResponse proto.InternalMessageInfo
+
+// The request message for the UpdateParams method.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{26}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// No response required.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40160e30cd70b841, []int{27}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterDataProxy)(nil), "sedachain.data_proxy.v1.MsgRegisterDataProxy")
+	proto.RegisterType((*MsgRegisterDataProxyResponse)(nil), "sedachain.data_proxy.v1.MsgRegisterDataProxyResponse")
+	proto.RegisterType((*MsgEditDataProxy)(nil), "sedachain.data_proxy.v1.MsgEditDataProxy")
+	proto.RegisterType((*MsgTransferAdmin)(nil), "sedachain.data_proxy.v1.MsgTransferAdmin")
+	proto.RegisterType((*MsgTransferAdminResponse)(nil), "sedachain.data_proxy.v1.MsgTransferAdminResponse")
+	proto.RegisterType((*MsgEditDataProxyResponse)(nil), "sedachain.data_proxy.v1.MsgEditDataProxyResponse")
+	proto.RegisterType((*MsgCancelFeeUpdate)(nil), "sedachain.data_proxy.v1.MsgCancelFeeUpdate")
+	proto.RegisterType((*MsgCancelFeeUpdateResponse)(nil), "sedachain.data_proxy.v1.MsgCancelFeeUpdateResponse")
+	proto.RegisterType((*MsgAmendFeeUpdate)(nil), "sedachain.data_proxy.v1.MsgAmendFeeUpdate")
+	proto.RegisterType((*MsgAmendFeeUpdateResponse)(nil), "sedachain.data_proxy.v1.MsgAmendFeeUpdateResponse")
+	proto.RegisterType((*MsgBondDataProxy)(nil), "sedachain.data_proxy.v1.MsgBondDataProxy")
+	proto.RegisterType((*MsgBondDataProxyResponse)(nil), "sedachain.data_proxy.v1.MsgBondDataProxyResponse")
+	proto.RegisterType((*MsgUnbondDataProxy)(nil), "sedachain.data_proxy.v1.MsgUnbondDataProxy")
+	proto.RegisterType((*MsgUnbondDataProxyResponse)(nil), "sedachain.data_proxy.v1.MsgUnbondDataProxyResponse")
+	proto.RegisterType((*MsgDeregisterDataProxy)(nil), "sedachain.data_proxy.v1.MsgDeregisterDataProxy")
+	proto.RegisterType((*MsgDeregisterDataProxyResponse)(nil), "sedachain.data_proxy.v1.MsgDeregisterDataProxyResponse")
+	proto.RegisterType((*MsgRotateDataProxyKey)(nil), "sedachain.data_proxy.v1.MsgRotateDataProxyKey")
+	proto.RegisterType((*MsgRotateDataProxyKeyResponse)(nil), "sedachain.data_proxy.v1.MsgRotateDataProxyKeyResponse")
+	proto.RegisterType((*MsgSlashDataProxy)(nil), "sedachain.data_proxy.v1.MsgSlashDataProxy")
+	proto.RegisterType((*MsgSlashDataProxyResponse)(nil), "sedachain.data_proxy.v1.MsgSlashDataProxyResponse")
+	proto.RegisterType((*MsgUpdateProxyDenylist)(nil), "sedachain.data_proxy.v1.MsgUpdateProxyDenylist")
+	proto.RegisterType((*MsgUpdateProxyDenylistResponse)(nil), "sedachain.data_proxy.v1.MsgUpdateProxyDenylistResponse")
+	proto.RegisterType((*MsgUpdateProxyAllowlist)(nil), "sedachain.data_proxy.v1.MsgUpdateProxyAllowlist")
+	proto.RegisterType((*MsgUpdateProxyAllowlistResponse)(nil), "sedachain.data_proxy.v1.MsgUpdateProxyAllowlistResponse")
+	proto.RegisterType((*MsgUpdateFeeExchangeRates)(nil), "sedachain.data_proxy.v1.MsgUpdateFeeExchangeRates")
+	proto.RegisterType((*MsgUpdateFeeExchangeRatesResponse)(nil), "sedachain.data_proxy.v1.MsgUpdateFeeExchangeRatesResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "sedachain.data_proxy.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sedachain.data_proxy.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("sedachain/data_proxy/v1/tx.proto", fileDescriptor_40160e30cd70b841) }
+
+var fileDescriptor_40160e30cd70b841 = []byte{
+	// 1670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xc6, 0xae, 0x93, 0x4c, 0xe2, 0xc4, 0xd9, 0x26, 0x8d, 0xe3, 0xa6, 0x8e, 0xeb, 0x1e,
+	0x70, 0xd3, 0xc6, 0x4e, 0x52, 0xb5, 0xa0, 0x48, 0x15, 0x4a, 0xea, 0x04, 0xaa, 0x12, 0x51, 0x39,
+	0x54, 0x7c, 0x1d, 0xac, 0xf1, 0xee, 0x64, 0xbd, 0xd4, 0x3b, 0x6b, 0x76, 0xd6, 0x71, 0x2c, 0x21,
+	0x55, 0xea, 0x01, 0xa1, 0x22, 0x10, 0x17, 0x04, 0xe2, 0x17, 0x70, 0xcc, 0xa1, 0x3f, 0xa2, 0x07,
+	0x24, 0xaa, 0x9e, 0x10, 0x87, 0x82, 0x5a, 0x89, 0xf0, 0x0b, 0x38, 0x70, 0x01, 0xcd, 0xec, 0x78,
+	0xbd, 0xb3, 0x5e, 0xaf, 0x13, 0xb7, 0x15, 0x12, 0x97, 0xd6, 0x7e, 0xbf, 0x3f, 0x9e, 0x77, 0xe6,
+	0x9d, 0x18, 0x64, 0x08, 0x52, 0xa1, 0x52, 0x85, 0x3a, 0x2e, 0xa8, 0xd0, 0x86, 0xe5, 0xba, 0x65,
+	0x1e, 0xb4, 0x0a, 0xfb, 0xab, 0x05, 0xfb, 0x20, 0x5f, 0xb7, 0x4c, 0xdb, 0x94, 0xe7, 0x5c, 0x89,
+	0x7c, 0x47, 0x22, 0xbf, 0xbf, 0x9a, 0x9a, 0xd1, 0x4c, 0xcd, 0x64, 0x32, 0x05, 0xfa, 0xc9, 0x11,
+	0x4f, 0xcd, 0x2b, 0x26, 0x31, 0x4c, 0x52, 0x76, 0x18, 0xce, 0x17, 0xce, 0x4a, 0x3b, 0xdf, 0x0a,
+	0x15, 0x48, 0x50, 0x61, 0x7f, 0xb5, 0x82, 0x6c, 0xb8, 0x5a, 0x50, 0x4c, 0x1d, 0x73, 0xfe, 0x1c,
+	0xe7, 0x1b, 0x44, 0xa3, 0x11, 0x18, 0x44, 0xe3, 0x8c, 0x5c, 0xaf, 0x20, 0x3d, 0x01, 0x39, 0x92,
+	0xd3, 0xd0, 0xd0, 0xb1, 0x59, 0x60, 0xff, 0x3a, 0xa4, 0xec, 0x3f, 0x11, 0x30, 0xb3, 0x43, 0xb4,
+	0x12, 0xd2, 0x74, 0x62, 0x23, 0xab, 0x08, 0x6d, 0x78, 0x9b, 0x6a, 0xc8, 0xd7, 0x41, 0x1c, 0xaa,
+	0x86, 0x8e, 0xcb, 0x50, 0x55, 0x2d, 0x44, 0x48, 0x52, 0xca, 0x48, 0xb9, 0xb1, 0xcd, 0xe4, 0x93,
+	0x87, 0xcb, 0x33, 0x3c, 0xee, 0x0d, 0x87, 0xb3, 0x6b, 0x5b, 0x3a, 0xd6, 0x4a, 0x13, 0x4c, 0x9c,
+	0xd3, 0xe4, 0x37, 0xc1, 0x64, 0x1d, 0xb6, 0xcc, 0x86, 0xed, 0xea, 0x0f, 0xf7, 0xd1, 0x8f, 0x3b,
+	0xf2, 0x6d, 0x03, 0x9b, 0x20, 0xb2, 0x87, 0x50, 0x32, 0x92, 0x91, 0x72, 0xe3, 0x6b, 0xf3, 0x79,
+	0xae, 0x42, 0x8b, 0x93, 0xe7, 0xc5, 0xc9, 0xdf, 0x30, 0x75, 0xbc, 0x39, 0xfb, 0xc3, 0xd1, 0xe1,
+	0xd2, 0x78, 0x0d, 0x69, 0x50, 0x69, 0x95, 0x69, 0xb9, 0x7e, 0x3c, 0x3a, 0x5c, 0x92, 0x4a, 0x54,
+	0x59, 0x96, 0x41, 0xd4, 0x40, 0x86, 0x99, 0x8c, 0x52, 0xd7, 0x25, 0xf6, 0x59, 0x9e, 0x03, 0x23,
+	0xf5, 0x46, 0xa5, 0x7c, 0x17, 0xb5, 0x92, 0xa7, 0x18, 0x39, 0x56, 0x6f, 0x54, 0x6e, 0xa1, 0x96,
+	0xbc, 0x00, 0xc6, 0x88, 0xae, 0x61, 0x68, 0x37, 0x2c, 0x94, 0x8c, 0x31, 0x56, 0x87, 0x20, 0x17,
+	0x41, 0xb4, 0x62, 0x62, 0x35, 0x39, 0x32, 0x50, 0x3c, 0x43, 0x25, 0xa6, 0x2d, 0x7f, 0x0c, 0xa6,
+	0x79, 0x55, 0x2c, 0xa4, 0xe8, 0x75, 0x1d, 0x61, 0x9b, 0x24, 0x47, 0x33, 0x91, 0xdc, 0xf8, 0x5a,
+	0x2e, 0xdf, 0x03, 0x49, 0xf9, 0xdb, 0x4c, 0xa3, 0xd4, 0x56, 0xd8, 0x8c, 0x3e, 0x7a, 0xba, 0x38,
+	0x54, 0x4a, 0xd4, 0x45, 0x32, 0x59, 0xbf, 0x7a, 0xff, 0xe8, 0x70, 0x49, 0x6c, 0xda, 0x83, 0xa3,
+	0xc3, 0xa5, 0x74, 0x07, 0x1e, 0x41, 0x8d, 0xce, 0xa6, 0xc1, 0x42, 0x10, 0xbd, 0x84, 0x48, 0xdd,
+	0xc4, 0x04, 0x65, 0xff, 0x8c, 0x82, 0xc4, 0x0e, 0xd1, 0xb6, 0x54, 0xdd, 0xee, 0xa0, 0x63, 0x05,
+	0xc4, 0x08, 0xc2, 0x2a, 0xb2, 0xfa, 0xc2, 0x82, 0xcb, 0xc9, 0xb7, 0x80, 0x8c, 0x51, 0xb3, 0x1c,
+	0x08, 0x8a, 0x73, 0xbd, 0xb4, 0x9d, 0xda, 0x25, 0x30, 0x6a, 0xde, 0x16, 0xc0, 0x91, 0x01, 0xa3,
+	0xd4, 0x18, 0x6b, 0x6e, 0x84, 0x99, 0x38, 0xe5, 0xb4, 0x7d, 0x04, 0xa3, 0xe6, 0x0e, 0x6d, 0xf3,
+	0x4d, 0x40, 0x3f, 0x96, 0x29, 0x84, 0xa2, 0x03, 0xb6, 0x2c, 0x86, 0x51, 0x73, 0x1b, 0x21, 0xb9,
+	0x00, 0x12, 0x7b, 0x08, 0x95, 0x1b, 0x75, 0x15, 0xda, 0xa8, 0xac, 0xa2, 0x1a, 0x74, 0xa0, 0x13,
+	0x77, 0x9c, 0x0e, 0x95, 0x26, 0xf7, 0x10, 0xba, 0xc3, 0xb8, 0x45, 0xca, 0x94, 0xd3, 0x1d, 0x88,
+	0xc5, 0xbc, 0xc1, 0xb5, 0x91, 0x56, 0x01, 0xb3, 0x9e, 0x52, 0x78, 0x90, 0x30, 0x32, 0x10, 0x12,
+	0x4e, 0xbb, 0xe5, 0xe9, 0x80, 0x41, 0xfe, 0x10, 0x24, 0x78, 0xfe, 0x65, 0xa2, 0x54, 0x91, 0xda,
+	0xa8, 0x21, 0x0e, 0xb4, 0x8b, 0x3d, 0xcd, 0x6f, 0x23, 0xb4, 0xcb, 0x65, 0xb7, 0xb0, 0x6d, 0xb5,
+	0xb8, 0xfd, 0x49, 0xa7, 0x0e, 0x6d, 0x96, 0x7c, 0x19, 0xc8, 0x4a, 0x0d, 0x41, 0x4b, 0x34, 0x3e,
+	0x96, 0x91, 0x72, 0xa3, 0xa5, 0x04, 0xe3, 0x78, 0xa4, 0xd7, 0x97, 0x28, 0x2a, 0x39, 0x08, 0x28,
+	0x1c, 0x53, 0x02, 0x1c, 0x05, 0x54, 0x65, 0x7f, 0x96, 0x18, 0xd4, 0xde, 0xb3, 0x20, 0x26, 0x7b,
+	0xc8, 0xda, 0xa0, 0x50, 0x1e, 0x00, 0x6a, 0x45, 0x30, 0x4d, 0x73, 0x17, 0x8f, 0xaf, 0x7e, 0xc7,
+	0xcf, 0x14, 0x46, 0xcd, 0x0d, 0xef, 0x09, 0xe6, 0x39, 0x28, 0x22, 0xde, 0x83, 0xa2, 0x4f, 0x46,
+	0x42, 0xf0, 0xd9, 0x14, 0x48, 0xfa, 0x69, 0xee, 0x60, 0x6d, 0x33, 0x9e, 0x50, 0x81, 0x36, 0x4f,
+	0x5e, 0x02, 0xd3, 0x1e, 0xcc, 0x55, 0x91, 0xae, 0x55, 0x6d, 0x96, 0x7f, 0xa4, 0x34, 0xe5, 0xa2,
+	0xed, 0x6d, 0x46, 0xce, 0x7e, 0x25, 0x01, 0x79, 0x87, 0x68, 0x37, 0x20, 0x56, 0x50, 0x6d, 0xbb,
+	0xcd, 0x1c, 0xa0, 0x6e, 0x9e, 0x8c, 0x87, 0x85, 0x8c, 0x2f, 0xfb, 0x32, 0x5e, 0x10, 0x32, 0xf6,
+	0x39, 0xce, 0x2e, 0x80, 0x54, 0x37, 0xd5, 0xcd, 0xfa, 0xb7, 0x61, 0x30, 0xbd, 0x43, 0xb4, 0x0d,
+	0x03, 0x61, 0xf5, 0x55, 0x04, 0xeb, 0x9d, 0xfc, 0xc8, 0x0b, 0x4e, 0x7e, 0xd0, 0x10, 0x45, 0x5f,
+	0xe5, 0x10, 0x9d, 0xea, 0x31, 0x44, 0x97, 0x7c, 0x0d, 0x38, 0x2b, 0x34, 0x40, 0xac, 0x65, 0xf6,
+	0x2d, 0x30, 0xdf, 0x45, 0x1c, 0x08, 0x58, 0xf7, 0x87, 0xd9, 0x38, 0x6e, 0x9a, 0x58, 0x7d, 0x91,
+	0x93, 0xbf, 0x67, 0xa7, 0x9a, 0x20, 0x06, 0x0d, 0xb3, 0x81, 0xed, 0xfe, 0x8d, 0x2a, 0xd2, 0x22,
+	0xfe, 0xfd, 0x74, 0xf1, 0x35, 0x4d, 0xb7, 0xab, 0x8d, 0x4a, 0x5e, 0x31, 0x0d, 0xbe, 0x3d, 0xf1,
+	0xff, 0x96, 0x89, 0x7a, 0xb7, 0x60, 0xb7, 0xea, 0x88, 0x30, 0x85, 0xe0, 0xa5, 0x80, 0xbb, 0xeb,
+	0x33, 0xc1, 0x42, 0xbe, 0x7c, 0x82, 0x05, 0x9a, 0x8b, 0xe5, 0xcf, 0x87, 0xd9, 0xe4, 0xdd, 0xc1,
+	0x95, 0xff, 0x5d, 0x89, 0xc2, 0x47, 0xde, 0x97, 0x71, 0xf6, 0x26, 0x1b, 0x79, 0x1f, 0xd5, 0xc5,
+	0xdc, 0x25, 0x30, 0xad, 0x98, 0x46, 0xbd, 0x86, 0x6c, 0xdd, 0xc4, 0x22, 0xe6, 0x12, 0x1d, 0x06,
+	0x07, 0xdd, 0xb7, 0x12, 0x38, 0xb3, 0x43, 0xb4, 0x22, 0xb2, 0xba, 0x56, 0xd2, 0x97, 0x78, 0xa2,
+	0xad, 0xf8, 0xd2, 0xcb, 0x08, 0xe9, 0x05, 0x38, 0xcf, 0xde, 0x01, 0xe9, 0x60, 0x8e, 0x9b, 0xe6,
+	0x15, 0x30, 0xab, 0x72, 0xb6, 0x05, 0xbb, 0x53, 0x9d, 0x11, 0x99, 0x3c, 0xdd, 0xbf, 0x24, 0x30,
+	0x4b, 0xd7, 0x2f, 0xd3, 0xa6, 0xeb, 0x43, 0xdb, 0x26, 0x6d, 0xfd, 0x4b, 0x44, 0x51, 0x1a, 0x8c,
+	0xb3, 0x85, 0x43, 0xb8, 0xce, 0xc6, 0xe8, 0xda, 0x10, 0xb0, 0xfa, 0x46, 0xfd, 0xab, 0xef, 0x05,
+	0x10, 0xa7, 0xda, 0x1d, 0x09, 0x67, 0x6f, 0x9e, 0xc0, 0xa8, 0xb9, 0xdb, 0xa6, 0xad, 0x17, 0x7c,
+	0x05, 0x5d, 0x14, 0xb7, 0xce, 0xae, 0xf4, 0xb2, 0xef, 0x83, 0x73, 0x81, 0x0c, 0xb7, 0x9c, 0xd7,
+	0xc0, 0x1c, 0xac, 0xe9, 0x90, 0x94, 0xd1, 0x41, 0x5d, 0x0f, 0x2a, 0xe8, 0x2c, 0x63, 0x6f, 0xb9,
+	0x5c, 0x5e, 0xd1, 0x3f, 0x24, 0x76, 0xc1, 0xec, 0xd6, 0x20, 0xa9, 0x76, 0xb0, 0x73, 0x0d, 0x8c,
+	0xc1, 0x86, 0x5d, 0x35, 0x2d, 0xdd, 0x6e, 0xf5, 0x2d, 0x68, 0x47, 0xb4, 0x77, 0x4d, 0x3f, 0x00,
+	0x93, 0x84, 0xba, 0x28, 0xef, 0x59, 0x50, 0xa1, 0xee, 0xf9, 0x22, 0xba, 0x4a, 0xc7, 0xf0, 0xd7,
+	0xa7, 0x8b, 0x67, 0x1d, 0xcb, 0x44, 0xbd, 0x9b, 0xd7, 0xcd, 0x82, 0x01, 0xed, 0x6a, 0xfe, 0x1d,
+	0x36, 0x6c, 0x45, 0xa4, 0x3c, 0x79, 0xb8, 0x0c, 0xb8, 0xe3, 0x22, 0x52, 0x4a, 0x71, 0x66, 0x68,
+	0x9b, 0xdb, 0x91, 0xcf, 0x80, 0x98, 0x85, 0x20, 0x31, 0x31, 0x6f, 0x05, 0xff, 0xb6, 0x3e, 0x49,
+	0x4b, 0xdc, 0x09, 0x2d, 0xfb, 0xb5, 0xc4, 0x0e, 0x7a, 0x31, 0x51, 0xb7, 0x7c, 0x9f, 0xf2, 0xf8,
+	0x90, 0x5a, 0xe6, 0x27, 0x88, 0xd4, 0xef, 0x04, 0x29, 0x9c, 0xf0, 0x04, 0xe1, 0x81, 0x23, 0x75,
+	0x83, 0x39, 0xc8, 0x3e, 0x70, 0x46, 0xd7, 0xb9, 0x43, 0x58, 0x34, 0x45, 0x84, 0x5b, 0x35, 0x9d,
+	0xd8, 0x03, 0x97, 0x3f, 0x01, 0x22, 0x50, 0x55, 0x93, 0xc3, 0x99, 0x48, 0x6e, 0xac, 0x44, 0x3f,
+	0x3a, 0xd5, 0x31, 0xcc, 0x7d, 0x7a, 0xbb, 0x47, 0x9c, 0xea, 0xd0, 0x6f, 0x5d, 0xd5, 0xc9, 0xb0,
+	0x79, 0x0d, 0x88, 0xc5, 0x3d, 0xbd, 0xbf, 0x94, 0xc0, 0x9c, 0x28, 0xb2, 0x51, 0xab, 0x99, 0xcd,
+	0xff, 0x28, 0xde, 0xf3, 0x60, 0xb1, 0x47, 0x30, 0x6e, 0xc0, 0x3f, 0x39, 0x0d, 0x77, 0x64, 0xb6,
+	0x11, 0xda, 0x3a, 0x50, 0xaa, 0x10, 0x6b, 0xa8, 0x04, 0x6d, 0x44, 0x06, 0x7a, 0x92, 0x8d, 0x11,
+	0x64, 0x97, 0x2d, 0xaa, 0xce, 0x42, 0x0e, 0x7b, 0x7b, 0xf8, 0xfc, 0xf1, 0xb5, 0x66, 0x94, 0x20,
+	0xdb, 0x71, 0x7f, 0x01, 0xc4, 0x9d, 0xcc, 0xca, 0x2a, 0xc2, 0xa6, 0x41, 0x78, 0xba, 0x13, 0x0e,
+	0xb1, 0xc8, 0x68, 0xeb, 0xe3, 0x9e, 0x53, 0x22, 0x7b, 0x01, 0x9c, 0xef, 0x99, 0x8d, 0x9b, 0xf3,
+	0xf7, 0x12, 0x98, 0xea, 0xd4, 0x05, 0x5a, 0xd0, 0x20, 0x03, 0x37, 0xe7, 0x3a, 0x88, 0xd5, 0x99,
+	0x05, 0x36, 0xca, 0xe3, 0x6b, 0x8b, 0x21, 0x0f, 0x2d, 0x2a, 0xc6, 0x73, 0xe4, 0x4a, 0x5d, 0x1d,
+	0x9b, 0xf7, 0xc2, 0x87, 0x89, 0xb4, 0xa3, 0x5e, 0xfb, 0x2e, 0x0e, 0x22, 0x3b, 0x44, 0x93, 0x5b,
+	0x60, 0xba, 0xfb, 0x2f, 0x2b, 0xcb, 0x3d, 0xdd, 0x06, 0xbd, 0xc3, 0x53, 0x57, 0x4f, 0x24, 0xee,
+	0xce, 0xbf, 0x01, 0xe2, 0xe2, 0x93, 0xfd, 0x62, 0x98, 0x1d, 0x41, 0x34, 0xb5, 0x7a, 0x6c, 0x51,
+	0xd7, 0x1d, 0x01, 0x53, 0xfe, 0x07, 0xc8, 0xa5, 0x30, 0x2b, 0x3e, 0xe1, 0xd4, 0x95, 0x13, 0x08,
+	0xbb, 0x4e, 0xeb, 0x60, 0xd2, 0xf7, 0x8e, 0x58, 0x0a, 0x33, 0x23, 0xca, 0xa6, 0xd6, 0x8e, 0x2f,
+	0xeb, 0xad, 0xaa, 0xf8, 0x3a, 0x0d, 0xad, 0xaa, 0x20, 0x1a, 0x5e, 0xd5, 0xc0, 0x27, 0x22, 0x75,
+	0x27, 0x6e, 0xdf, 0xa1, 0xee, 0x04, 0xd1, 0x70, 0x77, 0x81, 0xfb, 0x2c, 0x6d, 0xa2, 0x7f, 0x97,
+	0x0d, 0x6d, 0xa2, 0x4f, 0x38, 0xbc, 0x89, 0xbd, 0xb6, 0xc3, 0x7b, 0xe0, 0x74, 0xd0, 0xb2, 0x57,
+	0x08, 0xb3, 0x15, 0xa0, 0x90, 0x7a, 0xfd, 0x84, 0x0a, 0x6e, 0x00, 0x9f, 0x01, 0x39, 0x60, 0xfd,
+	0xca, 0x87, 0x8e, 0x5d, 0x97, 0x7c, 0xea, 0xda, 0xc9, 0xe4, 0xbd, 0x18, 0xf6, 0xad, 0x2a, 0xa1,
+	0x18, 0x16, 0x65, 0xc3, 0x31, 0xdc, 0x63, 0x33, 0xb8, 0x07, 0x4e, 0x07, 0x5d, 0xd1, 0xa1, 0x05,
+	0x0f, 0x50, 0x08, 0x2f, 0x78, 0xc8, 0xc5, 0x2b, 0xdf, 0x97, 0xc0, 0x4c, 0xe0, 0xad, 0xbb, 0x72,
+	0x4c, 0x8b, 0xae, 0x46, 0xea, 0x8d, 0x93, 0x6a, 0xb8, 0x41, 0x7c, 0x21, 0x81, 0x33, 0x3d, 0x6e,
+	0xd2, 0xb5, 0xfe, 0x46, 0xfd, 0x3a, 0xa9, 0xf5, 0x93, 0xeb, 0xb8, 0xa1, 0x7c, 0x02, 0x26, 0x84,
+	0xfb, 0x2d, 0x77, 0x8c, 0xa4, 0x98, 0x64, 0x6a, 0xe5, 0xb8, 0x92, 0x6d, 0x5f, 0x9b, 0xef, 0x3e,
+	0x7a, 0x96, 0x96, 0x1e, 0x3f, 0x4b, 0x4b, 0xbf, 0x3f, 0x4b, 0x4b, 0xdf, 0x3c, 0x4f, 0x0f, 0x3d,
+	0x7e, 0x9e, 0x1e, 0xfa, 0xe5, 0x79, 0x7a, 0xe8, 0xa3, 0xab, 0x9e, 0xad, 0x8f, 0x5a, 0x65, 0xbf,
+	0x0f, 0x28, 0x66, 0x8d, 0x7d, 0x59, 0x76, 0x56, 0xf9, 0x03, 0xf6, 0x9b, 0xc2, 0xb2, 0xf3, 0x0b,
+	0x03, 0x5b, 0x04, 0x2b, 0x31, 0x26, 0x77, 0xe5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x39,
+	0xd4, 0x1f, 0x2b, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Registers a new data proxy entry in the registry.
+	RegisterDataProxy(ctx context.Context, in *MsgRegisterDataProxy, opts ...grpc.CallOption) (*MsgRegisterDataProxyResponse, error)
+	// Edits an existing data proxy.
+	EditDataProxy(ctx context.Context, in *MsgEditDataProxy, opts ...grpc.CallOption) (*MsgEditDataProxyResponse, error)
+	// Cancels the pending fee update of a data proxy.
+	CancelFeeUpdate(ctx context.Context, in *MsgCancelFeeUpdate, opts ...grpc.CallOption) (*MsgCancelFeeUpdateResponse, error)
+	// Amends the pending fee update of a data proxy.
+	AmendFeeUpdate(ctx context.Context, in *MsgAmendFeeUpdate, opts ...grpc.CallOption) (*MsgAmendFeeUpdateResponse, error)
+	// Transfers the admin address of a data proxy
+	TransferAdmin(ctx context.Context, in *MsgTransferAdmin, opts ...grpc.CallOption) (*MsgTransferAdminResponse, error)
+	// Adds funds to the bond of an existing data proxy.
+	BondDataProxy(ctx context.Context, in *MsgBondDataProxy, opts ...grpc.CallOption) (*MsgBondDataProxyResponse, error)
+	// Starts unbonding funds from the bond of a data proxy.
+	UnbondDataProxy(ctx context.Context, in *MsgUnbondDataProxy, opts ...grpc.CallOption) (*MsgUnbondDataProxyResponse, error)
+	// Deregisters a data proxy after the deregistration delay.
+	DeregisterDataProxy(ctx context.Context, in *MsgDeregisterDataProxy, opts ...grpc.CallOption) (*MsgDeregisterDataProxyResponse, error)
+	// Moves a data proxy config to a new public key.
+	RotateDataProxyKey(ctx context.Context, in *MsgRotateDataProxyKey, opts ...grpc.CallOption) (*MsgRotateDataProxyKeyResponse, error)
+	// Slashes the bond of a misbehaving data proxy through governance.
+	SlashDataProxy(ctx context.Context, in *MsgSlashDataProxy, opts ...grpc.CallOption) (*MsgSlashDataProxyResponse, error)
+	// Adds or removes data proxies from the denylist through governance.
+	UpdateProxyDenylist(ctx context.Context, in *MsgUpdateProxyDenylist, opts ...grpc.CallOption) (*MsgUpdateProxyDenylistResponse, error)
+	// Adds or removes data proxies from the allowlist through governance.
+	UpdateProxyAllowlist(ctx context.Context, in *MsgUpdateProxyAllowlist, opts ...grpc.CallOption) (*MsgUpdateProxyAllowlistResponse, error)
+	// Sets or removes fee exchange rates through governance or the exchange
+	// rate oracle.
+	UpdateFeeExchangeRates(ctx context.Context, in *MsgUpdateFeeExchangeRates, opts ...grpc.CallOption) (*MsgUpdateFeeExchangeRatesResponse, error)
+	// Used to update the modules parameters through governance.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterDataProxy(ctx context.Context, in *MsgRegisterDataProxy, opts ...grpc.CallOption) (*MsgRegisterDataProxyResponse, error) {
+	out := new(MsgRegisterDataProxyResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/RegisterDataProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EditDataProxy(ctx context.Context, in *MsgEditDataProxy, opts ...grpc.CallOption) (*MsgEditDataProxyResponse, error) {
+	out := new(MsgEditDataProxyResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/EditDataProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelFeeUpdate(ctx context.Context, in *MsgCancelFeeUpdate, opts ...grpc.CallOption) (*MsgCancelFeeUpdateResponse, error) {
+	out := new(MsgCancelFeeUpdateResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/CancelFeeUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AmendFeeUpdate(ctx context.Context, in *MsgAmendFeeUpdate, opts ...grpc.CallOption) (*MsgAmendFeeUpdateResponse, error) {
+	out := new(MsgAmendFeeUpdateResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/AmendFeeUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferAdmin(ctx context.Context, in *MsgTransferAdmin, opts ...grpc.CallOption) (*MsgTransferAdminResponse, error) {
+	out := new(MsgTransferAdminResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/TransferAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BondDataProxy(ctx context.Context, in *MsgBondDataProxy, opts ...grpc.CallOption) (*MsgBondDataProxyResponse, error) {
+	out := new(MsgBondDataProxyResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/BondDataProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnbondDataProxy(ctx context.Context, in *MsgUnbondDataProxy, opts ...grpc.CallOption) (*MsgUnbondDataProxyResponse, error) {
+	out := new(MsgUnbondDataProxyResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/UnbondDataProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterDataProxy(ctx context.Context, in *MsgDeregisterDataProxy, opts ...grpc.CallOption) (*MsgDeregisterDataProxyResponse, error) {
+	out := new(MsgDeregisterDataProxyResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/DeregisterDataProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateDataProxyKey(ctx context.Context, in *MsgRotateDataProxyKey, opts ...grpc.CallOption) (*MsgRotateDataProxyKeyResponse, error) {
+	out := new(MsgRotateDataProxyKeyResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/RotateDataProxyKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SlashDataProxy(ctx context.Context, in *MsgSlashDataProxy, opts ...grpc.CallOption) (*MsgSlashDataProxyResponse, error) {
+	out := new(MsgSlashDataProxyResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/SlashDataProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateProxyDenylist(ctx context.Context, in *MsgUpdateProxyDenylist, opts ...grpc.CallOption) (*MsgUpdateProxyDenylistResponse, error) {
+	out := new(MsgUpdateProxyDenylistResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/UpdateProxyDenylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateProxyAllowlist(ctx context.Context, in *MsgUpdateProxyAllowlist, opts ...grpc.CallOption) (*MsgUpdateProxyAllowlistResponse, error) {
+	out := new(MsgUpdateProxyAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/UpdateProxyAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeeExchangeRates(ctx context.Context, in *MsgUpdateFeeExchangeRates, opts ...grpc.CallOption) (*MsgUpdateFeeExchangeRatesResponse, error) {
+	out := new(MsgUpdateFeeExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/UpdateFeeExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.data_proxy.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Registers a new data proxy entry in the registry.
+	RegisterDataProxy(context.Context, *MsgRegisterDataProxy) (*MsgRegisterDataProxyResponse, error)
+	// Edits an existing data proxy.
+	EditDataProxy(context.Context, *MsgEditDataProxy) (*MsgEditDataProxyResponse, error)
+	// Cancels the pending fee update of a data proxy.
+	CancelFeeUpdate(context.Context, *MsgCancelFeeUpdate) (*MsgCancelFeeUpdateResponse, error)
+	// Amends the pending fee update of a data proxy.
+	AmendFeeUpdate(context.Context, *MsgAmendFeeUpdate) (*MsgAmendFeeUpdateResponse, error)
+	// Transfers the admin address of a data proxy
+	TransferAdmin(context.Context, *MsgTransferAdmin) (*MsgTransferAdminResponse, error)
+	// Adds funds to the bond of an existing data proxy.
+	BondDataProxy(context.Context, *MsgBondDataProxy) (*MsgBondDataProxyResponse, error)
+	// Starts unbonding funds from the bond of a data proxy.
+	UnbondDataProxy(context.Context, *MsgUnbondDataProxy) (*MsgUnbondDataProxyResponse, error)
+	// Deregisters a data proxy after the deregistration delay.
+	DeregisterDataProxy(context.Context, *MsgDeregisterDataProxy) (*MsgDeregisterDataProxyResponse, error)
+	// Moves a data proxy config to a new public key.
+	RotateDataProxyKey(context.Context, *MsgRotateDataProxyKey) (*MsgRotateDataProxyKeyResponse, error)
+	// Slashes the bond of a misbehaving data proxy through governance.
+	SlashDataProxy(context.Context, *MsgSlashDataProxy) (*MsgSlashDataProxyResponse, error)
+	// Adds or removes data proxies from the denylist through governance.
+	UpdateProxyDenylist(context.Context, *MsgUpdateProxyDenylist) (*MsgUpdateProxyDenylistResponse, error)
+	// Adds or removes data proxies from the allowlist through governance.
+	UpdateProxyAllowlist(context.Context, *MsgUpdateProxyAllowlist) (*MsgUpdateProxyAllowlistResponse, error)
+	// Sets or removes fee exchange rates through governance or the exchange
+	// rate oracle.
+	UpdateFeeExchangeRates(context.Context, *MsgUpdateFeeExchangeRates) (*MsgUpdateFeeExchangeRatesResponse, error)
+	// Used to update the modules parameters through governance.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterDataProxy(ctx context.Context, req *MsgRegisterDataProxy) (*MsgRegisterDataProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDataProxy not implemented")
+}
+func (*UnimplementedMsgServer) EditDataProxy(ctx context.Context, req *MsgEditDataProxy) (*MsgEditDataProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditDataProxy not implemented")
+}
+func (*UnimplementedMsgServer) CancelFeeUpdate(ctx context.Context, req *MsgCancelFeeUpdate) (*MsgCancelFeeUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFeeUpdate not implemented")
+}
+func (*UnimplementedMsgServer) AmendFeeUpdate(ctx context.Context, req *MsgAmendFeeUpdate) (*MsgAmendFeeUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendFeeUpdate not implemented")
+}
+func (*UnimplementedMsgServer) TransferAdmin(ctx context.Context, req *MsgTransferAdmin) (*MsgTransferAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferAdmin not implemented")
+}
+func (*UnimplementedMsgServer) BondDataProxy(ctx context.Context, req *MsgBondDataProxy) (*MsgBondDataProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondDataProxy not implemented")
+}
+func (*UnimplementedMsgServer) UnbondDataProxy(ctx context.Context, req *MsgUnbondDataProxy) (*MsgUnbondDataProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondDataProxy not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterDataProxy(ctx context.Context, req *MsgDeregisterDataProxy) (*MsgDeregisterDataProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterDataProxy not implemented")
+}
+func (*UnimplementedMsgServer) RotateDataProxyKey(ctx context.Context, req *MsgRotateDataProxyKey) (*MsgRotateDataProxyKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateDataProxyKey not implemented")
+}
+func (*UnimplementedMsgServer) SlashDataProxy(ctx context.Context, req *MsgSlashDataProxy) (*MsgSlashDataProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashDataProxy not implemented")
+}
+func (*UnimplementedMsgServer) UpdateProxyDenylist(ctx context.Context, req *MsgUpdateProxyDenylist) (*MsgUpdateProxyDenylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProxyDenylist not implemented")
+}
+func (*UnimplementedMsgServer) UpdateProxyAllowlist(ctx context.Context, req *MsgUpdateProxyAllowlist) (*MsgUpdateProxyAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProxyAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFeeExchangeRates(ctx context.Context, req *MsgUpdateFeeExchangeRates) (*MsgUpdateFeeExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeExchangeRates not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterDataProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDataProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDataProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/RegisterDataProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDataProxy(ctx, req.(*MsgRegisterDataProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EditDataProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditDataProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditDataProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/EditDataProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditDataProxy(ctx, req.(*MsgEditDataProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelFeeUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelFeeUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelFeeUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/CancelFeeUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelFeeUpdate(ctx, req.(*MsgCancelFeeUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendFeeUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendFeeUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendFeeUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/AmendFeeUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendFeeUpdate(ctx, req.(*MsgAmendFeeUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/TransferAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferAdmin(ctx, req.(*MsgTransferAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BondDataProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBondDataProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BondDataProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/BondDataProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BondDataProxy(ctx, req.(*MsgBondDataProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbondDataProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondDataProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondDataProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/UnbondDataProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondDataProxy(ctx, req.(*MsgUnbondDataProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterDataProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterDataProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterDataProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/DeregisterDataProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterDataProxy(ctx, req.(*MsgDeregisterDataProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateDataProxyKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateDataProxyKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateDataProxyKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/RotateDataProxyKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateDataProxyKey(ctx, req.(*MsgRotateDataProxyKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SlashDataProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSlashDataProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SlashDataProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/SlashDataProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SlashDataProxy(ctx, req.(*MsgSlashDataProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProxyDenylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProxyDenylist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProxyDenylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/UpdateProxyDenylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProxyDenylist(ctx, req.(*MsgUpdateProxyDenylist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProxyAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProxyAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProxyAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/UpdateProxyAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProxyAllowlist(ctx, req.(*MsgUpdateProxyAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeeExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeExchangeRates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/UpdateFeeExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeExchangeRates(ctx, req.(*MsgUpdateFeeExchangeRates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.data_proxy.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sedachain.data_proxy.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterDataProxy",
+			Handler:    _Msg_RegisterDataProxy_Handler,
+		},
+		{
+			MethodName: "EditDataProxy",
+			Handler:    _Msg_EditDataProxy_Handler,
+		},
+		{
+			MethodName: "CancelFeeUpdate",
+			Handler:    _Msg_CancelFeeUpdate_Handler,
+		},
+		{
+			MethodName: "AmendFeeUpdate",
+			Handler:    _Msg_AmendFeeUpdate_Handler,
+		},
+		{
+			MethodName: "TransferAdmin",
+			Handler:    _Msg_TransferAdmin_Handler,
+		},
+		{
+			MethodName: "BondDataProxy",
+			Handler:    _Msg_BondDataProxy_Handler,
+		},
+		{
+			MethodName: "UnbondDataProxy",
+			Handler:    _Msg_UnbondDataProxy_Handler,
+		},
+		{
+			MethodName: "DeregisterDataProxy",
+			Handler:    _Msg_DeregisterDataProxy_Handler,
+		},
+		{
+			MethodName: "RotateDataProxyKey",
+			Handler:    _Msg_RotateDataProxyKey_Handler,
+		},
+		{
+			MethodName: "SlashDataProxy",
+			Handler:    _Msg_SlashDataProxy_Handler,
+		},
+		{
+			MethodName: "UpdateProxyDenylist",
+			Handler:    _Msg_UpdateProxyDenylist_Handler,
+		},
+		{
+			MethodName: "UpdateProxyAllowlist",
+			Handler:    _Msg_UpdateProxyAllowlist_Handler,
+		},
+		{
+			MethodName: "UpdateFeeExchangeRates",
+			Handler:    _Msg_UpdateFeeExchangeRates_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sedachain/data_proxy/v1/tx.proto",
+}
+
+func (m *MsgRegisterDataProxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDataProxy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDataProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayoutRecipients) > 0 {
+		for iNdEx := len(m.PayoutRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayoutRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Bond != nil {
+		{
+			size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Fee != nil {
+		{
+			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PayoutAddress) > 0 {
+		i -= len(m.PayoutAddress)
+		copy(dAtA[i:], m.PayoutAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayoutAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AdminAddress) > 0 {
+		i -= len(m.AdminAddress)
+		copy(dAtA[i:], m.AdminAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AdminAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDataProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDataProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDataProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditDataProxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditDataProxy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditDataProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClearFeeSchedule {
+		i--
+		if m.ClearFeeSchedule {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.NewFeeSchedule) > 0 {
+		for iNdEx := len(m.NewFeeSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewFeeSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.NewPayoutRecipients) > 0 {
+		for iNdEx := len(m.NewPayoutRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewPayoutRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FeeUpdateDelay != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeUpdateDelay))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NewFee != nil {
+		{
+			size, err := m.NewFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewMemo) > 0 {
+		i -= len(m.NewMemo)
+		copy(dAtA[i:], m.NewMemo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewMemo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewPayoutAddress) > 0 {
+		i -= len(m.NewPayoutAddress)
+		copy(dAtA[i:], m.NewPayoutAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewPayoutAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewAdminAddress) > 0 {
+		i -= len(m.NewAdminAddress)
+		copy(dAtA[i:], m.NewAdminAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdminAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditDataProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditDataProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditDataProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeUpdateHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeUpdateHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelFeeUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelFeeUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelFeeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelFeeUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelFeeUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelFeeUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendFeeUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendFeeUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendFeeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClearFeeSchedule {
+		i--
+		if m.ClearFeeSchedule {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewFeeSchedule) > 0 {
+		for iNdEx := len(m.NewFeeSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewFeeSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NewFee != nil {
+		{
+			size, err := m.NewFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendFeeUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendFeeUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendFeeUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeUpdateHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeUpdateHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondDataProxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondDataProxy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondDataProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondDataProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondDataProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondDataProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondDataProxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondDataProxy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondDataProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondDataProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondDataProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondDataProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterDataProxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterDataProxy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterDataProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterDataProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterDataProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterDataProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeregistrationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeregistrationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateDataProxyKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateDataProxyKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateDataProxyKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewSignature) > 0 {
+		i -= len(m.NewSignature)
+		copy(dAtA[i:], m.NewSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewPubKey) > 0 {
+		i -= len(m.NewPubKey)
+		copy(dAtA[i:], m.NewPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewPubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateDataProxyKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateDataProxyKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateDataProxyKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AliasExpirationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AliasExpirationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashDataProxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashDataProxy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashDataProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashDataProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashDataProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashDataProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SlashedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProxyDenylist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProxyDenylist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProxyDenylist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProxyDenylistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProxyDenylistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProxyDenylistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProxyAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProxyAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProxyAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProxyAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProxyAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProxyAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeExchangeRates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeExchangeRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeExchangeRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveDenoms) > 0 {
+		for iNdEx := len(m.RemoveDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveDenoms[iNdEx])
+			copy(dAtA[i:], m.RemoveDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SetRates) > 0 {
+		for iNdEx := len(m.SetRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SetRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterDataProxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Bond != nil {
+		l = m.Bond.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PayoutRecipients) > 0 {
+		for _, e := range m.PayoutRecipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterDataProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditDataProxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewPayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewMemo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewFee != nil {
+		l = m.NewFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeeUpdateDelay != 0 {
+		n += 1 + sovTx(uint64(m.FeeUpdateDelay))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NewPayoutRecipients) > 0 {
+		for _, e := range m.NewPayoutRecipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.NewFeeSchedule) > 0 {
+		for _, e := range m.NewFeeSchedule {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ClearFeeSchedule {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgTransferAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAdminAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditDataProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeUpdateHeight != 0 {
+		n += 1 + sovTx(uint64(m.FeeUpdateHeight))
+	}
+	return n
+}
+
+func (m *MsgCancelFeeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelFeeUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAmendFeeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewFee != nil {
+		l = m.NewFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NewFeeSchedule) > 0 {
+		for _, e := range m.NewFeeSchedule {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ClearFeeSchedule {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAmendFeeUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeUpdateHeight != 0 {
+		n += 1 + sovTx(uint64(m.FeeUpdateHeight))
+	}
+	return n
+}
+
+func (m *MsgBondDataProxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBondDataProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnbondDataProxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnbondDataProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		n += 1 + sovTx(uint64(m.CompletionHeight))
+	}
+	return n
+}
+
+func (m *MsgDeregisterDataProxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterDataProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeregistrationHeight != 0 {
+		n += 1 + sovTx(uint64(m.DeregistrationHeight))
+	}
+	return n
+}
+
+func (m *MsgRotateDataProxyKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateDataProxyKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AliasExpirationHeight != 0 {
+		n += 1 + sovTx(uint64(m.AliasExpirationHeight))
+	}
+	return n
+}
+
+func (m *MsgSlashDataProxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSlashDataProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateProxyDenylist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Add) > 0 {
+		for _, s := range m.Add {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateProxyDenylistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateProxyAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Add) > 0 {
+		for _, s := range m.Add {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateProxyAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateFeeExchangeRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SetRates) > 0 {
+		for _, e := range m.SetRates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveDenoms) > 0 {
+		for _, s := range m.RemoveDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateFeeExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterDataProxy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDataProxy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDataProxy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fee == nil {
+				m.Fee = &types.Coin{}
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bond == nil {
+				m.Bond = &types.Coin{}
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutRecipients = append(m.PayoutRecipients, PayoutRecipient{})
+			if err := m.PayoutRecipients[len(m.PayoutRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDataProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDataProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDataProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditDataProxy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditDataProxy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditDataProxy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPayoutAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMemo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewMemo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewFee == nil {
+				m.NewFee = &types.Coin{}
+			}
+			if err := m.NewFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeUpdateDelay", wireType)
+			}
+			m.FeeUpdateDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeUpdateDelay |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPayoutRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPayoutRecipients = append(m.NewPayoutRecipients, PayoutRecipient{})
+			if err := m.NewPayoutRecipients[len(m.NewPayoutRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFeeSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFeeSchedule = append(m.NewFeeSchedule, FeeScheduleEntry{})
+			if err := m.NewFeeSchedule[len(m.NewFeeSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearFeeSchedule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClearFeeSchedule = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditDataProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditDataProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditDataProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeUpdateHeight", wireType)
+			}
+			m.FeeUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFeeUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFeeUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFeeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFeeUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFeeUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFeeUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendFeeUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendFeeUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendFeeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewFee == nil {
+				m.NewFee = &types.Coin{}
+			}
+			if err := m.NewFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFeeSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFeeSchedule = append(m.NewFeeSchedule, FeeScheduleEntry{})
+			if err := m.NewFeeSchedule[len(m.NewFeeSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearFeeSchedule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClearFeeSchedule = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendFeeUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendFeeUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendFeeUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeUpdateHeight", wireType)
+			}
+			m.FeeUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondDataProxy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondDataProxy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondDataProxy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondDataProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondDataProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondDataProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondDataProxy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondDataProxy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondDataProxy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondDataProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondDataProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondDataProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
+			}
+			m.CompletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterDataProxy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterDataProxy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterDataProxy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterDataProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterDataProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterDataProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeregistrationHeight", wireType)
+			}
+			m.DeregistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeregistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateDataProxyKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateDataProxyKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateDataProxyKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgEditDataProxyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FeeUpdateHeight != 0 {
-		n += 1 + sovTx(uint64(m.FeeUpdateHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRotateDataProxyKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateDataProxyKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateDataProxyKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AliasExpirationHeight", wireType)
+			}
+			m.AliasExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AliasExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterDataProxy) Unmarshal(dAtA []byte) error {
+func (m *MsgSlashDataProxy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1252,15 +6291,15 @@ func (m *MsgRegisterDataProxy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterDataProxy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSlashDataProxy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterDataProxy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSlashDataProxy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1288,11 +6327,11 @@ func (m *MsgRegisterDataProxy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdminAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1320,11 +6359,127 @@ func (m *MsgRegisterDataProxy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			m.PubKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSlashDataProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSlashDataProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSlashDataProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1351,16 +6506,63 @@ func (m *MsgRegisterDataProxy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fee == nil {
-				m.Fee = &types.Coin{}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateProxyDenylist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProxyDenylist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProxyDenylist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1388,11 +6590,11 @@ func (m *MsgRegisterDataProxy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1420,11 +6622,11 @@ func (m *MsgRegisterDataProxy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1452,7 +6654,7 @@ func (m *MsgRegisterDataProxy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1475,7 +6677,7 @@ func (m *MsgRegisterDataProxy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterDataProxyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProxyDenylistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1498,10 +6700,10 @@ func (m *MsgRegisterDataProxyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterDataProxyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProxyDenylistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterDataProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProxyDenylistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1525,7 +6727,7 @@ func (m *MsgRegisterDataProxyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditDataProxy) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProxyAllowlist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1548,15 +6750,15 @@ func (m *MsgEditDataProxy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditDataProxy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProxyAllowlist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditDataProxy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProxyAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1584,11 +6786,11 @@ func (m *MsgEditDataProxy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPayoutAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1616,11 +6818,11 @@ func (m *MsgEditDataProxy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewPayoutAddress = string(dAtA[iNdEx:postIndex])
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewMemo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1648,95 +6850,58 @@ func (m *MsgEditDataProxy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewMemo = string(dAtA[iNdEx:postIndex])
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewFee == nil {
-				m.NewFee = &types.Coin{}
-			}
-			if err := m.NewFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeUpdateDelay", wireType)
-			}
-			m.FeeUpdateDelay = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FeeUpdateDelay |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateProxyAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProxyAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProxyAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1758,7 +6923,7 @@ func (m *MsgEditDataProxy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFeeExchangeRates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1781,10 +6946,10 @@ func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFeeExchangeRates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFeeExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1821,9 +6986,9 @@ func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdminAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetRates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1833,27 +6998,29 @@ func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAdminAddress = string(dAtA[iNdEx:postIndex])
+			m.SetRates = append(m.SetRates, FeeExchangeRate{})
+			if err := m.SetRates[len(m.SetRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1881,7 +7048,7 @@ func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
+			m.RemoveDenoms = append(m.RemoveDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1904,57 +7071,7 @@ func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferAdminResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferAdminResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgEditDataProxyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFeeExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1977,31 +7094,12 @@ func (m *MsgEditDataProxyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditDataProxyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFeeExchangeRatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditDataProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFeeExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeUpdateHeight", wireType)
-			}
-			m.FeeUpdateHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FeeUpdateHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
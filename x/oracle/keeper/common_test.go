@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/sedaprotocol/seda-chain/x/oracle"
+	"github.com/sedaprotocol/seda-chain/x/oracle/keeper"
+	"github.com/sedaprotocol/seda-chain/x/oracle/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgSrvr     types.MsgServer
+	queryClient types.QueryClient
+	authority   string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	t := s.T()
+	t.Helper()
+
+	s.authority = authtypes.NewModuleAddress("gov").String()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := sdktestutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(oracle.AppModuleBasic{})
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), s.authority)
+	s.ctx = testCtx.Ctx
+	s.msgSrvr = keeper.NewMsgServerImpl(s.keeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, keeper.Querier{Keeper: s.keeper})
+	s.queryClient = types.NewQueryClient(queryHelper)
+
+	err := s.keeper.SetParams(s.ctx, types.DefaultParams())
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) SetupSubTest() {
+	s.SetupTest()
+}
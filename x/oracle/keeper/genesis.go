@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/oracle/types"
+)
+
+// InitGenesis initializes the store based on the given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
+	err := k.params.Set(ctx, data.Params)
+	if err != nil {
+		panic(err)
+	}
+	for _, median := range data.MedianValues {
+		if err := k.SetMedianValue(ctx, median); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis extracts all data from store to genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
+	var gs types.GenesisState
+	var err error
+
+	gs.Params, err = k.params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+	gs.MedianValues, err = k.GetAllMedianValues(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return gs
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sedaprotocol/seda-chain/x/oracle/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func (q Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (q Querier) MedianValue(ctx context.Context, req *types.QueryMedianValueRequest) (*types.QueryMedianValueResponse, error) {
+	median, err := q.GetMedianValue(ctx, req.FeedId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("no median value for feed %s", req.FeedId)
+		}
+		return nil, err
+	}
+	return &types.QueryMedianValueResponse{MedianValue: median}, nil
+}
+
+func (q Querier) MedianValues(ctx context.Context, _ *types.QueryMedianValuesRequest) (*types.QueryMedianValuesResponse, error) {
+	medians, err := q.GetAllMedianValues(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMedianValuesResponse{MedianValues: medians}, nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/oracle/types"
+)
+
+type Keeper struct {
+	authority string
+
+	Schema       collections.Schema
+	params       collections.Item[types.Params]
+	medianValues collections.Map[string, types.MedianValue]
+}
+
+func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService, authority string) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		authority:    authority,
+		params:       collections.NewItem(sb, types.ParamsPrefix, "params", codec.CollValue[types.Params](cdc)),
+		medianValues: collections.NewMap(sb, types.MedianValuesPrefix, "median_values", collections.StringKey, codec.CollValue[types.MedianValue](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	return k.params.Set(ctx, params)
+}
+
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	return k.params.Get(ctx)
+}
+
+// SetMedianValue stores the median value of a feed.
+func (k Keeper) SetMedianValue(ctx context.Context, median types.MedianValue) error {
+	return k.medianValues.Set(ctx, median.FeedId, median)
+}
+
+// GetMedianValue returns the latest median value of a given feed.
+func (k Keeper) GetMedianValue(ctx context.Context, feedID string) (types.MedianValue, error) {
+	return k.medianValues.Get(ctx, feedID)
+}
+
+// GetAllMedianValues returns the latest median values of all feeds.
+func (k Keeper) GetAllMedianValues(ctx context.Context) ([]types.MedianValue, error) {
+	itr, err := k.medianValues.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	return itr.Values()
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/oracle/types"
+)
+
+// ValidateObservations decodes the given observations payload and
+// validates it against the module parameters.
+func (k Keeper) ValidateObservations(ctx context.Context, payload []byte) (types.Observations, error) {
+	var observations types.Observations
+	if err := observations.Unmarshal(payload); err != nil {
+		return types.Observations{}, types.ErrInvalidObservations.Wrap(err.Error())
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.Observations{}, err
+	}
+	if err := observations.Validate(params); err != nil {
+		return types.Observations{}, err
+	}
+	return observations, nil
+}
+
+// UpdateMedianValues computes the median of the values observed for each
+// feed and stores it, provided that the validators that observed the feed
+// hold at least the minimum participation percentage of the given total
+// voting power. Feeds without sufficient participation keep their
+// previous median value.
+func (k Keeper) UpdateMedianValues(ctx context.Context, totalPower int64, valObservations []types.ValidatorObservations) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.IsActive() || totalPower <= 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, feedID := range params.FeedIds {
+		var values []math.LegacyDec
+		var power int64
+		for _, valObs := range valObservations {
+			for _, obs := range valObs.Observations {
+				if obs.FeedId == feedID {
+					values = append(values, obs.Value)
+					power += valObs.Power
+					break
+				}
+			}
+		}
+
+		participation := math.LegacyNewDec(power).MulInt64(100).QuoInt64(totalPower)
+		if len(values) == 0 || participation.LT(math.LegacyNewDec(int64(params.MinParticipationPercent))) {
+			k.Logger(sdkCtx).Debug("insufficient participation to update median", "feed_id", feedID, "participation", participation)
+			continue
+		}
+
+		median := types.MedianValue{
+			FeedId:          feedID,
+			Value:           types.Median(values),
+			Height:          sdkCtx.BlockHeight(),
+			NumObservations: uint32(len(values)),
+		}
+		if err := k.SetMedianValue(ctx, median); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMedianValue,
+				sdk.NewAttribute(types.AttributeFeedID, feedID),
+				sdk.NewAttribute(types.AttributeValue, median.Value.String()),
+				sdk.NewAttribute(types.AttributeNumObservations, fmt.Sprintf("%d", median.NumObservations)),
+				sdk.NewAttribute(types.AttributeParticipation, participation.String()),
+			),
+		)
+	}
+	return nil
+}
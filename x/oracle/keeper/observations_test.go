@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sedaprotocol/seda-chain/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestValidateObservations() {
+	tests := []struct {
+		name         string
+		params       types.Params
+		observations []types.Observation
+		wantErr      error
+	}{
+		{
+			name:   "valid observations",
+			params: types.Params{FeedIds: []string{"a", "b"}, MinParticipationPercent: 50},
+			observations: []types.Observation{
+				{FeedId: "a", Value: math.LegacyNewDec(1)},
+				{FeedId: "b", Value: math.LegacyNewDec(2)},
+			},
+		},
+		{
+			name:         "inactive oracle",
+			params:       types.DefaultParams(),
+			observations: []types.Observation{{FeedId: "a", Value: math.LegacyNewDec(1)}},
+			wantErr:      types.ErrInactive,
+		},
+		{
+			name:         "unknown feed",
+			params:       types.Params{FeedIds: []string{"a"}, MinParticipationPercent: 50},
+			observations: []types.Observation{{FeedId: "b", Value: math.LegacyNewDec(1)}},
+			wantErr:      types.ErrInvalidObservations,
+		},
+		{
+			name:   "duplicate feed",
+			params: types.Params{FeedIds: []string{"a"}, MinParticipationPercent: 50},
+			observations: []types.Observation{
+				{FeedId: "a", Value: math.LegacyNewDec(1)},
+				{FeedId: "a", Value: math.LegacyNewDec(2)},
+			},
+			wantErr: types.ErrInvalidObservations,
+		},
+		{
+			name:         "non-positive value",
+			params:       types.Params{FeedIds: []string{"a"}, MinParticipationPercent: 50},
+			observations: []types.Observation{{FeedId: "a", Value: math.LegacyZeroDec()}},
+			wantErr:      types.ErrInvalidObservations,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Require().NoError(s.keeper.SetParams(s.ctx, tt.params))
+
+			payload, err := (&types.Observations{Observations: tt.observations}).Marshal()
+			s.Require().NoError(err)
+
+			observations, err := s.keeper.ValidateObservations(s.ctx, payload)
+			if tt.wantErr != nil {
+				s.Require().ErrorIs(err, tt.wantErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tt.observations, observations.Observations)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateMedianValues() {
+	s.ctx = s.ctx.WithBlockHeight(10)
+	err := s.keeper.SetParams(s.ctx, types.Params{
+		FeedIds:                 []string{"odd", "even", "sparse"},
+		MinParticipationPercent: 50,
+	})
+	s.Require().NoError(err)
+
+	valObservations := []types.ValidatorObservations{
+		{
+			Power: 30,
+			Observations: []types.Observation{
+				{FeedId: "odd", Value: math.LegacyMustNewDecFromStr("1.5")},
+				{FeedId: "even", Value: math.LegacyNewDec(10)},
+				{FeedId: "sparse", Value: math.LegacyNewDec(7)},
+			},
+		},
+		{
+			Power: 20,
+			Observations: []types.Observation{
+				{FeedId: "odd", Value: math.LegacyNewDec(3)},
+				{FeedId: "even", Value: math.LegacyNewDec(20)},
+			},
+		},
+		{
+			Power: 25,
+			Observations: []types.Observation{
+				{FeedId: "odd", Value: math.LegacyNewDec(2)},
+			},
+		},
+	}
+	err = s.keeper.UpdateMedianValues(s.ctx, 100, valObservations)
+	s.Require().NoError(err)
+
+	median, err := s.keeper.GetMedianValue(s.ctx, "odd")
+	s.Require().NoError(err)
+	s.Require().Equal(types.MedianValue{
+		FeedId:          "odd",
+		Value:           math.LegacyNewDec(2),
+		Height:          10,
+		NumObservations: 3,
+	}, median)
+
+	median, err = s.keeper.GetMedianValue(s.ctx, "even")
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDec(15), median.Value)
+	s.Require().Equal(uint32(2), median.NumObservations)
+
+	// Validators holding 30% of the power are below the minimum participation.
+	_, err = s.keeper.GetMedianValue(s.ctx, "sparse")
+	s.Require().Error(err)
+
+	res, err := s.queryClient.MedianValues(s.ctx, &types.QueryMedianValuesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.MedianValues, 2)
+
+	_, err = s.queryClient.MedianValue(s.ctx, &types.QueryMedianValueRequest{FeedId: "sparse"})
+	s.Require().ErrorContains(err, sdkerrors.ErrNotFound.Error())
+}
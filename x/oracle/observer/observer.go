@@ -0,0 +1,130 @@
+package observer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/sedaprotocol/seda-chain/x/oracle/types"
+)
+
+const (
+	// FeedIDBlockTime is the feed of the local time of validators at
+	// the time of extending their votes, whose median is the median
+	// block time of the chain.
+	FeedIDBlockTime = "block_time"
+
+	// DefaultDataProxyTimeout is the timeout of a request to the data
+	// proxy for a single feed.
+	DefaultDataProxyTimeout = 250 * time.Millisecond
+)
+
+// Source provides a node-local observation of a feed.
+type Source interface {
+	Observe(ctx context.Context, feedID string) (math.LegacyDec, error)
+}
+
+// Observer collects node-local observations of the oracle feeds to be
+// attached to vote extensions. Feeds without a registered source are
+// observed using the fallback source, if any.
+type Observer struct {
+	sources  map[string]Source
+	fallback Source
+	logger   log.Logger
+}
+
+// NewObserver returns an observer that observes the block time feed
+// using the local clock and every other feed using the data proxy at
+// the given URL. Only the block time feed is observed if the URL is
+// empty.
+func NewObserver(dataProxyURL string, logger log.Logger) *Observer {
+	o := &Observer{
+		sources: map[string]Source{
+			FeedIDBlockTime: LocalClockSource{},
+		},
+		logger: logger,
+	}
+	if dataProxyURL != "" {
+		o.fallback = NewDataProxySource(dataProxyURL, DefaultDataProxyTimeout)
+	}
+	return o
+}
+
+// Observe returns the observations of the given feeds. Feeds that
+// cannot be observed are left out.
+func (o *Observer) Observe(ctx context.Context, feedIDs []string) []types.Observation {
+	observations := make([]types.Observation, 0, len(feedIDs))
+	for _, feedID := range feedIDs {
+		source, ok := o.sources[feedID]
+		if !ok {
+			if o.fallback == nil {
+				continue
+			}
+			source = o.fallback
+		}
+
+		value, err := source.Observe(ctx, feedID)
+		if err != nil {
+			o.logger.Error("failed to observe oracle feed", "feed_id", feedID, "err", err)
+			continue
+		}
+		observations = append(observations, types.Observation{
+			FeedId: feedID,
+			Value:  value,
+		})
+	}
+	return observations
+}
+
+// LocalClockSource observes the local time as a Unix timestamp in
+// seconds with millisecond precision.
+type LocalClockSource struct{}
+
+func (LocalClockSource) Observe(_ context.Context, _ string) (math.LegacyDec, error) {
+	return math.LegacyNewDecWithPrec(time.Now().UnixMilli(), 3), nil
+}
+
+// DataProxySource observes feeds by querying a local data proxy stand-in
+// at <url>/<feed_id>, which is expected to respond with a JSON object of
+// the form {"value": "<decimal>"}.
+type DataProxySource struct {
+	url    string
+	client *http.Client
+}
+
+func NewDataProxySource(url string, timeout time.Duration) DataProxySource {
+	return DataProxySource{
+		url:    strings.TrimSuffix(url, "/"),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s DataProxySource) Observe(ctx context.Context, feedID string) (math.LegacyDec, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/%s", s.url, feedID), nil)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return math.LegacyDec{}, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	var body struct {
+		Value string `json:"value"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return math.LegacyDec{}, err
+	}
+	return math.LegacyNewDecFromStr(body.Value)
+}
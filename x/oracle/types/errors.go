@@ -0,0 +1,8 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrInactive            = errors.Register(ModuleName, 2, "oracle module is inactive")
+	ErrInvalidObservations = errors.Register(ModuleName, 3, "invalid observations")
+)
@@ -0,0 +1,10 @@
+package types
+
+const (
+	EventTypeMedianValue = "median_value"
+
+	AttributeFeedID          = "feed_id"
+	AttributeValue           = "value"
+	AttributeNumObservations = "num_observations"
+	AttributeParticipation   = "participation"
+)
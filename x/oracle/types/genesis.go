@@ -0,0 +1,29 @@
+package types
+
+// DefaultGenesisState creates a default GenesisState object.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates oracle genesis data.
+func ValidateGenesis(state GenesisState) error {
+	if err := state.Params.Validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]struct{}, len(state.MedianValues))
+	for _, median := range state.MedianValues {
+		if err := validateFeedID(median.FeedId); err != nil {
+			return err
+		}
+		if _, ok := seen[median.FeedId]; ok {
+			return ErrInvalidObservations.Wrapf("duplicate median value for feed %s", median.FeedId)
+		}
+		seen[median.FeedId] = struct{}{}
+		if median.Value.IsNil() || median.Value.IsNegative() {
+			return ErrInvalidObservations.Wrapf("invalid median value for feed %s", median.FeedId)
+		}
+	}
+	return nil
+}
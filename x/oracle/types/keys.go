@@ -0,0 +1,13 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	ModuleName = "oracle"
+	StoreKey   = ModuleName
+)
+
+var (
+	ParamsPrefix       = collections.NewPrefix(0)
+	MedianValuesPrefix = collections.NewPrefix(1)
+)
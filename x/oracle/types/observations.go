@@ -0,0 +1,58 @@
+package types
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+)
+
+// VoteExtensionPayloadType is the type of the vote extension payload
+// carrying the observations of a validator.
+const VoteExtensionPayloadType = "oracle/observations"
+
+// ValidatorObservations is the set of observations attached by a
+// validator to its vote extension along with its voting power.
+type ValidatorObservations struct {
+	Power        int64
+	Observations []Observation
+}
+
+// Validate checks the observations against the given parameters. Only
+// observed feeds are allowed and each feed may be observed at most once
+// with a positive value.
+func (o Observations) Validate(params Params) error {
+	if !params.IsActive() {
+		return ErrInactive
+	}
+	seen := make(map[string]struct{}, len(o.Observations))
+	for _, obs := range o.Observations {
+		if !params.HasFeed(obs.FeedId) {
+			return ErrInvalidObservations.Wrapf("unknown feed %s", obs.FeedId)
+		}
+		if _, ok := seen[obs.FeedId]; ok {
+			return ErrInvalidObservations.Wrapf("duplicate observation of feed %s", obs.FeedId)
+		}
+		seen[obs.FeedId] = struct{}{}
+		if obs.Value.IsNil() || !obs.Value.IsPositive() {
+			return ErrInvalidObservations.Wrapf("non-positive value for feed %s", obs.FeedId)
+		}
+	}
+	return nil
+}
+
+// Median returns the median of the given values. The median of an even
+// number of values is the mean of the two middle values. The given slice
+// is sorted in place.
+func Median(values []math.LegacyDec) math.LegacyDec {
+	if len(values) == 0 {
+		return math.LegacyZeroDec()
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].LT(values[j])
+	})
+	mid := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[mid]
+	}
+	return values[mid-1].Add(values[mid]).QuoInt64(2)
+}
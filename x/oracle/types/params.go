@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	DefaultMinParticipationPercent = 50
+
+	// MaxFeeds is the maximum number of feeds that can be observed, which
+	// bounds the size of the observations attached to vote extensions.
+	MaxFeeds = 10
+	// MaxFeedIDLength is the maximum length of a feed ID.
+	MaxFeedIDLength = 32
+)
+
+// DefaultParams returns default oracle module parameters. No feeds are
+// observed by default, which leaves the module inactive.
+func DefaultParams() Params {
+	return Params{
+		FeedIds:                 []string{},
+		MinParticipationPercent: DefaultMinParticipationPercent,
+	}
+}
+
+// Validate performs basic validation on oracle module parameters.
+func (p *Params) Validate() error {
+	if len(p.FeedIds) > MaxFeeds {
+		return sdkerrors.ErrInvalidRequest.Wrapf("number of feeds %d exceeds maximum %d", len(p.FeedIds), MaxFeeds)
+	}
+	seen := make(map[string]struct{}, len(p.FeedIds))
+	for _, feedID := range p.FeedIds {
+		if err := validateFeedID(feedID); err != nil {
+			return err
+		}
+		if _, ok := seen[feedID]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate feed ID %s", feedID)
+		}
+		seen[feedID] = struct{}{}
+	}
+	if p.MinParticipationPercent == 0 || p.MinParticipationPercent > 100 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("MinParticipationPercent should be between 1 and 100: %d", p.MinParticipationPercent)
+	}
+	return nil
+}
+
+// IsActive returns true if validators are expected to attach observations
+// to their vote extensions.
+func (p Params) IsActive() bool {
+	return len(p.FeedIds) > 0
+}
+
+// HasFeed returns true if the given feed is observed.
+func (p Params) HasFeed(feedID string) bool {
+	for _, id := range p.FeedIds {
+		if id == feedID {
+			return true
+		}
+	}
+	return false
+}
+
+func validateFeedID(feedID string) error {
+	if feedID == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty feed ID")
+	}
+	if len(feedID) > MaxFeedIDLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("feed ID %s exceeds maximum length %d", feedID, MaxFeedIDLength)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 
 	batchingtypes "github.com/sedaprotocol/seda-chain/x/batching/types"
 	"github.com/sedaprotocol/seda-chain/x/tally/types"
+	wasmstoragetypes "github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
 
 const (
@@ -146,6 +148,23 @@ func (k Keeper) ProcessTallies(ctx sdk.Context, tallyList []types.Request, param
 			ID:                req.ID,
 			Height:            req.Height,
 			ReplicationFactor: req.ReplicationFactor,
+			ExecProgramID:     req.ExecProgramID,
+		}
+		// Using the oracle programs of a request extends their expiration.
+		for _, programID := range []string{req.ExecProgramID, req.TallyProgramID} {
+			if err := k.wasmStorageKeeper.ExtendOracleProgramExpiration(ctx, programID); err != nil {
+				k.Logger(ctx).Debug("failed to extend oracle program expiration", "request_id", req.ID, "program_id", programID, "err", err)
+			}
+		}
+		if len(req.Reveals) > 0 {
+			if err := k.wasmStorageKeeper.RecordExecProgramUsage(ctx, req.ExecProgramID); err != nil {
+				k.Logger(ctx).Debug("failed to record exec program usage", "request_id", req.ID, "program_id", req.ExecProgramID, "err", err)
+			}
+		}
+
+		// An undecodable memo matches no memo tag of the data proxy fee schedules.
+		if memo, err := base64.StdEncoding.DecodeString(req.Memo); err == nil {
+			tallyResults[i].Memo = memo
 		}
 
 		dataResults[i], err = req.ToResult(ctx)
@@ -174,6 +193,15 @@ func (k Keeper) ProcessTallies(ctx sdk.Context, tallyList []types.Request, param
 			continue
 		}
 
+		tallyResults[i].MaxProxyFee, tallyResults[i].MaxTotalProxyFee, err = req.ProxyFeeCaps()
+		if err != nil {
+			markResultErr := types.MarkResultAsFallback(&dataResults[i], &tallyResults[i], err)
+			if markResultErr != nil {
+				return nil, nil, nil, err
+			}
+			continue
+		}
+
 		gasMeter := types.NewGasMeter(req.TallyGasLimit, req.ExecGasLimit, params.MaxTallyGasLimit, postedGasPrice, params.GasCostBase)
 
 		// Phase 1: Filtering
@@ -187,6 +215,7 @@ func (k Keeper) ProcessTallies(ctx sdk.Context, tallyList []types.Request, param
 			MeterExecutorGasFallback(req, params.ExecutionGasCostFallback, gasMeter)
 		} else {
 			reveals, executors, gasReports := req.SanitizeReveals(ctx.BlockHeight())
+			k.markDeniedProxyReveals(ctx, req.ID, reveals)
 			filterResult, filterErr := types.ExecuteFilter(reveals, req.ConsensusFilter, req.ReplicationFactor, params, gasMeter)
 
 			filterResult.Error = filterErr
@@ -241,13 +270,24 @@ func (k Keeper) ProcessTallies(ctx sdk.Context, tallyList []types.Request, param
 				tallyResults[resultIndex].StdErr = result.Stderr
 				dataResults[resultIndex].Result = result.Result
 				dataResults[resultIndex].ExitCode = result.ExitCode
+
+				err := k.wasmStorageKeeper.RecordTallyProgramUsage(ctx, tallyExecItems[i].Request.TallyProgramID, vmResults[vmResultIndex].GasUsed, result.ExitCode)
+				if err != nil {
+					k.Logger(ctx).Debug("failed to record tally program usage", "request_id", tallyExecItems[i].Request.ID, "err", err)
+				}
 				vmResultIndex++
 			} else {
 				// Tally was not executed.
 				resultIndex := tallyExecItems[i].Index
-				tallyResults[resultIndex].GasMeter.SetReducedPayoutMode()
 				dataResults[resultIndex].Result = []byte(tallyExecItems[i].TallyExecErr.Error())
-				dataResults[resultIndex].ExitCode = types.TallyExitCodeExecError
+				if errors.Is(tallyExecItems[i].TallyExecErr, wasmstoragetypes.ErrOracleProgramBlocked) {
+					// Executors are not at fault for a blocked tally program,
+					// so they are paid in full.
+					dataResults[resultIndex].ExitCode = types.TallyExitCodeBlockedProgram
+				} else {
+					tallyResults[resultIndex].GasMeter.SetReducedPayoutMode()
+					dataResults[resultIndex].ExitCode = types.TallyExitCodeExecError
+				}
 			}
 		}
 	}
@@ -259,7 +299,7 @@ func (k Keeper) ProcessTallies(ctx sdk.Context, tallyList []types.Request, param
 		// Calculate data proxy and executor gas consumptions if basic consensus
 		// was reached.
 		if !errors.Is(filterErr, types.ErrNoBasicConsensus) && !errors.Is(filterErr, types.ErrFilterDidNotRun) {
-			k.MeterProxyGas(ctx, tr.FilterResult.ProxyPubKeys, tr.ReplicationFactor, tr.GasMeter)
+			k.MeterProxyGas(ctx, tr)
 
 			if areGasReportsUniform(tr.GasReports) {
 				tr.MeterExecutorGasUniform()
@@ -274,6 +314,7 @@ func (k Keeper) ProcessTallies(ctx sdk.Context, tallyList []types.Request, param
 			tallyResults[i].ExecGasUsed = tr.GasMeter.ExecutionGasUsed()
 
 			processedReqs[tr.ID] = k.DistributionsFromGasMeter(ctx, tr.ID, tr.Height, tr.GasMeter, params.BurnRatio)
+			k.RecordProxyUsage(ctx, tr.ID, tr.GasMeter)
 			dataResults[i].GasUsed = tr.GasMeter.TotalGasUsed()
 		}
 
@@ -771,7 +771,7 @@ func TestExecutorPayout(t *testing.T) {
 			require.Equal(t, tt.expReducedPayout, gasMeter.ReducedPayout)
 			for _, proxy := range gasMeter.GetProxyGasUsed(request.ID, f.Context().BlockHeight()) {
 				require.Equal(t,
-					tt.expProxyGas[proxy.PayoutAddress].String(),
+					tt.expProxyGas[proxy.Recipients[0].Address].String(),
 					proxy.Amount.String(),
 				)
 			}
@@ -780,3 +780,180 @@ func TestExecutorPayout(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessTalliesDeniedProxyReveals(t *testing.T) {
+	f := initFixture(t)
+
+	tallyProgram := wasmstoragetypes.NewOracleProgram(testwasms.SampleTallyWasm2(), f.Context().BlockTime())
+	require.NoError(t, f.wasmStorageKeeper.OracleProgram.Set(f.Context(), tallyProgram.Hash, tallyProgram))
+
+	filterInput, err := hex.DecodeString("01000000000000000D242E726573756C742E74657874") // mode, json_path = $.result.text
+	require.NoError(t, err)
+
+	deniedPubKey := "020173bd90e73c5f8576b3141c53aa9959b10a1daf1bc9c0ccf0a942932c703dec"
+	require.NoError(t, f.SetDataProxyConfig(deniedPubKey, "seda1zcds6ws7l0e005h3xrmg5tx0378nyg8gtmn64f", sdk.NewCoin(bondDenom, math.NewInt(1000000000000000000))))
+	deniedPubKeyBytes, err := hex.DecodeString(deniedPubKey)
+	require.NoError(t, err)
+	require.NoError(t, f.dataProxyKeeper.DenyDataProxy(f.Context(), deniedPubKeyBytes))
+
+	reveals := map[string]types.RevealBody{
+		"a": {ExitCode: 0, Reveal: `{"result": {"text": "A"}}`, GasUsed: 10000, ProxyPubKeys: []string{deniedPubKey}},
+		"b": {ExitCode: 0, Reveal: `{"result": {"text": "A"}}`, GasUsed: 10000, ProxyPubKeys: []string{deniedPubKey}},
+		"c": {ExitCode: 0, Reveal: `{"result": {"text": "A"}}`, GasUsed: 10000, ProxyPubKeys: []string{deniedPubKey}},
+	}
+	commits := make(map[string][]byte)
+	for executor := range reveals {
+		commits[executor] = []byte{}
+	}
+
+	ctx := f.Context().WithEventManager(sdk.NewEventManager())
+	tallyRes, dataRes, _, err := f.tallyKeeper.ProcessTallies(
+		ctx,
+		[]types.Request{{
+			ID:                "01",
+			Commits:           commits,
+			Reveals:           reveals,
+			ReplicationFactor: uint16(len(reveals)),
+			ConsensusFilter:   base64.StdEncoding.EncodeToString(filterInput),
+			PostedGasPrice:    "1000000000000000000",
+			ExecGasLimit:      1e11,
+			TallyGasLimit:     types.DefaultMaxTallyGasLimit,
+			TallyProgramID:    hex.EncodeToString(tallyProgram.Hash),
+		}},
+		types.DefaultParams(), false,
+	)
+	require.NoError(t, err)
+	require.Len(t, tallyRes, 1)
+	require.Len(t, dataRes, 1)
+
+	// Reveals citing the denied data proxy are treated as errors, so
+	// consensus is reached on an error and the data proxy is not paid.
+	require.ErrorIs(t, tallyRes[0].FilterResult.Error, types.ErrConsensusInError)
+	require.Equal(t, []bool{true, true, true}, tallyRes[0].FilterResult.Errors)
+	require.Equal(t, uint32(types.TallyExitCodeFilterError), dataRes[0].ExitCode)
+	require.Empty(t, tallyRes[0].GasMeter.GetProxyGasUsed("01", 1))
+
+	deniedRevealEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeDeniedProxy {
+			continue
+		}
+		if _, found := event.GetAttribute(types.AttributeExecutor); found {
+			deniedRevealEvents++
+		}
+	}
+	require.Equal(t, len(reveals), deniedRevealEvents)
+}
+
+func TestProcessTalliesBlockedTallyProgram(t *testing.T) {
+	f := initFixture(t)
+
+	tallyProgram := wasmstoragetypes.NewOracleProgram(testwasms.SampleTallyWasm2(), f.Context().BlockTime())
+	require.NoError(t, f.wasmStorageKeeper.OracleProgram.Set(f.Context(), tallyProgram.Hash, tallyProgram))
+	require.NoError(t, f.wasmStorageKeeper.BlockedOracleProgram.Set(f.Context(), tallyProgram.Hash, wasmstoragetypes.BlockedOracleProgram{
+		Hash:   hex.EncodeToString(tallyProgram.Hash),
+		Reason: "malicious",
+	}))
+
+	filterInput, err := hex.DecodeString("01000000000000000D242E726573756C742E74657874") // mode, json_path = $.result.text
+	require.NoError(t, err)
+
+	reveals := map[string]types.RevealBody{
+		"a": {ExitCode: 0, Reveal: base64.StdEncoding.EncodeToString([]byte(`{"result": {"text": "A"}}`)), GasUsed: 10000},
+		"b": {ExitCode: 0, Reveal: base64.StdEncoding.EncodeToString([]byte(`{"result": {"text": "A"}}`)), GasUsed: 10000},
+		"c": {ExitCode: 0, Reveal: base64.StdEncoding.EncodeToString([]byte(`{"result": {"text": "A"}}`)), GasUsed: 10000},
+	}
+	commits := make(map[string][]byte)
+	for executor := range reveals {
+		commits[executor] = []byte{}
+	}
+
+	tallyRes, dataRes, _, err := f.tallyKeeper.ProcessTallies(
+		f.Context(),
+		[]types.Request{{
+			ID:                "01",
+			Commits:           commits,
+			Reveals:           reveals,
+			ReplicationFactor: uint16(len(reveals)),
+			ConsensusFilter:   base64.StdEncoding.EncodeToString(filterInput),
+			PostedGasPrice:    "1000000000000000000",
+			ExecGasLimit:      1e11,
+			TallyGasLimit:     types.DefaultMaxTallyGasLimit,
+			TallyProgramID:    hex.EncodeToString(tallyProgram.Hash),
+			TallyInputs:       base64.StdEncoding.EncodeToString([]byte("input")),
+			PaybackAddress:    base64.StdEncoding.EncodeToString([]byte("0x0")),
+		}},
+		types.DefaultParams(), false,
+	)
+	require.NoError(t, err)
+	require.Len(t, tallyRes, 1)
+	require.Len(t, dataRes, 1)
+
+	// The blocked tally program is not executed.
+	require.NoError(t, tallyRes[0].FilterResult.Error)
+	require.Equal(t, types.TallyExitCodeBlockedProgram, dataRes[0].ExitCode)
+	require.Contains(t, string(dataRes[0].Result), "malicious")
+
+	// The executors are paid in full.
+	require.False(t, tallyRes[0].GasMeter.ReducedPayout)
+}
+
+func TestProcessTalliesRecordsProgramStats(t *testing.T) {
+	f := initFixture(t)
+
+	tallyProgram := wasmstoragetypes.NewOracleProgram(testwasms.SampleTallyWasm2(), f.Context().BlockTime())
+	require.NoError(t, f.wasmStorageKeeper.OracleProgram.Set(f.Context(), tallyProgram.Hash, tallyProgram))
+	execProgram := wasmstoragetypes.NewOracleProgram([]byte("exec program"), f.Context().BlockTime())
+	require.NoError(t, f.wasmStorageKeeper.OracleProgram.Set(f.Context(), execProgram.Hash, execProgram))
+
+	filterInput, err := hex.DecodeString("01000000000000000D242E726573756C742E74657874") // mode, json_path = $.result.text
+	require.NoError(t, err)
+
+	reveals := map[string]types.RevealBody{
+		"a": {ExitCode: 0, Reveal: base64.StdEncoding.EncodeToString([]byte(`{"result": {"text": "A"}}`)), GasUsed: 10000},
+		"b": {ExitCode: 0, Reveal: base64.StdEncoding.EncodeToString([]byte(`{"result": {"text": "A"}}`)), GasUsed: 10000},
+	}
+	commits := make(map[string][]byte)
+	for executor := range reveals {
+		commits[executor] = []byte{}
+	}
+	request := types.Request{
+		Commits:           commits,
+		Reveals:           reveals,
+		ReplicationFactor: uint16(len(reveals)),
+		ConsensusFilter:   base64.StdEncoding.EncodeToString(filterInput),
+		PostedGasPrice:    "1000000000000000000",
+		ExecGasLimit:      1e11,
+		TallyGasLimit:     types.DefaultMaxTallyGasLimit,
+		ExecProgramID:     hex.EncodeToString(execProgram.Hash),
+		TallyProgramID:    hex.EncodeToString(tallyProgram.Hash),
+		TallyInputs:       base64.StdEncoding.EncodeToString([]byte("input")),
+		PaybackAddress:    base64.StdEncoding.EncodeToString([]byte("0x0")),
+	}
+
+	var totalGasUsed uint64
+	var exitCode uint32
+	for i, height := range []int64{10, 20} {
+		request.ID = fmt.Sprintf("0%d", i)
+		ctx := f.Context().WithBlockHeight(height)
+		tallyRes, dataRes, _, err := f.tallyKeeper.ProcessTallies(ctx, []types.Request{request}, types.DefaultParams(), false)
+		require.NoError(t, err)
+		totalGasUsed += tallyRes[0].GasMeter.TallyGasUsed()
+		exitCode = dataRes[0].ExitCode
+	}
+
+	stats, err := f.wasmStorageKeeper.GetOracleProgramStats(f.Context(), hex.EncodeToString(tallyProgram.Hash))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats.TallyInvocations)
+	require.Equal(t, uint64(0), stats.ExecInvocations)
+	require.Equal(t, int64(20), stats.LastUsedHeight)
+	require.NotZero(t, stats.TallyGasUsed)
+	require.Less(t, stats.TallyGasUsed, totalGasUsed)
+	require.Equal(t, []wasmstoragetypes.ExitCodeCount{{ExitCode: exitCode, Count: 2}}, stats.TallyExitCodes)
+
+	stats, err = f.wasmStorageKeeper.GetOracleProgramStats(f.Context(), hex.EncodeToString(execProgram.Hash))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats.ExecInvocations)
+	require.Equal(t, uint64(0), stats.TallyInvocations)
+	require.Equal(t, int64(20), stats.LastUsedHeight)
+}
@@ -11,6 +11,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	appparams "github.com/sedaprotocol/seda-chain/app/params"
+	dataproxytypes "github.com/sedaprotocol/seda-chain/x/data-proxy/types"
 	"github.com/sedaprotocol/seda-chain/x/tally/types"
 )
 
@@ -30,12 +32,18 @@ func (k Keeper) DistributionsFromGasMeter(ctx sdk.Context, reqID string, reqHeig
 	dists = append(dists, burn)
 	attrs = append(attrs, sdk.NewAttribute(types.AttributeTallyGas, strconv.FormatUint(gasMeter.TallyGasUsed(), 10)))
 
-	// Append distribution messages for data proxies.
+	// Append distribution messages for data proxies, one per payout recipient.
 	for _, proxy := range gasMeter.GetProxyGasUsed(reqID, ctx.BlockHeight()) {
-		proxyDist := types.NewDataProxyReward(proxy.PublicKey, proxy.PayoutAddress, proxy.Amount, gasMeter.GasPrice())
-		dists = append(dists, proxyDist)
-		attrs = append(attrs, sdk.NewAttribute(types.AttributeDataProxyGas,
-			fmt.Sprintf("%s,%s,%s", proxy.PublicKey, proxy.PayoutAddress, proxy.Amount.String())))
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeDataProxyRate,
+			fmt.Sprintf("%s,%s,%s", proxy.PublicKey, proxy.Fee.String(), proxy.ExchangeRate.String())))
+
+		shares := dataproxytypes.SplitPayout(proxy.Amount, proxy.Recipients)
+		for i, recipient := range proxy.Recipients {
+			proxyDist := types.NewDataProxyReward(proxy.PublicKey, recipient.Address, shares[i], gasMeter.GasPrice())
+			dists = append(dists, proxyDist)
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeDataProxyGas,
+				fmt.Sprintf("%s,%s,%s", proxy.PublicKey, recipient.Address, shares[i].String())))
+		}
 	}
 
 	// Append distribution messages for executors, burning a portion of their
@@ -63,20 +71,62 @@ func (k Keeper) DistributionsFromGasMeter(ctx sdk.Context, reqID string, reqHeig
 	return dists
 }
 
-// MeterProxyGas computes and records the gas consumption of data proxies given
-// proxy public keys in basic consensus and the request's replication factor.
-func (k Keeper) MeterProxyGas(ctx sdk.Context, proxyPubKeys []string, replicationFactor uint16, gasMeter *types.GasMeter) {
-	if len(proxyPubKeys) == 0 || gasMeter.RemainingExecGas() == 0 {
+// RecordProxyUsage adds the gas and fees of the data proxies paid according to
+// the given gas meter to their cumulative usage in the data proxy module.
+func (k Keeper) RecordProxyUsage(ctx sdk.Context, reqID string, gasMeter *types.GasMeter) {
+	for _, proxy := range gasMeter.GetProxyGasUsed(reqID, ctx.BlockHeight()) {
+		pubKeyBytes, err := hex.DecodeString(proxy.PublicKey)
+		if err != nil {
+			k.Logger(ctx).Error("failed to decode proxy public key", "error", err, "public_key", proxy.PublicKey)
+			continue
+		}
+
+		fee := sdk.NewCoin(appparams.DefaultBondDenom, proxy.Amount.Mul(gasMeter.GasPrice()))
+		err = k.dataProxyKeeper.RecordDataProxyUsage(ctx, pubKeyBytes, proxy.Amount, sdk.NewCoins(fee))
+		if err != nil {
+			k.Logger(ctx).Error("failed to record proxy usage", "error", err, "public_key", proxy.PublicKey)
+		}
+	}
+}
+
+// MeterProxyGas computes and records the gas consumption of data proxies in
+// basic consensus for the given tally result, taking into account the
+// request's replication factor. The fee of each data proxy is looked up in its
+// fee schedule by the request's exec program ID and memo, falling back to its
+// flat fee, and converted to aseda using the data proxy module's exchange rate
+// table. Previous keys of rotated data proxies are resolved to their current
+// config, while deregistered data proxies and data proxies denied by
+// governance are not paid. Fees are clamped to the per data proxy and total
+// fee caps of the request, if set.
+func (k Keeper) MeterProxyGas(ctx sdk.Context, tr types.TallyResult) {
+	gasMeter := tr.GasMeter
+	if len(tr.FilterResult.ProxyPubKeys) == 0 || gasMeter.RemainingExecGas() == 0 {
 		return
 	}
 
-	for _, pubKey := range proxyPubKeys {
+	remainingTotalFee := tr.MaxTotalProxyFee
+
+	for _, pubKey := range tr.FilterResult.ProxyPubKeys {
 		pubKeyBytes, err := hex.DecodeString(pubKey)
 		if err != nil {
 			k.Logger(ctx).Error("failed to decode proxy public key", "error", err, "public_key", pubKey)
 			continue
 		}
-		proxyConfig, err := k.dataProxyKeeper.GetDataProxyConfig(ctx, pubKeyBytes)
+
+		allowed, err := k.dataProxyKeeper.IsDataProxyAllowed(ctx, pubKeyBytes)
+		if err != nil {
+			k.Logger(ctx).Error("failed to check proxy access lists", "error", err, "public_key", pubKey)
+			continue
+		}
+		if !allowed {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDeniedProxy,
+				sdk.NewAttribute(types.AttributeDataRequestID, tr.ID),
+				sdk.NewAttribute(types.AttributeProxyPubKey, pubKey),
+			))
+			continue
+		}
+
+		proxyConfig, err := k.dataProxyKeeper.GetActiveDataProxyConfig(ctx, pubKeyBytes)
 		if err != nil {
 			k.Logger(ctx).Error("failed to get proxy config", "error", err, "public_key", pubKey)
 			continue
@@ -85,15 +135,82 @@ func (k Keeper) MeterProxyGas(ctx sdk.Context, proxyPubKeys []string, replicatio
 		// Compute the proxy gas used per executor, capping it at the max uint64
 		// value and the remaining execution gas.
 		// Noting that gasUsed * gasPrice = fee,
-		gasUsedPerExecInt := proxyConfig.Fee.Amount.Quo(gasMeter.GasPrice())
+		fee := proxyConfig.FeeFor(tr.ExecProgramID, tr.Memo)
+		convertedFee, exchangeRate, err := k.dataProxyKeeper.ConvertFee(ctx, fee)
+		if err != nil {
+			k.Logger(ctx).Error("failed to convert proxy fee", "error", err, "public_key", pubKey, "fee", fee.String())
+			continue
+		}
+
+		// Clamp the fee to the caps set by the requestor, if any.
+		feeAmount := convertedFee.Amount
+		if !tr.MaxProxyFee.IsNil() && feeAmount.GT(tr.MaxProxyFee) {
+			k.emitProxyFeeClamped(ctx, tr.ID, pubKey, feeAmount, tr.MaxProxyFee, "max_proxy_fee")
+			feeAmount = tr.MaxProxyFee
+		}
+		if !remainingTotalFee.IsNil() {
+			maxFee := remainingTotalFee.QuoRaw(int64(tr.ReplicationFactor))
+			if feeAmount.GT(maxFee) {
+				k.emitProxyFeeClamped(ctx, tr.ID, pubKey, feeAmount, maxFee, "max_total_proxy_fee")
+				feeAmount = maxFee
+			}
+		}
+
+		gasUsedPerExecInt := feeAmount.Quo(gasMeter.GasPrice())
 		var gasUsedPerExec uint64
 		if gasUsedPerExecInt.IsUint64() {
-			gasUsedPerExec = min(gasUsedPerExecInt.Uint64(), gasMeter.RemainingExecGas()/uint64(replicationFactor))
+			gasUsedPerExec = min(gasUsedPerExecInt.Uint64(), gasMeter.RemainingExecGas()/uint64(tr.ReplicationFactor))
 		} else {
-			gasUsedPerExec = min(stdmath.MaxUint64, gasMeter.RemainingExecGas()/uint64(replicationFactor))
+			gasUsedPerExec = min(stdmath.MaxUint64, gasMeter.RemainingExecGas()/uint64(tr.ReplicationFactor))
 		}
 
-		gasMeter.ConsumeExecGasForProxy(pubKey, proxyConfig.PayoutAddress, gasUsedPerExec, replicationFactor)
+		gasMeter.ConsumeExecGasForProxy(pubKey, proxyConfig.EffectivePayoutRecipients(), fee, exchangeRate, gasUsedPerExec, tr.ReplicationFactor)
+
+		if !remainingTotalFee.IsNil() {
+			paid := math.NewIntFromUint64(gasUsedPerExec).MulRaw(int64(tr.ReplicationFactor)).Mul(gasMeter.GasPrice())
+			remainingTotalFee = remainingTotalFee.Sub(paid)
+		}
+	}
+}
+
+// emitProxyFeeClamped emits an event for a data proxy fee, converted to aseda,
+// that was clamped to the given cap set by the requestor.
+func (k Keeper) emitProxyFeeClamped(ctx sdk.Context, reqID, pubKey string, fee, clampedFee math.Int, feeCap string) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeProxyFeeClamped,
+		sdk.NewAttribute(types.AttributeDataRequestID, reqID),
+		sdk.NewAttribute(types.AttributeProxyPubKey, pubKey),
+		sdk.NewAttribute(types.AttributeProxyFee, fee.String()),
+		sdk.NewAttribute(types.AttributeClampedFee, clampedFee.String()),
+		sdk.NewAttribute(types.AttributeFeeCap, feeCap),
+	))
+}
+
+// markDeniedProxyReveals sets the exit code of reveals citing a data proxy
+// denied by governance to RevealExitCodeDeniedProxy, so that the filter and
+// the tally program treat them as errors.
+func (k Keeper) markDeniedProxyReveals(ctx sdk.Context, reqID string, reveals []types.Reveal) {
+	for i, reveal := range reveals {
+		for _, pubKey := range reveal.ProxyPubKeys {
+			pubKeyBytes, err := hex.DecodeString(pubKey)
+			if err != nil {
+				continue
+			}
+
+			allowed, err := k.dataProxyKeeper.IsDataProxyAllowed(ctx, pubKeyBytes)
+			if err != nil {
+				k.Logger(ctx).Error("failed to check proxy access lists", "error", err, "public_key", pubKey)
+				continue
+			}
+			if !allowed {
+				reveals[i].ExitCode = types.RevealExitCodeDeniedProxy
+				ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDeniedProxy,
+					sdk.NewAttribute(types.AttributeDataRequestID, reqID),
+					sdk.NewAttribute(types.AttributeExecutor, reveal.Executor),
+					sdk.NewAttribute(types.AttributeProxyPubKey, pubKey),
+				))
+				break
+			}
+		}
 	}
 }
 
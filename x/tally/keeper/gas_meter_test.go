@@ -14,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/sedaprotocol/seda-chain/testutil/testwasms"
+	dataproxytypes "github.com/sedaprotocol/seda-chain/x/data-proxy/types"
 	"github.com/sedaprotocol/seda-chain/x/tally/types"
 	wasmstoragetypes "github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
@@ -104,7 +105,7 @@ func FuzzGasMetering(f *testing.F) {
 		// Check proxy gas used.
 		for _, proxy := range gasMeter.GetProxyGasUsed("dummy-request-id", fixture.Context().BlockHeight()) {
 			require.Equal(t,
-				expProxyGasUsed[proxy.PayoutAddress].String(),
+				expProxyGasUsed[proxy.Recipients[0].Address].String(),
 				proxy.Amount.String(),
 			)
 			sumExec = sumExec.Add(proxy.Amount)
@@ -183,7 +184,12 @@ func TestReducedPayoutWithProxies(t *testing.T) {
 
 	// Scenario: 4 data proxy calls (3 to the same proxy, 1 to a different proxy), replication factor = 1.
 	gasMeter := types.NewGasMeter(150000000000000, 300000000000000, types.DefaultMaxTallyGasLimit, math.NewInt(100000), types.DefaultGasCostBase)
-	fixture.tallyKeeper.MeterProxyGas(fixture.Context(), []string{"020173bd90e73c5f8576b3141c53aa9959b10a1daf1bc9c0ccf0a942932c703dec", "03b27f2df0cbdb5cdadff5b4be0c9fda5aa3a59557ef6d0b49b4298ef42c8ce2b0", "03b27f2df0cbdb5cdadff5b4be0c9fda5aa3a59557ef6d0b49b4298ef42c8ce2b0", "03b27f2df0cbdb5cdadff5b4be0c9fda5aa3a59557ef6d0b49b4298ef42c8ce2b0"}, 1, gasMeter)
+	fixture.tallyKeeper.MeterProxyGas(fixture.Context(), types.TallyResult{
+		ID:                "1",
+		ReplicationFactor: 1,
+		GasMeter:          gasMeter,
+		FilterResult:      types.FilterResult{ProxyPubKeys: []string{"020173bd90e73c5f8576b3141c53aa9959b10a1daf1bc9c0ccf0a942932c703dec", "03b27f2df0cbdb5cdadff5b4be0c9fda5aa3a59557ef6d0b49b4298ef42c8ce2b0", "03b27f2df0cbdb5cdadff5b4be0c9fda5aa3a59557ef6d0b49b4298ef42c8ce2b0", "03b27f2df0cbdb5cdadff5b4be0c9fda5aa3a59557ef6d0b49b4298ef42c8ce2b0"}},
+	})
 
 	tallyRes := types.TallyResult{
 		Reveals: []types.Reveal{
@@ -257,3 +263,289 @@ func TestReducedPayoutWithProxies(t *testing.T) {
 	// Sanity check that the difference between the two distributions is the same as the reduced payout.
 	require.Equal(t, distsReduced[0].Burn.Amount.Sub(dists[0].Burn.Amount).String(), dists[5].ExecutorReward.Amount.Sub(distsReduced[5].ExecutorReward.Amount).String(), "Difference between burn and executor reward is not the same as the reduced payout")
 }
+
+func TestMeterProxyGasSkipsDeregisteredProxies(t *testing.T) {
+	fixture := initFixture(t)
+
+	activePubKey, deregisteredPubKey := "03b27f2df0cbdb5cdadff5b4be0c9fda5aa3a59557ef6d0b49b4298ef42c8ce2b0", "020173bd90e73c5f8576b3141c53aa9959b10a1daf1bc9c0ccf0a942932c703dec"
+	activePayoutAddr, deregisteredPayoutAddr := "seda1zcds6ws7l0e005h3xrmg5tx0378nyg8gtmn64f", "seda149sewl80wccuzhhukxgn2jg4kcun02d8qclwkt"
+	err := fixture.SetDataProxyConfig(activePubKey, activePayoutAddr, sdk.NewCoin(bondDenom, math.NewInt(1000000000000000000)))
+	require.NoError(t, err)
+
+	pkBytes, err := hex.DecodeString(deregisteredPubKey)
+	require.NoError(t, err)
+	fee := sdk.NewCoin(bondDenom, math.NewInt(1000000000000000000))
+	err = fixture.dataProxyKeeper.SetDataProxyConfig(fixture.Context(), pkBytes, dataproxytypes.ProxyConfig{
+		PayoutAddress:        deregisteredPayoutAddr,
+		Fee:                  &fee,
+		DeregistrationHeight: 10,
+	})
+	require.NoError(t, err)
+
+	gasMeter := types.NewGasMeter(150000000000000, 300000000000000, types.DefaultMaxTallyGasLimit, math.NewInt(100000), types.DefaultGasCostBase)
+	fixture.tallyKeeper.MeterProxyGas(fixture.Context().WithBlockHeight(10), types.TallyResult{
+		ID:                "1",
+		ReplicationFactor: 1,
+		GasMeter:          gasMeter,
+		FilterResult:      types.FilterResult{ProxyPubKeys: []string{activePubKey, deregisteredPubKey}},
+	})
+
+	proxyGasUsed := gasMeter.GetProxyGasUsed("1", 1)
+	require.Len(t, proxyGasUsed, 1)
+	require.Equal(t, activePayoutAddr, proxyGasUsed[0].Recipients[0].Address)
+}
+
+func TestDistributionsSplitProxyPayouts(t *testing.T) {
+	fixture := initFixture(t)
+
+	pubKey := "03b27f2df0cbdb5cdadff5b4be0c9fda5aa3a59557ef6d0b49b4298ef42c8ce2b0"
+	payoutAddr1, payoutAddr2 := "seda1zcds6ws7l0e005h3xrmg5tx0378nyg8gtmn64f", "seda149sewl80wccuzhhukxgn2jg4kcun02d8qclwkt"
+
+	pkBytes, err := hex.DecodeString(pubKey)
+	require.NoError(t, err)
+	fee := sdk.NewCoin(bondDenom, math.NewInt(1000000000000000000))
+	err = fixture.dataProxyKeeper.SetDataProxyConfig(fixture.Context(), pkBytes, dataproxytypes.ProxyConfig{
+		PayoutAddress: payoutAddr1,
+		Fee:           &fee,
+		PayoutRecipients: []dataproxytypes.PayoutRecipient{
+			{Address: payoutAddr1, Weight: math.LegacyMustNewDecFromStr("0.667")},
+			{Address: payoutAddr2, Weight: math.LegacyMustNewDecFromStr("0.333")},
+		},
+	})
+	require.NoError(t, err)
+
+	gasMeter := types.NewGasMeter(150000000000000, 300000000000000, types.DefaultMaxTallyGasLimit, math.NewInt(100000), types.DefaultGasCostBase)
+	fixture.tallyKeeper.MeterProxyGas(fixture.Context(), types.TallyResult{
+		ID:                "1",
+		ReplicationFactor: 1,
+		GasMeter:          gasMeter,
+		FilterResult:      types.FilterResult{ProxyPubKeys: []string{pubKey}},
+	})
+
+	proxyGasUsed := gasMeter.GetProxyGasUsed("1", 1)
+	require.Len(t, proxyGasUsed, 1)
+
+	dists := fixture.tallyKeeper.DistributionsFromGasMeter(fixture.Context(), "1", 1, gasMeter, types.DefaultBurnRatio)
+	require.Len(t, dists, 3)
+	require.Equal(t, payoutAddr1, dists[1].DataProxyReward.PayoutAddress)
+	require.Equal(t, payoutAddr2, dists[2].DataProxyReward.PayoutAddress)
+
+	// The truncated remainder goes to the first recipient.
+	share2 := math.LegacyMustNewDecFromStr("0.333").MulInt(proxyGasUsed[0].Amount).TruncateInt()
+	share1 := proxyGasUsed[0].Amount.Sub(share2)
+	require.Equal(t, share1.Mul(gasMeter.GasPrice()).String(), dists[1].DataProxyReward.Amount.String())
+	require.Equal(t, share2.Mul(gasMeter.GasPrice()).String(), dists[2].DataProxyReward.Amount.String())
+}
+
+func TestRecordProxyUsage(t *testing.T) {
+	fixture := initFixture(t)
+
+	pubKey := "03b27f2df0cbdb5cdadff5b4be0c9fda5aa3a59557ef6d0b49b4298ef42c8ce2b0"
+	err := fixture.SetDataProxyConfig(pubKey, "seda1zcds6ws7l0e005h3xrmg5tx0378nyg8gtmn64f", sdk.NewCoin(bondDenom, math.NewInt(1000000000000000000)))
+	require.NoError(t, err)
+	pkBytes, err := hex.DecodeString(pubKey)
+	require.NoError(t, err)
+
+	gasMeter := types.NewGasMeter(150000000000000, 300000000000000, types.DefaultMaxTallyGasLimit, math.NewInt(100000), types.DefaultGasCostBase)
+	fixture.tallyKeeper.MeterProxyGas(fixture.Context(), types.TallyResult{
+		ID:                "1",
+		ReplicationFactor: 2,
+		GasMeter:          gasMeter,
+		FilterResult:      types.FilterResult{ProxyPubKeys: []string{pubKey}},
+	})
+	proxyGasUsed := gasMeter.GetProxyGasUsed("1", 1)
+	require.Len(t, proxyGasUsed, 1)
+
+	fixture.tallyKeeper.RecordProxyUsage(fixture.Context(), "1", gasMeter)
+	fixture.tallyKeeper.RecordProxyUsage(fixture.Context(), "2", gasMeter)
+
+	usage, err := fixture.dataProxyKeeper.GetDataProxyUsage(fixture.Context(), pkBytes)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), usage.RequestsServed)
+	require.Equal(t, proxyGasUsed[0].Amount.MulRaw(2).String(), usage.GasUsed.String())
+	require.Equal(t, proxyGasUsed[0].Amount.MulRaw(2).Mul(gasMeter.GasPrice()).String(), usage.FeesEarned.AmountOf(bondDenom).String())
+}
+
+func TestMeterProxyGasUsesFeeSchedule(t *testing.T) {
+	fixture := initFixture(t)
+
+	pubKey := "03b27f2df0cbdb5cdadff5b4be0c9fda5aa3a59557ef6d0b49b4298ef42c8ce2b0"
+	execProgramID := "6c7a3ee6f3b5b8a4ba8bea3c2b9d5a8c2f1b7e1bb5c9a0f2d4e4c2a1b0e9f8d7"
+	pkBytes, err := hex.DecodeString(pubKey)
+	require.NoError(t, err)
+
+	fee := sdk.NewCoin(bondDenom, math.NewInt(1000000000000000000))
+	err = fixture.dataProxyKeeper.SetDataProxyConfig(fixture.Context(), pkBytes, dataproxytypes.ProxyConfig{
+		PayoutAddress: "seda1zcds6ws7l0e005h3xrmg5tx0378nyg8gtmn64f",
+		Fee:           &fee,
+		FeeSchedule: []dataproxytypes.FeeScheduleEntry{
+			{ExecProgramID: execProgramID, Fee: sdk.NewCoin(bondDenom, math.NewInt(3000000000000000000))},
+			{MemoTag: "premium", Fee: sdk.NewCoin(bondDenom, math.NewInt(2000000000000000000))},
+		},
+	})
+	require.NoError(t, err)
+
+	gasPrice := math.NewInt(100000)
+	tests := []struct {
+		name          string
+		execProgramID string
+		memo          []byte
+		expFee        math.Int
+	}{
+		{"exec program entry", execProgramID, []byte("premium"), math.NewInt(3000000000000000000)},
+		{"memo tag entry", "", []byte("premium-feed"), math.NewInt(2000000000000000000)},
+		{"flat fee fallback", "", []byte("basic"), fee.Amount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gasMeter := types.NewGasMeter(150000000000000, 300000000000000, types.DefaultMaxTallyGasLimit, gasPrice, types.DefaultGasCostBase)
+			fixture.tallyKeeper.MeterProxyGas(fixture.Context(), types.TallyResult{
+				ID:                "1",
+				ReplicationFactor: 1,
+				ExecProgramID:     tt.execProgramID,
+				Memo:              tt.memo,
+				GasMeter:          gasMeter,
+				FilterResult:      types.FilterResult{ProxyPubKeys: []string{pubKey}},
+			})
+
+			proxyGasUsed := gasMeter.GetProxyGasUsed("1", 1)
+			require.Len(t, proxyGasUsed, 1)
+			require.Equal(t, tt.expFee.Quo(gasPrice).String(), proxyGasUsed[0].Amount.String())
+		})
+	}
+}
+
+func TestMeterProxyGasSkipsDeniedProxies(t *testing.T) {
+	fixture := initFixture(t)
+
+	allowedPubKey, deniedPubKey := "03b27f2df0cbdb5cdadff5b4be0c9fda5aa3a59557ef6d0b49b4298ef42c8ce2b0", "020173bd90e73c5f8576b3141c53aa9959b10a1daf1bc9c0ccf0a942932c703dec"
+	allowedPayoutAddr, deniedPayoutAddr := "seda1zcds6ws7l0e005h3xrmg5tx0378nyg8gtmn64f", "seda149sewl80wccuzhhukxgn2jg4kcun02d8qclwkt"
+	fee := sdk.NewCoin(bondDenom, math.NewInt(1000000000000000000))
+	require.NoError(t, fixture.SetDataProxyConfig(allowedPubKey, allowedPayoutAddr, fee))
+	require.NoError(t, fixture.SetDataProxyConfig(deniedPubKey, deniedPayoutAddr, fee))
+
+	deniedPubKeyBytes, err := hex.DecodeString(deniedPubKey)
+	require.NoError(t, err)
+	require.NoError(t, fixture.dataProxyKeeper.DenyDataProxy(fixture.Context(), deniedPubKeyBytes))
+
+	gasMeter := types.NewGasMeter(150000000000000, 300000000000000, types.DefaultMaxTallyGasLimit, math.NewInt(100000), types.DefaultGasCostBase)
+	ctx := fixture.Context().WithEventManager(sdk.NewEventManager())
+	fixture.tallyKeeper.MeterProxyGas(ctx, types.TallyResult{
+		ID:                "1",
+		ReplicationFactor: 1,
+		GasMeter:          gasMeter,
+		FilterResult:      types.FilterResult{ProxyPubKeys: []string{allowedPubKey, deniedPubKey}},
+	})
+
+	proxyGasUsed := gasMeter.GetProxyGasUsed("1", 1)
+	require.Len(t, proxyGasUsed, 1)
+	require.Equal(t, allowedPayoutAddr, proxyGasUsed[0].Recipients[0].Address)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeDeniedProxy, events[0].Type)
+	proxyAttr, found := events[0].GetAttribute(types.AttributeProxyPubKey)
+	require.True(t, found)
+	require.Equal(t, deniedPubKey, proxyAttr.Value)
+}
+
+func TestMeterProxyGasConvertsFeeDenoms(t *testing.T) {
+	fixture := initFixture(t)
+
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	convertedPubKey, unconvertiblePubKey := "03b27f2df0cbdb5cdadff5b4be0c9fda5aa3a59557ef6d0b49b4298ef42c8ce2b0", "020173bd90e73c5f8576b3141c53aa9959b10a1daf1bc9c0ccf0a942932c703dec"
+	payoutAddr := "seda1zcds6ws7l0e005h3xrmg5tx0378nyg8gtmn64f"
+	require.NoError(t, fixture.SetDataProxyConfig(convertedPubKey, payoutAddr, sdk.NewCoin(ibcDenom, math.NewInt(2000000))))
+	require.NoError(t, fixture.SetDataProxyConfig(unconvertiblePubKey, payoutAddr, sdk.NewCoin("uusdc", math.NewInt(2000000))))
+
+	// 1 unit of the IBC denom is worth 1.5e12 aseda.
+	rate := math.LegacyNewDecWithPrec(15, 1).MulInt64(1000000000000)
+	require.NoError(t, fixture.dataProxyKeeper.SetFeeExchangeRate(fixture.Context(), ibcDenom, rate))
+
+	gasMeter := types.NewGasMeter(150000000000000, 300000000000000, types.DefaultMaxTallyGasLimit, math.NewInt(100000), types.DefaultGasCostBase)
+	fixture.tallyKeeper.MeterProxyGas(fixture.Context(), types.TallyResult{
+		ID:                "1",
+		ReplicationFactor: 1,
+		GasMeter:          gasMeter,
+		FilterResult:      types.FilterResult{ProxyPubKeys: []string{convertedPubKey, unconvertiblePubKey}},
+	})
+
+	// Data proxies quoting fees in denominations without a rate are not paid.
+	proxyGasUsed := gasMeter.GetProxyGasUsed("1", 1)
+	require.Len(t, proxyGasUsed, 1)
+	require.Equal(t, convertedPubKey, proxyGasUsed[0].PublicKey)
+	// 2e6 * 1.5e12 aseda / 1e5 aseda per gas
+	require.Equal(t, math.NewInt(30000000000000), proxyGasUsed[0].Amount)
+
+	ctx := fixture.Context().WithEventManager(sdk.NewEventManager())
+	fixture.tallyKeeper.DistributionsFromGasMeter(ctx, "1", 1, gasMeter, types.DefaultBurnRatio)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	rateAttr, found := events[0].GetAttribute(types.AttributeDataProxyRate)
+	require.True(t, found)
+	require.Equal(t, fmt.Sprintf("%s,2000000%s,%s", convertedPubKey, ibcDenom, rate), rateAttr.Value)
+}
+
+func TestMeterProxyGasClampsToRequestorCaps(t *testing.T) {
+	fixture := initFixture(t)
+
+	pubKeys := []string{"03b27f2df0cbdb5cdadff5b4be0c9fda5aa3a59557ef6d0b49b4298ef42c8ce2b0", "020173bd90e73c5f8576b3141c53aa9959b10a1daf1bc9c0ccf0a942932c703dec"}
+	payoutAddr := "seda1zcds6ws7l0e005h3xrmg5tx0378nyg8gtmn64f"
+	for _, pubKey := range pubKeys {
+		require.NoError(t, fixture.SetDataProxyConfig(pubKey, payoutAddr, sdk.NewCoin(bondDenom, math.NewIntWithDecimal(1, 18))))
+	}
+
+	tests := []struct {
+		name             string
+		maxProxyFee      math.Int
+		maxTotalProxyFee math.Int
+		expGasUsed       []math.Int
+		expFeeCaps       []string
+	}{
+		{
+			name:       "No caps",
+			expGasUsed: []math.Int{math.NewInt(10000000000000), math.NewInt(10000000000000)},
+		},
+		{
+			name:        "Per data proxy cap",
+			maxProxyFee: math.NewIntWithDecimal(5, 17),
+			expGasUsed:  []math.Int{math.NewInt(5000000000000), math.NewInt(5000000000000)},
+			expFeeCaps:  []string{"max_proxy_fee", "max_proxy_fee"},
+		},
+		{
+			name:             "Total cap",
+			maxTotalProxyFee: math.NewIntWithDecimal(12, 17),
+			expGasUsed:       []math.Int{math.NewInt(10000000000000), math.NewInt(2000000000000)},
+			expFeeCaps:       []string{"max_total_proxy_fee"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := fixture.Context().WithEventManager(sdk.NewEventManager())
+			gasMeter := types.NewGasMeter(150000000000000, 300000000000000, types.DefaultMaxTallyGasLimit, math.NewInt(100000), types.DefaultGasCostBase)
+			fixture.tallyKeeper.MeterProxyGas(ctx, types.TallyResult{
+				ID:                "1",
+				ReplicationFactor: 1,
+				GasMeter:          gasMeter,
+				FilterResult:      types.FilterResult{ProxyPubKeys: pubKeys},
+				MaxProxyFee:       tt.maxProxyFee,
+				MaxTotalProxyFee:  tt.maxTotalProxyFee,
+			})
+
+			proxyGasUsed := gasMeter.GetProxyGasUsed("1", 1)
+			require.Len(t, proxyGasUsed, len(tt.expGasUsed))
+			for i, expGasUsed := range tt.expGasUsed {
+				require.Equal(t, expGasUsed, proxyGasUsed[i].Amount)
+			}
+
+			events := ctx.EventManager().Events()
+			require.Len(t, events, len(tt.expFeeCaps))
+			for i, expFeeCap := range tt.expFeeCaps {
+				require.Equal(t, types.EventTypeProxyFeeClamped, events[i].Type)
+				feeCap, found := events[i].GetAttribute(types.AttributeFeeCap)
+				require.True(t, found)
+				require.Equal(t, expFeeCap, feeCap.Value)
+			}
+		})
+	}
+}
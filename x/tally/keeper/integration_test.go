@@ -279,6 +279,8 @@ func initFixture(t testing.TB) *fixture {
 
 	err = tallyKeeper.SetParams(ctx, types.DefaultParams())
 	require.NoError(t, err)
+	err = dataProxyKeeper.SetParams(ctx, dataproxytypes.DefaultParams())
+	require.NoError(t, err)
 
 	// Upload, instantiate, and configure the Core Contract.
 	deployer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
@@ -416,3 +416,30 @@ func TestExecuteTallyProgramsParallel_ErrorHandling(t *testing.T) {
 	require.Contains(t, execItems[2].TallyExecErr.Error(), "illegal base64 data")
 	require.NoError(t, execItems[3].TallyExecErr, "Valid item should have no error")
 }
+
+func TestExecuteTallyProgramsParallel_BlockedProgram(t *testing.T) {
+	f := initFixture(t)
+
+	tallyProgram := wasmstoragetypes.NewOracleProgram(testwasms.RandomStringTallyWasm(), f.Context().BlockTime())
+	f.wasmStorageKeeper.OracleProgram.Set(f.Context(), tallyProgram.Hash, tallyProgram)
+	f.wasmStorageKeeper.BlockedOracleProgram.Set(f.Context(), tallyProgram.Hash, wasmstoragetypes.BlockedOracleProgram{
+		Hash:   hex.EncodeToString(tallyProgram.Hash),
+		Reason: "buggy",
+	})
+
+	execItems := []keeper.TallyParallelExecItem{
+		{
+			Request: types.Request{
+				ID:             "some_id_0",
+				TallyProgramID: hex.EncodeToString(tallyProgram.Hash),
+				TallyInputs:    base64.StdEncoding.EncodeToString([]byte("hello")),
+				PaybackAddress: base64.StdEncoding.EncodeToString([]byte("0x0")),
+			},
+			GasMeter: types.NewGasMeter(types.DefaultMaxTallyGasLimit, 100, types.DefaultMaxTallyGasLimit, math.NewInt(1), 1),
+		},
+	}
+
+	vmResults := f.tallyKeeper.ExecuteTallyProgramsParallel(f.Context(), execItems)
+	require.Empty(t, vmResults)
+	require.ErrorIs(t, execItems[0].TallyExecErr, wasmstoragetypes.ErrOracleProgramBlocked)
+}
@@ -65,6 +65,12 @@ type Request struct {
 	Reveals           map[string]RevealBody `json:"reveals"`
 	SedaPayload       string                `json:"seda_payload"`
 	Version           string                `json:"version"`
+	// MaxProxyFee optionally caps the aseda fee paid to each data proxy per
+	// executor, so that fee rises after posting do not affect the request.
+	MaxProxyFee string `json:"max_proxy_fee"`
+	// MaxTotalProxyFee optionally caps the total aseda fees paid to data
+	// proxies for the request.
+	MaxTotalProxyFee string `json:"max_total_proxy_fee"`
 }
 
 // Validate validates the request fields and returns any validation error along
@@ -106,6 +112,31 @@ func (req *Request) ToResult(ctx types.Context) (result batchingtypes.DataResult
 	return result, encodingErr
 }
 
+// ProxyFeeCaps parses the optional data proxy fee caps of the request. A nil
+// amount is returned for a cap that is not set.
+func (req *Request) ProxyFeeCaps() (maxProxyFee, maxTotalProxyFee math.Int, err error) {
+	parse := func(value string) (math.Int, error) {
+		if value == "" {
+			return math.Int{}, nil
+		}
+		amount, ok := math.NewIntFromString(value)
+		if !ok || amount.IsNegative() {
+			return math.Int{}, fmt.Errorf("invalid proxy fee cap: %s", value)
+		}
+		return amount, nil
+	}
+
+	maxProxyFee, err = parse(req.MaxProxyFee)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+	maxTotalProxyFee, err = parse(req.MaxTotalProxyFee)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+	return maxProxyFee, maxTotalProxyFee, nil
+}
+
 // SanitizeReveals returns sanitized reveals, executors, and gas reports. The
 // three slices are sorted by executor with given entropies and have the same
 // ordering. Each reveal's reported proxy public keys are also sorted.
@@ -3,6 +3,8 @@ package types
 const (
 	EventTypeTallyCompletion = "tally_completion"
 	EventTypeGasMeter        = "gas_calculation"
+	EventTypeDeniedProxy     = "denied_data_proxy"
+	EventTypeProxyFeeClamped = "proxy_fee_clamped"
 
 	AttributeDataRequestID     = "dr_id"
 	AttributeDataRequestHeight = "dr_height"
@@ -16,7 +18,13 @@ const (
 	AttributeProxyPubKeys      = "proxy_public_keys"
 	AttributeTallyGas          = "tally_gas"
 	AttributeDataProxyGas      = "data_proxy_gas"
+	AttributeDataProxyRate     = "data_proxy_exchange_rate"
 	AttributeExecutorGas       = "executor_reward_gas"
 	AttributeReducedPayout     = "reduced_payout"
 	AttributeReducedPayoutBurn = "reduced_payout_burn"
+	AttributeProxyPubKey       = "proxy_public_key"
+	AttributeExecutor          = "executor"
+	AttributeProxyFee          = "proxy_fee"
+	AttributeClampedFee        = "clamped_fee"
+	AttributeFeeCap            = "fee_cap"
 )
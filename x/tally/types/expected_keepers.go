@@ -3,6 +3,10 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	batchingtypes "github.com/sedaprotocol/seda-chain/x/batching/types"
 	dataproxytypes "github.com/sedaprotocol/seda-chain/x/data-proxy/types"
 )
@@ -12,5 +16,8 @@ type BatchingKeeper interface {
 }
 
 type DataProxyKeeper interface {
-	GetDataProxyConfig(ctx context.Context, pubKey []byte) (result dataproxytypes.ProxyConfig, err error)
+	GetActiveDataProxyConfig(ctx context.Context, pubKey []byte) (dataproxytypes.ProxyConfig, error)
+	RecordDataProxyUsage(ctx context.Context, pubKey []byte, gasUsed math.Int, fees sdk.Coins) error
+	IsDataProxyAllowed(ctx context.Context, pubKey []byte) (bool, error)
+	ConvertFee(ctx context.Context, fee sdk.Coin) (sdk.Coin, math.LegacyDec, error)
 }
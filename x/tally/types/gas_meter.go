@@ -4,6 +4,10 @@ import (
 	"encoding/binary"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dataproxytypes "github.com/sedaprotocol/seda-chain/x/data-proxy/types"
 )
 
 // GasMeter stores the results of the canonical gas consumption calculations.
@@ -22,9 +26,13 @@ type GasMeter struct {
 var _ HashSortable = ProxyGasUsed{}
 
 type ProxyGasUsed struct {
-	PayoutAddress string
-	PublicKey     string
-	Amount        math.Int
+	PublicKey  string
+	Recipients []dataproxytypes.PayoutRecipient
+	Amount     math.Int
+	// Fee is the fee of the data proxy as quoted in its config.
+	Fee sdk.Coin
+	// ExchangeRate is the rate applied to convert the fee to aseda.
+	ExchangeRate math.LegacyDec
 }
 
 func (p ProxyGasUsed) GetSortKey() []byte {
@@ -113,15 +121,18 @@ func (g *GasMeter) ConsumeTallyGas(amount uint64) bool {
 }
 
 // ConsumeExecGasForProxy consumes execution gas for data proxy payout and records
-// the payout information. It returns true if the execution gas runs out during
-// the process.
-func (g *GasMeter) ConsumeExecGasForProxy(proxyPubkey, payoutAddr string, gasUsedPerExec uint64, replicationFactor uint16) {
+// the payout information, including the weighted recipients among which the
+// payout is split and the exchange rate at which the fee was converted. It
+// returns true if the execution gas runs out during the process.
+func (g *GasMeter) ConsumeExecGasForProxy(proxyPubkey string, recipients []dataproxytypes.PayoutRecipient, fee sdk.Coin, exchangeRate math.LegacyDec, gasUsedPerExec uint64, replicationFactor uint16) {
 	amount := gasUsedPerExec * uint64(replicationFactor)
 
 	g.proxies = append(g.proxies, ProxyGasUsed{
-		PayoutAddress: payoutAddr,
-		PublicKey:     proxyPubkey,
-		Amount:        math.NewIntFromUint64(amount),
+		PublicKey:    proxyPubkey,
+		Recipients:   recipients,
+		Amount:       math.NewIntFromUint64(amount),
+		Fee:          fee,
+		ExchangeRate: exchangeRate,
 	})
 
 	if amount > g.execGasRemaining {
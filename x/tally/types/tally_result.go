@@ -12,6 +12,10 @@ type TallyResult struct {
 	ID                string
 	Height            uint64
 	ReplicationFactor uint16
+	ExecProgramID     string
+	Memo              []byte
+	MaxProxyFee       math.Int // nil if the request does not cap data proxy fees
+	MaxTotalProxyFee  math.Int // nil if the request does not cap total data proxy fees
 	Reveals           []Reveal
 	GasMeter          *GasMeter
 	GasReports        []uint64
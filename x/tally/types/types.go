@@ -11,4 +11,7 @@ import (
 type WasmStorageKeeper interface {
 	GetCoreContractAddr(ctx context.Context) (sdk.AccAddress, error)
 	GetOracleProgram(ctx context.Context, hash string) (types.OracleProgram, error)
+	ExtendOracleProgramExpiration(ctx context.Context, hash string) error
+	RecordExecProgramUsage(ctx context.Context, hash string) error
+	RecordTallyProgramUsage(ctx context.Context, hash string, gasUsed uint64, exitCode uint32) error
 }
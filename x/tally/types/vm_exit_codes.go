@@ -4,7 +4,12 @@ const (
 	TallyExitCodeNotEnoughCommits   uint32 = 200 // tally VM not executed due to not enough commits
 	TallyExitCodeInvalidRequest     uint32 = 201 // tally VM not executed due to invalid request
 	TallyExitCodeContractPaused     uint32 = 202 // tally VM not executed due to contract being paused
+	TallyExitCodeBlockedProgram     uint32 = 203 // tally VM not executed due to tally program being blocked
 	TallyExitCodeInvalidFilterInput uint32 = 253 // tally VM not executed due to invalid filter input
 	TallyExitCodeFilterError        uint32 = 254 // tally VM not executed due to filter error
 	TallyExitCodeExecError          uint32 = 255 // tally VM not executed due to error while preparing VM inputs
 )
+
+// RevealExitCodeDeniedProxy replaces the exit code of reveals citing a data
+// proxy denied by governance, so that they are treated as errors.
+const RevealExitCodeDeniedProxy byte = 255
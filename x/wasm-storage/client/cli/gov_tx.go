@@ -42,6 +42,9 @@ func SubmitProposalCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		ProposalInstantiateCoreContract(),
+		ProposalMigrateCoreContract(),
+		ProposalBlockOracleProgram(),
+		ProposalUnblockOracleProgram(),
 	)
 	return cmd
 }
@@ -97,6 +100,104 @@ func ProposalInstantiateCoreContract() *cobra.Command {
 	return cmd
 }
 
+func ProposalMigrateCoreContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-core-contract [code_id_int64] [json_encoded_migration_args] --title [string] --summary [string] --deposit 10000000aseda",
+		Short: "Submit a proposal to migrate the registered core contract to a new code ID",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(authority string) sdk.Msg {
+				return &types.MsgMigrateCoreContract{
+					Sender: authority,
+					CodeID: codeID,
+					Msg:    []byte(args[1]),
+				}
+			})
+		},
+	}
+
+	addCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func ProposalBlockOracleProgram() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-oracle-program [hash] [reason] --title [string] --summary [string] --deposit 10000000aseda",
+		Short: "Submit a proposal to block an oracle program from being used",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(authority string) sdk.Msg {
+				return &types.MsgBlockOracleProgram{
+					Authority: authority,
+					Hash:      args[0],
+					Reason:    args[1],
+				}
+			})
+		},
+	}
+
+	addCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func ProposalUnblockOracleProgram() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unblock-oracle-program [hash] --title [string] --summary [string] --deposit 10000000aseda",
+		Short: "Submit a proposal to remove an oracle program from the blocklist",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(authority string) sdk.Msg {
+				return &types.MsgUnblockOracleProgram{
+					Authority: authority,
+					Hash:      args[0],
+				}
+			})
+		},
+	}
+
+	addCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+// submitProposal submits a governance proposal containing the message
+// constructed with the authority given by the flags.
+func submitProposal(cmd *cobra.Command, newMsg func(authority string) sdk.Msg) error {
+	clientCtx, proposalTitle, summary, deposit, err := getProposalInfo(cmd)
+	if err != nil {
+		return err
+	}
+
+	authority, err := cmd.Flags().GetString(flagAuthority)
+	if err != nil {
+		return fmt.Errorf("authority: %s", err)
+	}
+	if len(authority) == 0 {
+		return errors.New("authority address is required")
+	}
+
+	msg := newMsg(authority)
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	proposalMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, false)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+}
+
 func parseInstantiateCoreContractArgs(rawCodeID, initMsg string, kr keyring.Keyring, sender string, flags *flag.FlagSet) (*types.MsgInstantiateCoreContract, error) {
 	codeID, err := strconv.ParseUint(rawCodeID, 10, 64)
 	if err != nil {
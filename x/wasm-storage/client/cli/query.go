@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -11,6 +13,14 @@ import (
 	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
 
+const (
+	FlagOrderByAddedAt  = "order-by-added-at"
+	FlagAddedAfter      = "added-after"
+	FlagAddedBefore     = "added-before"
+	FlagMinBytecodeSize = "min-bytecode-size"
+	FlagMaxBytecodeSize = "max-bytecode-size"
+)
+
 // GetQueryCmd returns the CLI query commands for this module
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -23,8 +33,11 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdQueryOracleProgram(),
+		GetCmdQueryOracleProgramExists(),
 		GetCmdQueryOraclePrograms(),
 		GetCmdQueryCoreContractRegistry(),
+		GetCmdQueryBlockedOraclePrograms(),
+		GetCmdQueryUploadSession(),
 		GetCmdQueryParams(),
 	)
 	return cmd
@@ -58,12 +71,40 @@ func GetCmdQueryOracleProgram() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryOracleProgramExists returns the command for checking
+// whether an oracle program is stored.
+func GetCmdQueryOracleProgramExists() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-program-exists <hash>",
+		Short: "Check whether an oracle program is stored given its hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OracleProgramExists(cmd.Context(), &types.QueryOracleProgramExistsRequest{
+				Hash: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryOraclePrograms returns the command for querying
 // oracle programs in the store.
 func GetCmdQueryOraclePrograms() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-oracle-programs",
-		Short: "List hashes of all oracle programs",
+		Short: "List oracle programs without their bytecode",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -76,10 +117,45 @@ func GetCmdQueryOraclePrograms() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+			name, err := cmd.Flags().GetString(FlagName)
+			if err != nil {
+				return err
+			}
+			orderByAddedAt, err := cmd.Flags().GetBool(FlagOrderByAddedAt)
+			if err != nil {
+				return err
+			}
+			addedAfter, err := readTimeFlag(cmd, FlagAddedAfter)
+			if err != nil {
+				return err
+			}
+			addedBefore, err := readTimeFlag(cmd, FlagAddedBefore)
+			if err != nil {
+				return err
+			}
+			minSize, err := cmd.Flags().GetUint64(FlagMinBytecodeSize)
+			if err != nil {
+				return err
+			}
+			maxSize, err := cmd.Flags().GetUint64(FlagMaxBytecodeSize)
+			if err != nil {
+				return err
+			}
 			res, err := queryClient.OraclePrograms(
 				cmd.Context(),
 				&types.QueryOracleProgramsRequest{
-					Pagination: pageReq,
+					Pagination:      pageReq,
+					Owner:           owner,
+					Name:            name,
+					OrderByAddedAt:  orderByAddedAt,
+					AddedAfter:      addedAfter,
+					AddedBefore:     addedBefore,
+					MinBytecodeSize: minSize,
+					MaxBytecodeSize: maxSize,
 				},
 			)
 			if err != nil {
@@ -89,11 +165,100 @@ func GetCmdQueryOraclePrograms() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagOwner, "", "only list oracle programs with the given owner")
+	cmd.Flags().String(FlagName, "", "only list oracle programs with the given name")
+	cmd.Flags().Bool(FlagOrderByAddedAt, false, "sort oracle programs by the time they were added instead of by hash (use --reverse to list the most recent first)")
+	cmd.Flags().String(FlagAddedAfter, "", "only list oracle programs added at or after the given RFC 3339 time, in the order they were added")
+	cmd.Flags().String(FlagAddedBefore, "", "only list oracle programs added before the given RFC 3339 time, in the order they were added")
+	cmd.Flags().Uint64(FlagMinBytecodeSize, 0, "only list oracle programs with at least the given bytecode size in bytes")
+	cmd.Flags().Uint64(FlagMaxBytecodeSize, 0, "only list oracle programs with at most the given bytecode size in bytes")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "oracle programs")
 	return cmd
 }
 
+// readTimeFlag returns the RFC 3339 time given by the flag or nil if the
+// flag is not set.
+func readTimeFlag(cmd *cobra.Command, name string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", name, err)
+	}
+	return &t, nil
+}
+
+// GetCmdQueryBlockedOraclePrograms returns the command for querying
+// the oracle programs blocked by governance.
+func GetCmdQueryBlockedOraclePrograms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-oracle-programs",
+		Short: "List oracle programs blocked by governance",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.BlockedOraclePrograms(
+				cmd.Context(),
+				&types.QueryBlockedOracleProgramsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blocked oracle programs")
+	return cmd
+}
+
+// GetCmdQueryUploadSession returns the command for querying a chunked
+// upload session.
+func GetCmdQueryUploadSession() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload-session <upload_id>",
+		Short: "Get a chunked oracle program upload session given its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			uploadID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.UploadSession(cmd.Context(), &types.QueryUploadSessionRequest{
+				UploadId: uploadID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryCoreContractRegistry returns the command for querying
 // Core Contract registry.
 func GetCmdQueryCoreContractRegistry() *cobra.Command {
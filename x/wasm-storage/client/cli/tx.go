@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -19,6 +22,21 @@ import (
 	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
 
+const (
+	FlagName             = "name"
+	FlagVersion          = "program-version"
+	FlagSourceRepository = "source-repository"
+	FlagSourceCommit     = "source-commit"
+	FlagBuildHash        = "build-hash"
+	FlagDescription      = "description"
+	FlagOwner            = "owner"
+	FlagChunkSize        = "chunk-size"
+
+	// DefaultChunkSize is the default size in bytes of the chunks of a
+	// chunked oracle program upload.
+	DefaultChunkSize = 512 * 1024
+)
+
 // GetTxCmd returns the CLI transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -31,6 +49,10 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdStoreOracleProgram(),
+		GetCmdTopUpOracleProgram(),
+		GetCmdBeginOracleProgramUpload(),
+		GetCmdAppendOracleProgramChunk(),
+		GetCmdFinalizeOracleProgramUpload(),
 		SubmitProposalCmd(),
 	)
 	return cmd
@@ -42,7 +64,9 @@ func GetCmdStoreOracleProgram() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "store-oracle-program [wasm_file]",
 		Short: "Store an oracle program",
-		Args:  cobra.ExactArgs(1),
+		Long: `Store an oracle program. If a program with the same hash is already stored,
+only its hash is sent, so that the store succeeds without paying the storage fee again.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -56,10 +80,26 @@ func GetCmdStoreOracleProgram() *cobra.Command {
 				return err
 			}
 
-			length, wasm, err := gzipWasmFile(args[0])
+			unzipped, wasm, err := gzipWasmFile(args[0])
+			if err != nil {
+				return err
+			}
+			length := int64(len(unzipped))
+
+			hash := hex.EncodeToString(types.NewOracleProgram(unzipped, time.Time{}).Hash)
+			exists, err := queryClient.OracleProgramExists(cmd.Context(), &types.QueryOracleProgramExistsRequest{
+				Hash: hash,
+			})
 			if err != nil {
 				return err
 			}
+			if exists.Exists {
+				msg := &types.MsgStoreOracleProgram{
+					Sender: clientCtx.GetFromAddress().String(),
+					Hash:   hash,
+				}
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
 
 			if length > params.Params.MaxWasmSize {
 				return fmt.Errorf("WASM file is too large. Max size is %d bytes", params.Params.MaxWasmSize)
@@ -67,33 +107,239 @@ func GetCmdStoreOracleProgram() *cobra.Command {
 
 			storageFee := math.NewIntFromUint64(params.Params.WasmCostPerByte).Mul(math.NewInt(length))
 
+			metadata, err := readMetadataFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgStoreOracleProgram{
 				Sender:     clientCtx.GetFromAddress().String(),
 				Wasm:       wasm,
 				StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, storageFee)),
+				Metadata:   metadata,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addMetadataFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-// gzipWasmFile returns the length of the unzipped wasm file and the zipped wasm file.
-func gzipWasmFile(filename string) (int64, []byte, error) {
+// GetCmdTopUpOracleProgram returns the command for paying rent to
+// extend the expiration of an oracle program.
+func GetCmdTopUpOracleProgram() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-up-oracle-program [hash] [rent]",
+		Short: "Pay rent to extend the expiration of an oracle program",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rent, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTopUpOracleProgram{
+				Sender: clientCtx.GetFromAddress().String(),
+				Hash:   args[0],
+				Rent:   rent,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBeginOracleProgramUpload returns the command for beginning a
+// chunked upload of an oracle program file.
+func GetCmdBeginOracleProgramUpload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "begin-oracle-program-upload [wasm_file]",
+		Short: "Begin a chunked upload of an oracle program and pay its storage fee into escrow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			wasm, zipped, err := gzipWasmFile(args[0])
+			if err != nil {
+				return err
+			}
+			if int64(len(wasm)) > params.Params.MaxWasmSize {
+				return fmt.Errorf("WASM file is too large. Max size is %d bytes", params.Params.MaxWasmSize)
+			}
+
+			storageFee := math.NewIntFromUint64(params.Params.WasmCostPerByte).Mul(math.NewInt(int64(len(wasm))))
+
+			metadata, err := readMetadataFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBeginOracleProgramUpload{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Hash:         hex.EncodeToString(types.NewOracleProgram(wasm, time.Time{}).Hash),
+				UnzippedSize: uint64(len(wasm)),
+				ZippedSize:   uint64(len(zipped)),
+				StorageFee:   sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, storageFee)),
+				Metadata:     metadata,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addMetadataFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAppendOracleProgramChunk returns the command for appending a
+// chunk of an oracle program file to a chunked upload.
+func GetCmdAppendOracleProgramChunk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "append-oracle-program-chunk [upload_id] [wasm_file] [chunk_index]",
+		Short: "Append a chunk of the gzip-compressed oracle program to a chunked upload",
+		Long: "Append the chunk with the given zero-based index of the gzip-compressed oracle program " +
+			"to a chunked upload. Chunks must be appended in order using the same chunk size.",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uploadID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			_, zipped, err := gzipWasmFile(args[1])
+			if err != nil {
+				return err
+			}
+			index, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			chunkSize, err := cmd.Flags().GetUint64(FlagChunkSize)
+			if err != nil {
+				return err
+			}
+			if chunkSize == 0 {
+				return fmt.Errorf("chunk size must be positive")
+			}
+
+			start := index * chunkSize
+			if start >= uint64(len(zipped)) {
+				return fmt.Errorf("chunk %d is out of range: the compressed file has %d chunks", index, (uint64(len(zipped))+chunkSize-1)/chunkSize)
+			}
+			end := min(start+chunkSize, uint64(len(zipped)))
+
+			msg := &types.MsgAppendOracleProgramChunk{
+				Sender:   clientCtx.GetFromAddress().String(),
+				UploadID: uploadID,
+				Chunk:    zipped[start:end],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagChunkSize, DefaultChunkSize, "size in bytes of each chunk of the compressed oracle program")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdFinalizeOracleProgramUpload returns the command for finalizing
+// a chunked upload of an oracle program.
+func GetCmdFinalizeOracleProgramUpload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-oracle-program-upload [upload_id]",
+		Short: "Verify and store an oracle program uploaded in chunks",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uploadID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgFinalizeOracleProgramUpload{
+				Sender:   clientCtx.GetFromAddress().String(),
+				UploadID: uploadID,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// addMetadataFlags adds the oracle program metadata flags to a command.
+func addMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagName, "", "name of the oracle program")
+	cmd.Flags().String(FlagVersion, "", "semantic version of the oracle program")
+	cmd.Flags().String(FlagSourceRepository, "", "URL of the source code repository")
+	cmd.Flags().String(FlagSourceCommit, "", "commit hash of the source code")
+	cmd.Flags().String(FlagBuildHash, "", "hex-encoded hash of a reproducible build")
+	cmd.Flags().String(FlagDescription, "", "description of the oracle program")
+	cmd.Flags().String(FlagOwner, "", "address of the owner of the oracle program, which must be the sender")
+}
+
+// readMetadataFlags reads the oracle program metadata from the command flags.
+func readMetadataFlags(cmd *cobra.Command) (types.OracleProgramMetadata, error) {
+	var metadata types.OracleProgramMetadata
+	for flag, field := range map[string]*string{
+		FlagName:             &metadata.Name,
+		FlagVersion:          &metadata.Version,
+		FlagSourceRepository: &metadata.SourceRepository,
+		FlagSourceCommit:     &metadata.SourceCommit,
+		FlagBuildHash:        &metadata.BuildHash,
+		FlagDescription:      &metadata.Description,
+		FlagOwner:            &metadata.Owner,
+	} {
+		value, err := cmd.Flags().GetString(flag)
+		if err != nil {
+			return types.OracleProgramMetadata{}, err
+		}
+		*field = value
+	}
+	return metadata, metadata.Validate()
+}
+
+// gzipWasmFile returns the unzipped wasm file and the zipped wasm file.
+func gzipWasmFile(filename string) ([]byte, []byte, error) {
 	wasm, err := os.ReadFile(filename)
 	if err != nil {
-		return 0, nil, err
+		return nil, nil, err
 	}
 
 	if !ioutils.IsWasm(wasm) {
-		return 0, nil, fmt.Errorf("invalid Wasm file")
+		return nil, nil, fmt.Errorf("invalid Wasm file")
 	}
 
 	zipped, err := ioutils.GzipIt(wasm)
 	if err != nil {
-		return 0, nil, err
+		return nil, nil, err
 	}
-	return int64(len(wasm)), zipped, nil
+	return wasm, zipped, nil
 }
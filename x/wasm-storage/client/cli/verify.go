@@ -0,0 +1,320 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+const (
+	FlagOut      = "out"
+	FlagGenesis  = "genesis"
+	FlagHash     = "hash"
+	FlagManifest = "manifest"
+)
+
+// programSource returns the bytecode of the oracle program with the
+// given hex-encoded hash, including blocked oracle programs.
+type programSource func(ctx context.Context, hash string) ([]byte, error)
+
+// GetAuditCmd returns the commands for exporting oracle programs and
+// verifying local builds against the bytecode stored on chain.
+func GetAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Export and verify the bytecode of stored oracle programs",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdExportOracleProgram(),
+		GetCmdVerifyOraclePrograms(),
+	)
+	return cmd
+}
+
+// GetCmdExportOracleProgram returns the command for writing the
+// bytecode of a stored oracle program to a file.
+func GetCmdExportOracleProgram() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-program <hash>",
+		Short: "Write the bytecode of an oracle program to a file after checking its hash",
+		Long: `Write the bytecode of an oracle program to a file. The bytecode is queried from
+a node or, if --genesis is given, read from a genesis file. The hash of the
+bytecode is recomputed and must match the requested hash.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			source, err := readProgramSource(cmd)
+			if err != nil {
+				return err
+			}
+
+			hash := strings.ToLower(args[0])
+			bytecode, err := source(cmd.Context(), hash)
+			if err != nil {
+				return err
+			}
+			if computed := oracleProgramHash(bytecode); computed != hash {
+				return fmt.Errorf("hash of downloaded bytecode %s does not match %s", computed, hash)
+			}
+
+			out, err := cmd.Flags().GetString(FlagOut)
+			if err != nil {
+				return err
+			}
+			if out == "" {
+				out = hash + ".wasm"
+			}
+			if err := os.WriteFile(out, bytecode, 0o600); err != nil {
+				return err
+			}
+			cmd.Printf("wrote %d bytes of oracle program %s to %s\n", len(bytecode), hash, out)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagOut, "", "file to write the bytecode to (defaults to <hash>.wasm)")
+	addProgramSourceFlags(cmd)
+	return cmd
+}
+
+// GetCmdVerifyOraclePrograms returns the command for verifying that
+// local builds of oracle programs match the bytecode stored on chain.
+func GetCmdVerifyOraclePrograms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <file.wasm|dir>",
+		Short: "Verify that local oracle program builds match the bytecode stored on chain",
+		Long: `Verify that local oracle program builds match the bytecode stored on chain.
+The expected on-chain hash of a single build is given with --hash. The expected
+hashes of several builds are given with --manifest, a file in which each line
+holds a hash followed by the name of a .wasm file, as written by sha256sum.
+A build is verified if it hashes to its expected hash and the program stored
+under that hash, downloaded from a node or, if --genesis is given, read from a
+genesis file, is identical to it. Blocked programs are verified as well. If a
+directory is given, every .wasm file in it is verified. The command fails if
+any build cannot be verified.`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			source, err := readProgramSource(cmd)
+			if err != nil {
+				return err
+			}
+
+			files, err := wasmFiles(args[0])
+			if err != nil {
+				return err
+			}
+			expected, err := readExpectedHashes(cmd, files)
+			if err != nil {
+				return err
+			}
+
+			var failed int
+			for _, file := range files {
+				hash := expected[filepath.Base(file)]
+				if err := verifyOracleProgram(cmd.Context(), source, file, hash); err != nil {
+					failed++
+					cmd.Printf("FAILED   %s %s: %s\n", hash, file, err)
+					continue
+				}
+				cmd.Printf("VERIFIED %s %s\n", hash, file)
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d oracle programs could not be verified", failed, len(files))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagHash, "", "expected on-chain hash of the oracle program file")
+	cmd.Flags().String(FlagManifest, "", "file listing the expected on-chain hash of each oracle program file")
+	cmd.MarkFlagsMutuallyExclusive(FlagHash, FlagManifest)
+	addProgramSourceFlags(cmd)
+	return cmd
+}
+
+// verifyOracleProgram checks that the given file hashes to the expected
+// hash and that the oracle program stored under the expected hash has
+// the same bytecode as the file.
+func verifyOracleProgram(ctx context.Context, source programSource, file, expected string) error {
+	if expected == "" {
+		return fmt.Errorf("no expected hash")
+	}
+	local, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if hash := oracleProgramHash(local); hash != expected {
+		return fmt.Errorf("local build hashes to %s", hash)
+	}
+
+	bytecode, err := source(ctx, expected)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(local, bytecode) {
+		return fmt.Errorf("downloaded bytecode with hash %s differs from local build", oracleProgramHash(bytecode))
+	}
+	return nil
+}
+
+// readExpectedHashes returns the expected on-chain hashes of the given
+// files keyed by their base names, as given by the hash flag for a single
+// file or by the manifest flag.
+func readExpectedHashes(cmd *cobra.Command, files []string) (map[string]string, error) {
+	hash, err := cmd.Flags().GetString(FlagHash)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := cmd.Flags().GetString(FlagManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case hash != "":
+		if len(files) != 1 {
+			return nil, fmt.Errorf("--%s can only be used to verify a single file, use --%s instead", FlagHash, FlagManifest)
+		}
+		return map[string]string{filepath.Base(files[0]): strings.ToLower(hash)}, nil
+	case manifest != "":
+		return readManifest(manifest)
+	default:
+		return nil, fmt.Errorf("the expected on-chain hash must be given with --%s or --%s", FlagHash, FlagManifest)
+	}
+}
+
+// readManifest reads a manifest in which each line holds the hex-encoded
+// hash of an oracle program followed by the name of its file. Blank lines
+// and lines starting with # are skipped.
+func readManifest(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	hashes := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected a hash and a file name", path, line)
+		}
+		hashes[filepath.Base(strings.TrimPrefix(fields[1], "*"))] = strings.ToLower(fields[0])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return hashes, nil
+}
+
+// wasmFiles returns the given path if it is a file or the .wasm files
+// directly inside it if it is a directory.
+func wasmFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(path, "*.wasm"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no .wasm files in %s", path)
+	}
+	return files, nil
+}
+
+// oracleProgramHash returns the hex-encoded hash of the given bytecode
+// as computed when the oracle program is stored.
+func oracleProgramHash(bytecode []byte) string {
+	return hex.EncodeToString(types.NewOracleProgram(bytecode, time.Time{}).Hash)
+}
+
+func addProgramSourceFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagGenesis, "", "read oracle programs from the given genesis file instead of querying a node")
+	flags.AddQueryFlagsToCmd(cmd)
+}
+
+// readProgramSource returns a source that reads oracle programs from the
+// genesis file given by the genesis flag or, if the flag is not set,
+// queries them from a node.
+func readProgramSource(cmd *cobra.Command) (programSource, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	genesisFile, err := cmd.Flags().GetString(FlagGenesis)
+	if err != nil {
+		return nil, err
+	}
+	if genesisFile != "" {
+		return genesisProgramSource(clientCtx.Codec, genesisFile)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	return func(ctx context.Context, hash string) ([]byte, error) {
+		res, err := queryClient.OracleProgram(ctx, &types.QueryOracleProgramRequest{Hash: hash, IncludeBlocked: true})
+		if err != nil {
+			return nil, err
+		}
+		return res.OracleProgram.Bytecode, nil
+	}, nil
+}
+
+// genesisProgramSource returns a source that reads oracle programs from
+// the wasm-storage state of the given genesis file.
+func genesisProgramSource(cdc codec.JSONCodec, genesisFile string) (programSource, error) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+	if err != nil {
+		return nil, err
+	}
+	appState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	if err != nil {
+		return nil, err
+	}
+	var genesisState types.GenesisState
+	if err := cdc.UnmarshalJSON(appState[types.ModuleName], &genesisState); err != nil {
+		return nil, err
+	}
+
+	programs := make(map[string][]byte, len(genesisState.OraclePrograms))
+	for _, program := range genesisState.OraclePrograms {
+		programs[hex.EncodeToString(program.Hash)] = program.Bytecode
+	}
+	return func(_ context.Context, hash string) ([]byte, error) {
+		bytecode, ok := programs[hash]
+		if !ok {
+			return nil, fmt.Errorf("oracle program %s is not in the genesis file", hash)
+		}
+		return bytecode, nil
+	}, nil
+}
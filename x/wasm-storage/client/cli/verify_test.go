@@ -0,0 +1,196 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+var (
+	storedWasm   = []byte("stored program")
+	unstoredWasm = []byte("unstored program")
+	storedHash   = oracleProgramHash(storedWasm)
+	unstoredHash = oracleProgramHash(unstoredWasm)
+)
+
+func writeFile(t *testing.T, dir, name string, content []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+	return path
+}
+
+// writeGenesis writes a genesis file whose wasm-storage state holds the
+// given oracle programs.
+func writeGenesis(t *testing.T, cdc codec.JSONCodec, dir string, bytecodes ...[]byte) string {
+	t.Helper()
+	genesisState := types.DefaultGenesisState()
+	for _, bytecode := range bytecodes {
+		genesisState.OraclePrograms = append(genesisState.OraclePrograms, types.NewOracleProgram(bytecode, time.Time{}))
+	}
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		types.ModuleName: cdc.MustMarshalJSON(genesisState),
+	})
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, "genesis.json")
+	require.NoError(t, genutiltypes.NewAppGenesisWithVersion("seda-test", appState).SaveAs(path))
+	return path
+}
+
+func TestWasmFiles(t *testing.T) {
+	dir := t.TempDir()
+	file := writeFile(t, dir, "a.wasm", storedWasm)
+	other := writeFile(t, dir, "b.wasm", unstoredWasm)
+	writeFile(t, dir, "notes.txt", []byte("not a program"))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "empty"), 0o700))
+
+	tests := []struct {
+		name    string
+		path    string
+		want    []string
+		wantErr string
+	}{
+		{name: "file", path: file, want: []string{file}},
+		{name: "directory", path: dir, want: []string{file, other}},
+		{name: "empty directory", path: filepath.Join(dir, "empty"), wantErr: "no .wasm files"},
+		{name: "missing path", path: filepath.Join(dir, "missing.wasm"), wantErr: "no such file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := wasmFiles(tt.path)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, files)
+		})
+	}
+}
+
+func TestGenesisProgramSource(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	source, err := genesisProgramSource(cdc, writeGenesis(t, cdc, t.TempDir(), storedWasm))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		hash    string
+		want    []byte
+		wantErr string
+	}{
+		{name: "stored program", hash: storedHash, want: storedWasm},
+		{name: "unstored program", hash: unstoredHash, wantErr: "is not in the genesis file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bytecode, err := source(context.Background(), tt.hash)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, bytecode)
+		})
+	}
+}
+
+func TestVerifyOraclePrograms(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dir := t.TempDir()
+	genesisFile := writeGenesis(t, cdc, dir, storedWasm)
+	stored := writeFile(t, dir, "stored.wasm", storedWasm)
+	unstored := writeFile(t, dir, "unstored.wasm", unstoredWasm)
+
+	tests := []struct {
+		name     string
+		args     []string
+		manifest string
+		wantOut  []string
+		wantErr  string
+	}{
+		{
+			name:    "matching build",
+			args:    []string{stored, "--hash", storedHash},
+			wantOut: []string{fmt.Sprintf("VERIFIED %s %s", storedHash, stored)},
+		},
+		{
+			name:    "build does not match the expected hash",
+			args:    []string{unstored, "--hash", storedHash},
+			wantOut: []string{fmt.Sprintf("FAILED   %s %s: local build hashes to %s", storedHash, unstored, unstoredHash)},
+			wantErr: "1 of 1 oracle programs could not be verified",
+		},
+		{
+			name:    "expected program is not stored",
+			args:    []string{unstored, "--hash", unstoredHash},
+			wantOut: []string{fmt.Sprintf("FAILED   %s %s: oracle program %s is not in the genesis file", unstoredHash, unstored, unstoredHash)},
+			wantErr: "1 of 1 oracle programs could not be verified",
+		},
+		{
+			name:     "directory with manifest",
+			manifest: fmt.Sprintf("# expected hashes\n%s  stored.wasm\n%s  unstored.wasm\n", storedHash, unstoredHash),
+			wantOut: []string{
+				fmt.Sprintf("VERIFIED %s %s", storedHash, stored),
+				fmt.Sprintf("FAILED   %s %s: oracle program %s is not in the genesis file", unstoredHash, unstored, unstoredHash),
+			},
+			wantErr: "1 of 2 oracle programs could not be verified",
+		},
+		{
+			name:     "file missing from manifest",
+			manifest: fmt.Sprintf("%s  stored.wasm\n", storedHash),
+			wantOut:  []string{fmt.Sprintf("FAILED    %s: no expected hash", unstored)},
+			wantErr:  "1 of 2 oracle programs could not be verified",
+		},
+		{
+			name:    "expected hash is required",
+			args:    []string{stored},
+			wantErr: "the expected on-chain hash must be given",
+		},
+		{
+			name:    "hash flag cannot be used with a directory",
+			args:    []string{dir, "--hash", storedHash},
+			wantErr: "can only be used to verify a single file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := tt.args
+			if tt.manifest != "" {
+				manifest := writeFile(t, t.TempDir(), "manifest.txt", []byte(tt.manifest))
+				args = []string{dir, "--manifest", manifest}
+			}
+
+			var out bytes.Buffer
+			cmd := GetCmdVerifyOraclePrograms()
+			cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &client.Context{Codec: cdc}))
+			cmd.SetOut(&out)
+			cmd.SetErr(&out)
+			cmd.SetArgs(append(args, "--genesis", genesisFile))
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, line := range tt.wantOut {
+				require.Contains(t, out.String(), line)
+			}
+		})
+	}
+}
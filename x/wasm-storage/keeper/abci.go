@@ -0,0 +1,12 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) EndBlock(ctx sdk.Context) error {
+	if err := k.PruneExpiredOraclePrograms(ctx); err != nil {
+		return err
+	}
+	return k.ExpireUploadSessions(ctx)
+}
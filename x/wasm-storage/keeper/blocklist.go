@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+// GetAllBlockedOraclePrograms returns all oracle programs blocked by
+// governance.
+func (k Keeper) GetAllBlockedOraclePrograms(ctx context.Context) ([]types.BlockedOracleProgram, error) {
+	iter, err := k.BlockedOracleProgram.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+func (s *KeeperTestSuite) TestBlockOracleProgram() {
+	s.SetupTest()
+
+	program := types.NewOracleProgram([]byte("blocked program"), s.ctx.BlockTime())
+	s.Require().NoError(s.keeper.SetOracleProgram(s.ctx, program))
+	hash := hex.EncodeToString(program.Hash)
+	unstoredHash := hex.EncodeToString(crypto.Keccak256([]byte("unstored program")))
+
+	s.Run("invalid authority", func() {
+		_, err := s.msgSrvr.BlockOracleProgram(s.ctx, &types.MsgBlockOracleProgram{
+			Authority: testAddrs[0].String(),
+			Hash:      hash,
+			Reason:    "malicious",
+		})
+		s.Require().ErrorIs(err, types.ErrInvalidAuthority)
+	})
+
+	s.Run("reason is required", func() {
+		_, err := s.msgSrvr.BlockOracleProgram(s.ctx, &types.MsgBlockOracleProgram{
+			Authority: s.authority,
+			Hash:      hash,
+		})
+		s.Require().ErrorContains(err, "reason is required")
+	})
+
+	s.Run("hash must be 32 bytes", func() {
+		_, err := s.msgSrvr.BlockOracleProgram(s.ctx, &types.MsgBlockOracleProgram{
+			Authority: s.authority,
+			Hash:      hash[:32],
+			Reason:    "malicious",
+		})
+		s.Require().ErrorIs(err, types.ErrInvalidHexWasmHash)
+	})
+
+	s.Run("blocked programs cannot be retrieved", func() {
+		ctx := s.ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+		_, err := s.msgSrvr.BlockOracleProgram(ctx, &types.MsgBlockOracleProgram{
+			Authority: s.authority,
+			Hash:      hash,
+			Reason:    "malicious",
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(types.EventTypeBlockOracleProgram, ctx.EventManager().Events()[0].Type)
+
+		_, err = s.keeper.GetOracleProgram(s.ctx, hash)
+		s.Require().ErrorIs(err, types.ErrOracleProgramBlocked)
+		s.Require().ErrorContains(err, "malicious")
+
+		_, err = s.queryClient.OracleProgram(s.ctx, &types.QueryOracleProgramRequest{Hash: hash})
+		s.Require().ErrorContains(err, types.ErrOracleProgramBlocked.Error())
+
+		// Blocked programs can still be queried for auditing.
+		res, err := s.queryClient.OracleProgram(s.ctx, &types.QueryOracleProgramRequest{Hash: hash, IncludeBlocked: true})
+		s.Require().NoError(err)
+		s.Require().Equal(program.Bytecode, res.OracleProgram.Bytecode)
+
+		exists, err := s.queryClient.OracleProgramExists(s.ctx, &types.QueryOracleProgramExistsRequest{Hash: hash})
+		s.Require().NoError(err)
+		s.Require().True(exists.Exists)
+		s.Require().True(exists.Blocked)
+	})
+
+	s.Run("hashes of programs not yet stored can be blocked", func() {
+		_, err := s.msgSrvr.BlockOracleProgram(s.ctx, &types.MsgBlockOracleProgram{
+			Authority: s.authority,
+			Hash:      unstoredHash,
+			Reason:    "preemptive",
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("blocklist is queryable", func() {
+		res, err := s.queryClient.BlockedOraclePrograms(s.ctx, &types.QueryBlockedOracleProgramsRequest{})
+		s.Require().NoError(err)
+		s.Require().ElementsMatch([]types.BlockedOracleProgram{
+			{Hash: hash, Reason: "malicious", BlockedHeight: 10},
+			{Hash: unstoredHash, Reason: "preemptive", BlockedHeight: s.ctx.BlockHeight()},
+		}, res.BlockedOraclePrograms)
+	})
+
+	s.Run("unblocked programs can be retrieved again", func() {
+		_, err := s.msgSrvr.UnblockOracleProgram(s.ctx, &types.MsgUnblockOracleProgram{
+			Authority: s.authority,
+			Hash:      hash,
+		})
+		s.Require().NoError(err)
+
+		res, err := s.keeper.GetOracleProgram(s.ctx, hash)
+		s.Require().NoError(err)
+		s.Require().Equal(program.Hash, res.Hash)
+
+		_, err = s.msgSrvr.UnblockOracleProgram(s.ctx, &types.MsgUnblockOracleProgram{
+			Authority: s.authority,
+			Hash:      hash,
+		})
+		s.Require().ErrorIs(err, types.ErrOracleProgramNotBlocked)
+	})
+}
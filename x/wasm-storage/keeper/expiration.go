@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+// SetOracleProgram stores an oracle program, indexes it by the time it
+// was added and, if it expires, adds it to the expiration queue.
+func (k Keeper) SetOracleProgram(ctx context.Context, program types.OracleProgram) error {
+	if err := k.OracleProgram.Set(ctx, program.Hash, program); err != nil {
+		return err
+	}
+	if err := k.OracleProgramAddedAt.Set(ctx, collections.Join(program.AddedAt, program.Hash)); err != nil {
+		return err
+	}
+	if program.ExpirationHeight == 0 {
+		return nil
+	}
+	return k.OracleProgramExpiration.Set(ctx, collections.Join(program.ExpirationHeight, program.Hash))
+}
+
+// setOracleProgramExpiration moves an oracle program to the given
+// expiration height in the expiration queue.
+func (k Keeper) setOracleProgramExpiration(ctx context.Context, program types.OracleProgram, expirationHeight int64) error {
+	err := k.OracleProgramExpiration.Remove(ctx, collections.Join(program.ExpirationHeight, program.Hash))
+	if err != nil {
+		return err
+	}
+	program.ExpirationHeight = expirationHeight
+	return k.SetOracleProgram(ctx, program)
+}
+
+// ExtendOracleProgramExpiration extends the expiration of an oracle program
+// given its hex-encoded hash to the program TTL from the current height. It
+// is called when the program is used. Programs that do not expire are left
+// untouched.
+func (k Keeper) ExtendOracleProgramExpiration(ctx context.Context, hash string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.ProgramTTL == 0 {
+		return nil
+	}
+
+	program, err := k.getOracleProgram(ctx, hash)
+	if err != nil {
+		return err
+	}
+	expirationHeight := sdk.UnwrapSDKContext(ctx).BlockHeight() + params.ProgramTTL
+	if program.ExpirationHeight == 0 || program.ExpirationHeight >= expirationHeight {
+		return nil
+	}
+	return k.setOracleProgramExpiration(ctx, program, expirationHeight)
+}
+
+// PruneExpiredOraclePrograms removes up to MaxPrunesPerBlock oracle programs
+// whose expiration height has been passed by more than the grace period.
+// The remaining expired programs are pruned in subsequent blocks.
+func (k Keeper) PruneExpiredOraclePrograms(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.ProgramTTL == 0 {
+		return nil
+	}
+
+	expired, err := k.getExpiredOraclePrograms(ctx, ctx.BlockHeight()-params.ProgramGracePeriod, int(params.MaxPrunesPerBlock))
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		program, err := k.OracleProgram.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if err := k.OracleProgram.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+		if err := k.OracleProgramAddedAt.Remove(ctx, collections.Join(program.AddedAt, program.Hash)); err != nil {
+			return err
+		}
+		if err := k.OracleProgramExpiration.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := k.OracleProgramStats.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypePruneOracleProgram,
+			sdk.NewAttribute(types.AttributeOracleProgramHash, hex.EncodeToString(key.K2())),
+			sdk.NewAttribute(types.AttributeExpirationHeight, strconv.FormatInt(key.K1(), 10)),
+		))
+	}
+	return nil
+}
+
+// getExpiredOraclePrograms returns up to limit expiration queue entries of
+// oracle programs expiring at or before the given height.
+func (k Keeper) getExpiredOraclePrograms(ctx context.Context, height int64, limit int) ([]collections.Pair[int64, []byte], error) {
+	rng := collections.NewPrefixUntilPairRange[int64, []byte](height)
+	iter, err := k.OracleProgramExpiration.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var expired []collections.Pair[int64, []byte]
+	for ; iter.Valid() && len(expired) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		expired = append(expired, key)
+	}
+	return expired, nil
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	appparams "github.com/sedaprotocol/seda-chain/app/params"
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+func (s *KeeperTestSuite) TestOracleProgramExpiration() {
+	s.SetupTest()
+	s.ctx = s.ctx.WithBlockHeight(100)
+
+	params := types.DefaultParams()
+	params.ProgramTTL = 1000
+	params.RentPerBytePerBlock = 10
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	wasm := testOracleProgram(1, []byte{0x0b})
+	zipped, err := ioutils.GzipIt(wasm)
+	s.Require().NoError(err)
+	rentPerBlock := int64(len(wasm)) * 10
+
+	s.Run("Stored programs expire after the program TTL", func() {
+		s.ApplyDefaultMockExpectations()
+		size := max(len(wasm), len(zipped))
+		res, err := s.msgSrvr.StoreOracleProgram(s.ctx, &types.MsgStoreOracleProgram{
+			Sender:     s.authority,
+			Wasm:       zipped,
+			StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(size)).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte))))),
+		})
+		s.Require().NoError(err)
+
+		program, err := s.keeper.GetOracleProgram(s.ctx, res.Hash)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(1100), program.ExpirationHeight)
+	})
+
+	hash := hex.EncodeToString(types.NewOracleProgram(wasm, s.ctx.BlockTime()).Hash)
+
+	s.Run("Using a program extends its expiration", func() {
+		s.Require().NoError(s.keeper.ExtendOracleProgramExpiration(s.ctx.WithBlockHeight(500), hash))
+		s.requireExpiration(hash, 1500)
+
+		// The expiration is never shortened.
+		s.Require().NoError(s.keeper.ExtendOracleProgramExpiration(s.ctx.WithBlockHeight(200), hash))
+		s.requireExpiration(hash, 1500)
+	})
+
+	s.Run("Topping up a program charges rent for whole blocks", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		res, err := s.msgSrvr.TopUpOracleProgram(ctx, &types.MsgTopUpOracleProgram{
+			Sender: s.authority,
+			Hash:   hash,
+			Rent:   sdk.NewCoins(sdk.NewInt64Coin(appparams.DefaultBondDenom, 3*rentPerBlock+1)),
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(int64(1503), res.ExpirationHeight)
+		s.requireExpiration(hash, 1503)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeTopUpOracleProgram, events[0].Type)
+		rent, found := events[0].GetAttribute(types.AttributeRent)
+		s.Require().True(found)
+		s.Require().Equal(sdk.NewInt64Coin(appparams.DefaultBondDenom, 3*rentPerBlock).String(), rent.Value)
+	})
+
+	s.Run("Rent must cover a block", func() {
+		_, err := s.msgSrvr.TopUpOracleProgram(s.ctx, &types.MsgTopUpOracleProgram{
+			Sender: s.authority,
+			Hash:   hash,
+			Rent:   sdk.NewCoins(sdk.NewInt64Coin(appparams.DefaultBondDenom, rentPerBlock-1)),
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	})
+
+	s.Run("Programs without expiration cannot be topped up", func() {
+		program := types.NewOracleProgram([]byte("no expiration"), s.ctx.BlockTime())
+		s.Require().NoError(s.keeper.SetOracleProgram(s.ctx, program))
+
+		_, err := s.msgSrvr.TopUpOracleProgram(s.ctx, &types.MsgTopUpOracleProgram{
+			Sender: s.authority,
+			Hash:   hex.EncodeToString(program.Hash),
+			Rent:   sdk.NewCoins(sdk.NewInt64Coin(appparams.DefaultBondDenom, rentPerBlock)),
+		})
+		s.Require().ErrorIs(err, types.ErrProgramDoesNotExpire)
+	})
+
+	s.Run("Rent cannot be paid when expiration is disabled", func() {
+		disabled := params
+		disabled.ProgramTTL = 0
+		s.Require().NoError(s.keeper.Params.Set(s.ctx, disabled))
+		defer func() { s.Require().NoError(s.keeper.Params.Set(s.ctx, params)) }()
+
+		_, err := s.msgSrvr.TopUpOracleProgram(s.ctx, &types.MsgTopUpOracleProgram{
+			Sender: s.authority,
+			Hash:   hash,
+			Rent:   sdk.NewCoins(sdk.NewInt64Coin(appparams.DefaultBondDenom, rentPerBlock)),
+		})
+		s.Require().ErrorIs(err, types.ErrRentDisabled)
+	})
+}
+
+func (s *KeeperTestSuite) TestPruneExpiredOraclePrograms() {
+	s.SetupTest()
+
+	params := types.DefaultParams()
+	params.ProgramTTL = 1000
+	params.MaxPrunesPerBlock = 2
+	params.ProgramGracePeriod = 0
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	var hashes [][]byte
+	for i, expirationHeight := range []int64{10, 10, 10, 20, 0} {
+		program := types.NewOracleProgram([]byte(fmt.Sprintf("program%d", i)), s.ctx.BlockTime())
+		program.ExpirationHeight = expirationHeight
+		s.Require().NoError(s.keeper.SetOracleProgram(s.ctx, program))
+		hashes = append(hashes, program.Hash)
+	}
+
+	// Pruning is bounded by the maximum number of prunes per block.
+	ctx := s.ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.EndBlock(ctx))
+	s.Require().Len(ctx.EventManager().Events(), 2)
+	s.Require().Equal(types.EventTypePruneOracleProgram, ctx.EventManager().Events()[0].Type)
+	s.Require().Equal(3, s.countOraclePrograms())
+
+	// The remaining expired programs are pruned in the next block.
+	ctx = s.ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.EndBlock(ctx))
+	s.Require().Len(ctx.EventManager().Events(), 1)
+	s.Require().Equal(2, s.countOraclePrograms())
+
+	// Programs are not pruned while expiration is disabled.
+	params.ProgramTTL = 0
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.keeper.EndBlock(s.ctx.WithBlockHeight(20)))
+	s.Require().Equal(2, s.countOraclePrograms())
+
+	params.ProgramTTL = 1000
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.keeper.EndBlock(s.ctx.WithBlockHeight(20)))
+	s.Require().Equal(1, s.countOraclePrograms())
+
+	// Programs without expiration height are never pruned.
+	has, err := s.keeper.OracleProgram.Has(s.ctx, hashes[4])
+	s.Require().NoError(err)
+	s.Require().True(has)
+	has, err = s.keeper.OracleProgramExpiration.Has(s.ctx, collections.Join(int64(20), hashes[3]))
+	s.Require().NoError(err)
+	s.Require().False(has)
+	has, err = s.keeper.OracleProgramAddedAt.Has(s.ctx, collections.Join(s.ctx.BlockTime(), hashes[3]))
+	s.Require().NoError(err)
+	s.Require().False(has)
+	has, err = s.keeper.OracleProgramAddedAt.Has(s.ctx, collections.Join(s.ctx.BlockTime(), hashes[4]))
+	s.Require().NoError(err)
+	s.Require().True(has)
+}
+
+func (s *KeeperTestSuite) TestPruneGracePeriod() {
+	s.SetupTest()
+
+	params := types.DefaultParams()
+	params.ProgramTTL = 1000
+	params.ProgramGracePeriod = 50
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	program := types.NewOracleProgram([]byte("program"), s.ctx.BlockTime())
+	program.ExpirationHeight = 10
+	s.Require().NoError(s.keeper.SetOracleProgram(s.ctx, program))
+	hash := hex.EncodeToString(program.Hash)
+
+	// Expired programs are kept during the grace period.
+	s.Require().NoError(s.keeper.EndBlock(s.ctx.WithBlockHeight(59)))
+	s.Require().Equal(1, s.countOraclePrograms())
+
+	// Using a program during the grace period extends its expiration.
+	s.Require().NoError(s.keeper.ExtendOracleProgramExpiration(s.ctx.WithBlockHeight(59), hash))
+	s.requireExpiration(hash, 1059)
+	s.Require().NoError(s.keeper.EndBlock(s.ctx.WithBlockHeight(60)))
+	s.Require().Equal(1, s.countOraclePrograms())
+
+	// Programs are pruned once the grace period has passed.
+	s.Require().NoError(s.keeper.EndBlock(s.ctx.WithBlockHeight(1108)))
+	s.Require().Equal(1, s.countOraclePrograms())
+	s.Require().NoError(s.keeper.EndBlock(s.ctx.WithBlockHeight(1109)))
+	s.Require().Equal(0, s.countOraclePrograms())
+}
+
+func (s *KeeperTestSuite) requireExpiration(hash string, expirationHeight int64) {
+	program, err := s.keeper.GetOracleProgram(s.ctx, hash)
+	s.Require().NoError(err)
+	s.Require().Equal(expirationHeight, program.ExpirationHeight)
+
+	has, err := s.keeper.OracleProgramExpiration.Has(s.ctx, collections.Join(expirationHeight, program.Hash))
+	s.Require().NoError(err)
+	s.Require().True(has)
+}
+
+func (s *KeeperTestSuite) countOraclePrograms() int {
+	return len(s.keeper.ListOraclePrograms(s.ctx))
+}
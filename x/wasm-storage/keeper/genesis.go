@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"errors"
 
 	"cosmossdk.io/collections"
@@ -19,8 +20,44 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		panic(err)
 	}
 
+	for _, entry := range data.CoreContractHistory {
+		if err := k.CoreContractHistory.Set(ctx, entry.ActivationHeight, entry); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, blocked := range data.BlockedOraclePrograms {
+		hash, err := hex.DecodeString(blocked.Hash)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.BlockedOracleProgram.Set(ctx, hash, blocked); err != nil {
+			panic(err)
+		}
+	}
+
 	for _, program := range data.OraclePrograms {
-		if err := k.OracleProgram.Set(ctx, program.Hash, program); err != nil {
+		if err := k.SetOracleProgram(ctx, program); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, session := range data.UploadSessions {
+		if err := k.SetUploadSession(ctx, session); err != nil {
+			panic(err)
+		}
+	}
+	for _, chunk := range data.UploadChunks {
+		if err := k.UploadChunk.Set(ctx, collections.Join(chunk.UploadID, chunk.Index), chunk.Data); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.UploadSessionID.Set(ctx, data.NextUploadID); err != nil {
+		panic(err)
+	}
+
+	for _, stats := range data.OracleProgramStats {
+		if err := k.OracleProgramStats.Set(ctx, stats.Hash, stats); err != nil {
 			panic(err)
 		}
 	}
@@ -33,12 +70,62 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 		panic(err)
 	}
 	programs := k.GetAllOraclePrograms(ctx)
+	history, err := k.GetCoreContractHistory(ctx)
+	if err != nil {
+		panic(err)
+	}
+	blocked, err := k.GetAllBlockedOraclePrograms(ctx)
+	if err != nil {
+		panic(err)
+	}
+	var coreAddr string
 	core, err := k.GetCoreContractAddr(ctx)
+	if err == nil {
+		coreAddr = core.String()
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
+	gs := types.NewGenesisState(params, programs, coreAddr, history, blocked)
+	gs.UploadSessions, gs.UploadChunks, gs.NextUploadID, err = k.exportUploads(ctx)
 	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return types.NewGenesisState(params, programs, "")
-		}
 		panic(err)
 	}
-	return types.NewGenesisState(params, programs, core.String())
+	gs.OracleProgramStats, err = k.GetAllOracleProgramStats(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return gs
+}
+
+// exportUploads returns the chunked upload sessions, their chunks, and
+// the next upload session ID.
+func (k Keeper) exportUploads(ctx sdk.Context) ([]types.UploadSession, []types.UploadChunk, uint64, error) {
+	sessionIter, err := k.UploadSession.Iterate(ctx, nil)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	sessions, err := sessionIter.Values()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	chunkIter, err := k.UploadChunk.Iterate(ctx, nil)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	kvs, err := chunkIter.KeyValues()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	chunks := make([]types.UploadChunk, len(kvs))
+	for i, kv := range kvs {
+		chunks[i] = types.UploadChunk{UploadID: kv.Key.K1(), Index: kv.Key.K2(), Data: kv.Value}
+	}
+
+	nextID, err := k.UploadSessionID.Peek(ctx)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return sessions, chunks, nextID, nil
 }
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
+
 	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
 
@@ -23,11 +25,32 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	s.keeper.CoreContractRegistry.Set(s.ctx, coreContractAddr)
 	expectedState.CoreContractRegistry = coreContractAddr
 
+	entry := types.CoreContractRegistryEntry{Address: coreContractAddr, CodeID: 1, ActivationHeight: 5}
+	s.keeper.CoreContractHistory.Set(s.ctx, entry.ActivationHeight, entry)
+	expectedState.CoreContractHistory = []types.CoreContractRegistryEntry{entry}
+
+	blocked := types.BlockedOracleProgram{Hash: "abcd", Reason: "malicious", BlockedHeight: 5}
+	s.keeper.BlockedOracleProgram.Set(s.ctx, []byte{0xab, 0xcd}, blocked)
+	expectedState.BlockedOraclePrograms = []types.BlockedOracleProgram{blocked}
+
+	uploadID, err := s.keeper.UploadSessionID.Next(s.ctx)
+	require.NoError(s.T(), err)
+	session := types.UploadSession{ID: uploadID, Sender: coreContractAddr, Hash: []byte{0x01}, UnzippedSize: 10, ChunkCount: 1, ReceivedBytes: 3, ExpirationHeight: 10}
+	require.NoError(s.T(), s.keeper.SetUploadSession(s.ctx, session))
+	require.NoError(s.T(), s.keeper.UploadChunk.Set(s.ctx, collections.Join(uploadID, uint32(0)), []byte("abc")))
+	expectedState.UploadSessions = []types.UploadSession{session}
+	expectedState.UploadChunks = []types.UploadChunk{{UploadID: uploadID, Index: 0, Data: []byte("abc")}}
+	expectedState.NextUploadID = uploadID + 1
+
+	stats := types.OracleProgramStats{Hash: expectedState.OraclePrograms[0].Hash, TallyInvocations: 2, LastUsedHeight: 7, TallyGasUsed: 100, TallyExitCodes: []types.ExitCodeCount{{ExitCode: 0, Count: 2}}}
+	require.NoError(s.T(), s.keeper.OracleProgramStats.Set(s.ctx, stats.Hash, stats))
+	expectedState.OracleProgramStats = []types.OracleProgramStats{stats}
+
 	params := types.Params{
 		MaxWasmSize:     512 * 1024,
 		WasmCostPerByte: 90000000000000,
 	}
-	err := s.keeper.Params.Set(s.ctx, params)
+	err = s.keeper.Params.Set(s.ctx, params)
 	require.NoError(s.T(), err)
 	expectedState.Params = params
 
@@ -39,6 +62,12 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 
 	require.ElementsMatch(s.T(), expectedState.OraclePrograms, exportedState.OraclePrograms)
 	require.Equal(s.T(), expectedState.CoreContractRegistry, exportedState.CoreContractRegistry)
+	require.Equal(s.T(), expectedState.CoreContractHistory, exportedState.CoreContractHistory)
+	require.Equal(s.T(), expectedState.BlockedOraclePrograms, exportedState.BlockedOraclePrograms)
+	require.Equal(s.T(), expectedState.UploadSessions, exportedState.UploadSessions)
+	require.Equal(s.T(), expectedState.UploadChunks, exportedState.UploadChunks)
+	require.Equal(s.T(), expectedState.NextUploadID, exportedState.NextUploadID)
+	require.Equal(s.T(), expectedState.OracleProgramStats, exportedState.OracleProgramStats)
 	require.Equal(s.T(), expectedState.Params, exportedState.Params)
 
 	s.keeper.InitGenesis(s.ctx, exportedState)
@@ -50,5 +79,11 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 
 	require.ElementsMatch(s.T(), exportedState.OraclePrograms, importedState.OraclePrograms)
 	require.Equal(s.T(), exportedState.CoreContractRegistry, importedState.CoreContractRegistry)
+	require.Equal(s.T(), exportedState.CoreContractHistory, importedState.CoreContractHistory)
+	require.Equal(s.T(), exportedState.BlockedOraclePrograms, importedState.BlockedOraclePrograms)
+	require.Equal(s.T(), exportedState.UploadSessions, importedState.UploadSessions)
+	require.Equal(s.T(), exportedState.UploadChunks, importedState.UploadChunks)
+	require.Equal(s.T(), exportedState.NextUploadID, importedState.NextUploadID)
+	require.Equal(s.T(), exportedState.OracleProgramStats, importedState.OracleProgramStats)
 	require.Equal(s.T(), exportedState.Params, importedState.Params)
 }
@@ -3,7 +3,10 @@ package keeper
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
@@ -33,6 +36,31 @@ type Keeper struct {
 	OracleProgram        collections.Map[[]byte, types.OracleProgram]
 	CoreContractRegistry collections.Item[string]
 	Params               collections.Item[types.Params]
+	// OracleProgramExpiration is the queue of oracle programs that expire,
+	// keyed by expiration height and program hash.
+	OracleProgramExpiration collections.KeySet[collections.Pair[int64, []byte]]
+	// CoreContractHistory records the Core Contract Registry entries
+	// keyed by activation height.
+	CoreContractHistory collections.Map[int64, types.CoreContractRegistryEntry]
+	// BlockedOracleProgram is the governance-controlled blocklist of
+	// oracle programs keyed by program hash.
+	BlockedOracleProgram collections.Map[[]byte, types.BlockedOracleProgram]
+	// UploadSession stores the chunked upload sessions keyed by ID.
+	UploadSession collections.Map[uint64, types.UploadSession]
+	// UploadChunk stores the chunks of chunked upload sessions keyed by
+	// upload session ID and chunk index.
+	UploadChunk collections.Map[collections.Pair[uint64, uint32], []byte]
+	// UploadSessionExpiration is the queue of chunked upload sessions
+	// keyed by expiration height and upload session ID.
+	UploadSessionExpiration collections.KeySet[collections.Pair[int64, uint64]]
+	// UploadSessionID is the sequence of chunked upload session IDs.
+	UploadSessionID collections.Sequence
+	// OracleProgramStats stores the usage statistics of oracle programs
+	// keyed by program hash.
+	OracleProgramStats collections.Map[[]byte, types.OracleProgramStats]
+	// OracleProgramAddedAt indexes the oracle programs by the time they
+	// were added and program hash.
+	OracleProgramAddedAt collections.KeySet[collections.Pair[time.Time, []byte]]
 }
 
 func NewKeeper(
@@ -57,6 +85,19 @@ func NewKeeper(
 		OracleProgram:        collections.NewMap(sb, types.OracleProgramPrefix, "oracle_program", collections.BytesKey, codec.CollValue[types.OracleProgram](cdc)),
 		CoreContractRegistry: collections.NewItem(sb, types.CoreContractRegistryPrefix, "core_contract_registry", collections.StringValue),
 		Params:               collections.NewItem(sb, types.ParamsPrefix, "params", codec.CollValue[types.Params](cdc)),
+		OracleProgramExpiration: collections.NewKeySet(sb, types.OracleProgramExpirationPrefix, "oracle_program_expiration",
+			collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		CoreContractHistory:  collections.NewMap(sb, types.CoreContractHistoryPrefix, "core_contract_history", collections.Int64Key, codec.CollValue[types.CoreContractRegistryEntry](cdc)),
+		BlockedOracleProgram: collections.NewMap(sb, types.BlockedOracleProgramPrefix, "blocked_oracle_program", collections.BytesKey, codec.CollValue[types.BlockedOracleProgram](cdc)),
+		UploadSession:        collections.NewMap(sb, types.UploadSessionPrefix, "upload_session", collections.Uint64Key, codec.CollValue[types.UploadSession](cdc)),
+		UploadChunk: collections.NewMap(sb, types.UploadChunkPrefix, "upload_chunk",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), collections.BytesValue),
+		UploadSessionExpiration: collections.NewKeySet(sb, types.UploadSessionExpirationPrefix, "upload_session_expiration",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		UploadSessionID:    collections.NewSequence(sb, types.UploadSessionIDPrefix, "upload_session_id"),
+		OracleProgramStats: collections.NewMap(sb, types.OracleProgramStatsPrefix, "oracle_program_stats", collections.BytesKey, codec.CollValue[types.OracleProgramStats](cdc)),
+		OracleProgramAddedAt: collections.NewKeySet(sb, types.OracleProgramAddedAtPrefix, "oracle_program_added_at",
+			collections.PairKeyCodec(sdk.TimeKey, collections.BytesKey)),
 	}
 
 	schema, err := sb.Build()
@@ -88,9 +129,93 @@ func (k Keeper) GetCoreContractAddr(ctx context.Context) (sdk.AccAddress, error)
 	return contractAddr, nil
 }
 
+// RegisterCoreContract sets the Core Contract Registry to the given
+// address and records the entry in the registry history with the
+// current block height as its activation height. Since the history is
+// keyed by activation height, the Core Contract can be registered at
+// most once per block.
+func (k Keeper) RegisterCoreContract(ctx sdk.Context, contractAddr sdk.AccAddress, codeID uint64) error {
+	registered, err := k.CoreContractHistory.Has(ctx, ctx.BlockHeight())
+	if err != nil {
+		return err
+	}
+	if registered {
+		return types.ErrCoreContractRegistered.Wrapf("height %d", ctx.BlockHeight())
+	}
+
+	err = k.CoreContractRegistry.Set(ctx, contractAddr.String())
+	if err != nil {
+		return err
+	}
+	err = k.CoreContractHistory.Set(ctx, ctx.BlockHeight(), types.CoreContractRegistryEntry{
+		Address:          contractAddr.String(),
+		CodeID:           codeID,
+		ActivationHeight: ctx.BlockHeight(),
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCoreContract,
+			sdk.NewAttribute(types.AttributeContractAddress, contractAddr.String()),
+			sdk.NewAttribute(types.AttributeCodeID, strconv.FormatUint(codeID, 10)),
+			sdk.NewAttribute(types.AttributeActivationHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+	return nil
+}
+
+// SeedCoreContractHistory records the given Core Contract, registered
+// before the registry history was introduced, in the history with the
+// current block height as its activation height. It does nothing if the
+// history already has entries.
+func (k Keeper) SeedCoreContractHistory(ctx sdk.Context, contractAddr sdk.AccAddress, codeID uint64) error {
+	history, err := k.GetCoreContractHistory(ctx)
+	if err != nil {
+		return err
+	}
+	if len(history) > 0 {
+		return nil
+	}
+	return k.CoreContractHistory.Set(ctx, ctx.BlockHeight(), types.CoreContractRegistryEntry{
+		Address:          contractAddr.String(),
+		CodeID:           codeID,
+		ActivationHeight: ctx.BlockHeight(),
+	})
+}
+
+// GetCoreContractHistory returns the Core Contract Registry entries
+// in order of activation height.
+func (k Keeper) GetCoreContractHistory(ctx context.Context) ([]types.CoreContractRegistryEntry, error) {
+	iter, err := k.CoreContractHistory.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
 // GetOracleProgram retrieves the oracle program from the store
-// given its hex-encoded hash.
+// given its hex-encoded hash. It returns ErrOracleProgramBlocked
+// if the program has been blocked by governance.
 func (k Keeper) GetOracleProgram(ctx context.Context, hash string) (types.OracleProgram, error) {
+	hexHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return types.OracleProgram{}, types.ErrInvalidHexWasmHash
+	}
+	blocked, err := k.BlockedOracleProgram.Get(ctx, hexHash)
+	if err == nil {
+		return types.OracleProgram{}, types.ErrOracleProgramBlocked.Wrapf("%s: %s", hash, blocked.Reason)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return types.OracleProgram{}, err
+	}
+	return k.getOracleProgram(ctx, hash)
+}
+
+// getOracleProgram retrieves the oracle program from the store given
+// its hex-encoded hash regardless of whether it is blocked.
+func (k Keeper) getOracleProgram(ctx context.Context, hash string) (types.OracleProgram, error) {
 	hexHash, err := hex.DecodeString(hash)
 	if err != nil {
 		return types.OracleProgram{}, types.ErrInvalidHexWasmHash
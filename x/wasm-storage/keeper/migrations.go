@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the parameters introduced with oracle program
+// expiration and chunked uploads to their defaults and indexes the
+// stored oracle programs by the time they were added.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defaults := types.DefaultParams()
+	if params.RentPerBytePerBlock == 0 {
+		params.RentPerBytePerBlock = defaults.RentPerBytePerBlock
+	}
+	if params.MaxPrunesPerBlock == 0 {
+		params.MaxPrunesPerBlock = defaults.MaxPrunesPerBlock
+	}
+	if params.UploadSessionTTL == 0 {
+		params.UploadSessionTTL = defaults.UploadSessionTTL
+	}
+	if params.ProgramGracePeriod == 0 {
+		params.ProgramGracePeriod = defaults.ProgramGracePeriod
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	iter, err := m.keeper.OracleProgram.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		program, err := iter.Value()
+		if err != nil {
+			return err
+		}
+		if err := m.keeper.OracleProgramAddedAt.Set(ctx, collections.Join(program.AddedAt, program.Hash)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/keeper"
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	s.SetupTest()
+
+	// Parameters stored before oracle program expiration and chunked
+	// uploads were introduced.
+	params := types.DefaultParams()
+	params.RentPerBytePerBlock = 0
+	params.MaxPrunesPerBlock = 0
+	params.UploadSessionTTL = 0
+	params.ProgramGracePeriod = 0
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	var programs []types.OracleProgram
+	for i := 0; i < 3; i++ {
+		program := types.NewOracleProgram([]byte(fmt.Sprintf("program%d", i)), s.ctx.BlockTime())
+		s.Require().NoError(s.keeper.OracleProgram.Set(s.ctx, program.Hash, program))
+		programs = append(programs, program)
+	}
+
+	s.Require().NoError(keeper.NewMigrator(*s.keeper).Migrate1to2(s.ctx))
+
+	migrated, err := s.keeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), migrated)
+
+	for _, program := range programs {
+		has, err := s.keeper.OracleProgramAddedAt.Has(s.ctx, collections.Join(program.AddedAt, program.Hash))
+		s.Require().NoError(err)
+		s.Require().True(has)
+	}
+}
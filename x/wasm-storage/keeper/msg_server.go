@@ -1,12 +1,17 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	gomath "math"
+	"strconv"
 
 	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
@@ -31,8 +36,10 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 var _ types.MsgServer = msgServer{}
 
 // StoreOracleProgram stores an oracle program. It unzips a gzip-
-// compressed wasm and stores it using its hash as the key. If a
-// duplicate wasm already exists, an error is returned.
+// compressed wasm, statically validates it against the requirements
+// of the SEDA VM, and stores it using its hash as the key. If the
+// program is already stored, it is returned without charging the
+// storage fee.
 func (m msgServer) StoreOracleProgram(goCtx context.Context, msg *types.MsgStoreOracleProgram) (*types.MsgStoreOracleProgramResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -40,6 +47,12 @@ func (m msgServer) StoreOracleProgram(goCtx context.Context, msg *types.MsgStore
 		return nil, err
 	}
 
+	// A program that is already stored can be referenced by its hash
+	// without uploading the wasm or paying the storage fee again.
+	if msg.Hash != "" {
+		return m.existingOracleProgram(ctx, msg.Hash)
+	}
+
 	params, err := m.Params.Get(ctx)
 	if err != nil {
 		return nil, err
@@ -59,41 +72,335 @@ func (m msgServer) StoreOracleProgram(goCtx context.Context, msg *types.MsgStore
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "WASM file is too large")
 	}
 
+	unzipped, err := unzipWasm(msg.Wasm, paidStorage.Int64())
+	if err != nil {
+		return nil, err
+	}
+	hash := types.NewOracleProgram(unzipped, ctx.BlockTime()).Hash
+	if exists, _ := m.OracleProgram.Has(ctx, hash); exists {
+		return m.existingOracleProgram(ctx, hex.EncodeToString(hash))
+	}
+
 	senderAddress, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
 	}
-
 	err = m.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddress, authtypes.FeeCollectorName, msg.StorageFee)
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
 	}
 
-	unzipped, err := unzipWasm(msg.Wasm, paidStorage.Int64())
+	program, err := m.storeOracleProgram(ctx, msg.Sender, unzipped, msg.Metadata, params)
 	if err != nil {
 		return nil, err
 	}
 
+	return &types.MsgStoreOracleProgramResponse{
+		Hash:     hex.EncodeToString(program.Hash),
+		Metadata: program.Metadata,
+	}, nil
+}
+
+// existingOracleProgram returns the response for storing an oracle
+// program that is already stored under the given hex-encoded hash.
+func (m msgServer) existingOracleProgram(ctx sdk.Context, hash string) (*types.MsgStoreOracleProgramResponse, error) {
+	program, err := m.GetOracleProgram(ctx, hash)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrOracleProgramNotFound.Wrap(hash)
+		}
+		return nil, err
+	}
+	return &types.MsgStoreOracleProgramResponse{
+		Hash:     hex.EncodeToString(program.Hash),
+		Metadata: program.Metadata,
+	}, nil
+}
+
+// storeOracleProgram statically validates an unzipped oracle program
+// and stores it unless a program with the same hash already exists.
+func (m msgServer) storeOracleProgram(ctx sdk.Context, sender string, unzipped []byte, metadata types.OracleProgramMetadata, params types.Params) (types.OracleProgram, error) {
+	if err := types.ValidateOracleProgramWasm(unzipped); err != nil {
+		return types.OracleProgram{}, err
+	}
+
 	program := types.NewOracleProgram(unzipped, ctx.BlockTime())
+	program.Metadata = metadata
+	if params.ProgramTTL > 0 {
+		program.ExpirationHeight = ctx.BlockHeight() + params.ProgramTTL
+	}
 	if exists, _ := m.OracleProgram.Has(ctx, program.Hash); exists {
+		return types.OracleProgram{}, types.ErrWasmAlreadyExists
+	}
+
+	if err := m.SetOracleProgram(ctx, program); err != nil {
+		return types.OracleProgram{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStoreOracleProgram,
+			sdk.NewAttribute(types.AttributeSender, sender),
+			sdk.NewAttribute(types.AttributeOracleProgramHash, hex.EncodeToString(program.Hash)),
+		),
+	)
+	return program, nil
+}
+
+// BeginOracleProgramUpload begins a chunked upload of an oracle program
+// whose gzip-compressed wasm does not fit in a single transaction. The
+// storage fee for the declared size is held in escrow until the upload
+// is finalized or the session expires.
+func (m msgServer) BeginOracleProgramUpload(goCtx context.Context, msg *types.MsgBeginOracleProgramUpload) (*types.MsgBeginOracleProgramUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	params, err := m.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.UploadSessionTTL == 0 {
+		return nil, types.ErrChunkedUploadDisabled
+	}
+	if msg.UnzippedSize > uint64(params.MaxWasmSize) {
+		return nil, types.ErrWasmTooLarge.Wrapf("%d > %d", msg.UnzippedSize, params.MaxWasmSize)
+	}
+	if msg.ZippedSize > uint64(params.MaxWasmSize) {
+		return nil, types.ErrWasmTooLarge.Wrapf("zipped size %d > %d", msg.ZippedSize, params.MaxWasmSize)
+	}
+
+	hash, err := hex.DecodeString(msg.Hash)
+	if err != nil {
+		return nil, types.ErrInvalidHexWasmHash
+	}
+	if exists, _ := m.OracleProgram.Has(ctx, hash); exists {
 		return nil, types.ErrWasmAlreadyExists
 	}
 
-	if err := m.OracleProgram.Set(ctx, program.Hash, program); err != nil {
+	denom, err := m.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requiredFee := math.NewIntFromUint64(msg.UnzippedSize).Mul(math.NewIntFromUint64(params.WasmCostPerByte))
+	if msg.StorageFee.AmountOf(denom).LT(requiredFee) {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf("storage fee must be at least %s%s", requiredFee, denom)
+	}
+
+	session := types.UploadSession{
+		Sender:           msg.Sender,
+		Hash:             hash,
+		UnzippedSize:     msg.UnzippedSize,
+		ZippedSize:       msg.ZippedSize,
+		StorageFee:       msg.StorageFee,
+		ExpirationHeight: ctx.BlockHeight() + params.UploadSessionTTL,
+		Metadata:         msg.Metadata,
+	}
+
+	senderAddress, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	err = m.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddress, types.ModuleName, msg.StorageFee)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	id, err := m.UploadSessionID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session.ID = id
+	if err := m.SetUploadSession(ctx, session); err != nil {
 		return nil, err
 	}
 
-	hashHex := hex.EncodeToString(program.Hash)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			types.EventTypeStoreOracleProgram,
+			types.EventTypeBeginUpload,
+			sdk.NewAttribute(types.AttributeUploadID, strconv.FormatUint(id, 10)),
 			sdk.NewAttribute(types.AttributeSender, msg.Sender),
-			sdk.NewAttribute(types.AttributeOracleProgramHash, hashHex),
+			sdk.NewAttribute(types.AttributeOracleProgramHash, msg.Hash),
+			sdk.NewAttribute(types.AttributeExpirationHeight, strconv.FormatInt(session.ExpirationHeight, 10)),
 		),
 	)
 
-	return &types.MsgStoreOracleProgramResponse{
-		Hash: hashHex,
+	return &types.MsgBeginOracleProgramUploadResponse{
+		UploadID:         id,
+		ExpirationHeight: session.ExpirationHeight,
+	}, nil
+}
+
+// AppendOracleProgramChunk appends the next chunk of the gzip-compressed
+// wasm to a chunked upload session. The total uploaded size may not
+// exceed the declared size of the gzip-compressed oracle program.
+func (m msgServer) AppendOracleProgramChunk(goCtx context.Context, msg *types.MsgAppendOracleProgramChunk) (*types.MsgAppendOracleProgramChunkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	session, err := m.getUploadSession(ctx, msg.UploadID, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	receivedBytes := session.ReceivedBytes + uint64(len(msg.Chunk))
+	if receivedBytes > session.ZippedSize {
+		return nil, types.ErrUploadTooLarge.Wrapf("%d > %d", receivedBytes, session.ZippedSize)
+	}
+
+	err = m.UploadChunk.Set(ctx, collections.Join(session.ID, session.ChunkCount), msg.Chunk)
+	if err != nil {
+		return nil, err
+	}
+	session.ReceivedBytes = receivedBytes
+	session.ChunkCount++
+	if err := m.UploadSession.Set(ctx, session.ID, session); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAppendOracleProgramChunkResponse{
+		ReceivedBytes: receivedBytes,
+	}, nil
+}
+
+// FinalizeOracleProgramUpload unzips the wasm uploaded in a chunked
+// upload session, verifies it against the declared hash and sizes, and
+// stores it. The escrowed storage fee is then sent to the fee collector,
+// or refunded to the sender if the program has been stored in the
+// meantime.
+func (m msgServer) FinalizeOracleProgramUpload(goCtx context.Context, msg *types.MsgFinalizeOracleProgramUpload) (*types.MsgFinalizeOracleProgramUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	session, err := m.getUploadSession(ctx, msg.UploadID, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if session.ReceivedBytes != session.ZippedSize {
+		return nil, types.ErrUploadMismatch.Wrapf("zipped size %d != %d", session.ReceivedBytes, session.ZippedSize)
+	}
+	params, err := m.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wasm, err := m.getUploadedWasm(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	unzipped, err := unzipWasm(wasm, int64(session.UnzippedSize))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(unzipped)) != session.UnzippedSize {
+		return nil, types.ErrUploadMismatch.Wrapf("size %d != %d", len(unzipped), session.UnzippedSize)
+	}
+	hash := types.NewOracleProgram(unzipped, ctx.BlockTime()).Hash
+	if !bytes.Equal(hash, session.Hash) {
+		return nil, types.ErrUploadMismatch.Wrapf("hash %x != %x", hash, session.Hash)
+	}
+
+	// If the program was stored by someone else while the session was
+	// open, the session is closed and the escrowed fee is refunded.
+	if exists, _ := m.OracleProgram.Has(ctx, hash); exists {
+		if err := m.refundUploadSession(ctx, session); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := m.storeOracleProgram(ctx, session.Sender, unzipped, session.Metadata, params); err != nil {
+			return nil, err
+		}
+		err = m.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, session.StorageFee)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := m.removeUploadSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFinalizeOracleProgramUploadResponse{
+		Hash: hex.EncodeToString(hash),
+	}, nil
+}
+
+// TopUpOracleProgram extends the expiration of an oracle program by
+// the number of blocks covered by the given rent. Only the rent for
+// whole blocks is charged.
+func (m msgServer) TopUpOracleProgram(goCtx context.Context, msg *types.MsgTopUpOracleProgram) (*types.MsgTopUpOracleProgramResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	params, err := m.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.ProgramTTL == 0 {
+		return nil, types.ErrRentDisabled
+	}
+
+	program, err := m.getOracleProgram(ctx, msg.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if program.ExpirationHeight == 0 {
+		return nil, types.ErrProgramDoesNotExpire
+	}
+
+	denom, err := m.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute the number of blocks covered by the rent and charge
+	// only for these blocks.
+	rentPerBlock := math.NewIntFromUint64(params.RentPerBytePerBlock).MulRaw(int64(len(program.Bytecode)))
+	blocks := msg.Rent.AmountOf(denom).Quo(rentPerBlock)
+	if blocks.IsZero() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "rent of one block is %s%s", rentPerBlock, denom)
+	}
+	base := max(program.ExpirationHeight, ctx.BlockHeight())
+	if !blocks.IsInt64() || blocks.Int64() > gomath.MaxInt64-base {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("rent exceeds maximum expiration height")
+	}
+	rent := sdk.NewCoins(sdk.NewCoin(denom, blocks.Mul(rentPerBlock)))
+
+	senderAddress, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	err = m.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddress, authtypes.FeeCollectorName, rent)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	expirationHeight := base + blocks.Int64()
+	if err := m.setOracleProgramExpiration(ctx, program, expirationHeight); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTopUpOracleProgram,
+			sdk.NewAttribute(types.AttributeSender, msg.Sender),
+			sdk.NewAttribute(types.AttributeOracleProgramHash, msg.Hash),
+			sdk.NewAttribute(types.AttributeRent, rent.String()),
+			sdk.NewAttribute(types.AttributeExpirationHeight, strconv.FormatInt(expirationHeight, 10)),
+		),
+	)
+
+	return &types.MsgTopUpOracleProgramResponse{
+		ExpirationHeight: expirationHeight,
 	}, nil
 }
 
@@ -122,7 +429,7 @@ func (m msgServer) InstantiateCoreContract(goCtx context.Context, msg *types.Msg
 	}
 
 	// Update the core contract registry.
-	err = m.CoreContractRegistry.Set(ctx, contractAddr.String())
+	err = m.RegisterCoreContract(ctx, contractAddr, msg.CodeID)
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +439,121 @@ func (m msgServer) InstantiateCoreContract(goCtx context.Context, msg *types.Msg
 	}, nil
 }
 
+// MigrateCoreContract migrates the registered core contract to a
+// new code ID and records the migration in the registry history.
+// The core contract admin must be the authority for the migration
+// to be permitted by wasmd.
+func (m msgServer) MigrateCoreContract(goCtx context.Context, msg *types.MsgMigrateCoreContract) (*types.MsgMigrateCoreContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if m.GetAuthority() != msg.Sender {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", m.authority, msg.Sender)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contractAddr, err := m.GetCoreContractAddr(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrCoreContractNotRegistered
+		}
+		return nil, err
+	}
+	if contractAddr == nil {
+		return nil, types.ErrCoreContractNotRegistered
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	_, err = m.wasmKeeper.Migrate(ctx, contractAddr, sender, msg.CodeID, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the migration in the core contract registry.
+	err = m.RegisterCoreContract(ctx, contractAddr, msg.CodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateCoreContractResponse{
+		ContractAddress: contractAddr.String(),
+	}, nil
+}
+
+// BlockOracleProgram adds an oracle program hash to the blocklist so
+// that the program can no longer be used. The program does not need
+// to be stored yet.
+func (m msgServer) BlockOracleProgram(goCtx context.Context, msg *types.MsgBlockOracleProgram) (*types.MsgBlockOracleProgramResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if m.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	hash, err := hex.DecodeString(msg.Hash)
+	if err != nil {
+		return nil, types.ErrInvalidHexWasmHash
+	}
+	err = m.BlockedOracleProgram.Set(ctx, hash, types.BlockedOracleProgram{
+		Hash:          hex.EncodeToString(hash),
+		Reason:        msg.Reason,
+		BlockedHeight: ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBlockOracleProgram,
+			sdk.NewAttribute(types.AttributeOracleProgramHash, hex.EncodeToString(hash)),
+			sdk.NewAttribute(types.AttributeReason, msg.Reason),
+		),
+	)
+
+	return &types.MsgBlockOracleProgramResponse{}, nil
+}
+
+// UnblockOracleProgram removes an oracle program hash from the blocklist.
+func (m msgServer) UnblockOracleProgram(goCtx context.Context, msg *types.MsgUnblockOracleProgram) (*types.MsgUnblockOracleProgramResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if m.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	hash, err := hex.DecodeString(msg.Hash)
+	if err != nil {
+		return nil, types.ErrInvalidHexWasmHash
+	}
+	blocked, err := m.BlockedOracleProgram.Has(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if !blocked {
+		return nil, types.ErrOracleProgramNotBlocked.Wrap(msg.Hash)
+	}
+	if err := m.BlockedOracleProgram.Remove(ctx, hash); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnblockOracleProgram,
+			sdk.NewAttribute(types.AttributeOracleProgramHash, hex.EncodeToString(hash)),
+		),
+	)
+
+	return &types.MsgUnblockOracleProgramResponse{}, nil
+}
+
 // unzipWasm unzips a gzipped wasm.
 func unzipWasm(wasm []byte, maxSize int64) ([]byte, error) {
 	var unzipped []byte
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"math"
@@ -67,6 +69,32 @@ func (s *KeeperTestSuite) TestStoreOracleProgram() {
 				Hash: hex.EncodeToString(crypto.Keccak256(regWasm)),
 			},
 		},
+		{
+			name: "Invalid metadata",
+			preRun: func() {
+				s.ApplyDefaultMockExpectations()
+			}, input: types.MsgStoreOracleProgram{
+				Sender:     s.authority,
+				Wasm:       regWasmZipped,
+				StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(len(regWasm))).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte))))),
+				Metadata:   types.OracleProgramMetadata{Version: "1.0"},
+			},
+			expErr:    true,
+			expErrMsg: "invalid semantic version",
+		},
+		{
+			name: "Owner other than sender",
+			preRun: func() {
+				s.ApplyDefaultMockExpectations()
+			}, input: types.MsgStoreOracleProgram{
+				Sender:     s.authority,
+				Wasm:       regWasmZipped,
+				StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(len(regWasm))).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte))))),
+				Metadata:   types.OracleProgramMetadata{Owner: "seda1uea9km4nup9q7qu96ak683kc67x9jf7ste45z5"},
+			},
+			expErr:    true,
+			expErrMsg: "must be empty or the sender",
+		},
 		{
 			name: "Invalid address",
 			preRun: func() {
@@ -124,6 +152,7 @@ func (s *KeeperTestSuite) TestStoreOracleProgram() {
 				Sender:     s.authority,
 				Wasm:       regWasmZipped,
 				StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(len(regWasm))).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte))))),
+				Metadata:   types.OracleProgramMetadata{Name: "other"},
 			},
 			preRun: func() {
 				s.ApplyDefaultMockExpectations()
@@ -131,12 +160,93 @@ func (s *KeeperTestSuite) TestStoreOracleProgram() {
 					Sender:     s.authority,
 					Wasm:       regWasmZipped,
 					StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(len(regWasm))).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte))))),
+					Metadata:   types.OracleProgramMetadata{Name: "memory", Version: "1.0.0"},
 				}
 				_, err := s.msgSrvr.StoreOracleProgram(s.ctx, &input)
 				s.Require().Nil(err)
 			},
+			expErr: false,
+			expOutput: types.MsgStoreOracleProgramResponse{
+				Hash:     hex.EncodeToString(crypto.Keccak256(regWasm)),
+				Metadata: types.OracleProgramMetadata{Name: "memory", Version: "1.0.0"},
+			},
+		},
+		{
+			name: "store by hash of existing oracle program",
+			input: types.MsgStoreOracleProgram{
+				Sender: s.authority,
+				Hash:   hex.EncodeToString(crypto.Keccak256(regWasm)),
+			},
+			preRun: func() {
+				s.ApplyDefaultMockExpectations()
+				input := types.MsgStoreOracleProgram{
+					Sender:     s.authority,
+					Wasm:       regWasmZipped,
+					StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(len(regWasm))).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte))))),
+					Metadata:   types.OracleProgramMetadata{Name: "memory", Version: "1.0.0"},
+				}
+				_, err := s.msgSrvr.StoreOracleProgram(s.ctx, &input)
+				s.Require().Nil(err)
+			},
+			expErr: false,
+			expOutput: types.MsgStoreOracleProgramResponse{
+				Hash:     hex.EncodeToString(crypto.Keccak256(regWasm)),
+				Metadata: types.OracleProgramMetadata{Name: "memory", Version: "1.0.0"},
+			},
+		},
+		{
+			name: "store by hash of oracle program that is not stored",
+			input: types.MsgStoreOracleProgram{
+				Sender: s.authority,
+				Hash:   hex.EncodeToString(crypto.Keccak256(regWasm)),
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "oracle program not found",
+		},
+		{
+			name: "store by hash that is too short",
+			input: types.MsgStoreOracleProgram{
+				Sender: s.authority,
+				Hash:   hex.EncodeToString(crypto.Keccak256(regWasm)[:16]),
+			},
+			preRun:    func() {},
 			expErr:    true,
-			expErrMsg: "already exists",
+			expErrMsg: types.ErrInvalidHexWasmHash.Error(),
+		},
+		{
+			name: "store by hash with storage fee",
+			input: types.MsgStoreOracleProgram{
+				Sender:     s.authority,
+				Hash:       hex.EncodeToString(crypto.Keccak256(regWasm)),
+				StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(1))),
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "storage fee cannot be given with hash",
+		},
+		{
+			name: "store by hash with metadata",
+			input: types.MsgStoreOracleProgram{
+				Sender:   s.authority,
+				Hash:     hex.EncodeToString(crypto.Keccak256(regWasm)),
+				Metadata: types.OracleProgramMetadata{Name: "memory"},
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "metadata cannot be given with hash",
+		},
+		{
+			name: "both wasm and hash",
+			input: types.MsgStoreOracleProgram{
+				Sender:     s.authority,
+				Wasm:       regWasmZipped,
+				StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(len(regWasm))).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte))))),
+				Hash:       hex.EncodeToString(crypto.Keccak256(regWasm)),
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "wasm and hash cannot both be given",
 		},
 		{
 			name: "unzipped Wasm",
@@ -181,6 +291,152 @@ func (s *KeeperTestSuite) TestStoreOracleProgram() {
 	}
 }
 
+func (s *KeeperTestSuite) TestStoreExistingOracleProgram() {
+	s.SetupTest()
+	s.mockStakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("aseda", nil).AnyTimes()
+
+	wasm := testwasms.SampleTallyWasm()
+	zipped, err := ioutils.GzipIt(wasm)
+	s.Require().NoError(err)
+	program := types.NewOracleProgram(wasm, s.ctx.BlockTime())
+	s.Require().NoError(s.keeper.SetOracleProgram(s.ctx, program))
+
+	// No storage fee is charged, since the bank keeper mock expects no
+	// calls.
+	res, err := s.msgSrvr.StoreOracleProgram(s.ctx, &types.MsgStoreOracleProgram{
+		Sender:     s.authority,
+		Wasm:       zipped,
+		StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(len(wasm))).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte))))),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(hex.EncodeToString(program.Hash), res.Hash)
+}
+
+func (s *KeeperTestSuite) TestStoreOracleProgramValidation() {
+	cases := []struct {
+		name   string
+		wasm   []byte
+		expErr error
+	}{
+		{
+			name: "minimal oracle program",
+			wasm: testOracleProgram(1, []byte{0x0b}),
+		},
+		{
+			name:   "not a wasm module",
+			wasm:   []byte("this is not a wasm module"),
+			expErr: types.ErrInvalidWasm,
+		},
+		{
+			name:   "truncated wasm module",
+			wasm:   testOracleProgram(1, []byte{0x0b})[:30],
+			expErr: types.ErrInvalidWasm,
+		},
+		{
+			name:   "missing memory export",
+			wasm:   testwasms.BigWasm(),
+			expErr: types.ErrMissingWasmExport,
+		},
+		{
+			name:   "missing entry point export",
+			wasm:   bytes.Replace(testOracleProgram(1, []byte{0x0b}), []byte("_start"), []byte("_other"), 1),
+			expErr: types.ErrMissingWasmExport,
+		},
+		{
+			name:   "disallowed import",
+			wasm:   testwasms.InvalidImportWasm(),
+			expErr: types.ErrDisallowedWasmImport,
+		},
+		{
+			name:   "import from a module not provided by the VM",
+			wasm:   testwasms.HelloWorldWasm(),
+			expErr: types.ErrDisallowedWasmImport,
+		},
+		{
+			name: "floating-point instructions",
+			wasm: testOracleProgram(1, []byte{0x43, 0x00, 0x00, 0x80, 0x3f, 0x43, 0x00, 0x00, 0x80, 0x3f, 0x92, 0x1a, 0x0b}),
+		},
+		{
+			name: "saturating truncation",
+			wasm: testOracleProgram(1, []byte{0x43, 0x00, 0x00, 0x00, 0x00, 0xfc, 0x00, 0x1a, 0x0b}),
+		},
+		{
+			name:   "atomic instruction",
+			wasm:   testOracleProgram(1, []byte{0xfe, 0x03, 0x00, 0x0b}),
+			expErr: types.ErrNonDeterministicWasm,
+		},
+		{
+			name:   "SIMD instruction",
+			wasm:   testOracleProgram(1, []byte{0xfd, 0x0c, 0x0b}),
+			expErr: types.ErrNonDeterministicWasm,
+		},
+		{
+			name:   "oversized memory",
+			wasm:   testOracleProgram(types.MaxWasmMemoryPages+1, []byte{0x0b}),
+			expErr: types.ErrWasmLimitExceeded,
+		},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.ApplyDefaultMockExpectations()
+
+			zipped, err := ioutils.GzipIt(tc.wasm)
+			s.Require().NoError(err)
+			// Small modules grow when compressed, so pay for the larger size.
+			size := max(len(tc.wasm), len(zipped))
+			_, err = s.msgSrvr.StoreOracleProgram(s.ctx, &types.MsgStoreOracleProgram{
+				Sender:     s.authority,
+				Wasm:       zipped,
+				StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(size)).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte))))),
+			})
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestStoreSDKOraclePrograms() {
+	for i, wasm := range testwasms.SDKWasms {
+		s.Run(fmt.Sprintf("program %d", i), func() {
+			s.SetupTest()
+			s.ApplyDefaultMockExpectations()
+
+			zipped, err := ioutils.GzipIt(wasm)
+			s.Require().NoError(err)
+			_, err = s.msgSrvr.StoreOracleProgram(s.ctx, &types.MsgStoreOracleProgram{
+				Sender:     s.authority,
+				Wasm:       zipped,
+				StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(len(wasm))).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte))))),
+			})
+			s.Require().NoError(err)
+		})
+	}
+}
+
+// testOracleProgram returns a wasm module with the given number of memory
+// pages that exports memory and a _start function with the given body.
+func testOracleProgram(memoryPages uint64, body []byte) []byte {
+	section := func(id byte, content []byte) []byte {
+		return append(binary.AppendUvarint([]byte{id}, uint64(len(content))), content...)
+	}
+	code := append([]byte{0x00}, body...)
+
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	module = append(module, section(1, []byte{0x01, 0x60, 0x00, 0x00})...)
+	module = append(module, section(3, []byte{0x01, 0x00})...)
+	module = append(module, section(5, binary.AppendUvarint([]byte{0x01, 0x00}, memoryPages))...)
+	module = append(module, section(7, append(
+		append([]byte{0x02, 0x06}, append([]byte("_start"), 0x00, 0x00)...),
+		append([]byte{0x06}, append([]byte("memory"), 0x02, 0x00)...)...,
+	))...)
+	module = append(module, section(10, append(binary.AppendUvarint([]byte{0x01}, uint64(len(code))), code...))...)
+	return module
+}
+
 func (s *KeeperTestSuite) TestInstantiateCoreContract() {
 	cases := []struct {
 		name      string
@@ -251,10 +507,125 @@ func (s *KeeperTestSuite) TestInstantiateCoreContract() {
 			addr, err := s.keeper.CoreContractRegistry.Get(s.ctx)
 			s.Require().NoError(err)
 			s.Require().Equal(testAddrs[0].String(), addr)
+
+			entry, err := s.keeper.CoreContractHistory.Get(s.ctx, s.ctx.BlockHeight())
+			s.Require().NoError(err)
+			s.Require().Equal(types.CoreContractRegistryEntry{
+				Address:          testAddrs[0].String(),
+				CodeID:           tc.input.CodeID,
+				ActivationHeight: s.ctx.BlockHeight(),
+			}, entry)
 		})
 	}
 }
 
+func (s *KeeperTestSuite) TestMigrateCoreContract() {
+	s.SetupTest()
+	authority, err := sdk.AccAddressFromBech32(s.authority)
+	s.Require().NoError(err)
+
+	msg := &types.MsgMigrateCoreContract{
+		Sender: s.authority,
+		CodeID: 2,
+		Msg:    []byte(`{}`),
+	}
+
+	s.Run("core contract must be registered", func() {
+		_, err := s.msgSrvr.MigrateCoreContract(s.ctx, msg)
+		s.Require().ErrorIs(err, types.ErrCoreContractNotRegistered)
+	})
+
+	ctx := s.ctx.WithBlockHeight(10)
+	s.Require().NoError(s.keeper.RegisterCoreContract(ctx, testAddrs[0], 1))
+
+	s.Run("invalid authority", func() {
+		_, err := s.msgSrvr.MigrateCoreContract(s.ctx, &types.MsgMigrateCoreContract{
+			Sender: testAddrs[1].String(),
+			CodeID: 2,
+			Msg:    []byte(`{}`),
+		})
+		s.Require().ErrorIs(err, types.ErrInvalidAuthority)
+	})
+
+	s.Run("invalid msg json", func() {
+		_, err := s.msgSrvr.MigrateCoreContract(s.ctx, &types.MsgMigrateCoreContract{
+			Sender: s.authority,
+			CodeID: 2,
+			Msg:    []byte(`}`),
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("happy path", func() {
+		ctx := s.ctx.WithBlockHeight(20)
+		s.mockWasmKeeper.EXPECT().Migrate(ctx, testAddrs[0], authority, uint64(2), []byte(`{}`)).Return(nil, nil)
+
+		res, err := s.msgSrvr.MigrateCoreContract(ctx, msg)
+		s.Require().NoError(err)
+		s.Require().Equal(testAddrs[0].String(), res.ContractAddress)
+
+		// The address is kept and the migration is recorded in the history.
+		coreContract, err := s.keeper.GetCoreContractAddr(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(testAddrs[0], coreContract)
+
+		registry, err := s.queryClient.CoreContractRegistry(ctx, &types.QueryCoreContractRegistryRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(testAddrs[0].String(), registry.Address)
+		s.Require().Equal([]types.CoreContractRegistryEntry{
+			{Address: testAddrs[0].String(), CodeID: 1, ActivationHeight: 10},
+			{Address: testAddrs[0].String(), CodeID: 2, ActivationHeight: 20},
+		}, registry.History)
+	})
+
+	s.Run("only one registration per block", func() {
+		ctx := s.ctx.WithBlockHeight(20)
+		s.mockWasmKeeper.EXPECT().Migrate(ctx, testAddrs[0], authority, uint64(3), []byte(`{}`)).Return(nil, nil)
+
+		_, err := s.msgSrvr.MigrateCoreContract(ctx, &types.MsgMigrateCoreContract{
+			Sender: s.authority,
+			CodeID: 3,
+			Msg:    []byte(`{}`),
+		})
+		s.Require().ErrorIs(err, types.ErrCoreContractRegistered)
+	})
+
+	s.Run("failed migration leaves the registry unchanged", func() {
+		ctx := s.ctx.WithBlockHeight(30)
+		s.mockWasmKeeper.EXPECT().Migrate(ctx, testAddrs[0], authority, uint64(3), []byte(`{}`)).Return(nil, fmt.Errorf("migration failed"))
+
+		_, err := s.msgSrvr.MigrateCoreContract(ctx, &types.MsgMigrateCoreContract{
+			Sender: s.authority,
+			CodeID: 3,
+			Msg:    []byte(`{}`),
+		})
+		s.Require().ErrorContains(err, "migration failed")
+
+		history, err := s.keeper.GetCoreContractHistory(ctx)
+		s.Require().NoError(err)
+		s.Require().Len(history, 2)
+	})
+}
+
+func (s *KeeperTestSuite) TestSeedCoreContractHistory() {
+	s.SetupTest()
+
+	// The history of a Core Contract registered before the history was
+	// introduced is seeded.
+	s.Require().NoError(s.keeper.CoreContractRegistry.Set(s.ctx, testAddrs[0].String()))
+	ctx := s.ctx.WithBlockHeight(10)
+	s.Require().NoError(s.keeper.SeedCoreContractHistory(ctx, testAddrs[0], 1))
+
+	// An existing history is left untouched.
+	s.Require().NoError(s.keeper.SeedCoreContractHistory(s.ctx.WithBlockHeight(20), testAddrs[1], 2))
+
+	history, err := s.keeper.GetCoreContractHistory(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.CoreContractRegistryEntry{
+		{Address: testAddrs[0].String(), CodeID: 1, ActivationHeight: 10},
+	}, history)
+}
+
 func (s *KeeperTestSuite) TestUpdateParams() {
 	authority := s.keeper.GetAuthority()
 	cases := []struct {
@@ -3,8 +3,13 @@ package keeper
 import (
 	"context"
 	"encoding/hex"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
@@ -24,7 +29,13 @@ func NewQuerierImpl(keeper Keeper) types.QueryServer {
 
 func (q Querier) OracleProgram(c context.Context, req *types.QueryOracleProgramRequest) (*types.QueryOracleProgramResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	program, err := q.GetOracleProgram(ctx, req.Hash)
+	var program types.OracleProgram
+	var err error
+	if req.IncludeBlocked {
+		program, err = q.getOracleProgram(ctx, req.Hash)
+	} else {
+		program, err = q.GetOracleProgram(ctx, req.Hash)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -33,35 +44,230 @@ func (q Querier) OracleProgram(c context.Context, req *types.QueryOracleProgramR
 	}, nil
 }
 
+func (q Querier) OracleProgramExists(c context.Context, req *types.QueryOracleProgramExistsRequest) (*types.QueryOracleProgramExistsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, types.ErrInvalidHexWasmHash
+	}
+	exists, err := q.Keeper.OracleProgram.Has(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := q.Keeper.BlockedOracleProgram.Has(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOracleProgramExistsResponse{
+		Exists:  exists,
+		Blocked: blocked,
+	}, nil
+}
+
 func (q Querier) OraclePrograms(c context.Context, req *types.QueryOracleProgramsRequest) (*types.QueryOracleProgramsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	results, pageRes, err := query.CollectionPaginate(
-		ctx, q.Keeper.OracleProgram, req.Pagination,
-		func(_ []byte, v types.OracleProgram) (string, error) {
-			return hex.EncodeToString(v.Hash), nil
-		},
-	)
+	if req.MaxBytecodeSize != 0 && req.MinBytecodeSize > req.MaxBytecodeSize {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("min bytecode size exceeds max bytecode size")
+	}
+
+	var results []types.OracleProgramSummary
+	var pageRes *query.PageResponse
+	var err error
+	// Time range filters are served from the index of programs by the
+	// time they were added, so that only the requested range is iterated.
+	if req.OrderByAddedAt || req.AddedAfter != nil || req.AddedBefore != nil {
+		results, pageRes, err = q.oracleProgramsByAddedAt(ctx, req)
+	} else {
+		results, pageRes, err = query.CollectionFilteredPaginate(
+			ctx, q.Keeper.OracleProgram, req.Pagination,
+			func(_ []byte, v types.OracleProgram) (bool, error) {
+				return matchOracleProgram(req, v), nil
+			},
+			func(_ []byte, v types.OracleProgram) (types.OracleProgramSummary, error) {
+				return v.Summary(), nil
+			},
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	list := make([]string, len(results))
+	for i, summary := range results {
+		list[i] = summary.Hash
+	}
 	return &types.QueryOracleProgramsResponse{
-		List:       results,
+		List:       list,
 		Pagination: pageRes,
+		Programs:   results,
 	}, nil
 }
 
+// oracleProgramsByAddedAt paginates the oracle programs matching the
+// request in the order they were added. Only the part of the index
+// within the requested time range is iterated.
+func (q Querier) oracleProgramsByAddedAt(ctx sdk.Context, req *types.QueryOracleProgramsRequest) ([]types.OracleProgramSummary, *query.PageResponse, error) {
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, sdkerrors.ErrInvalidRequest.Wrap("either offset or key is expected, got both")
+	}
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+	countTotal = countTotal && len(pageReq.Key) == 0
+
+	keyCodec := q.Keeper.OracleProgramAddedAt.KeyCodec()
+	rng := new(collections.Range[collections.Pair[time.Time, []byte]])
+	if req.AddedAfter != nil {
+		rng.StartInclusive(collections.Join(*req.AddedAfter, []byte{}))
+	}
+	if req.AddedBefore != nil {
+		rng.EndExclusive(collections.Join(*req.AddedBefore, []byte{}))
+	}
+	if len(pageReq.Key) != 0 {
+		_, key, err := keyCodec.Decode(pageReq.Key)
+		if err != nil {
+			return nil, nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid pagination key: %s", err)
+		}
+		if pageReq.Reverse {
+			rng.EndInclusive(key)
+		} else {
+			rng.StartInclusive(key)
+		}
+	}
+	if pageReq.Reverse {
+		rng.Descending()
+	}
+
+	iter, err := q.Keeper.OracleProgramAddedAt.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var results []types.OracleProgramSummary
+	var nextKey []byte
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, nil, err
+		}
+		if uint64(len(results)) == limit && nextKey == nil {
+			nextKey = make([]byte, keyCodec.Size(key))
+			if _, err := keyCodec.Encode(nextKey, key); err != nil {
+				return nil, nil, err
+			}
+			if !countTotal {
+				break
+			}
+		}
+
+		program, err := q.Keeper.OracleProgram.Get(ctx, key.K2())
+		if err != nil {
+			return nil, nil, err
+		}
+		if !matchOracleProgram(req, program) {
+			continue
+		}
+		count++
+		if count > pageReq.Offset && uint64(len(results)) < limit {
+			results = append(results, program.Summary())
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+	return results, pageRes, nil
+}
+
+// matchOracleProgram returns true if the oracle program passes the
+// filters of the request.
+func matchOracleProgram(req *types.QueryOracleProgramsRequest, program types.OracleProgram) bool {
+	if req.Owner != "" && program.Metadata.Owner != req.Owner {
+		return false
+	}
+	if req.Name != "" && program.Metadata.Name != req.Name {
+		return false
+	}
+	if req.AddedAfter != nil && program.AddedAt.Before(*req.AddedAfter) {
+		return false
+	}
+	if req.AddedBefore != nil && !program.AddedAt.Before(*req.AddedBefore) {
+		return false
+	}
+	size := uint64(len(program.Bytecode))
+	if size < req.MinBytecodeSize {
+		return false
+	}
+	if req.MaxBytecodeSize != 0 && size > req.MaxBytecodeSize {
+		return false
+	}
+	return true
+}
+
 func (q Querier) CoreContractRegistry(c context.Context, _ *types.QueryCoreContractRegistryRequest) (*types.QueryCoreContractRegistryResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	coreAddress, err := q.GetCoreContractAddr(ctx)
 	if err != nil {
 		return nil, err
 	}
+	history, err := q.GetCoreContractHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return &types.QueryCoreContractRegistryResponse{
 		Address: coreAddress.String(),
+		History: history,
+	}, nil
+}
+
+func (q Querier) BlockedOraclePrograms(c context.Context, req *types.QueryBlockedOracleProgramsRequest) (*types.QueryBlockedOracleProgramsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	results, pageRes, err := query.CollectionPaginate(
+		ctx, q.Keeper.BlockedOracleProgram, req.Pagination,
+		func(_ []byte, v types.BlockedOracleProgram) (types.BlockedOracleProgram, error) {
+			return v, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlockedOracleProgramsResponse{
+		BlockedOraclePrograms: results,
+		Pagination:            pageRes,
 	}, nil
 }
 
+func (q Querier) OracleProgramStats(c context.Context, req *types.QueryOracleProgramStatsRequest) (*types.QueryOracleProgramStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	stats, err := q.GetOracleProgramStats(ctx, req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOracleProgramStatsResponse{Stats: stats}, nil
+}
+
+func (q Querier) UploadSession(c context.Context, req *types.QueryUploadSessionRequest) (*types.QueryUploadSessionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	session, err := q.Keeper.UploadSession.Get(ctx, req.UploadId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrUploadSessionNotFound.Wrapf("id %d", req.UploadId)
+		}
+		return nil, err
+	}
+	return &types.QueryUploadSessionResponse{UploadSession: session}, nil
+}
+
 func (q Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	params, err := q.GetParams(ctx)
@@ -1,13 +1,16 @@
 package keeper_test
 
 import (
+	"bytes"
 	"encoding/hex"
+	"time"
 
 	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
 
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	appparams "github.com/sedaprotocol/seda-chain/app/params"
 	"github.com/sedaprotocol/seda-chain/testutil/testwasms"
@@ -17,7 +20,7 @@ import (
 func (s *KeeperTestSuite) TestOracleProgram() {
 	s.SetupTest()
 	s.ApplyDefaultMockExpectations()
-	wasm := testwasms.HelloWorldWasm()
+	wasm := testwasms.SampleTallyWasm()
 	compWasm, err := ioutils.GzipIt(wasm)
 	s.Require().NoError(err)
 	input := types.MsgStoreOracleProgram{
@@ -35,6 +38,34 @@ func (s *KeeperTestSuite) TestOracleProgram() {
 	s.Require().Equal(storedWasm.Hash, hex.EncodeToString(res.OracleProgram.Hash))
 }
 
+func (s *KeeperTestSuite) TestOracleProgramExists() {
+	s.SetupTest()
+	s.ApplyDefaultMockExpectations()
+	wasm := testwasms.MemoryWasm()
+	hash := hex.EncodeToString(types.NewOracleProgram(wasm, s.ctx.BlockTime()).Hash)
+
+	res, err := s.queryClient.OracleProgramExists(s.ctx, &types.QueryOracleProgramExistsRequest{Hash: hash})
+	s.Require().NoError(err)
+	s.Require().False(res.Exists)
+
+	compWasm, err := ioutils.GzipIt(wasm)
+	s.Require().NoError(err)
+	_, err = s.msgSrvr.StoreOracleProgram(s.ctx, &types.MsgStoreOracleProgram{
+		Sender:     s.authority,
+		Wasm:       compWasm,
+		StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(len(wasm))).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte))))),
+	})
+	s.Require().NoError(err)
+
+	res, err = s.queryClient.OracleProgramExists(s.ctx, &types.QueryOracleProgramExistsRequest{Hash: hash})
+	s.Require().NoError(err)
+	s.Require().True(res.Exists)
+	s.Require().False(res.Blocked)
+
+	_, err = s.queryClient.OracleProgramExists(s.ctx, &types.QueryOracleProgramExistsRequest{Hash: "not hex"})
+	s.Require().ErrorContains(err, "invalid hex-encoded wasm hash")
+}
+
 func (s *KeeperTestSuite) TestOraclePrograms() {
 	s.SetupTest()
 	s.ApplyDefaultMockExpectations()
@@ -69,3 +100,161 @@ func (s *KeeperTestSuite) TestOraclePrograms() {
 	s.Require().Contains(res.List, storedWasm.Hash)
 	s.Require().Contains(res.List, storedWasm2.Hash)
 }
+
+func (s *KeeperTestSuite) TestOracleProgramsWithMetadata() {
+	s.SetupTest()
+	s.ApplyDefaultMockExpectations()
+
+	owner := s.authority
+	programs := []struct {
+		wasm     []byte
+		metadata types.OracleProgramMetadata
+	}{
+		{
+			wasm: testwasms.MemoryWasm(),
+			metadata: types.OracleProgramMetadata{
+				Name:             "memory",
+				Version:          "1.0.0-rc.1",
+				SourceRepository: "https://github.com/sedaprotocol/seda-sdk",
+				SourceCommit:     "a1b2c3d",
+				BuildHash:        "5f2f30ecfb1b0f5c49d69c3b0da8b8ddb56e6ba5d2c3c65d2e4d06ff8a1f4c2e",
+				Description:      "Allocates memory",
+				Owner:            owner,
+			},
+		},
+		{
+			wasm:     testwasms.MaxDrWasm(),
+			metadata: types.OracleProgramMetadata{Name: "max-dr", Owner: owner},
+		},
+		{
+			wasm:     testwasms.MaxResultWasm(),
+			metadata: types.OracleProgramMetadata{Name: "memory"},
+		},
+	}
+	hashes := make([]string, len(programs))
+	for i, program := range programs {
+		compWasm, err := ioutils.GzipIt(program.wasm)
+		s.Require().NoError(err)
+		res, err := s.msgSrvr.StoreOracleProgram(s.ctx, &types.MsgStoreOracleProgram{
+			Sender:     s.authority,
+			Wasm:       compWasm,
+			StorageFee: sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(len(program.wasm))).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte))))),
+			Metadata:   program.metadata,
+		})
+		s.Require().NoError(err)
+		hashes[i] = res.Hash
+	}
+
+	res, err := s.queryClient.OracleProgram(s.ctx, &types.QueryOracleProgramRequest{Hash: hashes[0]})
+	s.Require().NoError(err)
+	s.Require().Equal(programs[0].metadata, res.OracleProgram.Metadata)
+
+	byOwner, err := s.queryClient.OraclePrograms(s.ctx, &types.QueryOracleProgramsRequest{Owner: owner})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]string{hashes[0], hashes[1]}, byOwner.List)
+
+	byName, err := s.queryClient.OraclePrograms(s.ctx, &types.QueryOracleProgramsRequest{Name: "memory"})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]string{hashes[0], hashes[2]}, byName.List)
+
+	byBoth, err := s.queryClient.OraclePrograms(s.ctx, &types.QueryOracleProgramsRequest{Owner: owner, Name: "memory"})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{hashes[0]}, byBoth.List)
+}
+
+func (s *KeeperTestSuite) TestOracleProgramsByAddedAt() {
+	s.SetupTest()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	metadata := types.OracleProgramMetadata{Name: "program", Version: "1.0.0"}
+	var programs []types.OracleProgram
+	// The programs are added in an order that differs from their hash order.
+	for i, size := range []int{40, 10, 30, 20} {
+		program := types.NewOracleProgram(bytes.Repeat([]byte{byte(i)}, size), start.Add(time.Duration(i)*time.Hour))
+		if i == 0 {
+			program.Metadata = metadata
+		}
+		s.Require().NoError(s.keeper.SetOracleProgram(s.ctx, program))
+		programs = append(programs, program)
+	}
+	hashes := func(indexes ...int) []string {
+		list := make([]string, len(indexes))
+		for i, index := range indexes {
+			list[i] = hex.EncodeToString(programs[index].Hash)
+		}
+		return list
+	}
+
+	res, err := s.queryClient.OraclePrograms(s.ctx, &types.QueryOracleProgramsRequest{OrderByAddedAt: true})
+	s.Require().NoError(err)
+	s.Require().Equal(hashes(0, 1, 2, 3), res.List)
+	s.Require().Equal(uint64(4), res.Pagination.Total)
+	s.Require().Equal(types.OracleProgramSummary{
+		Hash:         hashes(0)[0],
+		BytecodeSize: 40,
+		AddedAt:      start,
+		Metadata:     &metadata,
+	}, res.Programs[0])
+	s.Require().Nil(res.Programs[1].Metadata)
+
+	s.Run("Most recent programs are listed first in reverse order", func() {
+		res, err := s.queryClient.OraclePrograms(s.ctx, &types.QueryOracleProgramsRequest{
+			OrderByAddedAt: true,
+			Pagination:     &query.PageRequest{Limit: 3, Reverse: true},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(hashes(3, 2, 1), res.List)
+		s.Require().NotNil(res.Pagination.NextKey)
+
+		res, err = s.queryClient.OraclePrograms(s.ctx, &types.QueryOracleProgramsRequest{
+			OrderByAddedAt: true,
+			Pagination:     &query.PageRequest{Limit: 3, Reverse: true, Key: res.Pagination.NextKey},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(hashes(0), res.List)
+		s.Require().Nil(res.Pagination.NextKey)
+	})
+
+	s.Run("Programs are filtered by time range", func() {
+		after, before := start.Add(time.Hour), start.Add(3*time.Hour)
+		for _, orderByAddedAt := range []bool{true, false} {
+			res, err := s.queryClient.OraclePrograms(s.ctx, &types.QueryOracleProgramsRequest{
+				OrderByAddedAt: orderByAddedAt,
+				AddedAfter:     &after,
+				AddedBefore:    &before,
+			})
+			s.Require().NoError(err)
+			// Time range filters always list programs in the order they were added.
+			s.Require().Equal(hashes(1, 2), res.List)
+		}
+	})
+
+	s.Run("Programs are filtered by bytecode size", func() {
+		for _, orderByAddedAt := range []bool{true, false} {
+			res, err := s.queryClient.OraclePrograms(s.ctx, &types.QueryOracleProgramsRequest{
+				OrderByAddedAt:  orderByAddedAt,
+				MinBytecodeSize: 20,
+				MaxBytecodeSize: 30,
+			})
+			s.Require().NoError(err)
+			s.Require().ElementsMatch(hashes(2, 3), res.List)
+		}
+
+		_, err := s.queryClient.OraclePrograms(s.ctx, &types.QueryOracleProgramsRequest{
+			MinBytecodeSize: 30,
+			MaxBytecodeSize: 20,
+		})
+		s.Require().ErrorContains(err, "min bytecode size exceeds max bytecode size")
+	})
+
+	s.Run("Offset skips matching programs", func() {
+		res, err := s.queryClient.OraclePrograms(s.ctx, &types.QueryOracleProgramsRequest{
+			OrderByAddedAt:  true,
+			MinBytecodeSize: 20,
+			Pagination:      &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(hashes(2), res.List)
+		s.Require().Equal(uint64(3), res.Pagination.Total)
+	})
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"sort"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+// RecordExecProgramUsage records the use of an oracle program as the
+// execution program of a tallied data request. Usage of programs that
+// are not stored is not recorded.
+func (k Keeper) RecordExecProgramUsage(ctx context.Context, hash string) error {
+	stats, found, err := k.getOracleProgramStats(ctx, hash)
+	if err != nil || !found {
+		return err
+	}
+	stats.ExecInvocations++
+	stats.LastUsedHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return k.OracleProgramStats.Set(ctx, stats.Hash, stats)
+}
+
+// RecordTallyProgramUsage records an execution of an oracle program as a
+// tally program along with the gas it used and its exit code. Usage of
+// programs that are not stored is not recorded.
+func (k Keeper) RecordTallyProgramUsage(ctx context.Context, hash string, gasUsed uint64, exitCode uint32) error {
+	stats, found, err := k.getOracleProgramStats(ctx, hash)
+	if err != nil || !found {
+		return err
+	}
+	stats.TallyInvocations++
+	stats.LastUsedHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	stats.TallyGasUsed += gasUsed
+
+	i := sort.Search(len(stats.TallyExitCodes), func(i int) bool {
+		return stats.TallyExitCodes[i].ExitCode >= exitCode
+	})
+	if i < len(stats.TallyExitCodes) && stats.TallyExitCodes[i].ExitCode == exitCode {
+		stats.TallyExitCodes[i].Count++
+	} else {
+		stats.TallyExitCodes = append(stats.TallyExitCodes, types.ExitCodeCount{})
+		copy(stats.TallyExitCodes[i+1:], stats.TallyExitCodes[i:])
+		stats.TallyExitCodes[i] = types.ExitCodeCount{ExitCode: exitCode, Count: 1}
+	}
+	return k.OracleProgramStats.Set(ctx, stats.Hash, stats)
+}
+
+// GetOracleProgramStats returns the usage statistics of an oracle program
+// given its hex-encoded hash. Programs that have not been used yet have
+// empty statistics.
+func (k Keeper) GetOracleProgramStats(ctx context.Context, hash string) (types.OracleProgramStats, error) {
+	stats, found, err := k.getOracleProgramStats(ctx, hash)
+	if err != nil {
+		return types.OracleProgramStats{}, err
+	}
+	if !found {
+		return types.OracleProgramStats{}, collections.ErrNotFound
+	}
+	return stats, nil
+}
+
+// getOracleProgramStats returns the usage statistics of a stored oracle
+// program, initializing them if the program has not been used yet. The
+// returned boolean is false if the program is not stored.
+func (k Keeper) getOracleProgramStats(ctx context.Context, hash string) (types.OracleProgramStats, bool, error) {
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return types.OracleProgramStats{}, false, types.ErrInvalidHexWasmHash
+	}
+	stats, err := k.OracleProgramStats.Get(ctx, hashBytes)
+	if err == nil {
+		return stats, true, nil
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return types.OracleProgramStats{}, false, err
+	}
+
+	exists, err := k.OracleProgram.Has(ctx, hashBytes)
+	if err != nil || !exists {
+		return types.OracleProgramStats{}, false, err
+	}
+	return types.OracleProgramStats{Hash: hashBytes}, true, nil
+}
+
+// GetAllOracleProgramStats returns the usage statistics of all oracle
+// programs that have been used.
+func (k Keeper) GetAllOracleProgramStats(ctx context.Context) ([]types.OracleProgramStats, error) {
+	iter, err := k.OracleProgramStats.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	"cosmossdk.io/collections"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+func (s *KeeperTestSuite) TestOracleProgramStats() {
+	s.SetupTest()
+
+	program := types.NewOracleProgram([]byte("program"), s.ctx.BlockTime())
+	s.Require().NoError(s.keeper.SetOracleProgram(s.ctx, program))
+	hash := hex.EncodeToString(program.Hash)
+
+	s.Run("Unused programs have empty statistics", func() {
+		res, err := s.queryClient.OracleProgramStats(s.ctx, &types.QueryOracleProgramStatsRequest{Hash: hash})
+		s.Require().NoError(err)
+		s.Require().Equal(types.OracleProgramStats{Hash: program.Hash}, res.Stats)
+	})
+
+	s.Run("Usage is recorded with a sorted exit code histogram", func() {
+		s.Require().NoError(s.keeper.RecordExecProgramUsage(s.ctx.WithBlockHeight(5), hash))
+		for i, exitCode := range []uint32{255, 0, 255, 1} {
+			s.Require().NoError(s.keeper.RecordTallyProgramUsage(s.ctx.WithBlockHeight(int64(10+i)), hash, 100, exitCode))
+		}
+
+		res, err := s.queryClient.OracleProgramStats(s.ctx, &types.QueryOracleProgramStatsRequest{Hash: hash})
+		s.Require().NoError(err)
+		s.Require().Equal(types.OracleProgramStats{
+			Hash:             program.Hash,
+			ExecInvocations:  1,
+			TallyInvocations: 4,
+			LastUsedHeight:   13,
+			TallyGasUsed:     400,
+			TallyExitCodes: []types.ExitCodeCount{
+				{ExitCode: 0, Count: 1},
+				{ExitCode: 1, Count: 1},
+				{ExitCode: 255, Count: 2},
+			},
+		}, res.Stats)
+	})
+
+	s.Run("Usage of programs that are not stored is not recorded", func() {
+		unknown := hex.EncodeToString([]byte("unknown"))
+		s.Require().NoError(s.keeper.RecordTallyProgramUsage(s.ctx, unknown, 100, 0))
+
+		_, err := s.keeper.GetOracleProgramStats(s.ctx, unknown)
+		s.Require().ErrorIs(err, collections.ErrNotFound)
+	})
+}
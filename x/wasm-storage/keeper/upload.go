@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+// SetUploadSession stores a chunked upload session and adds it to the
+// expiration queue.
+func (k Keeper) SetUploadSession(ctx context.Context, session types.UploadSession) error {
+	if err := k.UploadSession.Set(ctx, session.ID, session); err != nil {
+		return err
+	}
+	return k.UploadSessionExpiration.Set(ctx, collections.Join(session.ExpirationHeight, session.ID))
+}
+
+// getUploadSession retrieves a chunked upload session given its ID and
+// checks that it was begun by the given sender.
+func (k Keeper) getUploadSession(ctx context.Context, id uint64, sender string) (types.UploadSession, error) {
+	session, err := k.UploadSession.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.UploadSession{}, types.ErrUploadSessionNotFound.Wrapf("id %d", id)
+		}
+		return types.UploadSession{}, err
+	}
+	if session.Sender != sender {
+		return types.UploadSession{}, sdkerrors.ErrUnauthorized.Wrapf("upload session %d belongs to %s", id, session.Sender)
+	}
+	return session, nil
+}
+
+// getUploadedWasm concatenates the chunks of a chunked upload session in
+// order.
+func (k Keeper) getUploadedWasm(ctx context.Context, session types.UploadSession) ([]byte, error) {
+	iter, err := k.UploadChunk.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint32](session.ID))
+	if err != nil {
+		return nil, err
+	}
+	chunks, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	wasm := make([]byte, 0, session.ReceivedBytes)
+	for _, chunk := range chunks {
+		wasm = append(wasm, chunk...)
+	}
+	return wasm, nil
+}
+
+// removeUploadSession removes a chunked upload session along with its
+// chunks and expiration queue entry.
+func (k Keeper) removeUploadSession(ctx context.Context, session types.UploadSession) error {
+	err := k.UploadChunk.Clear(ctx, collections.NewPrefixedPairRange[uint64, uint32](session.ID))
+	if err != nil {
+		return err
+	}
+	err = k.UploadSessionExpiration.Remove(ctx, collections.Join(session.ExpirationHeight, session.ID))
+	if err != nil {
+		return err
+	}
+	return k.UploadSession.Remove(ctx, session.ID)
+}
+
+// ExpireUploadSessions removes up to MaxPrunesPerBlock chunked upload
+// sessions whose expiration height has been reached and refunds their
+// escrowed storage fees to the senders. A failed refund is logged and the
+// session is removed regardless so that it does not hold up the queue.
+func (k Keeper) ExpireUploadSessions(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	expired, err := k.getExpiredUploadSessions(ctx, ctx.BlockHeight(), int(params.MaxPrunesPerBlock))
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		session, err := k.UploadSession.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if err := k.removeUploadSession(ctx, session); err != nil {
+			return err
+		}
+		if err := k.refundUploadSession(ctx, session); err != nil {
+			k.Logger(ctx).Error("failed to refund expired upload session", "error", err, "upload_id", session.ID, "sender", session.Sender)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExpireUpload,
+			sdk.NewAttribute(types.AttributeUploadID, strconv.FormatUint(session.ID, 10)),
+			sdk.NewAttribute(types.AttributeSender, session.Sender),
+			sdk.NewAttribute(types.AttributeStorageFee, session.StorageFee.String()),
+		))
+	}
+	return nil
+}
+
+// refundUploadSession returns the storage fee escrowed by a chunked
+// upload session to its sender.
+func (k Keeper) refundUploadSession(ctx sdk.Context, session types.UploadSession) error {
+	sender, err := sdk.AccAddressFromBech32(session.Sender)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, session.StorageFee)
+}
+
+// getExpiredUploadSessions returns up to limit expiration queue entries of
+// the chunked upload sessions expiring at or before the given height.
+func (k Keeper) getExpiredUploadSessions(ctx context.Context, height int64, limit int) ([]collections.Pair[int64, uint64], error) {
+	rng := collections.NewPrefixUntilPairRange[int64, uint64](height)
+	iter, err := k.UploadSessionExpiration.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var expired []collections.Pair[int64, uint64]
+	for ; iter.Valid() && len(expired) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		expired = append(expired, key)
+	}
+	return expired, nil
+}
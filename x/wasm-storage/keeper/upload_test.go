@@ -0,0 +1,280 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	appparams "github.com/sedaprotocol/seda-chain/app/params"
+	"github.com/sedaprotocol/seda-chain/testutil/testwasms"
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+func (s *KeeperTestSuite) TestChunkedUpload() {
+	s.SetupTest()
+	s.ctx = s.ctx.WithBlockHeight(100)
+	s.mockStakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("aseda", nil).AnyTimes()
+
+	wasm := testwasms.MemoryWasm()
+	zipped, err := ioutils.GzipIt(wasm)
+	s.Require().NoError(err)
+	hash := hex.EncodeToString(types.NewOracleProgram(wasm, s.ctx.BlockTime()).Hash)
+	storageFee := sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(len(wasm))).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte)))))
+
+	begin := func() uint64 {
+		s.mockBankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, storageFee).Return(nil)
+		res, err := s.msgSrvr.BeginOracleProgramUpload(s.ctx, &types.MsgBeginOracleProgramUpload{
+			Sender:       s.authority,
+			Hash:         hash,
+			UnzippedSize: uint64(len(wasm)),
+			ZippedSize:   uint64(len(zipped)),
+			StorageFee:   storageFee,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(s.ctx.BlockHeight()+types.DefaultUploadSessionTTL, res.ExpirationHeight)
+		return res.UploadID
+	}
+
+	s.Run("Storage fee must cover the declared size", func() {
+		_, err := s.msgSrvr.BeginOracleProgramUpload(s.ctx, &types.MsgBeginOracleProgramUpload{
+			Sender:       s.authority,
+			Hash:         hash,
+			UnzippedSize: uint64(len(wasm)) + 1,
+			ZippedSize:   uint64(len(zipped)),
+			StorageFee:   storageFee,
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	})
+
+	s.Run("Zipped size cannot exceed the max wasm size", func() {
+		_, err := s.msgSrvr.BeginOracleProgramUpload(s.ctx, &types.MsgBeginOracleProgramUpload{
+			Sender:       s.authority,
+			Hash:         hash,
+			UnzippedSize: uint64(len(wasm)),
+			ZippedSize:   uint64(types.DefaultMaxWasmSize) + 1,
+			StorageFee:   storageFee,
+		})
+		s.Require().ErrorIs(err, types.ErrWasmTooLarge)
+	})
+
+	s.Run("Owner must be the sender", func() {
+		_, err := s.msgSrvr.BeginOracleProgramUpload(s.ctx, &types.MsgBeginOracleProgramUpload{
+			Sender:       s.authority,
+			Hash:         hash,
+			UnzippedSize: uint64(len(wasm)),
+			ZippedSize:   uint64(len(zipped)),
+			StorageFee:   storageFee,
+			Metadata:     types.OracleProgramMetadata{Owner: testAddrs[0].String()},
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	})
+
+	s.Run("Uploading in chunks stores the program", func() {
+		id := begin()
+
+		mid := len(zipped) / 2
+		for _, chunk := range [][]byte{zipped[:mid], zipped[mid:]} {
+			_, err := s.msgSrvr.AppendOracleProgramChunk(s.ctx, &types.MsgAppendOracleProgramChunk{
+				Sender:   s.authority,
+				UploadID: id,
+				Chunk:    chunk,
+			})
+			s.Require().NoError(err)
+		}
+
+		session, err := s.queryClient.UploadSession(s.ctx, &types.QueryUploadSessionRequest{UploadId: id})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(len(zipped)), session.UploadSession.ReceivedBytes)
+		s.Require().Equal(uint32(2), session.UploadSession.ChunkCount)
+
+		// Only the sender of the session can finalize it.
+		_, err = s.msgSrvr.FinalizeOracleProgramUpload(s.ctx, &types.MsgFinalizeOracleProgramUpload{
+			Sender:   testAddrs[0].String(),
+			UploadID: id,
+		})
+		s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+		s.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, storageFee).Return(nil)
+		res, err := s.msgSrvr.FinalizeOracleProgramUpload(s.ctx, &types.MsgFinalizeOracleProgramUpload{
+			Sender:   s.authority,
+			UploadID: id,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(hash, res.Hash)
+
+		program, err := s.keeper.GetOracleProgram(s.ctx, hash)
+		s.Require().NoError(err)
+		s.Require().Equal(wasm, program.Bytecode)
+
+		// The session and its chunks are removed.
+		has, err := s.keeper.UploadSession.Has(s.ctx, id)
+		s.Require().NoError(err)
+		s.Require().False(has)
+		has, err = s.keeper.UploadChunk.Has(s.ctx, collections.Join(id, uint32(0)))
+		s.Require().NoError(err)
+		s.Require().False(has)
+
+		// The program can no longer be uploaded.
+		_, err = s.msgSrvr.BeginOracleProgramUpload(s.ctx, &types.MsgBeginOracleProgramUpload{
+			Sender:       s.authority,
+			Hash:         hash,
+			UnzippedSize: uint64(len(wasm)),
+			ZippedSize:   uint64(len(zipped)),
+			StorageFee:   storageFee,
+		})
+		s.Require().ErrorIs(err, types.ErrWasmAlreadyExists)
+	})
+
+	s.Require().NoError(s.keeper.OracleProgram.Remove(s.ctx, types.NewOracleProgram(wasm, s.ctx.BlockTime()).Hash))
+
+	s.Run("Uploads cannot exceed the declared size", func() {
+		id := begin()
+		_, err := s.msgSrvr.AppendOracleProgramChunk(s.ctx, &types.MsgAppendOracleProgramChunk{
+			Sender:   s.authority,
+			UploadID: id,
+			Chunk:    make([]byte, len(zipped)+1),
+		})
+		s.Require().ErrorIs(err, types.ErrUploadTooLarge)
+	})
+
+	s.Run("Finalization requires the declared zipped size", func() {
+		id := begin()
+		_, err := s.msgSrvr.AppendOracleProgramChunk(s.ctx, &types.MsgAppendOracleProgramChunk{
+			Sender:   s.authority,
+			UploadID: id,
+			Chunk:    zipped[:len(zipped)-1],
+		})
+		s.Require().NoError(err)
+
+		_, err = s.msgSrvr.FinalizeOracleProgramUpload(s.ctx, &types.MsgFinalizeOracleProgramUpload{
+			Sender:   s.authority,
+			UploadID: id,
+		})
+		s.Require().ErrorIs(err, types.ErrUploadMismatch)
+	})
+
+	s.Run("Programs stored in the meantime are refunded", func() {
+		id := begin()
+		_, err := s.msgSrvr.AppendOracleProgramChunk(s.ctx, &types.MsgAppendOracleProgramChunk{
+			Sender:   s.authority,
+			UploadID: id,
+			Chunk:    zipped,
+		})
+		s.Require().NoError(err)
+
+		program := types.NewOracleProgram(wasm, s.ctx.BlockTime())
+		s.Require().NoError(s.keeper.SetOracleProgram(s.ctx, program))
+
+		sender, err := sdk.AccAddressFromBech32(s.authority)
+		s.Require().NoError(err)
+		s.mockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sender, storageFee).Return(nil)
+		res, err := s.msgSrvr.FinalizeOracleProgramUpload(s.ctx, &types.MsgFinalizeOracleProgramUpload{
+			Sender:   s.authority,
+			UploadID: id,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(hash, res.Hash)
+
+		has, err := s.keeper.UploadSession.Has(s.ctx, id)
+		s.Require().NoError(err)
+		s.Require().False(has)
+		s.Require().NoError(s.keeper.OracleProgram.Remove(s.ctx, program.Hash))
+	})
+
+	s.Run("Finalization verifies the declared hash", func() {
+		other := testwasms.MaxDrWasm()
+		otherZipped, err := ioutils.GzipIt(other)
+		s.Require().NoError(err)
+		fee := sdk.NewCoins(sdk.NewCoin(appparams.DefaultBondDenom, sdkmath.NewInt(int64(len(other))).Mul(sdkmath.NewInt(int64(types.DefaultWasmCostPerByte)))))
+
+		s.mockBankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, fee).Return(nil)
+		res, err := s.msgSrvr.BeginOracleProgramUpload(s.ctx, &types.MsgBeginOracleProgramUpload{
+			Sender:       s.authority,
+			Hash:         hash,
+			UnzippedSize: uint64(len(other)),
+			ZippedSize:   uint64(len(otherZipped)),
+			StorageFee:   fee,
+		})
+		s.Require().NoError(err)
+
+		_, err = s.msgSrvr.AppendOracleProgramChunk(s.ctx, &types.MsgAppendOracleProgramChunk{
+			Sender:   s.authority,
+			UploadID: res.UploadID,
+			Chunk:    otherZipped,
+		})
+		s.Require().NoError(err)
+
+		_, err = s.msgSrvr.FinalizeOracleProgramUpload(s.ctx, &types.MsgFinalizeOracleProgramUpload{
+			Sender:   s.authority,
+			UploadID: res.UploadID,
+		})
+		s.Require().ErrorIs(err, types.ErrUploadMismatch)
+	})
+
+	s.Run("Expired sessions are refunded", func() {
+		sessions, err := s.keeper.UploadSession.Iterate(s.ctx, nil)
+		s.Require().NoError(err)
+		pending, err := sessions.Values()
+		s.Require().NoError(err)
+		s.Require().Len(pending, 3)
+
+		// Sessions are kept until their expiration height.
+		s.Require().NoError(s.keeper.EndBlock(s.ctx.WithBlockHeight(pending[0].ExpirationHeight - 1)))
+
+		// At most MaxPrunesPerBlock sessions are removed per block.
+		params := types.DefaultParams()
+		params.MaxPrunesPerBlock = 1
+		s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+		for _, session := range pending {
+			sender, err := sdk.AccAddressFromBech32(session.Sender)
+			s.Require().NoError(err)
+			s.mockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sender, session.StorageFee).Return(nil)
+		}
+		for i := range pending {
+			ctx := s.ctx.WithBlockHeight(pending[0].ExpirationHeight + int64(i)).WithEventManager(sdk.NewEventManager())
+			s.Require().NoError(s.keeper.EndBlock(ctx))
+			s.Require().Len(ctx.EventManager().Events(), 1)
+			s.Require().Equal(types.EventTypeExpireUpload, ctx.EventManager().Events()[0].Type)
+		}
+
+		_, err = s.queryClient.UploadSession(s.ctx, &types.QueryUploadSessionRequest{UploadId: pending[0].ID})
+		s.Require().ErrorContains(err, types.ErrUploadSessionNotFound.Error())
+	})
+
+	s.Run("Failed refunds do not halt expiration", func() {
+		s.Require().NoError(s.keeper.Params.Set(s.ctx, types.DefaultParams()))
+		id := begin()
+
+		s.mockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), storageFee).Return(sdkerrors.ErrInsufficientFunds)
+		ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + types.DefaultUploadSessionTTL).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.keeper.EndBlock(ctx))
+		s.Require().Empty(ctx.EventManager().Events())
+
+		_, err := s.queryClient.UploadSession(s.ctx, &types.QueryUploadSessionRequest{UploadId: id})
+		s.Require().ErrorContains(err, types.ErrUploadSessionNotFound.Error())
+	})
+
+	s.Run("Chunked uploads can be disabled", func() {
+		params := types.DefaultParams()
+		params.UploadSessionTTL = 0
+		s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+		_, err := s.msgSrvr.BeginOracleProgramUpload(s.ctx, &types.MsgBeginOracleProgramUpload{
+			Sender:       s.authority,
+			Hash:         hash,
+			UnzippedSize: uint64(len(wasm)),
+			ZippedSize:   uint64(len(zipped)),
+			StorageFee:   storageFee,
+		})
+		s.Require().ErrorIs(err, types.ErrChunkedUploadDisabled)
+	})
+}
@@ -3,11 +3,27 @@ package types
 import "cosmossdk.io/errors"
 
 var (
-	ErrInvalidParam          = errors.Register(ModuleName, 2, "invalid param")
-	ErrWasmAlreadyExists     = errors.Register(ModuleName, 3, "wasm with the same hash already exists")
-	ErrWasmTooLarge          = errors.Register(ModuleName, 4, "wasm size is too large")
-	ErrWasmTooSmall          = errors.Register(ModuleName, 5, "wasm size is too small")
-	ErrInvalidHexWasmHash    = errors.Register(ModuleName, 6, "invalid hex-encoded wasm hash")
-	ErrWasmNotGzipCompressed = errors.Register(ModuleName, 7, "wasm is not gzip compressed")
-	ErrInvalidAuthority      = errors.Register(ModuleName, 8, "invalid authority")
+	ErrInvalidParam              = errors.Register(ModuleName, 2, "invalid param")
+	ErrWasmAlreadyExists         = errors.Register(ModuleName, 3, "wasm with the same hash already exists")
+	ErrWasmTooLarge              = errors.Register(ModuleName, 4, "wasm size is too large")
+	ErrWasmTooSmall              = errors.Register(ModuleName, 5, "wasm size is too small")
+	ErrInvalidHexWasmHash        = errors.Register(ModuleName, 6, "invalid hex-encoded wasm hash")
+	ErrWasmNotGzipCompressed     = errors.Register(ModuleName, 7, "wasm is not gzip compressed")
+	ErrInvalidAuthority          = errors.Register(ModuleName, 8, "invalid authority")
+	ErrInvalidWasm               = errors.Register(ModuleName, 9, "invalid wasm module")
+	ErrMissingWasmExport         = errors.Register(ModuleName, 10, "missing required wasm export")
+	ErrDisallowedWasmImport      = errors.Register(ModuleName, 11, "disallowed wasm import")
+	ErrNonDeterministicWasm      = errors.Register(ModuleName, 12, "non-deterministic wasm instruction")
+	ErrWasmLimitExceeded         = errors.Register(ModuleName, 13, "wasm module limit exceeded")
+	ErrRentDisabled              = errors.Register(ModuleName, 14, "oracle program rent is disabled")
+	ErrProgramDoesNotExpire      = errors.Register(ModuleName, 15, "oracle program does not expire")
+	ErrCoreContractNotRegistered = errors.Register(ModuleName, 16, "core contract is not registered")
+	ErrOracleProgramBlocked      = errors.Register(ModuleName, 17, "oracle program is blocked")
+	ErrOracleProgramNotBlocked   = errors.Register(ModuleName, 18, "oracle program is not blocked")
+	ErrChunkedUploadDisabled     = errors.Register(ModuleName, 19, "chunked upload is disabled")
+	ErrUploadSessionNotFound     = errors.Register(ModuleName, 20, "upload session not found")
+	ErrUploadTooLarge            = errors.Register(ModuleName, 21, "upload exceeds declared size")
+	ErrUploadMismatch            = errors.Register(ModuleName, 22, "uploaded wasm does not match declaration")
+	ErrOracleProgramNotFound     = errors.Register(ModuleName, 23, "oracle program not found")
+	ErrCoreContractRegistered    = errors.Register(ModuleName, 24, "core contract already registered in this block")
 )
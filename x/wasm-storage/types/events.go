@@ -1,8 +1,23 @@
 package types
 
 const (
-	EventTypeStoreOracleProgram = "store_oracle_program"
+	EventTypeStoreOracleProgram   = "store_oracle_program"
+	EventTypeTopUpOracleProgram   = "top_up_oracle_program"
+	EventTypePruneOracleProgram   = "prune_oracle_program"
+	EventTypeRegisterCoreContract = "register_core_contract"
+	EventTypeBlockOracleProgram   = "block_oracle_program"
+	EventTypeUnblockOracleProgram = "unblock_oracle_program"
+	EventTypeBeginUpload          = "begin_oracle_program_upload"
+	EventTypeExpireUpload         = "expire_oracle_program_upload"
 
 	AttributeOracleProgramHash = "oracle_program_hash"
 	AttributeSender            = "sender"
+	AttributeExpirationHeight  = "expiration_height"
+	AttributeRent              = "rent"
+	AttributeContractAddress   = "contract_address"
+	AttributeCodeID            = "code_id"
+	AttributeActivationHeight  = "activation_height"
+	AttributeReason            = "reason"
+	AttributeUploadID          = "upload_id"
+	AttributeStorageFee        = "storage_fee"
 )
@@ -1,21 +1,26 @@
 package types
 
 import (
+	"encoding/hex"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState constructs a GenesisState object.
-func NewGenesisState(params Params, programs []OracleProgram, coreAddr string) GenesisState {
+func NewGenesisState(params Params, programs []OracleProgram, coreAddr string, history []CoreContractRegistryEntry, blocked []BlockedOracleProgram) GenesisState {
 	return GenesisState{
-		Params:               params,
-		OraclePrograms:       programs,
-		CoreContractRegistry: coreAddr,
+		Params:                params,
+		OraclePrograms:        programs,
+		CoreContractRegistry:  coreAddr,
+		CoreContractHistory:   history,
+		BlockedOraclePrograms: blocked,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object.
 func DefaultGenesisState() *GenesisState {
-	state := NewGenesisState(DefaultParams(), nil, "")
+	state := NewGenesisState(DefaultParams(), nil, "", nil, nil)
 	return &state
 }
 
@@ -27,5 +32,38 @@ func ValidateGenesis(gs GenesisState) error {
 			return err
 		}
 	}
+
+	seenHeights := make(map[int64]bool, len(gs.CoreContractHistory))
+	for _, entry := range gs.CoreContractHistory {
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return err
+		}
+		if seenHeights[entry.ActivationHeight] {
+			return fmt.Errorf("duplicate core contract history entry at height %d", entry.ActivationHeight)
+		}
+		seenHeights[entry.ActivationHeight] = true
+	}
+
+	for _, blocked := range gs.BlockedOraclePrograms {
+		if _, err := hex.DecodeString(blocked.Hash); err != nil {
+			return ErrInvalidHexWasmHash
+		}
+	}
+
+	sessions := make(map[uint64]bool, len(gs.UploadSessions))
+	for _, session := range gs.UploadSessions {
+		if _, err := sdk.AccAddressFromBech32(session.Sender); err != nil {
+			return err
+		}
+		if session.ID >= gs.NextUploadID {
+			return fmt.Errorf("upload session id %d is not below next upload id %d", session.ID, gs.NextUploadID)
+		}
+		sessions[session.ID] = true
+	}
+	for _, chunk := range gs.UploadChunks {
+		if !sessions[chunk.UploadID] {
+			return fmt.Errorf("upload chunk of unknown upload session %d", chunk.UploadID)
+		}
+	}
 	return gs.Params.Validate()
 }
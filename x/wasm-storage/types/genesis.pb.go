@@ -25,9 +25,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines wasm-storage module's genesis state.
 type GenesisState struct {
-	Params               Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	OraclePrograms       []OracleProgram `protobuf:"bytes,2,rep,name=oracle_programs,json=oraclePrograms,proto3" json:"oracle_programs"`
-	CoreContractRegistry string          `protobuf:"bytes,3,opt,name=core_contract_registry,json=coreContractRegistry,proto3" json:"core_contract_registry,omitempty"`
+	Params                Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	OraclePrograms        []OracleProgram             `protobuf:"bytes,2,rep,name=oracle_programs,json=oraclePrograms,proto3" json:"oracle_programs"`
+	CoreContractRegistry  string                      `protobuf:"bytes,3,opt,name=core_contract_registry,json=coreContractRegistry,proto3" json:"core_contract_registry,omitempty"`
+	CoreContractHistory   []CoreContractRegistryEntry `protobuf:"bytes,4,rep,name=core_contract_history,json=coreContractHistory,proto3" json:"core_contract_history"`
+	BlockedOraclePrograms []BlockedOracleProgram      `protobuf:"bytes,5,rep,name=blocked_oracle_programs,json=blockedOraclePrograms,proto3" json:"blocked_oracle_programs"`
+	UploadSessions        []UploadSession             `protobuf:"bytes,6,rep,name=upload_sessions,json=uploadSessions,proto3" json:"upload_sessions"`
+	UploadChunks          []UploadChunk               `protobuf:"bytes,7,rep,name=upload_chunks,json=uploadChunks,proto3" json:"upload_chunks"`
+	NextUploadID          uint64                      `protobuf:"varint,8,opt,name=next_upload_id,json=nextUploadId,proto3" json:"next_upload_id,omitempty"`
+	OracleProgramStats    []OracleProgramStats        `protobuf:"bytes,9,rep,name=oracle_program_stats,json=oracleProgramStats,proto3" json:"oracle_program_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,8 +90,112 @@ func (m *GenesisState) GetCoreContractRegistry() string {
 	return ""
 }
 
+func (m *GenesisState) GetCoreContractHistory() []CoreContractRegistryEntry {
+	if m != nil {
+		return m.CoreContractHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockedOraclePrograms() []BlockedOracleProgram {
+	if m != nil {
+		return m.BlockedOraclePrograms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUploadSessions() []UploadSession {
+	if m != nil {
+		return m.UploadSessions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUploadChunks() []UploadChunk {
+	if m != nil {
+		return m.UploadChunks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextUploadID() uint64 {
+	if m != nil {
+		return m.NextUploadID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetOracleProgramStats() []OracleProgramStats {
+	if m != nil {
+		return m.OracleProgramStats
+	}
+	return nil
+}
+
+// UploadChunk is a chunk of an in-progress chunked upload.
+type UploadChunk struct {
+	UploadID uint64 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Index    uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Data     []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *UploadChunk) Reset()         { *m = UploadChunk{} }
+func (m *UploadChunk) String() string { return proto.CompactTextString(m) }
+func (*UploadChunk) ProtoMessage()    {}
+func (*UploadChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7dee811c18d199f, []int{1}
+}
+func (m *UploadChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UploadChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UploadChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadChunk.Merge(m, src)
+}
+func (m *UploadChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *UploadChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadChunk proto.InternalMessageInfo
+
+func (m *UploadChunk) GetUploadID() uint64 {
+	if m != nil {
+		return m.UploadID
+	}
+	return 0
+}
+
+func (m *UploadChunk) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *UploadChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sedachain.wasm_storage.v1.GenesisState")
+	proto.RegisterType((*UploadChunk)(nil), "sedachain.wasm_storage.v1.UploadChunk")
 }
 
 func init() {
@@ -93,26 +203,40 @@ func init() {
 }
 
 var fileDescriptor_f7dee811c18d199f = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x18, 0x86, 0x1b, 0x27, 0x03, 0x33, 0x51, 0x28, 0x43, 0xe6, 0x0e, 0x71, 0x7a, 0xb1, 0x07, 0x97,
-	0xb0, 0x29, 0x78, 0x14, 0xe6, 0xc1, 0xa3, 0xb3, 0x1e, 0x04, 0x2f, 0x25, 0x8b, 0x21, 0x2b, 0xac,
-	0xfd, 0x4a, 0x12, 0xa7, 0xfb, 0x17, 0xfe, 0xac, 0x1d, 0x77, 0xf4, 0x34, 0xa4, 0xfd, 0x23, 0xd2,
-	0xb4, 0xca, 0x3c, 0xcc, 0x5b, 0x92, 0xef, 0x79, 0x9f, 0x7c, 0xbc, 0xf8, 0xdc, 0xc8, 0x17, 0x2e,
-	0xa6, 0x3c, 0x4e, 0xd9, 0x1b, 0x37, 0x49, 0x64, 0x2c, 0x68, 0xae, 0x24, 0x9b, 0x0f, 0x98, 0x92,
-	0xa9, 0x34, 0xb1, 0xa1, 0x99, 0x06, 0x0b, 0xfe, 0xf1, 0x2f, 0x48, 0x37, 0x41, 0x3a, 0x1f, 0x74,
-	0xdb, 0x0a, 0x14, 0x38, 0x8a, 0x95, 0xa7, 0x2a, 0xd0, 0xbd, 0xd8, 0x6e, 0xfe, 0x23, 0x70, 0xf4,
-	0xd9, 0x1a, 0xe1, 0xfd, 0xbb, 0xea, 0xc3, 0x47, 0xcb, 0xad, 0xf4, 0x6f, 0x70, 0x33, 0xe3, 0x9a,
-	0x27, 0xa6, 0x83, 0x7a, 0x28, 0x68, 0x0d, 0x4f, 0xe9, 0xd6, 0x05, 0xe8, 0xd8, 0x81, 0xa3, 0xdd,
-	0xe5, 0xfa, 0xc4, 0x0b, 0xeb, 0x98, 0xff, 0x84, 0x0f, 0x41, 0x73, 0x31, 0x93, 0x51, 0xa6, 0x41,
-	0x39, 0xd3, 0x4e, 0xaf, 0x11, 0xb4, 0x86, 0xc1, 0x3f, 0xa6, 0x7b, 0x97, 0x18, 0x57, 0x81, 0x5a,
-	0x78, 0x00, 0x9b, 0x8f, 0xc6, 0xbf, 0xc2, 0x47, 0x02, 0xb4, 0x8c, 0x04, 0xa4, 0x56, 0x73, 0x61,
-	0x23, 0x2d, 0x55, 0x6c, 0xac, 0x5e, 0x74, 0x1a, 0x3d, 0x14, 0xec, 0x85, 0xed, 0x72, 0x7a, 0x5b,
-	0x0f, 0xc3, 0x7a, 0x36, 0x7a, 0x58, 0xe6, 0x04, 0xad, 0x72, 0x82, 0xbe, 0x72, 0x82, 0x3e, 0x0a,
-	0xe2, 0xad, 0x0a, 0xe2, 0x7d, 0x16, 0xc4, 0x7b, 0xbe, 0x56, 0xb1, 0x9d, 0xbe, 0x4e, 0xa8, 0x80,
-	0x84, 0x95, 0x9b, 0xb9, 0x42, 0x04, 0xcc, 0xdc, 0xa5, 0x5f, 0x35, 0xf8, 0xee, 0x3a, 0xeb, 0xff,
-	0x74, 0x68, 0x17, 0x99, 0x34, 0x93, 0xa6, 0x23, 0x2f, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x16,
-	0x1a, 0x8a, 0x36, 0xc4, 0x01, 0x00, 0x00,
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6f, 0xd3, 0x3e,
+	0x14, 0xc7, 0x9b, 0xad, 0xeb, 0xaf, 0x75, 0xb3, 0xfd, 0x90, 0xe9, 0x20, 0xec, 0x90, 0x86, 0x1d,
+	0x20, 0x48, 0x34, 0xd1, 0xc6, 0x04, 0x47, 0xa4, 0x16, 0x04, 0x5c, 0x60, 0xcb, 0x84, 0x90, 0xb8,
+	0x44, 0x4e, 0x62, 0xa5, 0xd1, 0x5a, 0x3b, 0xb2, 0x9d, 0xd1, 0xfe, 0x07, 0x1c, 0xf9, 0xb3, 0x76,
+	0xdc, 0x91, 0x53, 0x85, 0xd2, 0x7f, 0x04, 0xc5, 0x4e, 0xd7, 0x14, 0xba, 0x8e, 0x9b, 0xed, 0xf7,
+	0xbe, 0x9f, 0xf7, 0xf5, 0xd3, 0x7b, 0xe0, 0x29, 0xc7, 0x11, 0x0a, 0x87, 0x28, 0x21, 0xee, 0x37,
+	0xc4, 0xc7, 0x3e, 0x17, 0x94, 0xa1, 0x18, 0xbb, 0x97, 0x47, 0x6e, 0x8c, 0x09, 0xe6, 0x09, 0x77,
+	0x52, 0x46, 0x05, 0x85, 0x8f, 0x6e, 0x12, 0x9d, 0x6a, 0xa2, 0x73, 0x79, 0x74, 0xd0, 0x89, 0x69,
+	0x4c, 0x65, 0x96, 0x5b, 0x9c, 0x94, 0xe0, 0xe0, 0xf9, 0xed, 0xe4, 0x15, 0x80, 0xcc, 0x3e, 0xfc,
+	0xde, 0x00, 0xfa, 0x3b, 0x55, 0xf0, 0x5c, 0x20, 0x81, 0xe1, 0x6b, 0xd0, 0x48, 0x11, 0x43, 0x63,
+	0x6e, 0x68, 0x96, 0x66, 0xb7, 0x8f, 0x1f, 0x3b, 0xb7, 0x1a, 0x70, 0x4e, 0x65, 0x62, 0xbf, 0x7e,
+	0x35, 0xeb, 0xd6, 0xbc, 0x52, 0x06, 0xbf, 0x80, 0xff, 0x29, 0x43, 0xe1, 0x08, 0xfb, 0x29, 0xa3,
+	0xb1, 0x24, 0x6d, 0x59, 0xdb, 0x76, 0xfb, 0xd8, 0xde, 0x40, 0xfa, 0x24, 0x15, 0xa7, 0x4a, 0x50,
+	0x02, 0xf7, 0x68, 0xf5, 0x91, 0xc3, 0x13, 0xf0, 0x20, 0xa4, 0x0c, 0xfb, 0x21, 0x25, 0x82, 0xa1,
+	0x50, 0xf8, 0x0c, 0xc7, 0x09, 0x17, 0x6c, 0x6a, 0x6c, 0x5b, 0x9a, 0xdd, 0xf2, 0x3a, 0x45, 0x74,
+	0x50, 0x06, 0xbd, 0x32, 0x06, 0x09, 0xd8, 0x5f, 0x55, 0x0d, 0x93, 0xa2, 0xee, 0xd4, 0xa8, 0x4b,
+	0x53, 0x27, 0x1b, 0x4c, 0x0d, 0xd6, 0xf0, 0xde, 0x12, 0xc1, 0xa6, 0xa5, 0xc1, 0xfb, 0xd5, 0x82,
+	0xef, 0x15, 0x16, 0x8e, 0xc1, 0xc3, 0x60, 0x44, 0xc3, 0x0b, 0x1c, 0xf9, 0x7f, 0xb6, 0x61, 0x47,
+	0x56, 0x74, 0x37, 0x54, 0xec, 0x2b, 0xe5, 0xba, 0x6e, 0xec, 0x07, 0x6b, 0x62, 0xb2, 0xdb, 0x59,
+	0x3a, 0xa2, 0x28, 0xf2, 0x39, 0xe6, 0x3c, 0xa1, 0x84, 0x1b, 0x8d, 0x3b, 0xbb, 0xfd, 0x59, 0x2a,
+	0xce, 0x95, 0x60, 0xd1, 0xed, 0xac, 0xfa, 0xc8, 0xe1, 0x19, 0xd8, 0x2d, 0xc1, 0xe1, 0x30, 0x23,
+	0x17, 0xdc, 0xf8, 0x4f, 0x62, 0x9f, 0xdc, 0x89, 0x1d, 0x14, 0xe9, 0x25, 0x54, 0xcf, 0x96, 0x4f,
+	0x1c, 0xbe, 0x04, 0x7b, 0x04, 0x4f, 0x84, 0x5f, 0x72, 0x93, 0xc8, 0x68, 0x5a, 0x9a, 0x5d, 0xef,
+	0xdf, 0xcb, 0x67, 0x5d, 0xfd, 0x23, 0x9e, 0x08, 0x05, 0xf8, 0xf0, 0xc6, 0xd3, 0xc9, 0xf2, 0x16,
+	0x41, 0x0c, 0x3a, 0xab, 0xad, 0xf4, 0xb9, 0x40, 0x82, 0x1b, 0x2d, 0xe9, 0xa8, 0xf7, 0xaf, 0x63,
+	0x55, 0xcc, 0xf7, 0x62, 0x58, 0x21, 0xfd, 0x2b, 0x72, 0x18, 0x80, 0x76, 0xe5, 0x07, 0xf0, 0x19,
+	0x68, 0x2d, 0x8d, 0x6a, 0xd2, 0xa8, 0x9e, 0xcf, 0xba, 0xcd, 0x1b, 0x93, 0xcd, 0x6c, 0x61, 0xb0,
+	0x03, 0x76, 0x12, 0x12, 0xe1, 0x89, 0xb1, 0x65, 0x69, 0xf6, 0xae, 0xa7, 0x2e, 0x10, 0x82, 0x7a,
+	0x84, 0x04, 0x92, 0xd3, 0xa9, 0x7b, 0xf2, 0xdc, 0x3f, 0xbb, 0xca, 0x4d, 0xed, 0x3a, 0x37, 0xb5,
+	0x5f, 0xb9, 0xa9, 0xfd, 0x98, 0x9b, 0xb5, 0xeb, 0xb9, 0x59, 0xfb, 0x39, 0x37, 0x6b, 0x5f, 0x5f,
+	0xc5, 0x89, 0x18, 0x66, 0x81, 0x13, 0xd2, 0xb1, 0x5b, 0x7c, 0x48, 0xae, 0x67, 0x48, 0x47, 0xf2,
+	0xd2, 0x53, 0xfb, 0x3c, 0x91, 0x1b, 0xdc, 0x5b, 0x6c, 0xb4, 0x98, 0xa6, 0x98, 0x07, 0x0d, 0x99,
+	0xf9, 0xe2, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0xf5, 0x7b, 0x63, 0x52, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +259,81 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OracleProgramStats) > 0 {
+		for iNdEx := len(m.OracleProgramStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleProgramStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextUploadID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextUploadID))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.UploadChunks) > 0 {
+		for iNdEx := len(m.UploadChunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UploadChunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.UploadSessions) > 0 {
+		for iNdEx := len(m.UploadSessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UploadSessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BlockedOraclePrograms) > 0 {
+		for iNdEx := len(m.BlockedOraclePrograms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedOraclePrograms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CoreContractHistory) > 0 {
+		for iNdEx := len(m.CoreContractHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoreContractHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.CoreContractRegistry) > 0 {
 		i -= len(m.CoreContractRegistry)
 		copy(dAtA[i:], m.CoreContractRegistry)
@@ -169,6 +368,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UploadChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UploadID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UploadID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -198,6 +437,58 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.CoreContractHistory) > 0 {
+		for _, e := range m.CoreContractHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedOraclePrograms) > 0 {
+		for _, e := range m.BlockedOraclePrograms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UploadSessions) > 0 {
+		for _, e := range m.UploadSessions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UploadChunks) > 0 {
+		for _, e := range m.UploadChunks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextUploadID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextUploadID))
+	}
+	if len(m.OracleProgramStats) > 0 {
+		for _, e := range m.OracleProgramStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UploadChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UploadID != 0 {
+		n += 1 + sovGenesis(uint64(m.UploadID))
+	}
+	if m.Index != 0 {
+		n += 1 + sovGenesis(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -335,6 +626,317 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.CoreContractRegistry = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreContractHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoreContractHistory = append(m.CoreContractHistory, CoreContractRegistryEntry{})
+			if err := m.CoreContractHistory[len(m.CoreContractHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedOraclePrograms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedOraclePrograms = append(m.BlockedOraclePrograms, BlockedOracleProgram{})
+			if err := m.BlockedOraclePrograms[len(m.BlockedOraclePrograms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadSessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadSessions = append(m.UploadSessions, UploadSession{})
+			if err := m.UploadSessions[len(m.UploadSessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadChunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadChunks = append(m.UploadChunks, UploadChunk{})
+			if err := m.UploadChunks[len(m.UploadChunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUploadID", wireType)
+			}
+			m.NextUploadID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextUploadID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleProgramStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleProgramStats = append(m.OracleProgramStats, OracleProgramStats{})
+			if err := m.OracleProgramStats[len(m.OracleProgramStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UploadChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
+			}
+			m.UploadID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -21,4 +21,30 @@ var (
 	CoreContractRegistryPrefix = collections.NewPrefix(1)
 	// ParamsPrefix defines prefix to store parameters of wasm-storage module.
 	ParamsPrefix = collections.NewPrefix(2)
+	// OracleProgramExpirationPrefix defines prefix to store the queue of
+	// oracle programs by expiration height.
+	OracleProgramExpirationPrefix = collections.NewPrefix(3)
+	// CoreContractHistoryPrefix defines prefix to store the history of
+	// Core Contract Registry entries by activation height.
+	CoreContractHistoryPrefix = collections.NewPrefix(4)
+	// BlockedOracleProgramPrefix defines prefix to store the oracle
+	// programs blocked by governance.
+	BlockedOracleProgramPrefix = collections.NewPrefix(5)
+	// UploadSessionPrefix defines prefix to store chunked upload sessions.
+	UploadSessionPrefix = collections.NewPrefix(6)
+	// UploadChunkPrefix defines prefix to store the chunks of chunked
+	// upload sessions.
+	UploadChunkPrefix = collections.NewPrefix(7)
+	// UploadSessionExpirationPrefix defines prefix to store the queue of
+	// chunked upload sessions by expiration height.
+	UploadSessionExpirationPrefix = collections.NewPrefix(8)
+	// UploadSessionIDPrefix defines prefix to store the next upload
+	// session ID.
+	UploadSessionIDPrefix = collections.NewPrefix(9)
+	// OracleProgramStatsPrefix defines prefix to store the usage
+	// statistics of oracle programs.
+	OracleProgramStatsPrefix = collections.NewPrefix(10)
+	// OracleProgramAddedAtPrefix defines prefix to store the index of
+	// oracle programs by the time they were added.
+	OracleProgramAddedAtPrefix = collections.NewPrefix(11)
 )
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"encoding/hex"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MaxMetadataNameLength        = 128
+	MaxMetadataVersionLength     = 64
+	MaxMetadataRepositoryLength  = 256
+	MaxMetadataDescriptionLength = 1024
+)
+
+// semVerRegex matches a semantic version as specified by semver.org.
+var semVerRegex = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// commitRegex matches a full or abbreviated hex-encoded commit hash.
+var commitRegex = regexp.MustCompile(`^[0-9a-fA-F]{7,64}$`)
+
+// Validate validates the optional fields of the oracle program metadata.
+func (m OracleProgramMetadata) Validate() error {
+	if len(m.Name) > MaxMetadataNameLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("name exceeds %d characters", MaxMetadataNameLength)
+	}
+	if m.Version != "" {
+		if len(m.Version) > MaxMetadataVersionLength || !semVerRegex.MatchString(m.Version) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid semantic version %s", m.Version)
+		}
+	}
+	if len(m.SourceRepository) > MaxMetadataRepositoryLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("source repository exceeds %d characters", MaxMetadataRepositoryLength)
+	}
+	if m.SourceCommit != "" && !commitRegex.MatchString(m.SourceCommit) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid source commit %s", m.SourceCommit)
+	}
+	if m.BuildHash != "" {
+		hash, err := hex.DecodeString(m.BuildHash)
+		if err != nil || len(hash) != 32 {
+			return sdkerrors.ErrInvalidRequest.Wrap("build hash must be a hex-encoded 32-byte hash")
+		}
+	}
+	if len(m.Description) > MaxMetadataDescriptionLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("description exceeds %d characters", MaxMetadataDescriptionLength)
+	}
+	if m.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+		}
+	}
+	return nil
+}
+
+// ValidateOwner checks that the owner of the oracle program is either unset
+// or the account storing it, so that programs cannot be attributed to
+// other accounts.
+func (m OracleProgramMetadata) ValidateOwner(sender string) error {
+	if m.Owner != "" && m.Owner != sender {
+		return sdkerrors.ErrUnauthorized.Wrapf("owner %s must be empty or the sender %s", m.Owner, sender)
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/hex"
+
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -14,6 +16,13 @@ import (
 var (
 	_ sdk.Msg = &MsgStoreOracleProgram{}
 	_ sdk.Msg = &MsgInstantiateCoreContract{}
+	_ sdk.Msg = &MsgMigrateCoreContract{}
+	_ sdk.Msg = &MsgBlockOracleProgram{}
+	_ sdk.Msg = &MsgUnblockOracleProgram{}
+	_ sdk.Msg = &MsgBeginOracleProgramUpload{}
+	_ sdk.Msg = &MsgAppendOracleProgramChunk{}
+	_ sdk.Msg = &MsgFinalizeOracleProgramUpload{}
+	_ sdk.Msg = &MsgTopUpOracleProgram{}
 )
 
 func (msg *MsgStoreOracleProgram) ValidateBasic() error {
@@ -21,13 +30,128 @@ func (msg *MsgStoreOracleProgram) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
 	}
 
+	if msg.Hash != "" {
+		if len(msg.Wasm) != 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("wasm and hash cannot both be given")
+		}
+		if hash, err := hex.DecodeString(msg.Hash); err != nil || len(hash) != 32 {
+			return ErrInvalidHexWasmHash
+		}
+		if !msg.StorageFee.IsZero() {
+			return sdkerrors.ErrInvalidRequest.Wrap("storage fee cannot be given with hash")
+		}
+		if msg.Metadata != (OracleProgramMetadata{}) {
+			return sdkerrors.ErrInvalidRequest.Wrap("metadata cannot be given with hash")
+		}
+		return nil
+	}
+
 	if msg.StorageFee.AmountOf(appparams.DefaultBondDenom).IsZero() {
 		return sdkerrors.ErrInvalidRequest.Wrap("storage fee must be greater than 0aseda")
 	}
 
+	if err := msg.Metadata.Validate(); err != nil {
+		return err
+	}
+	if err := msg.Metadata.ValidateOwner(msg.Sender); err != nil {
+		return err
+	}
+
 	return validateWasmSize(msg.Wasm)
 }
 
+func (msg *MsgTopUpOracleProgram) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := hex.DecodeString(msg.Hash); err != nil {
+		return ErrInvalidHexWasmHash
+	}
+	if !msg.Rent.IsValid() || msg.Rent.AmountOf(appparams.DefaultBondDenom).IsZero() {
+		return sdkerrors.ErrInvalidRequest.Wrap("rent must be greater than 0aseda")
+	}
+	return nil
+}
+
+func (msg *MsgMigrateCoreContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("code id is required")
+	}
+	return msg.Msg.ValidateBasic()
+}
+
+func (msg *MsgBeginOracleProgramUpload) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if hash, err := hex.DecodeString(msg.Hash); err != nil || len(hash) != 32 {
+		return ErrInvalidHexWasmHash
+	}
+	if msg.UnzippedSize == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("size must be greater than 0")
+	}
+	if msg.ZippedSize == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("zipped size must be greater than 0")
+	}
+	if !msg.StorageFee.IsValid() || msg.StorageFee.AmountOf(appparams.DefaultBondDenom).IsZero() {
+		return sdkerrors.ErrInvalidRequest.Wrap("storage fee must be greater than 0aseda")
+	}
+	if err := msg.Metadata.Validate(); err != nil {
+		return err
+	}
+	return msg.Metadata.ValidateOwner(msg.Sender)
+}
+
+func (msg *MsgAppendOracleProgramChunk) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if len(msg.Chunk) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty chunk")
+	}
+	return nil
+}
+
+func (msg *MsgFinalizeOracleProgramUpload) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// MaxBlockReasonLength is the maximum length of the reason for blocking
+// an oracle program.
+const MaxBlockReasonLength = 512
+
+func (msg *MsgBlockOracleProgram) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if hash, err := hex.DecodeString(msg.Hash); err != nil || len(hash) != 32 {
+		return ErrInvalidHexWasmHash
+	}
+	if msg.Reason == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("reason is required")
+	}
+	if len(msg.Reason) > MaxBlockReasonLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("reason exceeds %d characters", MaxBlockReasonLength)
+	}
+	return nil
+}
+
+func (msg *MsgUnblockOracleProgram) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := hex.DecodeString(msg.Hash); err != nil {
+		return ErrInvalidHexWasmHash
+	}
+	return nil
+}
+
 func (msg *MsgInstantiateCoreContract) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
 		return err
@@ -1,15 +1,25 @@
 package types
 
 const (
-	DefaultMaxWasmSize     int64  = 1024 * 1024    // 1 MB
-	DefaultWasmCostPerByte uint64 = 50000000000000 // 0,00005 SEDA
+	DefaultMaxWasmSize         int64  = 1024 * 1024    // 1 MB
+	DefaultWasmCostPerByte     uint64 = 50000000000000 // 0,00005 SEDA
+	DefaultProgramTTL          int64  = 0              // expiration disabled
+	DefaultRentPerBytePerBlock uint64 = 100000000      // 0,0000000001 SEDA
+	DefaultMaxPrunesPerBlock   uint32 = 100
+	DefaultUploadSessionTTL    int64  = 1000
+	DefaultProgramGracePeriod  int64  = 1000
 )
 
 // DefaultParams returns default wasm-storage module parameters.
 func DefaultParams() Params {
 	return Params{
-		MaxWasmSize:     DefaultMaxWasmSize,
-		WasmCostPerByte: DefaultWasmCostPerByte,
+		MaxWasmSize:         DefaultMaxWasmSize,
+		WasmCostPerByte:     DefaultWasmCostPerByte,
+		ProgramTTL:          DefaultProgramTTL,
+		RentPerBytePerBlock: DefaultRentPerBytePerBlock,
+		MaxPrunesPerBlock:   DefaultMaxPrunesPerBlock,
+		UploadSessionTTL:    DefaultUploadSessionTTL,
+		ProgramGracePeriod:  DefaultProgramGracePeriod,
 	}
 }
 
@@ -21,5 +31,25 @@ func (p *Params) Validate() error {
 	if p.WasmCostPerByte <= 0 {
 		return ErrInvalidParam.Wrapf("invalid wasm cost per byte %d", p.WasmCostPerByte)
 	}
+	if p.ProgramTTL < 0 {
+		return ErrInvalidParam.Wrapf("invalid program ttl %d", p.ProgramTTL)
+	}
+	if p.ProgramGracePeriod < 0 {
+		return ErrInvalidParam.Wrapf("invalid program grace period %d", p.ProgramGracePeriod)
+	}
+	if p.UploadSessionTTL < 0 {
+		return ErrInvalidParam.Wrapf("invalid upload session ttl %d", p.UploadSessionTTL)
+	}
+	if p.UploadSessionTTL > 0 && p.MaxPrunesPerBlock == 0 {
+		return ErrInvalidParam.Wrap("max prunes per block must be positive when chunked uploads are enabled")
+	}
+	if p.ProgramTTL > 0 {
+		if p.RentPerBytePerBlock == 0 {
+			return ErrInvalidParam.Wrap("rent per byte per block must be positive when expiration is enabled")
+		}
+		if p.MaxPrunesPerBlock == 0 {
+			return ErrInvalidParam.Wrap("max prunes per block must be positive when expiration is enabled")
+		}
+	}
 	return nil
 }
@@ -10,19 +10,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +37,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // The request message for QueryOracleProgram RPC.
 type QueryOracleProgramRequest struct {
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// IncludeBlocked returns the oracle program even if it is blocked, so
+	// that its bytecode can still be audited.
+	IncludeBlocked bool `protobuf:"varint,2,opt,name=include_blocked,json=includeBlocked,proto3" json:"include_blocked,omitempty"`
 }
 
 func (m *QueryOracleProgramRequest) Reset()         { *m = QueryOracleProgramRequest{} }
@@ -75,6 +82,13 @@ func (m *QueryOracleProgramRequest) GetHash() string {
 	return ""
 }
 
+func (m *QueryOracleProgramRequest) GetIncludeBlocked() bool {
+	if m != nil {
+		return m.IncludeBlocked
+	}
+	return false
+}
+
 // The response message for QueryOracleProgram RPC.
 type QueryOracleProgramResponse struct {
 	OracleProgram *OracleProgram `protobuf:"bytes,1,opt,name=oracle_program,json=oracleProgram,proto3" json:"oracle_program,omitempty"`
@@ -120,17 +134,138 @@ func (m *QueryOracleProgramResponse) GetOracleProgram() *OracleProgram {
 	return nil
 }
 
+// The request message for QueryOracleProgramExists RPC.
+type QueryOracleProgramExistsRequest struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryOracleProgramExistsRequest) Reset()         { *m = QueryOracleProgramExistsRequest{} }
+func (m *QueryOracleProgramExistsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleProgramExistsRequest) ProtoMessage()    {}
+func (*QueryOracleProgramExistsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{2}
+}
+func (m *QueryOracleProgramExistsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleProgramExistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleProgramExistsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleProgramExistsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleProgramExistsRequest.Merge(m, src)
+}
+func (m *QueryOracleProgramExistsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleProgramExistsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleProgramExistsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleProgramExistsRequest proto.InternalMessageInfo
+
+func (m *QueryOracleProgramExistsRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// The response message for QueryOracleProgramExists RPC.
+type QueryOracleProgramExistsResponse struct {
+	// Exists is true if the oracle program is stored.
+	Exists bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	// Blocked is true if the oracle program is blocked by governance.
+	Blocked bool `protobuf:"varint,2,opt,name=blocked,proto3" json:"blocked,omitempty"`
+}
+
+func (m *QueryOracleProgramExistsResponse) Reset()         { *m = QueryOracleProgramExistsResponse{} }
+func (m *QueryOracleProgramExistsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleProgramExistsResponse) ProtoMessage()    {}
+func (*QueryOracleProgramExistsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{3}
+}
+func (m *QueryOracleProgramExistsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleProgramExistsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleProgramExistsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleProgramExistsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleProgramExistsResponse.Merge(m, src)
+}
+func (m *QueryOracleProgramExistsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleProgramExistsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleProgramExistsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleProgramExistsResponse proto.InternalMessageInfo
+
+func (m *QueryOracleProgramExistsResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
+}
+
+func (m *QueryOracleProgramExistsResponse) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
+	}
+	return false
+}
+
 // The request message for QueryOraclePrograms RPC.
 type QueryOracleProgramsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Owner optionally filters oracle programs by the owner in their metadata.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Name optionally filters oracle programs by the name in their metadata.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// OrderByAddedAt sorts oracle programs by the time they were added instead
+	// of by hash. Combined with pagination.reverse, the most recently added
+	// programs are listed first.
+	OrderByAddedAt bool `protobuf:"varint,4,opt,name=order_by_added_at,json=orderByAddedAt,proto3" json:"order_by_added_at,omitempty"`
+	// AddedAfter optionally filters out oracle programs added before the
+	// given time. If AddedAfter or AddedBefore is set, oracle programs are
+	// listed in the order they were added, as with OrderByAddedAt.
+	AddedAfter *time.Time `protobuf:"bytes,5,opt,name=added_after,json=addedAfter,proto3,stdtime" json:"added_after,omitempty"`
+	// AddedBefore optionally filters out oracle programs added at or after
+	// the given time.
+	AddedBefore *time.Time `protobuf:"bytes,6,opt,name=added_before,json=addedBefore,proto3,stdtime" json:"added_before,omitempty"`
+	// MinBytecodeSize optionally filters out oracle programs whose unzipped
+	// bytecode is smaller than the given number of bytes.
+	MinBytecodeSize uint64 `protobuf:"varint,7,opt,name=min_bytecode_size,json=minBytecodeSize,proto3" json:"min_bytecode_size,omitempty"`
+	// MaxBytecodeSize optionally filters out oracle programs whose unzipped
+	// bytecode is larger than the given number of bytes.
+	MaxBytecodeSize uint64 `protobuf:"varint,8,opt,name=max_bytecode_size,json=maxBytecodeSize,proto3" json:"max_bytecode_size,omitempty"`
 }
 
 func (m *QueryOracleProgramsRequest) Reset()         { *m = QueryOracleProgramsRequest{} }
 func (m *QueryOracleProgramsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOracleProgramsRequest) ProtoMessage()    {}
 func (*QueryOracleProgramsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e3a991a3b0319b3, []int{2}
+	return fileDescriptor_0e3a991a3b0319b3, []int{4}
 }
 func (m *QueryOracleProgramsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,17 +301,69 @@ func (m *QueryOracleProgramsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryOracleProgramsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryOracleProgramsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryOracleProgramsRequest) GetOrderByAddedAt() bool {
+	if m != nil {
+		return m.OrderByAddedAt
+	}
+	return false
+}
+
+func (m *QueryOracleProgramsRequest) GetAddedAfter() *time.Time {
+	if m != nil {
+		return m.AddedAfter
+	}
+	return nil
+}
+
+func (m *QueryOracleProgramsRequest) GetAddedBefore() *time.Time {
+	if m != nil {
+		return m.AddedBefore
+	}
+	return nil
+}
+
+func (m *QueryOracleProgramsRequest) GetMinBytecodeSize() uint64 {
+	if m != nil {
+		return m.MinBytecodeSize
+	}
+	return 0
+}
+
+func (m *QueryOracleProgramsRequest) GetMaxBytecodeSize() uint64 {
+	if m != nil {
+		return m.MaxBytecodeSize
+	}
+	return 0
+}
+
 // The response message for QueryOraclePrograms RPC.
 type QueryOracleProgramsResponse struct {
+	// List is the list of hex-encoded hashes of the oracle programs.
 	List       []string            `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Programs describes the oracle programs in the same order as List.
+	Programs []OracleProgramSummary `protobuf:"bytes,3,rep,name=programs,proto3" json:"programs"`
 }
 
 func (m *QueryOracleProgramsResponse) Reset()         { *m = QueryOracleProgramsResponse{} }
 func (m *QueryOracleProgramsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOracleProgramsResponse) ProtoMessage()    {}
 func (*QueryOracleProgramsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e3a991a3b0319b3, []int{3}
+	return fileDescriptor_0e3a991a3b0319b3, []int{5}
 }
 func (m *QueryOracleProgramsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,6 +406,13 @@ func (m *QueryOracleProgramsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryOracleProgramsResponse) GetPrograms() []OracleProgramSummary {
+	if m != nil {
+		return m.Programs
+	}
+	return nil
+}
+
 // The request message for QueryCoreContractRegistry RPC.
 type QueryCoreContractRegistryRequest struct {
 }
@@ -227,7 +421,7 @@ func (m *QueryCoreContractRegistryRequest) Reset()         { *m = QueryCoreContr
 func (m *QueryCoreContractRegistryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCoreContractRegistryRequest) ProtoMessage()    {}
 func (*QueryCoreContractRegistryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e3a991a3b0319b3, []int{4}
+	return fileDescriptor_0e3a991a3b0319b3, []int{6}
 }
 func (m *QueryCoreContractRegistryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,13 +453,15 @@ var xxx_messageInfo_QueryCoreContractRegistryRequest proto.InternalMessageInfo
 // The response message for QueryCoreContractRegistry RPC.
 type QueryCoreContractRegistryResponse struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// History lists the registry entries in order of activation height.
+	History []CoreContractRegistryEntry `protobuf:"bytes,2,rep,name=history,proto3" json:"history"`
 }
 
 func (m *QueryCoreContractRegistryResponse) Reset()         { *m = QueryCoreContractRegistryResponse{} }
 func (m *QueryCoreContractRegistryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCoreContractRegistryResponse) ProtoMessage()    {}
 func (*QueryCoreContractRegistryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e3a991a3b0319b3, []int{5}
+	return fileDescriptor_0e3a991a3b0319b3, []int{7}
 }
 func (m *QueryCoreContractRegistryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,22 +497,31 @@ func (m *QueryCoreContractRegistryResponse) GetAddress() string {
 	return ""
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+func (m *QueryCoreContractRegistryResponse) GetHistory() []CoreContractRegistryEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e3a991a3b0319b3, []int{6}
+// The request message for QueryBlockedOraclePrograms RPC.
+type QueryBlockedOracleProgramsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryBlockedOracleProgramsRequest) Reset()         { *m = QueryBlockedOracleProgramsRequest{} }
+func (m *QueryBlockedOracleProgramsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedOracleProgramsRequest) ProtoMessage()    {}
+func (*QueryBlockedOracleProgramsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{8}
+}
+func (m *QueryBlockedOracleProgramsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBlockedOracleProgramsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBlockedOracleProgramsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -326,36 +531,43 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryBlockedOracleProgramsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedOracleProgramsRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryBlockedOracleProgramsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryBlockedOracleProgramsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedOracleProgramsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBlockedOracleProgramsRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params defines the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryBlockedOracleProgramsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e3a991a3b0319b3, []int{7}
+// The response message for QueryBlockedOraclePrograms RPC.
+type QueryBlockedOracleProgramsResponse struct {
+	BlockedOraclePrograms []BlockedOracleProgram `protobuf:"bytes,1,rep,name=blocked_oracle_programs,json=blockedOraclePrograms,proto3" json:"blocked_oracle_programs"`
+	Pagination            *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryBlockedOracleProgramsResponse) Reset()         { *m = QueryBlockedOracleProgramsResponse{} }
+func (m *QueryBlockedOracleProgramsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedOracleProgramsResponse) ProtoMessage()    {}
+func (*QueryBlockedOracleProgramsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{9}
+}
+func (m *QueryBlockedOracleProgramsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBlockedOracleProgramsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBlockedOracleProgramsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -365,142 +577,502 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryBlockedOracleProgramsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedOracleProgramsResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryBlockedOracleProgramsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryBlockedOracleProgramsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedOracleProgramsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBlockedOracleProgramsResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryBlockedOracleProgramsResponse) GetBlockedOraclePrograms() []BlockedOracleProgram {
 	if m != nil {
-		return m.Params
+		return m.BlockedOraclePrograms
 	}
-	return Params{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryOracleProgramRequest)(nil), "sedachain.wasm_storage.v1.QueryOracleProgramRequest")
-	proto.RegisterType((*QueryOracleProgramResponse)(nil), "sedachain.wasm_storage.v1.QueryOracleProgramResponse")
-	proto.RegisterType((*QueryOracleProgramsRequest)(nil), "sedachain.wasm_storage.v1.QueryOracleProgramsRequest")
-	proto.RegisterType((*QueryOracleProgramsResponse)(nil), "sedachain.wasm_storage.v1.QueryOracleProgramsResponse")
-	proto.RegisterType((*QueryCoreContractRegistryRequest)(nil), "sedachain.wasm_storage.v1.QueryCoreContractRegistryRequest")
-	proto.RegisterType((*QueryCoreContractRegistryResponse)(nil), "sedachain.wasm_storage.v1.QueryCoreContractRegistryResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "sedachain.wasm_storage.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "sedachain.wasm_storage.v1.QueryParamsResponse")
+func (m *QueryBlockedOracleProgramsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("sedachain/wasm_storage/v1/query.proto", fileDescriptor_0e3a991a3b0319b3)
+// The request message for QueryOracleProgramStats RPC.
+type QueryOracleProgramStatsRequest struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-var fileDescriptor_0e3a991a3b0319b3 = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x4b, 0x1a, 0xc4, 0xa2, 0xf6, 0xb0, 0xe4, 0x90, 0x1a, 0xe4, 0x26, 0x96, 0xa0, 0x51,
-	0x45, 0x76, 0x9b, 0x02, 0xed, 0x01, 0x10, 0x52, 0x2b, 0xc1, 0xb1, 0x69, 0x0e, 0x1c, 0xb8, 0x44,
-	0x1b, 0x67, 0xe5, 0x58, 0x4a, 0x3c, 0xee, 0xee, 0x26, 0x10, 0x10, 0x17, 0xbe, 0xa0, 0x12, 0x5f,
-	0x82, 0xc4, 0x47, 0x54, 0x9c, 0x2a, 0xb8, 0x70, 0x42, 0x28, 0xe1, 0x43, 0x90, 0xd7, 0x1b, 0x1a,
-	0x4b, 0x71, 0xd2, 0x70, 0x1b, 0xaf, 0xe7, 0xbd, 0x79, 0xf3, 0xf6, 0xd9, 0xe8, 0xbe, 0xe4, 0x1d,
-	0xe6, 0x75, 0x59, 0x10, 0xd2, 0xb7, 0x4c, 0xf6, 0x5b, 0x52, 0x81, 0x60, 0x3e, 0xa7, 0xc3, 0x3a,
-	0x3d, 0x1b, 0x70, 0x31, 0x22, 0x91, 0x00, 0x05, 0x78, 0xeb, 0x5f, 0x1b, 0x99, 0x6d, 0x23, 0xc3,
-	0xba, 0x7d, 0xcf, 0x07, 0xf0, 0x7b, 0x9c, 0xb2, 0x28, 0xa0, 0x2c, 0x0c, 0x41, 0x31, 0x15, 0x40,
-	0x28, 0x13, 0xa0, 0x5d, 0xf4, 0xc1, 0x07, 0x5d, 0xd2, 0xb8, 0x32, 0xa7, 0xbb, 0x1e, 0xc8, 0x3e,
-	0x48, 0xda, 0x66, 0x92, 0x27, 0x73, 0xe8, 0xb0, 0xde, 0xe6, 0x8a, 0xd5, 0x69, 0xc4, 0xfc, 0x20,
-	0xd4, 0x14, 0xa6, 0xf7, 0x61, 0xb6, 0xc2, 0x94, 0x14, 0xdd, 0xed, 0x52, 0xb4, 0x75, 0x1a, 0xf3,
-	0x9d, 0x08, 0xe6, 0xf5, 0x78, 0x43, 0x80, 0x2f, 0x58, 0xbf, 0xc9, 0xcf, 0x06, 0x5c, 0x2a, 0x8c,
-	0x51, 0xbe, 0xcb, 0x64, 0xb7, 0x64, 0x95, 0xad, 0xea, 0xad, 0xa6, 0xae, 0xdd, 0x3e, 0xb2, 0xe7,
-	0x01, 0x64, 0x04, 0xa1, 0xe4, 0xf8, 0x04, 0x6d, 0x82, 0x7e, 0xd1, 0x8a, 0x92, 0x37, 0x1a, 0x7b,
-	0x7b, 0xbf, 0x4a, 0x32, 0x0d, 0x21, 0x69, 0xa6, 0x0d, 0x98, 0x7d, 0x74, 0x3b, 0xf3, 0xc6, 0xc9,
-	0xa9, 0xc0, 0x97, 0x08, 0x5d, 0xed, 0x6f, 0x46, 0x3d, 0x20, 0x89, 0x59, 0x24, 0x36, 0x8b, 0x24,
-	0x97, 0x62, 0xcc, 0x22, 0x0d, 0xe6, 0x73, 0x83, 0x6d, 0xce, 0x20, 0xdd, 0xf7, 0xe8, 0xee, 0xdc,
-	0x29, 0x66, 0x2b, 0x8c, 0xf2, 0xbd, 0x40, 0xaa, 0x92, 0x55, 0xbe, 0x11, 0xfb, 0x10, 0xd7, 0xf8,
-	0x55, 0x6a, 0xf4, 0x9a, 0x1e, 0xbd, 0xb3, 0x74, 0x74, 0x42, 0x98, 0x9a, 0xed, 0xa2, 0xb2, 0x9e,
-	0x7d, 0x0c, 0x82, 0x1f, 0x43, 0xa8, 0x04, 0xf3, 0x54, 0x93, 0xfb, 0x81, 0x54, 0x62, 0x64, 0xb4,
-	0xba, 0xcf, 0x51, 0x65, 0x41, 0x8f, 0x51, 0x59, 0x42, 0x37, 0x59, 0xa7, 0x23, 0xb8, 0x94, 0xe6,
-	0xc2, 0xa6, 0x8f, 0x6e, 0x11, 0x61, 0x0d, 0x6f, 0xb0, 0x19, 0xf3, 0xdc, 0xd7, 0xe8, 0x4e, 0xea,
-	0xd4, 0xd0, 0xbc, 0x40, 0x85, 0x48, 0x9f, 0x18, 0x3f, 0x2b, 0x0b, 0xae, 0x2e, 0x81, 0x1e, 0xe5,
-	0x2f, 0x7e, 0x6d, 0xe7, 0x9a, 0x06, 0xb6, 0xff, 0x7d, 0x1d, 0xad, 0x6b, 0x62, 0xfc, 0xd5, 0x42,
-	0x1b, 0x29, 0x4b, 0xf1, 0xe3, 0x05, 0x64, 0x99, 0x39, 0xb4, 0x9f, 0xac, 0x88, 0x4a, 0x36, 0x71,
-	0x0f, 0x3e, 0xfd, 0xf8, 0xf3, 0x79, 0x6d, 0x0f, 0x13, 0x1a, 0xc3, 0x6b, 0x57, 0xdf, 0x44, 0x6d,
-	0xfa, 0x4d, 0xa4, 0xb3, 0x4a, 0x3f, 0xc4, 0x09, 0xff, 0x88, 0xbf, 0x58, 0x68, 0x33, 0x9d, 0x04,
-	0xbc, 0x9a, 0x82, 0xa9, 0xc5, 0xf6, 0xc1, 0xaa, 0x30, 0xa3, 0x7c, 0x4f, 0x2b, 0xdf, 0xc5, 0xd5,
-	0x6b, 0x2a, 0x97, 0xf8, 0x9b, 0x85, 0x8a, 0xf3, 0xd2, 0x81, 0x9f, 0x2e, 0x93, 0xb0, 0x20, 0x77,
-	0xf6, 0xb3, 0xff, 0x03, 0x9b, 0x2d, 0x0e, 0xf5, 0x16, 0x75, 0x4c, 0x33, 0xb7, 0xf0, 0x40, 0xf0,
-	0x96, 0x67, 0xf0, 0x2d, 0x31, 0xd5, 0x7c, 0x6e, 0xa1, 0x42, 0x12, 0x2d, 0x5c, 0x5b, 0xa6, 0x20,
-	0x95, 0x69, 0x9b, 0x5c, 0xb7, 0xdd, 0x48, 0xdc, 0xd1, 0x12, 0x2b, 0x78, 0x3b, 0x53, 0x62, 0x12,
-	0xea, 0xa3, 0xd3, 0x8b, 0xb1, 0x63, 0x5d, 0x8e, 0x1d, 0xeb, 0xf7, 0xd8, 0xb1, 0xce, 0x27, 0x4e,
-	0xee, 0x72, 0xe2, 0xe4, 0x7e, 0x4e, 0x9c, 0xdc, 0x9b, 0x43, 0x3f, 0x50, 0xdd, 0x41, 0x9b, 0x78,
-	0xd0, 0xd7, 0x24, 0xfa, 0xbf, 0xea, 0x41, 0x6f, 0x96, 0xf1, 0x5d, 0x9a, 0x53, 0x8d, 0x22, 0x2e,
-	0xdb, 0x05, 0xdd, 0xf9, 0xe8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xad, 0x94, 0x29, 0x75, 0x53,
-	0x06, 0x00, 0x00,
+func (m *QueryOracleProgramStatsRequest) Reset()         { *m = QueryOracleProgramStatsRequest{} }
+func (m *QueryOracleProgramStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleProgramStatsRequest) ProtoMessage()    {}
+func (*QueryOracleProgramStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{10}
+}
+func (m *QueryOracleProgramStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleProgramStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleProgramStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleProgramStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleProgramStatsRequest.Merge(m, src)
+}
+func (m *QueryOracleProgramStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleProgramStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleProgramStatsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryOracleProgramStatsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// OracleProgram returns an oracle program given its hash.
-	OracleProgram(ctx context.Context, in *QueryOracleProgramRequest, opts ...grpc.CallOption) (*QueryOracleProgramResponse, error)
-	// OraclePrograms returns all oracle programs.
-	OraclePrograms(ctx context.Context, in *QueryOracleProgramsRequest, opts ...grpc.CallOption) (*QueryOracleProgramsResponse, error)
-	// CoreContractRegistry returns the Core Contract Registry address.
-	CoreContractRegistry(ctx context.Context, in *QueryCoreContractRegistryRequest, opts ...grpc.CallOption) (*QueryCoreContractRegistryResponse, error)
-	// Params returns the total set of wasm-storage parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryOracleProgramStatsRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// The response message for QueryOracleProgramStats RPC.
+type QueryOracleProgramStatsResponse struct {
+	Stats OracleProgramStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryOracleProgramStatsResponse) Reset()         { *m = QueryOracleProgramStatsResponse{} }
+func (m *QueryOracleProgramStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleProgramStatsResponse) ProtoMessage()    {}
+func (*QueryOracleProgramStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{11}
 }
-
-func (c *queryClient) OracleProgram(ctx context.Context, in *QueryOracleProgramRequest, opts ...grpc.CallOption) (*QueryOracleProgramResponse, error) {
-	out := new(QueryOracleProgramResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/OracleProgram", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOracleProgramStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleProgramStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleProgramStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOracleProgramStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleProgramStatsResponse.Merge(m, src)
+}
+func (m *QueryOracleProgramStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleProgramStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleProgramStatsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) OraclePrograms(ctx context.Context, in *QueryOracleProgramsRequest, opts ...grpc.CallOption) (*QueryOracleProgramsResponse, error) {
-	out := new(QueryOracleProgramsResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/OraclePrograms", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOracleProgramStatsResponse proto.InternalMessageInfo
+
+func (m *QueryOracleProgramStatsResponse) GetStats() OracleProgramStats {
+	if m != nil {
+		return m.Stats
 	}
-	return out, nil
+	return OracleProgramStats{}
 }
 
-func (c *queryClient) CoreContractRegistry(ctx context.Context, in *QueryCoreContractRegistryRequest, opts ...grpc.CallOption) (*QueryCoreContractRegistryResponse, error) {
-	out := new(QueryCoreContractRegistryResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/CoreContractRegistry", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// The request message for QueryUploadSession RPC.
+type QueryUploadSessionRequest struct {
+	UploadId uint64 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/Params", in, out, opts...)
+func (m *QueryUploadSessionRequest) Reset()         { *m = QueryUploadSessionRequest{} }
+func (m *QueryUploadSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUploadSessionRequest) ProtoMessage()    {}
+func (*QueryUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{12}
+}
+func (m *QueryUploadSessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUploadSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUploadSessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUploadSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUploadSessionRequest.Merge(m, src)
+}
+func (m *QueryUploadSessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUploadSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUploadSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUploadSessionRequest proto.InternalMessageInfo
+
+func (m *QueryUploadSessionRequest) GetUploadId() uint64 {
+	if m != nil {
+		return m.UploadId
+	}
+	return 0
+}
+
+// The response message for QueryUploadSession RPC.
+type QueryUploadSessionResponse struct {
+	UploadSession UploadSession `protobuf:"bytes,1,opt,name=upload_session,json=uploadSession,proto3" json:"upload_session"`
+}
+
+func (m *QueryUploadSessionResponse) Reset()         { *m = QueryUploadSessionResponse{} }
+func (m *QueryUploadSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUploadSessionResponse) ProtoMessage()    {}
+func (*QueryUploadSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{13}
+}
+func (m *QueryUploadSessionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUploadSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUploadSessionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUploadSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUploadSessionResponse.Merge(m, src)
+}
+func (m *QueryUploadSessionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUploadSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUploadSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUploadSessionResponse proto.InternalMessageInfo
+
+func (m *QueryUploadSessionResponse) GetUploadSession() UploadSession {
+	if m != nil {
+		return m.UploadSession
+	}
+	return UploadSession{}
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{14}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{15}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryOracleProgramRequest)(nil), "sedachain.wasm_storage.v1.QueryOracleProgramRequest")
+	proto.RegisterType((*QueryOracleProgramResponse)(nil), "sedachain.wasm_storage.v1.QueryOracleProgramResponse")
+	proto.RegisterType((*QueryOracleProgramExistsRequest)(nil), "sedachain.wasm_storage.v1.QueryOracleProgramExistsRequest")
+	proto.RegisterType((*QueryOracleProgramExistsResponse)(nil), "sedachain.wasm_storage.v1.QueryOracleProgramExistsResponse")
+	proto.RegisterType((*QueryOracleProgramsRequest)(nil), "sedachain.wasm_storage.v1.QueryOracleProgramsRequest")
+	proto.RegisterType((*QueryOracleProgramsResponse)(nil), "sedachain.wasm_storage.v1.QueryOracleProgramsResponse")
+	proto.RegisterType((*QueryCoreContractRegistryRequest)(nil), "sedachain.wasm_storage.v1.QueryCoreContractRegistryRequest")
+	proto.RegisterType((*QueryCoreContractRegistryResponse)(nil), "sedachain.wasm_storage.v1.QueryCoreContractRegistryResponse")
+	proto.RegisterType((*QueryBlockedOracleProgramsRequest)(nil), "sedachain.wasm_storage.v1.QueryBlockedOracleProgramsRequest")
+	proto.RegisterType((*QueryBlockedOracleProgramsResponse)(nil), "sedachain.wasm_storage.v1.QueryBlockedOracleProgramsResponse")
+	proto.RegisterType((*QueryOracleProgramStatsRequest)(nil), "sedachain.wasm_storage.v1.QueryOracleProgramStatsRequest")
+	proto.RegisterType((*QueryOracleProgramStatsResponse)(nil), "sedachain.wasm_storage.v1.QueryOracleProgramStatsResponse")
+	proto.RegisterType((*QueryUploadSessionRequest)(nil), "sedachain.wasm_storage.v1.QueryUploadSessionRequest")
+	proto.RegisterType((*QueryUploadSessionResponse)(nil), "sedachain.wasm_storage.v1.QueryUploadSessionResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "sedachain.wasm_storage.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "sedachain.wasm_storage.v1.QueryParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("sedachain/wasm_storage/v1/query.proto", fileDescriptor_0e3a991a3b0319b3)
+}
+
+var fileDescriptor_0e3a991a3b0319b3 = []byte{
+	// 1153 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0xdc, 0x54,
+	0x10, 0x8e, 0x93, 0xcd, 0x4f, 0x27, 0x24, 0x55, 0x4f, 0x53, 0x70, 0xb7, 0x68, 0xb3, 0xb5, 0x04,
+	0x59, 0x22, 0x62, 0x27, 0x69, 0x7e, 0x4a, 0x7f, 0x84, 0xb2, 0x51, 0x41, 0xbd, 0x6a, 0xe2, 0xa4,
+	0x08, 0x71, 0x63, 0x9d, 0xb5, 0x4f, 0x36, 0x56, 0xd7, 0x3e, 0x5b, 0x1f, 0x6f, 0x9a, 0x6d, 0xd5,
+	0x1b, 0x9e, 0x20, 0x52, 0xaf, 0x78, 0x0c, 0x24, 0x6e, 0x78, 0x83, 0x82, 0x54, 0x54, 0x89, 0x1b,
+	0x2e, 0x10, 0xa0, 0x84, 0xb7, 0xe0, 0x06, 0xf9, 0x9c, 0xd9, 0xed, 0x3a, 0xf1, 0xfe, 0x24, 0x82,
+	0x3b, 0x7b, 0x3c, 0xdf, 0xcc, 0xf7, 0x9d, 0x99, 0x33, 0x63, 0xf8, 0x48, 0x30, 0x8f, 0xba, 0xfb,
+	0xd4, 0x0f, 0xad, 0x67, 0x54, 0x04, 0x8e, 0x88, 0x79, 0x44, 0xab, 0xcc, 0x3a, 0x58, 0xb2, 0x9e,
+	0x36, 0x58, 0xd4, 0x34, 0xeb, 0x11, 0x8f, 0x39, 0xb9, 0xde, 0x76, 0x33, 0x3b, 0xdd, 0xcc, 0x83,
+	0xa5, 0xfc, 0x87, 0x55, 0xce, 0xab, 0x35, 0x66, 0xd1, 0xba, 0x6f, 0xd1, 0x30, 0xe4, 0x31, 0x8d,
+	0x7d, 0x1e, 0x0a, 0x05, 0xcc, 0xcf, 0x54, 0x79, 0x95, 0xcb, 0x47, 0x2b, 0x79, 0x42, 0xeb, 0x2c,
+	0x62, 0xe4, 0x5b, 0xa5, 0xb1, 0x67, 0xc5, 0x7e, 0xc0, 0x44, 0x4c, 0x83, 0x3a, 0x3a, 0xcc, 0xbb,
+	0x5c, 0x04, 0x5c, 0x58, 0x15, 0x2a, 0x98, 0x22, 0x62, 0x1d, 0x2c, 0x55, 0x58, 0x4c, 0x97, 0xac,
+	0x3a, 0xad, 0xfa, 0xa1, 0xcc, 0x81, 0xbe, 0x9f, 0x76, 0x97, 0x90, 0xe2, 0x2a, 0xbd, 0x8d, 0xaf,
+	0xe1, 0xfa, 0x76, 0x12, 0xef, 0x51, 0x44, 0xdd, 0x1a, 0xdb, 0x8a, 0x78, 0x35, 0xa2, 0x81, 0xcd,
+	0x9e, 0x36, 0x98, 0x88, 0x09, 0x81, 0xdc, 0x3e, 0x15, 0xfb, 0xba, 0x56, 0xd4, 0x4a, 0x97, 0x6c,
+	0xf9, 0x4c, 0xe6, 0xe0, 0xb2, 0x1f, 0xba, 0xb5, 0x86, 0xc7, 0x9c, 0x4a, 0x8d, 0xbb, 0x4f, 0x98,
+	0xa7, 0x0f, 0x17, 0xb5, 0xd2, 0x84, 0x3d, 0x8d, 0xe6, 0xb2, 0xb2, 0x1a, 0x01, 0xe4, 0xb3, 0x22,
+	0x8b, 0x3a, 0x0f, 0x05, 0x23, 0x8f, 0x60, 0x9a, 0xcb, 0x0f, 0x4e, 0x5d, 0x7d, 0x91, 0x49, 0x26,
+	0x97, 0x4b, 0x66, 0xd7, 0xa3, 0x35, 0xd3, 0x91, 0xa6, 0x78, 0xe7, 0xab, 0xb1, 0x0a, 0xb3, 0x67,
+	0xd3, 0x3d, 0x38, 0xf4, 0x45, 0x2c, 0x7a, 0xc8, 0x31, 0x76, 0xa1, 0xd8, 0x1d, 0x86, 0x5c, 0xdf,
+	0x87, 0x31, 0x26, 0x2d, 0x12, 0x39, 0x61, 0xe3, 0x1b, 0xd1, 0x61, 0x3c, 0x7d, 0x04, 0xad, 0x57,
+	0xe3, 0xbb, 0x91, 0x2c, 0xf1, 0x6d, 0x22, 0x5f, 0x00, 0xbc, 0x2b, 0x1b, 0x0a, 0xff, 0xd8, 0x54,
+	0x35, 0x36, 0x93, 0x1a, 0x9b, 0xaa, 0xd9, 0xb0, 0xc6, 0xe6, 0x16, 0xad, 0x32, 0xc4, 0xda, 0x1d,
+	0x48, 0x32, 0x03, 0xa3, 0xfc, 0x59, 0xc8, 0x22, 0x99, 0xfe, 0x92, 0xad, 0x5e, 0x12, 0x99, 0x21,
+	0x0d, 0x98, 0x3e, 0xa2, 0x64, 0x26, 0xcf, 0xe4, 0x13, 0xb8, 0xc2, 0x23, 0x8f, 0x45, 0x4e, 0xa5,
+	0xe9, 0x50, 0xcf, 0x63, 0x9e, 0x43, 0x63, 0x3d, 0xa7, 0xea, 0x26, 0x3f, 0x94, 0x9b, 0x1b, 0x89,
+	0x79, 0x23, 0x26, 0x1b, 0x30, 0x89, 0x1e, 0x7b, 0x31, 0x8b, 0xf4, 0x51, 0xc9, 0x2e, 0x6f, 0xaa,
+	0x16, 0x35, 0x5b, 0x2d, 0x6a, 0xee, 0xb6, 0x5a, 0xb4, 0x9c, 0x3b, 0xfa, 0x73, 0x56, 0xb3, 0x41,
+	0x82, 0x36, 0x12, 0x0c, 0xd9, 0x84, 0xf7, 0x54, 0x88, 0x0a, 0xdb, 0xe3, 0x11, 0xd3, 0xc7, 0x06,
+	0x8c, 0xa1, 0x12, 0x97, 0x25, 0x88, 0xcc, 0xc3, 0x95, 0xc0, 0x0f, 0x9d, 0x4a, 0x33, 0x66, 0x2e,
+	0xf7, 0x98, 0x23, 0xfc, 0xe7, 0x4c, 0x1f, 0x2f, 0x6a, 0xa5, 0x9c, 0x7d, 0x39, 0xf0, 0xc3, 0x32,
+	0xda, 0x77, 0xfc, 0xe7, 0xca, 0x97, 0x1e, 0x9e, 0xf2, 0x9d, 0x40, 0x5f, 0x7a, 0xd8, 0xe9, 0x6b,
+	0xbc, 0xd1, 0xe0, 0x46, 0x66, 0x6d, 0xb0, 0xda, 0x04, 0x72, 0x35, 0x5f, 0xc4, 0xba, 0x56, 0x1c,
+	0x49, 0x8e, 0x2f, 0x79, 0x26, 0x5f, 0xa6, 0x0a, 0x36, 0x2c, 0xe5, 0xcc, 0xf5, 0x2d, 0x98, 0x0a,
+	0x98, 0xaa, 0xd8, 0x36, 0x4c, 0x60, 0xbf, 0x0b, 0x7d, 0xa4, 0x38, 0x52, 0x9a, 0x5c, 0xb6, 0x06,
+	0x6d, 0xf8, 0x9d, 0x46, 0x10, 0xd0, 0xa8, 0x59, 0xce, 0xbd, 0xfe, 0x63, 0x76, 0xc8, 0x6e, 0x87,
+	0x31, 0x0c, 0xec, 0xe0, 0x4d, 0x1e, 0xb1, 0x4d, 0x1e, 0xc6, 0x11, 0x75, 0x63, 0x9b, 0x55, 0x7d,
+	0x11, 0x47, 0x4d, 0x6c, 0x1a, 0xe3, 0x95, 0x06, 0x37, 0x7b, 0x38, 0xa1, 0x72, 0x1d, 0xc6, 0xa9,
+	0xe7, 0x45, 0x4c, 0x08, 0xbc, 0x22, 0xad, 0x57, 0xb2, 0x0b, 0xe3, 0xfb, 0x7e, 0x42, 0xac, 0xa9,
+	0x0f, 0x4b, 0xd6, 0x2b, 0x3d, 0x58, 0x67, 0xe5, 0x78, 0x10, 0xc6, 0x6d, 0xea, 0xad, 0x50, 0xc6,
+	0x13, 0x24, 0x85, 0x13, 0xe3, 0x7f, 0xbd, 0x2b, 0xc6, 0xef, 0x1a, 0x18, 0xbd, 0xb2, 0xe1, 0x19,
+	0x04, 0xf0, 0x01, 0x5e, 0x62, 0x27, 0x3d, 0x9f, 0x84, 0x6c, 0x88, 0xde, 0xf5, 0xca, 0x0a, 0x8d,
+	0xa2, 0xaf, 0x55, 0xb2, 0xd2, 0xfe, 0x67, 0x8d, 0x65, 0xac, 0x40, 0xe1, 0x6c, 0x53, 0xef, 0xc4,
+	0xb4, 0xf7, 0xf4, 0xab, 0x65, 0x0d, 0x4d, 0x44, 0xe1, 0x81, 0x3c, 0x84, 0x51, 0x91, 0x18, 0xf0,
+	0xe8, 0x17, 0x06, 0x6e, 0xd7, 0x04, 0x84, 0xe2, 0x55, 0x04, 0xe3, 0x36, 0xee, 0x9a, 0xc7, 0xf5,
+	0x1a, 0xa7, 0xde, 0x0e, 0x13, 0xc2, 0xe7, 0x61, 0x8b, 0xde, 0x0d, 0xb8, 0xd4, 0x90, 0x76, 0xc7,
+	0xf7, 0x64, 0xae, 0x9c, 0x3d, 0xa1, 0x0c, 0x0f, 0x3d, 0x43, 0xe0, 0x38, 0x3d, 0x85, 0x44, 0x8a,
+	0x8f, 0x61, 0x1a, 0xa1, 0x42, 0x7d, 0x19, 0x60, 0x97, 0xa4, 0x22, 0x21, 0xcd, 0xa9, 0x46, 0xa7,
+	0xd1, 0x98, 0x01, 0x22, 0x93, 0x6e, 0xd1, 0x8e, 0x7e, 0x34, 0xbe, 0x82, 0xab, 0x29, 0x2b, 0x72,
+	0xf8, 0x1c, 0xc6, 0xea, 0x14, 0xdb, 0x24, 0xc9, 0x7d, 0xb3, 0x47, 0x6e, 0x05, 0xc5, 0xa4, 0x08,
+	0x5b, 0xfe, 0x67, 0x12, 0x46, 0x65, 0x60, 0xf2, 0x83, 0x06, 0x53, 0xa9, 0xa3, 0x24, 0xbd, 0x6e,
+	0x5b, 0xd7, 0xed, 0x9d, 0x5f, 0x3d, 0x27, 0x4a, 0x29, 0x31, 0xd6, 0xbe, 0xfd, 0xf5, 0xef, 0x57,
+	0xc3, 0x8b, 0xc4, 0xb4, 0x12, 0xf8, 0xc2, 0xbb, 0x3f, 0x89, 0x85, 0xd6, 0x9f, 0x44, 0xfa, 0x62,
+	0x58, 0x2f, 0x92, 0x56, 0x7a, 0x49, 0xde, 0x68, 0x70, 0x35, 0x63, 0x8b, 0x92, 0x3b, 0xe7, 0xa2,
+	0x91, 0xda, 0xd8, 0xf9, 0xbb, 0x17, 0xc2, 0xa2, 0x90, 0xfb, 0x52, 0xc8, 0x3a, 0x59, 0x1d, 0x50,
+	0x88, 0xa3, 0xd6, 0x7a, 0x4b, 0xcf, 0xf7, 0x1a, 0x4c, 0x9f, 0xba, 0xad, 0xe7, 0x3b, 0xd1, 0xb6,
+	0x8a, 0xb5, 0xf3, 0xc2, 0x50, 0xc0, 0xa2, 0x14, 0x30, 0x4f, 0x4a, 0x03, 0x0a, 0x10, 0xe4, 0x67,
+	0x0d, 0x66, 0xb2, 0xc6, 0x2f, 0xe9, 0x7b, 0x90, 0x3d, 0xb6, 0x47, 0xfe, 0xde, 0xc5, 0xc0, 0xa8,
+	0x62, 0x5d, 0xaa, 0x58, 0x22, 0x56, 0x57, 0x15, 0x2e, 0x8f, 0x98, 0xe3, 0x22, 0xde, 0x89, 0x5a,
+	0x9c, 0x7f, 0xd1, 0xe0, 0x5a, 0xe6, 0xb0, 0x26, 0x7d, 0x09, 0xf5, 0xda, 0x28, 0xf9, 0xfb, 0x17,
+	0x44, 0xa3, 0x9e, 0xdb, 0x52, 0xcf, 0x32, 0x59, 0xec, 0xaa, 0xa7, 0xcb, 0x02, 0x21, 0x3f, 0x69,
+	0x40, 0xce, 0xce, 0x48, 0xf2, 0xd9, 0xb9, 0xda, 0xa3, 0x73, 0xa6, 0xe7, 0xef, 0x5c, 0x04, 0x8a,
+	0x3a, 0xee, 0x49, 0x1d, 0x6b, 0x64, 0x65, 0xd0, 0xeb, 0x21, 0x87, 0x78, 0xeb, 0x76, 0xfc, 0xa8,
+	0xc1, 0x54, 0x6a, 0x86, 0xf6, 0x1f, 0x52, 0x59, 0x63, 0xbf, 0xff, 0x90, 0xca, 0x1c, 0xf9, 0xc6,
+	0x5d, 0x49, 0x7e, 0x95, 0xdc, 0xea, 0x4a, 0x3e, 0xbd, 0x11, 0xac, 0x17, 0xed, 0xe5, 0xf2, 0x92,
+	0x1c, 0x69, 0x30, 0xa6, 0x66, 0x30, 0x59, 0xe8, 0x97, 0x3e, 0x35, 0xfc, 0xf3, 0xe6, 0xa0, 0xee,
+	0x48, 0x73, 0x4e, 0xd2, 0xbc, 0x49, 0x66, 0xbb, 0xd2, 0x54, 0xd3, 0xbf, 0xbc, 0xfd, 0xfa, 0xb8,
+	0xa0, 0xbd, 0x3d, 0x2e, 0x68, 0x7f, 0x1d, 0x17, 0xb4, 0xa3, 0x93, 0xc2, 0xd0, 0xdb, 0x93, 0xc2,
+	0xd0, 0x6f, 0x27, 0x85, 0xa1, 0x6f, 0xd6, 0xab, 0x7e, 0xbc, 0xdf, 0xa8, 0x98, 0x2e, 0x0f, 0x64,
+	0x10, 0xf9, 0xf3, 0xec, 0xf2, 0x5a, 0x67, 0xc4, 0xc3, 0x74, 0xcc, 0xb8, 0x59, 0x67, 0xa2, 0x32,
+	0x26, 0x3d, 0x6f, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x8c, 0x5f, 0x48, 0xd3, 0x0e, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// OracleProgram returns an oracle program given its hash.
+	OracleProgram(ctx context.Context, in *QueryOracleProgramRequest, opts ...grpc.CallOption) (*QueryOracleProgramResponse, error)
+	// OracleProgramExists returns whether an oracle program is stored
+	// without returning its bytecode.
+	OracleProgramExists(ctx context.Context, in *QueryOracleProgramExistsRequest, opts ...grpc.CallOption) (*QueryOracleProgramExistsResponse, error)
+	// OraclePrograms returns the hashes of all oracle programs, optionally
+	// filtered by owner or name.
+	OraclePrograms(ctx context.Context, in *QueryOracleProgramsRequest, opts ...grpc.CallOption) (*QueryOracleProgramsResponse, error)
+	// CoreContractRegistry returns the Core Contract Registry address and the
+	// history of registry entries.
+	CoreContractRegistry(ctx context.Context, in *QueryCoreContractRegistryRequest, opts ...grpc.CallOption) (*QueryCoreContractRegistryResponse, error)
+	// BlockedOraclePrograms returns the oracle programs blocked by governance.
+	BlockedOraclePrograms(ctx context.Context, in *QueryBlockedOracleProgramsRequest, opts ...grpc.CallOption) (*QueryBlockedOracleProgramsResponse, error)
+	// OracleProgramStats returns the usage statistics of an oracle program.
+	OracleProgramStats(ctx context.Context, in *QueryOracleProgramStatsRequest, opts ...grpc.CallOption) (*QueryOracleProgramStatsResponse, error)
+	// UploadSession returns an in-progress chunked upload session.
+	UploadSession(ctx context.Context, in *QueryUploadSessionRequest, opts ...grpc.CallOption) (*QueryUploadSessionResponse, error)
+	// Params returns the total set of wasm-storage parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) OracleProgram(ctx context.Context, in *QueryOracleProgramRequest, opts ...grpc.CallOption) (*QueryOracleProgramResponse, error) {
+	out := new(QueryOracleProgramResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/OracleProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleProgramExists(ctx context.Context, in *QueryOracleProgramExistsRequest, opts ...grpc.CallOption) (*QueryOracleProgramExistsResponse, error) {
+	out := new(QueryOracleProgramExistsResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/OracleProgramExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OraclePrograms(ctx context.Context, in *QueryOracleProgramsRequest, opts ...grpc.CallOption) (*QueryOracleProgramsResponse, error) {
+	out := new(QueryOracleProgramsResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/OraclePrograms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CoreContractRegistry(ctx context.Context, in *QueryCoreContractRegistryRequest, opts ...grpc.CallOption) (*QueryCoreContractRegistryResponse, error) {
+	out := new(QueryCoreContractRegistryResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/CoreContractRegistry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedOraclePrograms(ctx context.Context, in *QueryBlockedOracleProgramsRequest, opts ...grpc.CallOption) (*QueryBlockedOracleProgramsResponse, error) {
+	out := new(QueryBlockedOracleProgramsResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/BlockedOraclePrograms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleProgramStats(ctx context.Context, in *QueryOracleProgramStatsRequest, opts ...grpc.CallOption) (*QueryOracleProgramStatsResponse, error) {
+	out := new(QueryOracleProgramStatsResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/OracleProgramStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UploadSession(ctx context.Context, in *QueryUploadSessionRequest, opts ...grpc.CallOption) (*QueryUploadSessionResponse, error) {
+	out := new(QueryUploadSessionResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/UploadSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -511,10 +1083,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 type QueryServer interface {
 	// OracleProgram returns an oracle program given its hash.
 	OracleProgram(context.Context, *QueryOracleProgramRequest) (*QueryOracleProgramResponse, error)
-	// OraclePrograms returns all oracle programs.
+	// OracleProgramExists returns whether an oracle program is stored
+	// without returning its bytecode.
+	OracleProgramExists(context.Context, *QueryOracleProgramExistsRequest) (*QueryOracleProgramExistsResponse, error)
+	// OraclePrograms returns the hashes of all oracle programs, optionally
+	// filtered by owner or name.
 	OraclePrograms(context.Context, *QueryOracleProgramsRequest) (*QueryOracleProgramsResponse, error)
-	// CoreContractRegistry returns the Core Contract Registry address.
+	// CoreContractRegistry returns the Core Contract Registry address and the
+	// history of registry entries.
 	CoreContractRegistry(context.Context, *QueryCoreContractRegistryRequest) (*QueryCoreContractRegistryResponse, error)
+	// BlockedOraclePrograms returns the oracle programs blocked by governance.
+	BlockedOraclePrograms(context.Context, *QueryBlockedOracleProgramsRequest) (*QueryBlockedOracleProgramsResponse, error)
+	// OracleProgramStats returns the usage statistics of an oracle program.
+	OracleProgramStats(context.Context, *QueryOracleProgramStatsRequest) (*QueryOracleProgramStatsResponse, error)
+	// UploadSession returns an in-progress chunked upload session.
+	UploadSession(context.Context, *QueryUploadSessionRequest) (*QueryUploadSessionResponse, error)
 	// Params returns the total set of wasm-storage parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -526,12 +1109,24 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) OracleProgram(ctx context.Context, req *QueryOracleProgramRequest) (*QueryOracleProgramResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OracleProgram not implemented")
 }
+func (*UnimplementedQueryServer) OracleProgramExists(ctx context.Context, req *QueryOracleProgramExistsRequest) (*QueryOracleProgramExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleProgramExists not implemented")
+}
 func (*UnimplementedQueryServer) OraclePrograms(ctx context.Context, req *QueryOracleProgramsRequest) (*QueryOracleProgramsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OraclePrograms not implemented")
 }
 func (*UnimplementedQueryServer) CoreContractRegistry(ctx context.Context, req *QueryCoreContractRegistryRequest) (*QueryCoreContractRegistryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CoreContractRegistry not implemented")
 }
+func (*UnimplementedQueryServer) BlockedOraclePrograms(ctx context.Context, req *QueryBlockedOracleProgramsRequest) (*QueryBlockedOracleProgramsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedOraclePrograms not implemented")
+}
+func (*UnimplementedQueryServer) OracleProgramStats(ctx context.Context, req *QueryOracleProgramStatsRequest) (*QueryOracleProgramStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleProgramStats not implemented")
+}
+func (*UnimplementedQueryServer) UploadSession(ctx context.Context, req *QueryUploadSessionRequest) (*QueryUploadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadSession not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -558,6 +1153,24 @@ func _Query_OracleProgram_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OracleProgramExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleProgramExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleProgramExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Query/OracleProgramExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleProgramExists(ctx, req.(*QueryOracleProgramExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_OraclePrograms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryOracleProgramsRequest)
 	if err := dec(in); err != nil {
@@ -594,33 +1207,91 @@ func _Query_CoreContractRegistry_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
+func _Query_BlockedOraclePrograms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedOracleProgramsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+		return srv.(QueryServer).BlockedOraclePrograms(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sedachain.wasm_storage.v1.Query/Params",
+		FullMethod: "/sedachain.wasm_storage.v1.Query/BlockedOraclePrograms",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+		return srv.(QueryServer).BlockedOraclePrograms(ctx, req.(*QueryBlockedOracleProgramsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sedachain.wasm_storage.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _Query_OracleProgramStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleProgramStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleProgramStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Query/OracleProgramStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleProgramStats(ctx, req.(*QueryOracleProgramStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUploadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Query/UploadSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UploadSession(ctx, req.(*QueryUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sedachain.wasm_storage.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "OracleProgram",
 			Handler:    _Query_OracleProgram_Handler,
 		},
+		{
+			MethodName: "OracleProgramExists",
+			Handler:    _Query_OracleProgramExists_Handler,
+		},
 		{
 			MethodName: "OraclePrograms",
 			Handler:    _Query_OraclePrograms_Handler,
@@ -629,6 +1300,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CoreContractRegistry",
 			Handler:    _Query_CoreContractRegistry_Handler,
 		},
+		{
+			MethodName: "BlockedOraclePrograms",
+			Handler:    _Query_BlockedOraclePrograms_Handler,
+		},
+		{
+			MethodName: "OracleProgramStats",
+			Handler:    _Query_OracleProgramStats_Handler,
+		},
+		{
+			MethodName: "UploadSession",
+			Handler:    _Query_UploadSession_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -658,6 +1341,16 @@ func (m *QueryOracleProgramRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.IncludeBlocked {
+		i--
+		if m.IncludeBlocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Hash) > 0 {
 		i -= len(m.Hash)
 		copy(dAtA[i:], m.Hash)
@@ -703,6 +1396,79 @@ func (m *QueryOracleProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryOracleProgramExistsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleProgramExistsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleProgramExistsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleProgramExistsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleProgramExistsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleProgramExistsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Exists {
+		i--
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryOracleProgramsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -723,6 +1489,60 @@ func (m *QueryOracleProgramsRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBytecodeSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBytecodeSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinBytecodeSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinBytecodeSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AddedBefore != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.AddedBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AddedBefore):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AddedAfter != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.AddedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AddedAfter):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OrderByAddedAt {
+		i--
+		if m.OrderByAddedAt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -758,6 +1578,20 @@ func (m *QueryOracleProgramsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.Programs) > 0 {
+		for iNdEx := len(m.Programs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Programs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -825,6 +1659,20 @@ func (m *QueryCoreContractRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -835,7 +1683,7 @@ func (m *QueryCoreContractRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBlockedOracleProgramsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -845,20 +1693,32 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBlockedOracleProgramsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBlockedOracleProgramsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBlockedOracleProgramsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -868,68 +1728,295 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBlockedOracleProgramsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBlockedOracleProgramsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockedOraclePrograms) > 0 {
+		for iNdEx := len(m.BlockedOraclePrograms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedOraclePrograms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryOracleProgramStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryOracleProgramRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryOracleProgramStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleProgramStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOracleProgramResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOracleProgramStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleProgramStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleProgramStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OracleProgram != nil {
-		l = m.OracleProgram.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOracleProgramsRequest) Size() (n int) {
-	if m == nil {
+func (m *QueryUploadSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUploadSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUploadSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UploadId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UploadId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUploadSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUploadSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUploadSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UploadSession.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryOracleProgramRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeBlocked {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryOracleProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleProgram != nil {
+		l = m.OracleProgram.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleProgramExistsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleProgramExistsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exists {
+		n += 2
+	}
+	if m.Blocked {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryOracleProgramsRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -938,6 +2025,31 @@ func (m *QueryOracleProgramsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OrderByAddedAt {
+		n += 2
+	}
+	if m.AddedAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AddedAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AddedBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AddedBefore)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinBytecodeSize != 0 {
+		n += 1 + sovQuery(uint64(m.MinBytecodeSize))
+	}
+	if m.MaxBytecodeSize != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBytecodeSize))
+	}
 	return n
 }
 
@@ -957,6 +2069,12 @@ func (m *QueryOracleProgramsResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.Programs) > 0 {
+		for _, e := range m.Programs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -979,36 +2097,963 @@ func (m *QueryCoreContractRegistryResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlockedOracleProgramsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedOracleProgramsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedOraclePrograms) > 0 {
+		for _, e := range m.BlockedOraclePrograms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleProgramStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleProgramStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUploadSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UploadId != 0 {
+		n += 1 + sovQuery(uint64(m.UploadId))
+	}
+	return n
+}
+
+func (m *QueryUploadSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UploadSession.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryOracleProgramRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleProgramRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleProgramRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeBlocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeBlocked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleProgram", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OracleProgram == nil {
+				m.OracleProgram = &OracleProgram{}
+			}
+			if err := m.OracleProgram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleProgramExistsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleProgramExistsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleProgramExistsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleProgramExistsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleProgramExistsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleProgramExistsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exists = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleProgramsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleProgramsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleProgramsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderByAddedAt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OrderByAddedAt = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddedAfter == nil {
+				m.AddedAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.AddedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddedBefore == nil {
+				m.AddedBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.AddedBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBytecodeSize", wireType)
+			}
+			m.MinBytecodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBytecodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytecodeSize", wireType)
+			}
+			m.MaxBytecodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytecodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleProgramsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleProgramsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleProgramsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = append(m.List, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Programs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Programs = append(m.Programs, OracleProgramSummary{})
+			if err := m.Programs[len(m.Programs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCoreContractRegistryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCoreContractRegistryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCoreContractRegistryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryOracleProgramRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCoreContractRegistryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1031,15 +3076,15 @@ func (m *QueryOracleProgramRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleProgramRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCoreContractRegistryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleProgramRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCoreContractRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1067,7 +3112,41 @@ func (m *QueryOracleProgramRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, CoreContractRegistryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1090,7 +3169,7 @@ func (m *QueryOracleProgramRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleProgramResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockedOracleProgramsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1113,15 +3192,15 @@ func (m *QueryOracleProgramResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleProgramResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockedOracleProgramsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockedOracleProgramsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleProgram", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1148,10 +3227,10 @@ func (m *QueryOracleProgramResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OracleProgram == nil {
-				m.OracleProgram = &OracleProgram{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.OracleProgram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1176,7 +3255,7 @@ func (m *QueryOracleProgramResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleProgramsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockedOracleProgramsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1199,13 +3278,47 @@ func (m *QueryOracleProgramsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleProgramsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockedOracleProgramsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleProgramsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockedOracleProgramsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedOraclePrograms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedOraclePrograms = append(m.BlockedOraclePrograms, BlockedOracleProgram{})
+			if err := m.BlockedOraclePrograms[len(m.BlockedOraclePrograms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1235,7 +3348,7 @@ func (m *QueryOracleProgramsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1262,7 +3375,7 @@ func (m *QueryOracleProgramsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOracleProgramsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOracleProgramStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1285,15 +3398,15 @@ func (m *QueryOracleProgramsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOracleProgramsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOracleProgramStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOracleProgramsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOracleProgramStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1321,11 +3434,61 @@ func (m *QueryOracleProgramsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.List = append(m.List, string(dAtA[iNdEx:postIndex]))
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleProgramStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleProgramStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleProgramStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1352,10 +3515,7 @@ func (m *QueryOracleProgramsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1380,7 +3540,7 @@ func (m *QueryOracleProgramsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCoreContractRegistryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUploadSessionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1403,12 +3563,31 @@ func (m *QueryCoreContractRegistryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCoreContractRegistryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUploadSessionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCoreContractRegistryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUploadSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadId", wireType)
+			}
+			m.UploadId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1430,7 +3609,7 @@ func (m *QueryCoreContractRegistryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCoreContractRegistryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUploadSessionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1453,17 +3632,17 @@ func (m *QueryCoreContractRegistryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCoreContractRegistryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUploadSessionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCoreContractRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUploadSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadSession", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1473,23 +3652,24 @@ func (m *QueryCoreContractRegistryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.UploadSession.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
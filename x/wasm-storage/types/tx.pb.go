@@ -41,6 +41,12 @@ type MsgStoreOracleProgram struct {
 	Wasm []byte `protobuf:"bytes,2,opt,name=wasm,proto3" json:"wasm,omitempty"`
 	// StorageFee is the fee incurred for storing the unzipped wasm bytes.
 	StorageFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=storage_fee,json=storageFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"storage_fee"`
+	// Metadata is the optional descriptive information of the oracle program.
+	Metadata OracleProgramMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata"`
+	// Hash is the hex-encoded hash of an oracle program that is already
+	// stored. It can be given instead of the wasm to make the store
+	// idempotent, in which case no storage fee is charged.
+	Hash string `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
 func (m *MsgStoreOracleProgram) Reset()         { *m = MsgStoreOracleProgram{} }
@@ -97,9 +103,25 @@ func (m *MsgStoreOracleProgram) GetStorageFee() github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+func (m *MsgStoreOracleProgram) GetMetadata() OracleProgramMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return OracleProgramMetadata{}
+}
+
+func (m *MsgStoreOracleProgram) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
 // The response message for the StoreOracleProgram method.
 type MsgStoreOracleProgramResponse struct {
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Metadata is the metadata of the stored oracle program.
+	Metadata OracleProgramMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *MsgStoreOracleProgramResponse) Reset()         { *m = MsgStoreOracleProgramResponse{} }
@@ -142,30 +164,41 @@ func (m *MsgStoreOracleProgramResponse) GetHash() string {
 	return ""
 }
 
-// The request message for the InstantiateCoreContract method.
-type MsgInstantiateCoreContract struct {
-	Sender string                                                    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Admin  string                                                    `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
-	CodeID uint64                                                    `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
-	Label  string                                                    `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
-	Msg    github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,5,opt,name=msg,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"msg,omitempty"`
-	Funds  github_com_cosmos_cosmos_sdk_types.Coins                  `protobuf:"bytes,6,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
-	Salt   []byte                                                    `protobuf:"bytes,7,opt,name=salt,proto3" json:"salt,omitempty"`
-	FixMsg bool                                                      `protobuf:"varint,8,opt,name=fix_msg,json=fixMsg,proto3" json:"fix_msg,omitempty"`
+func (m *MsgStoreOracleProgramResponse) GetMetadata() OracleProgramMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return OracleProgramMetadata{}
 }
 
-func (m *MsgInstantiateCoreContract) Reset()         { *m = MsgInstantiateCoreContract{} }
-func (m *MsgInstantiateCoreContract) String() string { return proto.CompactTextString(m) }
-func (*MsgInstantiateCoreContract) ProtoMessage()    {}
-func (*MsgInstantiateCoreContract) Descriptor() ([]byte, []int) {
+// The request message for the BeginOracleProgramUpload method.
+type MsgBeginOracleProgramUpload struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hash is the hex-encoded hash of the unzipped oracle program.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// UnzippedSize is the size of the unzipped oracle program in bytes.
+	UnzippedSize uint64 `protobuf:"varint,3,opt,name=unzipped_size,json=unzippedSize,proto3" json:"unzipped_size,omitempty"`
+	// StorageFee is the fee incurred for storing the unzipped wasm bytes. It
+	// is held in escrow until the upload is finalized or expires.
+	StorageFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=storage_fee,json=storageFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"storage_fee"`
+	// Metadata is the optional descriptive information of the oracle program.
+	Metadata OracleProgramMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata"`
+	// ZippedSize is the size of the gzip-compressed oracle program in bytes.
+	ZippedSize uint64 `protobuf:"varint,6,opt,name=zipped_size,json=zippedSize,proto3" json:"zipped_size,omitempty"`
+}
+
+func (m *MsgBeginOracleProgramUpload) Reset()         { *m = MsgBeginOracleProgramUpload{} }
+func (m *MsgBeginOracleProgramUpload) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginOracleProgramUpload) ProtoMessage()    {}
+func (*MsgBeginOracleProgramUpload) Descriptor() ([]byte, []int) {
 	return fileDescriptor_10a76e8135c0d000, []int{2}
 }
-func (m *MsgInstantiateCoreContract) XXX_Unmarshal(b []byte) error {
+func (m *MsgBeginOracleProgramUpload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstantiateCoreContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBeginOracleProgramUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstantiateCoreContract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBeginOracleProgramUpload.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -175,91 +208,78 @@ func (m *MsgInstantiateCoreContract) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgInstantiateCoreContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstantiateCoreContract.Merge(m, src)
+func (m *MsgBeginOracleProgramUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginOracleProgramUpload.Merge(m, src)
 }
-func (m *MsgInstantiateCoreContract) XXX_Size() int {
+func (m *MsgBeginOracleProgramUpload) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstantiateCoreContract) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstantiateCoreContract.DiscardUnknown(m)
+func (m *MsgBeginOracleProgramUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginOracleProgramUpload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstantiateCoreContract proto.InternalMessageInfo
+var xxx_messageInfo_MsgBeginOracleProgramUpload proto.InternalMessageInfo
 
-func (m *MsgInstantiateCoreContract) GetSender() string {
+func (m *MsgBeginOracleProgramUpload) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgInstantiateCoreContract) GetAdmin() string {
+func (m *MsgBeginOracleProgramUpload) GetHash() string {
 	if m != nil {
-		return m.Admin
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *MsgInstantiateCoreContract) GetCodeID() uint64 {
+func (m *MsgBeginOracleProgramUpload) GetUnzippedSize() uint64 {
 	if m != nil {
-		return m.CodeID
+		return m.UnzippedSize
 	}
 	return 0
 }
 
-func (m *MsgInstantiateCoreContract) GetLabel() string {
-	if m != nil {
-		return m.Label
-	}
-	return ""
-}
-
-func (m *MsgInstantiateCoreContract) GetMsg() github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage {
-	if m != nil {
-		return m.Msg
-	}
-	return nil
-}
-
-func (m *MsgInstantiateCoreContract) GetFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *MsgBeginOracleProgramUpload) GetStorageFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Funds
+		return m.StorageFee
 	}
 	return nil
 }
 
-func (m *MsgInstantiateCoreContract) GetSalt() []byte {
+func (m *MsgBeginOracleProgramUpload) GetMetadata() OracleProgramMetadata {
 	if m != nil {
-		return m.Salt
+		return m.Metadata
 	}
-	return nil
+	return OracleProgramMetadata{}
 }
 
-func (m *MsgInstantiateCoreContract) GetFixMsg() bool {
+func (m *MsgBeginOracleProgramUpload) GetZippedSize() uint64 {
 	if m != nil {
-		return m.FixMsg
+		return m.ZippedSize
 	}
-	return false
+	return 0
 }
 
-// The response message for the InstantiateCoreContract method.
-type MsgInstantiateCoreContractResponse struct {
-	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+// The response message for the BeginOracleProgramUpload method.
+type MsgBeginOracleProgramUploadResponse struct {
+	UploadID         uint64 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	ExpirationHeight int64  `protobuf:"varint,2,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
 }
 
-func (m *MsgInstantiateCoreContractResponse) Reset()         { *m = MsgInstantiateCoreContractResponse{} }
-func (m *MsgInstantiateCoreContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgInstantiateCoreContractResponse) ProtoMessage()    {}
-func (*MsgInstantiateCoreContractResponse) Descriptor() ([]byte, []int) {
+func (m *MsgBeginOracleProgramUploadResponse) Reset()         { *m = MsgBeginOracleProgramUploadResponse{} }
+func (m *MsgBeginOracleProgramUploadResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginOracleProgramUploadResponse) ProtoMessage()    {}
+func (*MsgBeginOracleProgramUploadResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_10a76e8135c0d000, []int{3}
 }
-func (m *MsgInstantiateCoreContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBeginOracleProgramUploadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstantiateCoreContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBeginOracleProgramUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstantiateCoreContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBeginOracleProgramUploadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -269,45 +289,52 @@ func (m *MsgInstantiateCoreContractResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgInstantiateCoreContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstantiateCoreContractResponse.Merge(m, src)
+func (m *MsgBeginOracleProgramUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginOracleProgramUploadResponse.Merge(m, src)
 }
-func (m *MsgInstantiateCoreContractResponse) XXX_Size() int {
+func (m *MsgBeginOracleProgramUploadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstantiateCoreContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstantiateCoreContractResponse.DiscardUnknown(m)
+func (m *MsgBeginOracleProgramUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginOracleProgramUploadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstantiateCoreContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBeginOracleProgramUploadResponse proto.InternalMessageInfo
 
-func (m *MsgInstantiateCoreContractResponse) GetContractAddress() string {
+func (m *MsgBeginOracleProgramUploadResponse) GetUploadID() uint64 {
 	if m != nil {
-		return m.ContractAddress
+		return m.UploadID
 	}
-	return ""
+	return 0
 }
 
-// The request message for the UpdateParams method.
-type MsgUpdateParams struct {
-	// Authority is the address that controls the module (defaults to x/gov unless
-	// overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+func (m *MsgBeginOracleProgramUploadResponse) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+// The request message for the AppendOracleProgramChunk method.
+type MsgAppendOracleProgramChunk struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	UploadID uint64 `protobuf:"varint,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// Chunk is the next chunk of the gzip-compressed wasm.
+	Chunk []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (m *MsgAppendOracleProgramChunk) Reset()         { *m = MsgAppendOracleProgramChunk{} }
+func (m *MsgAppendOracleProgramChunk) String() string { return proto.CompactTextString(m) }
+func (*MsgAppendOracleProgramChunk) ProtoMessage()    {}
+func (*MsgAppendOracleProgramChunk) Descriptor() ([]byte, []int) {
 	return fileDescriptor_10a76e8135c0d000, []int{4}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgAppendOracleProgramChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAppendOracleProgramChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAppendOracleProgramChunk.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -317,48 +344,56 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgAppendOracleProgramChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppendOracleProgramChunk.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgAppendOracleProgramChunk) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgAppendOracleProgramChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppendOracleProgramChunk.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgAppendOracleProgramChunk proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgAppendOracleProgramChunk) GetSender() string {
 	if m != nil {
-		return m.Authority
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgAppendOracleProgramChunk) GetUploadID() uint64 {
 	if m != nil {
-		return m.Params
+		return m.UploadID
 	}
-	return Params{}
+	return 0
 }
 
-// The response message for the UpdateParams method.
-type MsgUpdateParamsResponse struct {
+func (m *MsgAppendOracleProgramChunk) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+// The response message for the AppendOracleProgramChunk method.
+type MsgAppendOracleProgramChunkResponse struct {
+	ReceivedBytes uint64 `protobuf:"varint,1,opt,name=received_bytes,json=receivedBytes,proto3" json:"received_bytes,omitempty"`
+}
+
+func (m *MsgAppendOracleProgramChunkResponse) Reset()         { *m = MsgAppendOracleProgramChunkResponse{} }
+func (m *MsgAppendOracleProgramChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppendOracleProgramChunkResponse) ProtoMessage()    {}
+func (*MsgAppendOracleProgramChunkResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_10a76e8135c0d000, []int{5}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAppendOracleProgramChunkResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAppendOracleProgramChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAppendOracleProgramChunkResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -368,42 +403,43 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgAppendOracleProgramChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppendOracleProgramChunkResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgAppendOracleProgramChunkResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgAppendOracleProgramChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppendOracleProgramChunkResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAppendOracleProgramChunkResponse proto.InternalMessageInfo
 
-// The request message for the MsgRefundTxFee method.
-type MsgRefundTxFee struct {
-	// Authority is the address that controls the method.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// DrId is the hex-encoded data request ID.
-	DrId string `protobuf:"bytes,2,opt,name=dr_id,json=drId,proto3" json:"dr_id,omitempty"`
-	// PublicKey is the hex-encoded public key (identifier) of the executor.
-	PublicKey string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	// IsReveal is true for a reveal message and false for a commit message.
-	IsReveal bool `protobuf:"varint,4,opt,name=is_reveal,json=isReveal,proto3" json:"is_reveal,omitempty"`
+func (m *MsgAppendOracleProgramChunkResponse) GetReceivedBytes() uint64 {
+	if m != nil {
+		return m.ReceivedBytes
+	}
+	return 0
 }
 
-func (m *MsgRefundTxFee) Reset()         { *m = MsgRefundTxFee{} }
-func (m *MsgRefundTxFee) String() string { return proto.CompactTextString(m) }
-func (*MsgRefundTxFee) ProtoMessage()    {}
-func (*MsgRefundTxFee) Descriptor() ([]byte, []int) {
+// The request message for the FinalizeOracleProgramUpload method.
+type MsgFinalizeOracleProgramUpload struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	UploadID uint64 `protobuf:"varint,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+}
+
+func (m *MsgFinalizeOracleProgramUpload) Reset()         { *m = MsgFinalizeOracleProgramUpload{} }
+func (m *MsgFinalizeOracleProgramUpload) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeOracleProgramUpload) ProtoMessage()    {}
+func (*MsgFinalizeOracleProgramUpload) Descriptor() ([]byte, []int) {
 	return fileDescriptor_10a76e8135c0d000, []int{6}
 }
-func (m *MsgRefundTxFee) XXX_Unmarshal(b []byte) error {
+func (m *MsgFinalizeOracleProgramUpload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRefundTxFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFinalizeOracleProgramUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRefundTxFee.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFinalizeOracleProgramUpload.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -413,62 +449,51 @@ func (m *MsgRefundTxFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgRefundTxFee) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRefundTxFee.Merge(m, src)
+func (m *MsgFinalizeOracleProgramUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeOracleProgramUpload.Merge(m, src)
 }
-func (m *MsgRefundTxFee) XXX_Size() int {
+func (m *MsgFinalizeOracleProgramUpload) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRefundTxFee) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRefundTxFee.DiscardUnknown(m)
+func (m *MsgFinalizeOracleProgramUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeOracleProgramUpload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRefundTxFee proto.InternalMessageInfo
-
-func (m *MsgRefundTxFee) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
-}
+var xxx_messageInfo_MsgFinalizeOracleProgramUpload proto.InternalMessageInfo
 
-func (m *MsgRefundTxFee) GetDrId() string {
+func (m *MsgFinalizeOracleProgramUpload) GetSender() string {
 	if m != nil {
-		return m.DrId
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgRefundTxFee) GetPublicKey() string {
+func (m *MsgFinalizeOracleProgramUpload) GetUploadID() uint64 {
 	if m != nil {
-		return m.PublicKey
+		return m.UploadID
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgRefundTxFee) GetIsReveal() bool {
-	if m != nil {
-		return m.IsReveal
-	}
-	return false
+// The response message for the FinalizeOracleProgramUpload method.
+type MsgFinalizeOracleProgramUploadResponse struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-// The response message for the MsgRefundTxFee method.
-type MsgRefundTxFeeResponse struct {
+func (m *MsgFinalizeOracleProgramUploadResponse) Reset() {
+	*m = MsgFinalizeOracleProgramUploadResponse{}
 }
-
-func (m *MsgRefundTxFeeResponse) Reset()         { *m = MsgRefundTxFeeResponse{} }
-func (m *MsgRefundTxFeeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRefundTxFeeResponse) ProtoMessage()    {}
-func (*MsgRefundTxFeeResponse) Descriptor() ([]byte, []int) {
+func (m *MsgFinalizeOracleProgramUploadResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeOracleProgramUploadResponse) ProtoMessage()    {}
+func (*MsgFinalizeOracleProgramUploadResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_10a76e8135c0d000, []int{7}
 }
-func (m *MsgRefundTxFeeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgFinalizeOracleProgramUploadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRefundTxFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFinalizeOracleProgramUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRefundTxFeeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFinalizeOracleProgramUploadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -478,793 +503,4054 @@ func (m *MsgRefundTxFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgRefundTxFeeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRefundTxFeeResponse.Merge(m, src)
+func (m *MsgFinalizeOracleProgramUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeOracleProgramUploadResponse.Merge(m, src)
 }
-func (m *MsgRefundTxFeeResponse) XXX_Size() int {
+func (m *MsgFinalizeOracleProgramUploadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRefundTxFeeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRefundTxFeeResponse.DiscardUnknown(m)
+func (m *MsgFinalizeOracleProgramUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeOracleProgramUploadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRefundTxFeeResponse proto.InternalMessageInfo
-
-func init() {
-	proto.RegisterType((*MsgStoreOracleProgram)(nil), "sedachain.wasm_storage.v1.MsgStoreOracleProgram")
-	proto.RegisterType((*MsgStoreOracleProgramResponse)(nil), "sedachain.wasm_storage.v1.MsgStoreOracleProgramResponse")
-	proto.RegisterType((*MsgInstantiateCoreContract)(nil), "sedachain.wasm_storage.v1.MsgInstantiateCoreContract")
-	proto.RegisterType((*MsgInstantiateCoreContractResponse)(nil), "sedachain.wasm_storage.v1.MsgInstantiateCoreContractResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "sedachain.wasm_storage.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sedachain.wasm_storage.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgRefundTxFee)(nil), "sedachain.wasm_storage.v1.MsgRefundTxFee")
-	proto.RegisterType((*MsgRefundTxFeeResponse)(nil), "sedachain.wasm_storage.v1.MsgRefundTxFeeResponse")
-}
+var xxx_messageInfo_MsgFinalizeOracleProgramUploadResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterFile("sedachain/wasm_storage/v1/tx.proto", fileDescriptor_10a76e8135c0d000)
+func (m *MsgFinalizeOracleProgramUploadResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
 }
 
-var fileDescriptor_10a76e8135c0d000 = []byte{
-	// 854 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4b, 0x6f, 0x1b, 0x55,
-	0x14, 0xce, 0xd4, 0xb1, 0x13, 0x1f, 0x47, 0x2d, 0x0c, 0x81, 0x4c, 0x8c, 0xea, 0x18, 0xb3, 0x31,
-	0x11, 0x99, 0x69, 0x52, 0xf1, 0xaa, 0x54, 0x21, 0x6c, 0x14, 0x29, 0x42, 0x56, 0xcb, 0x14, 0x84,
-	0xc4, 0x66, 0x74, 0x3d, 0x73, 0x73, 0x3d, 0x8a, 0x67, 0xae, 0x75, 0xcf, 0x4d, 0x6a, 0xef, 0x50,
-	0xff, 0x00, 0xac, 0xfb, 0x07, 0x78, 0xac, 0xb2, 0xe0, 0x47, 0x74, 0x59, 0xb1, 0x62, 0x15, 0x50,
-	0xb2, 0xc8, 0x92, 0x25, 0x12, 0x2b, 0x74, 0x1f, 0x76, 0x1d, 0x88, 0x9d, 0x36, 0x1b, 0xfb, 0x3e,
-	0xbe, 0xf3, 0x9d, 0x73, 0xbe, 0x73, 0xe6, 0x5c, 0x68, 0x20, 0x4d, 0x48, 0xdc, 0x23, 0x69, 0x1e,
-	0x3c, 0x26, 0x98, 0x45, 0x28, 0xb9, 0x20, 0x8c, 0x06, 0x47, 0xdb, 0x81, 0x1c, 0xfa, 0x03, 0xc1,
-	0x25, 0x77, 0xd7, 0x27, 0x18, 0x7f, 0x1a, 0xe3, 0x1f, 0x6d, 0x57, 0x6b, 0x31, 0xc7, 0x8c, 0x63,
-	0xd0, 0x25, 0xa8, 0x6c, 0xba, 0x54, 0x92, 0xed, 0x20, 0xe6, 0x69, 0x6e, 0x4c, 0xab, 0x6b, 0xf6,
-	0x3e, 0x43, 0xa6, 0x28, 0x33, 0x64, 0xf6, 0x62, 0x95, 0x71, 0xc6, 0xf5, 0x32, 0x50, 0x2b, 0x7b,
-	0xba, 0x6e, 0xe0, 0x91, 0xb9, 0x30, 0x1b, 0x7b, 0xf5, 0xfe, 0xec, 0x40, 0x2f, 0x04, 0x65, 0xd0,
-	0xaf, 0x93, 0x2c, 0xcd, 0x79, 0xa0, 0x7f, 0xcd, 0x51, 0xe3, 0x2f, 0x07, 0xde, 0xec, 0x20, 0x7b,
-	0x24, 0xb9, 0xa0, 0x0f, 0x04, 0x89, 0xfb, 0xf4, 0xa1, 0xe0, 0x4c, 0x90, 0xcc, 0xbd, 0x03, 0x25,
-	0xa4, 0x79, 0x42, 0x85, 0xe7, 0xd4, 0x9d, 0x66, 0xb9, 0xe5, 0xfd, 0xf6, 0xeb, 0xd6, 0xaa, 0x75,
-	0xfe, 0x59, 0x92, 0x08, 0x8a, 0xf8, 0x48, 0x8a, 0x34, 0x67, 0xa1, 0xc5, 0xb9, 0x2e, 0x2c, 0x2a,
-	0xa7, 0xde, 0x8d, 0xba, 0xd3, 0x5c, 0x09, 0xf5, 0xda, 0x7d, 0xe2, 0x40, 0xc5, 0x06, 0x11, 0xed,
-	0x53, 0xea, 0x15, 0xea, 0x85, 0x66, 0x65, 0x67, 0xdd, 0xb7, 0x44, 0x4a, 0x21, 0xdf, 0x2a, 0xe4,
-	0xb7, 0x79, 0x9a, 0xb7, 0x76, 0x9f, 0x9d, 0x6c, 0x2c, 0xfc, 0xf2, 0xc7, 0x46, 0x93, 0xa5, 0xb2,
-	0x77, 0xd8, 0xf5, 0x63, 0x9e, 0xd9, 0x94, 0xed, 0xdf, 0x16, 0x26, 0x07, 0x81, 0x1c, 0x0d, 0x28,
-	0x6a, 0x03, 0x7c, 0x7a, 0x7e, 0xbc, 0xb9, 0xd2, 0xa7, 0x8c, 0xc4, 0xa3, 0x48, 0x69, 0x8c, 0x3f,
-	0x9d, 0x1f, 0x6f, 0x3a, 0x21, 0x58, 0xaf, 0xbb, 0x94, 0xde, 0xab, 0x3c, 0x39, 0x3f, 0xde, 0xb4,
-	0x51, 0x36, 0xee, 0xc2, 0xed, 0x4b, 0x13, 0x0e, 0x29, 0x0e, 0x78, 0x8e, 0x54, 0xa5, 0xd1, 0x23,
-	0xd8, 0x33, 0x69, 0x87, 0x7a, 0xdd, 0xf8, 0xb1, 0x00, 0xd5, 0x0e, 0xb2, 0xbd, 0x1c, 0x25, 0xc9,
-	0x65, 0x4a, 0x24, 0x6d, 0x73, 0x41, 0xdb, 0x3c, 0x97, 0x82, 0xc4, 0xf2, 0x1a, 0x5a, 0xf9, 0x50,
-	0x24, 0x49, 0x96, 0xe6, 0x5a, 0xac, 0x79, 0x06, 0x06, 0xe6, 0xbe, 0x0b, 0x4b, 0x31, 0x4f, 0x68,
-	0x94, 0x26, 0x5e, 0xa1, 0xee, 0x34, 0x17, 0x5b, 0x70, 0x7a, 0xb2, 0x51, 0x6a, 0xf3, 0x84, 0xee,
-	0x7d, 0x1e, 0x96, 0xd4, 0xd5, 0x5e, 0xe2, 0xae, 0x42, 0xb1, 0x4f, 0xba, 0xb4, 0xef, 0x2d, 0xea,
-	0xd0, 0xcd, 0xc6, 0x7d, 0x00, 0x85, 0x0c, 0x99, 0x57, 0x54, 0x55, 0x69, 0xdd, 0xff, 0xe7, 0x64,
-	0xe3, 0x93, 0x29, 0x69, 0xdb, 0x1c, 0xb3, 0x6f, 0x08, 0x66, 0xba, 0x5d, 0x92, 0x60, 0xa8, 0xff,
-	0xad, 0xbc, 0x21, 0x79, 0x3c, 0xce, 0xb0, 0x43, 0x11, 0x09, 0xa3, 0xa1, 0x62, 0x72, 0x09, 0x14,
-	0xf7, 0x0f, 0xf3, 0x04, 0xbd, 0xd2, 0x55, 0xc5, 0xbc, 0xf3, 0xaa, 0xc5, 0x0c, 0x0d, 0xb3, 0xaa,
-	0x01, 0x92, 0xbe, 0xf4, 0x96, 0x4c, 0x2b, 0xa9, 0xb5, 0xbb, 0x06, 0x4b, 0xfb, 0xe9, 0x30, 0x52,
-	0xb9, 0x2c, 0xd7, 0x9d, 0xe6, 0x72, 0x58, 0xda, 0x4f, 0x87, 0x1d, 0x64, 0x17, 0xcb, 0x9b, 0x42,
-	0x63, 0x76, 0xa1, 0x26, 0x35, 0x6e, 0xc3, 0x6b, 0xb1, 0x3d, 0x8b, 0x88, 0xd1, 0xfb, 0xca, 0xd2,
-	0xdd, 0x1a, 0x5b, 0xd8, 0xe3, 0xc6, 0x53, 0x07, 0x6e, 0x75, 0x90, 0x7d, 0x3d, 0x48, 0x88, 0xa4,
-	0x0f, 0x89, 0x20, 0x19, 0xba, 0x1f, 0x42, 0x99, 0x1c, 0xca, 0x1e, 0x17, 0xa9, 0x1c, 0x5d, 0xc9,
-	0xf8, 0x02, 0xea, 0x7e, 0x0a, 0xa5, 0x81, 0x66, 0xd0, 0x0d, 0x51, 0xd9, 0x79, 0xc7, 0x9f, 0x39,
-	0x5e, 0x7c, 0xe3, 0xaa, 0xb5, 0xa8, 0xc4, 0x0d, 0xad, 0xd9, 0xbd, 0x9b, 0x4a, 0x84, 0x17, 0x84,
-	0x8d, 0x75, 0x58, 0xfb, 0x4f, 0x6c, 0xe3, 0xe4, 0x1b, 0x3f, 0x3b, 0x70, 0xb3, 0x83, 0x2c, 0xa4,
-	0x4a, 0xeb, 0xaf, 0x86, 0xbb, 0x94, 0x5e, 0x3b, 0xec, 0x37, 0xa0, 0x98, 0x08, 0xd5, 0x94, 0x37,
-	0xcc, 0xc7, 0x92, 0x88, 0xbd, 0xc4, 0xbd, 0x0d, 0x30, 0x38, 0xec, 0xf6, 0xd3, 0x38, 0x3a, 0xa0,
-	0x23, 0xdd, 0xae, 0xe5, 0xb0, 0x6c, 0x4e, 0xbe, 0xa0, 0x23, 0xf7, 0x6d, 0x28, 0xa7, 0x18, 0x09,
-	0x7a, 0x44, 0x89, 0xe9, 0xd4, 0xe5, 0x70, 0x39, 0xc5, 0x50, 0xef, 0xff, 0x97, 0x86, 0x07, 0x6f,
-	0x5d, 0x0c, 0x75, 0x9c, 0xc5, 0xce, 0xdf, 0x05, 0x28, 0x74, 0x90, 0xb9, 0xdf, 0x39, 0xe0, 0x5e,
-	0x36, 0xbe, 0xe6, 0x08, 0x78, 0xe9, 0xf7, 0x5f, 0xfd, 0xf8, 0x55, 0x2d, 0x26, 0xdd, 0xf4, 0xbd,
-	0x03, 0x6b, 0xb3, 0x46, 0xc3, 0x07, 0xf3, 0x59, 0x67, 0x98, 0x55, 0xef, 0x5f, 0xcb, 0x6c, 0x12,
-	0x51, 0x0e, 0x2b, 0x17, 0xda, 0x72, 0x73, 0x3e, 0xdd, 0x34, 0xb6, 0xba, 0xf3, 0xf2, 0xd8, 0x89,
-	0xbf, 0x03, 0xa8, 0x4c, 0xb7, 0xd3, 0x7b, 0xf3, 0x29, 0xa6, 0xa0, 0xd5, 0xed, 0x97, 0x86, 0x8e,
-	0x9d, 0xb5, 0xbe, 0x7c, 0x76, 0x5a, 0x73, 0x9e, 0x9f, 0xd6, 0x9c, 0x3f, 0x4f, 0x6b, 0xce, 0x0f,
-	0x67, 0xb5, 0x85, 0xe7, 0x67, 0xb5, 0x85, 0xdf, 0xcf, 0x6a, 0x0b, 0xdf, 0x7e, 0x34, 0x35, 0x67,
-	0x14, 0xad, 0x7e, 0xe3, 0x62, 0xde, 0xd7, 0x9b, 0x2d, 0xf3, 0x4e, 0x9a, 0x11, 0xb7, 0x35, 0x7e,
-	0x29, 0xf5, 0xf0, 0xe9, 0x96, 0x34, 0xf2, 0xee, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x64, 0xc6,
-	0x71, 0xfe, 0xf9, 0x07, 0x00, 0x00,
+// The request message for the InstantiateCoreContract method.
+type MsgInstantiateCoreContract struct {
+	Sender string                                                    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Admin  string                                                    `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	CodeID uint64                                                    `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	Label  string                                                    `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Msg    github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,5,opt,name=msg,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"msg,omitempty"`
+	Funds  github_com_cosmos_cosmos_sdk_types.Coins                  `protobuf:"bytes,6,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
+	Salt   []byte                                                    `protobuf:"bytes,7,opt,name=salt,proto3" json:"salt,omitempty"`
+	FixMsg bool                                                      `protobuf:"varint,8,opt,name=fix_msg,json=fixMsg,proto3" json:"fix_msg,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// StoreOracleProgram stores an oracle program.
-	StoreOracleProgram(ctx context.Context, in *MsgStoreOracleProgram, opts ...grpc.CallOption) (*MsgStoreOracleProgramResponse, error)
-	// InstantiateCoreContract instantiates the Core Contract and registers its
-	// address.
-	InstantiateCoreContract(ctx context.Context, in *MsgInstantiateCoreContract, opts ...grpc.CallOption) (*MsgInstantiateCoreContractResponse, error)
-	// The UpdateParams method updates the module's parameters.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// The RefundTxFee method is used by the Core Contract to refund tx fee.
-	RefundTxFee(ctx context.Context, in *MsgRefundTxFee, opts ...grpc.CallOption) (*MsgRefundTxFeeResponse, error)
+func (m *MsgInstantiateCoreContract) Reset()         { *m = MsgInstantiateCoreContract{} }
+func (m *MsgInstantiateCoreContract) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateCoreContract) ProtoMessage()    {}
+func (*MsgInstantiateCoreContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{8}
+}
+func (m *MsgInstantiateCoreContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantiateCoreContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantiateCoreContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantiateCoreContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateCoreContract.Merge(m, src)
+}
+func (m *MsgInstantiateCoreContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantiateCoreContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateCoreContract.DiscardUnknown(m)
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_MsgInstantiateCoreContract proto.InternalMessageInfo
+
+func (m *MsgInstantiateCoreContract) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgInstantiateCoreContract) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
 }
 
-func (c *msgClient) StoreOracleProgram(ctx context.Context, in *MsgStoreOracleProgram, opts ...grpc.CallOption) (*MsgStoreOracleProgramResponse, error) {
-	out := new(MsgStoreOracleProgramResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/StoreOracleProgram", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInstantiateCoreContract) GetCodeID() uint64 {
+	if m != nil {
+		return m.CodeID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) InstantiateCoreContract(ctx context.Context, in *MsgInstantiateCoreContract, opts ...grpc.CallOption) (*MsgInstantiateCoreContractResponse, error) {
-	out := new(MsgInstantiateCoreContractResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/InstantiateCoreContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInstantiateCoreContract) GetLabel() string {
+	if m != nil {
+		return m.Label
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInstantiateCoreContract) GetMsg() github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage {
+	if m != nil {
+		return m.Msg
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RefundTxFee(ctx context.Context, in *MsgRefundTxFee, opts ...grpc.CallOption) (*MsgRefundTxFeeResponse, error) {
-	out := new(MsgRefundTxFeeResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/RefundTxFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInstantiateCoreContract) GetFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Funds
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// StoreOracleProgram stores an oracle program.
-	StoreOracleProgram(context.Context, *MsgStoreOracleProgram) (*MsgStoreOracleProgramResponse, error)
-	// InstantiateCoreContract instantiates the Core Contract and registers its
-	// address.
-	InstantiateCoreContract(context.Context, *MsgInstantiateCoreContract) (*MsgInstantiateCoreContractResponse, error)
-	// The UpdateParams method updates the module's parameters.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// The RefundTxFee method is used by the Core Contract to refund tx fee.
-	RefundTxFee(context.Context, *MsgRefundTxFee) (*MsgRefundTxFeeResponse, error)
+func (m *MsgInstantiateCoreContract) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgInstantiateCoreContract) GetFixMsg() bool {
+	if m != nil {
+		return m.FixMsg
+	}
+	return false
 }
 
-func (*UnimplementedMsgServer) StoreOracleProgram(ctx context.Context, req *MsgStoreOracleProgram) (*MsgStoreOracleProgramResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoreOracleProgram not implemented")
+// The response message for the InstantiateCoreContract method.
+type MsgInstantiateCoreContractResponse struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
-func (*UnimplementedMsgServer) InstantiateCoreContract(ctx context.Context, req *MsgInstantiateCoreContract) (*MsgInstantiateCoreContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstantiateCoreContract not implemented")
+
+func (m *MsgInstantiateCoreContractResponse) Reset()         { *m = MsgInstantiateCoreContractResponse{} }
+func (m *MsgInstantiateCoreContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateCoreContractResponse) ProtoMessage()    {}
+func (*MsgInstantiateCoreContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{9}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgInstantiateCoreContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RefundTxFee(ctx context.Context, req *MsgRefundTxFee) (*MsgRefundTxFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefundTxFee not implemented")
+func (m *MsgInstantiateCoreContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantiateCoreContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgInstantiateCoreContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateCoreContractResponse.Merge(m, src)
+}
+func (m *MsgInstantiateCoreContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantiateCoreContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateCoreContractResponse.DiscardUnknown(m)
 }
 
-func _Msg_StoreOracleProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStoreOracleProgram)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StoreOracleProgram(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.wasm_storage.v1.Msg/StoreOracleProgram",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StoreOracleProgram(ctx, req.(*MsgStoreOracleProgram))
+var xxx_messageInfo_MsgInstantiateCoreContractResponse proto.InternalMessageInfo
+
+func (m *MsgInstantiateCoreContractResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_InstantiateCoreContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInstantiateCoreContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InstantiateCoreContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.wasm_storage.v1.Msg/InstantiateCoreContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InstantiateCoreContract(ctx, req.(*MsgInstantiateCoreContract))
-	}
-	return interceptor(ctx, in, info, handler)
+// The request message for the MigrateCoreContract method.
+type MsgMigrateCoreContract struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// CodeID is the code ID to migrate the Core Contract to.
+	CodeID uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Msg is the JSON-encoded migrate message passed to the contract.
+	Msg github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,3,opt,name=msg,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"msg,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.wasm_storage.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgMigrateCoreContract) Reset()         { *m = MsgMigrateCoreContract{} }
+func (m *MsgMigrateCoreContract) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateCoreContract) ProtoMessage()    {}
+func (*MsgMigrateCoreContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{10}
+}
+func (m *MsgMigrateCoreContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateCoreContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateCoreContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgMigrateCoreContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateCoreContract.Merge(m, src)
+}
+func (m *MsgMigrateCoreContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateCoreContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateCoreContract.DiscardUnknown(m)
 }
 
-func _Msg_RefundTxFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRefundTxFee)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RefundTxFee(ctx, in)
+var xxx_messageInfo_MsgMigrateCoreContract proto.InternalMessageInfo
+
+func (m *MsgMigrateCoreContract) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.wasm_storage.v1.Msg/RefundTxFee",
+	return ""
+}
+
+func (m *MsgMigrateCoreContract) GetCodeID() uint64 {
+	if m != nil {
+		return m.CodeID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RefundTxFee(ctx, req.(*MsgRefundTxFee))
+	return 0
+}
+
+func (m *MsgMigrateCoreContract) GetMsg() github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage {
+	if m != nil {
+		return m.Msg
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sedachain.wasm_storage.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StoreOracleProgram",
-			Handler:    _Msg_StoreOracleProgram_Handler,
-		},
-		{
-			MethodName: "InstantiateCoreContract",
-			Handler:    _Msg_InstantiateCoreContract_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RefundTxFee",
-			Handler:    _Msg_RefundTxFee_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sedachain/wasm_storage/v1/tx.proto",
+// The response message for the MigrateCoreContract method.
+type MsgMigrateCoreContractResponse struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func (m *MsgStoreOracleProgram) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgMigrateCoreContractResponse) Reset()         { *m = MsgMigrateCoreContractResponse{} }
+func (m *MsgMigrateCoreContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateCoreContractResponse) ProtoMessage()    {}
+func (*MsgMigrateCoreContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{11}
 }
-
-func (m *MsgStoreOracleProgram) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMigrateCoreContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgStoreOracleProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StorageFee) > 0 {
-		for iNdEx := len(m.StorageFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StorageFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgMigrateCoreContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateCoreContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Wasm) > 0 {
-		i -= len(m.Wasm)
-		copy(dAtA[i:], m.Wasm)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Wasm)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgStoreOracleProgramResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgMigrateCoreContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateCoreContractResponse.Merge(m, src)
 }
-
-func (m *MsgStoreOracleProgramResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMigrateCoreContractResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgStoreOracleProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgMigrateCoreContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateCoreContractResponse.DiscardUnknown(m)
 }
 
-func (m *MsgInstantiateCoreContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgMigrateCoreContractResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateCoreContractResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgInstantiateCoreContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The request message for the BlockOracleProgram method.
+type MsgBlockOracleProgram struct {
+	// Authority is the address that controls the method.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Hash is the hex-encoded hash of the oracle program to block.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Reason describes why the oracle program is blocked.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgInstantiateCoreContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FixMsg {
-		i--
-		if m.FixMsg {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Salt) > 0 {
-		i -= len(m.Salt)
-		copy(dAtA[i:], m.Salt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Funds) > 0 {
-		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+func (m *MsgBlockOracleProgram) Reset()         { *m = MsgBlockOracleProgram{} }
+func (m *MsgBlockOracleProgram) String() string { return proto.CompactTextString(m) }
+func (*MsgBlockOracleProgram) ProtoMessage()    {}
+func (*MsgBlockOracleProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{12}
+}
+func (m *MsgBlockOracleProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBlockOracleProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBlockOracleProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.CodeID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgInstantiateCoreContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgBlockOracleProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBlockOracleProgram.Merge(m, src)
+}
+func (m *MsgBlockOracleProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBlockOracleProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBlockOracleProgram.DiscardUnknown(m)
 }
 
-func (m *MsgInstantiateCoreContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgBlockOracleProgram proto.InternalMessageInfo
+
+func (m *MsgBlockOracleProgram) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgInstantiateCoreContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgBlockOracleProgram) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBlockOracleProgram) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The response message for the BlockOracleProgram method.
+type MsgBlockOracleProgramResponse struct {
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgBlockOracleProgramResponse) Reset()         { *m = MsgBlockOracleProgramResponse{} }
+func (m *MsgBlockOracleProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBlockOracleProgramResponse) ProtoMessage()    {}
+func (*MsgBlockOracleProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{13}
+}
+func (m *MsgBlockOracleProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBlockOracleProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBlockOracleProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgBlockOracleProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBlockOracleProgramResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBlockOracleProgramResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgBlockOracleProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBlockOracleProgramResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRefundTxFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgBlockOracleProgramResponse proto.InternalMessageInfo
 
-func (m *MsgRefundTxFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The request message for the UnblockOracleProgram method.
+type MsgUnblockOracleProgram struct {
+	// Authority is the address that controls the method.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Hash is the hex-encoded hash of the oracle program to unblock.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (m *MsgRefundTxFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsReveal {
-		i--
-		if m.IsReveal {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgUnblockOracleProgram) Reset()         { *m = MsgUnblockOracleProgram{} }
+func (m *MsgUnblockOracleProgram) String() string { return proto.CompactTextString(m) }
+func (*MsgUnblockOracleProgram) ProtoMessage()    {}
+func (*MsgUnblockOracleProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{14}
+}
+func (m *MsgUnblockOracleProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnblockOracleProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnblockOracleProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DrId) > 0 {
-		i -= len(m.DrId)
-		copy(dAtA[i:], m.DrId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DrId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnblockOracleProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnblockOracleProgram.Merge(m, src)
+}
+func (m *MsgUnblockOracleProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnblockOracleProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnblockOracleProgram.DiscardUnknown(m)
 }
 
-func (m *MsgRefundTxFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnblockOracleProgram proto.InternalMessageInfo
+
+func (m *MsgUnblockOracleProgram) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRefundTxFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnblockOracleProgram) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
 }
 
-func (m *MsgRefundTxFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// The response message for the UnblockOracleProgram method.
+type MsgUnblockOracleProgramResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgUnblockOracleProgramResponse) Reset()         { *m = MsgUnblockOracleProgramResponse{} }
+func (m *MsgUnblockOracleProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnblockOracleProgramResponse) ProtoMessage()    {}
+func (*MsgUnblockOracleProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{15}
 }
-func (m *MsgStoreOracleProgram) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Wasm)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.StorageFee) > 0 {
-		for _, e := range m.StorageFee {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnblockOracleProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnblockOracleProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnblockOracleProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUnblockOracleProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnblockOracleProgramResponse.Merge(m, src)
+}
+func (m *MsgUnblockOracleProgramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnblockOracleProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnblockOracleProgramResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStoreOracleProgramResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+var xxx_messageInfo_MsgUnblockOracleProgramResponse proto.InternalMessageInfo
+
+// The request message for the UpdateParams method.
+type MsgUpdateParams struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgInstantiateCoreContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CodeID != 0 {
-		n += 1 + sovTx(uint64(m.CodeID))
-	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Funds) > 0 {
-		for _, e := range m.Funds {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{16}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.FixMsg {
-		n += 2
-	}
-	return n
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgInstantiateCoreContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return n
+	return ""
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return Params{}
+}
+
+// The response message for the UpdateParams method.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{17}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// The request message for the MsgRefundTxFee method.
+type MsgRefundTxFee struct {
+	// Authority is the address that controls the method.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// DrId is the hex-encoded data request ID.
+	DrId string `protobuf:"bytes,2,opt,name=dr_id,json=drId,proto3" json:"dr_id,omitempty"`
+	// PublicKey is the hex-encoded public key (identifier) of the executor.
+	PublicKey string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// IsReveal is true for a reveal message and false for a commit message.
+	IsReveal bool `protobuf:"varint,4,opt,name=is_reveal,json=isReveal,proto3" json:"is_reveal,omitempty"`
+}
+
+func (m *MsgRefundTxFee) Reset()         { *m = MsgRefundTxFee{} }
+func (m *MsgRefundTxFee) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundTxFee) ProtoMessage()    {}
+func (*MsgRefundTxFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{18}
+}
+func (m *MsgRefundTxFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundTxFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundTxFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgRefundTxFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundTxFee.Merge(m, src)
+}
+func (m *MsgRefundTxFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundTxFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundTxFee.DiscardUnknown(m)
 }
 
-func (m *MsgRefundTxFee) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRefundTxFee proto.InternalMessageInfo
+
+func (m *MsgRefundTxFee) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgRefundTxFee) GetDrId() string {
+	if m != nil {
+		return m.DrId
 	}
-	l = len(m.DrId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgRefundTxFee) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgRefundTxFee) GetIsReveal() bool {
+	if m != nil {
+		return m.IsReveal
 	}
-	if m.IsReveal {
-		n += 2
+	return false
+}
+
+// The response message for the MsgRefundTxFee method.
+type MsgRefundTxFeeResponse struct {
+}
+
+func (m *MsgRefundTxFeeResponse) Reset()         { *m = MsgRefundTxFeeResponse{} }
+func (m *MsgRefundTxFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundTxFeeResponse) ProtoMessage()    {}
+func (*MsgRefundTxFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{19}
+}
+func (m *MsgRefundTxFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundTxFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundTxFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgRefundTxFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundTxFeeResponse.Merge(m, src)
+}
+func (m *MsgRefundTxFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundTxFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundTxFeeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRefundTxFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRefundTxFeeResponse proto.InternalMessageInfo
+
+// The request message for the TopUpOracleProgram method.
+type MsgTopUpOracleProgram struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hash is the hex-encoded hash of the oracle program.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Rent is the maximum rent to pay for extending the expiration. Only the
+	// amount covering whole blocks is charged.
+	Rent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rent"`
+}
+
+func (m *MsgTopUpOracleProgram) Reset()         { *m = MsgTopUpOracleProgram{} }
+func (m *MsgTopUpOracleProgram) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpOracleProgram) ProtoMessage()    {}
+func (*MsgTopUpOracleProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{20}
+}
+func (m *MsgTopUpOracleProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpOracleProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpOracleProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTopUpOracleProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpOracleProgram.Merge(m, src)
+}
+func (m *MsgTopUpOracleProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpOracleProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpOracleProgram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTopUpOracleProgram proto.InternalMessageInfo
+
+func (m *MsgTopUpOracleProgram) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTopUpOracleProgram) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *MsgTopUpOracleProgram) GetRent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rent
+	}
+	return nil
+}
+
+// The response message for the TopUpOracleProgram method.
+type MsgTopUpOracleProgramResponse struct {
+	ExpirationHeight int64 `protobuf:"varint,1,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *MsgTopUpOracleProgramResponse) Reset()         { *m = MsgTopUpOracleProgramResponse{} }
+func (m *MsgTopUpOracleProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpOracleProgramResponse) ProtoMessage()    {}
+func (*MsgTopUpOracleProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{21}
+}
+func (m *MsgTopUpOracleProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpOracleProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpOracleProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTopUpOracleProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpOracleProgramResponse.Merge(m, src)
+}
+func (m *MsgTopUpOracleProgramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpOracleProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpOracleProgramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTopUpOracleProgramResponse proto.InternalMessageInfo
+
+func (m *MsgTopUpOracleProgramResponse) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgStoreOracleProgram)(nil), "sedachain.wasm_storage.v1.MsgStoreOracleProgram")
+	proto.RegisterType((*MsgStoreOracleProgramResponse)(nil), "sedachain.wasm_storage.v1.MsgStoreOracleProgramResponse")
+	proto.RegisterType((*MsgBeginOracleProgramUpload)(nil), "sedachain.wasm_storage.v1.MsgBeginOracleProgramUpload")
+	proto.RegisterType((*MsgBeginOracleProgramUploadResponse)(nil), "sedachain.wasm_storage.v1.MsgBeginOracleProgramUploadResponse")
+	proto.RegisterType((*MsgAppendOracleProgramChunk)(nil), "sedachain.wasm_storage.v1.MsgAppendOracleProgramChunk")
+	proto.RegisterType((*MsgAppendOracleProgramChunkResponse)(nil), "sedachain.wasm_storage.v1.MsgAppendOracleProgramChunkResponse")
+	proto.RegisterType((*MsgFinalizeOracleProgramUpload)(nil), "sedachain.wasm_storage.v1.MsgFinalizeOracleProgramUpload")
+	proto.RegisterType((*MsgFinalizeOracleProgramUploadResponse)(nil), "sedachain.wasm_storage.v1.MsgFinalizeOracleProgramUploadResponse")
+	proto.RegisterType((*MsgInstantiateCoreContract)(nil), "sedachain.wasm_storage.v1.MsgInstantiateCoreContract")
+	proto.RegisterType((*MsgInstantiateCoreContractResponse)(nil), "sedachain.wasm_storage.v1.MsgInstantiateCoreContractResponse")
+	proto.RegisterType((*MsgMigrateCoreContract)(nil), "sedachain.wasm_storage.v1.MsgMigrateCoreContract")
+	proto.RegisterType((*MsgMigrateCoreContractResponse)(nil), "sedachain.wasm_storage.v1.MsgMigrateCoreContractResponse")
+	proto.RegisterType((*MsgBlockOracleProgram)(nil), "sedachain.wasm_storage.v1.MsgBlockOracleProgram")
+	proto.RegisterType((*MsgBlockOracleProgramResponse)(nil), "sedachain.wasm_storage.v1.MsgBlockOracleProgramResponse")
+	proto.RegisterType((*MsgUnblockOracleProgram)(nil), "sedachain.wasm_storage.v1.MsgUnblockOracleProgram")
+	proto.RegisterType((*MsgUnblockOracleProgramResponse)(nil), "sedachain.wasm_storage.v1.MsgUnblockOracleProgramResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "sedachain.wasm_storage.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sedachain.wasm_storage.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRefundTxFee)(nil), "sedachain.wasm_storage.v1.MsgRefundTxFee")
+	proto.RegisterType((*MsgRefundTxFeeResponse)(nil), "sedachain.wasm_storage.v1.MsgRefundTxFeeResponse")
+	proto.RegisterType((*MsgTopUpOracleProgram)(nil), "sedachain.wasm_storage.v1.MsgTopUpOracleProgram")
+	proto.RegisterType((*MsgTopUpOracleProgramResponse)(nil), "sedachain.wasm_storage.v1.MsgTopUpOracleProgramResponse")
+}
+
+func init() {
+	proto.RegisterFile("sedachain/wasm_storage/v1/tx.proto", fileDescriptor_10a76e8135c0d000)
+}
+
+var fileDescriptor_10a76e8135c0d000 = []byte{
+	// 1369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdf, 0x6f, 0xdb, 0xd4,
+	0x17, 0xef, 0x6d, 0xd2, 0x2c, 0x39, 0xc9, 0x7e, 0x79, 0xfd, 0xae, 0x69, 0xa6, 0x25, 0x5d, 0xa6,
+	0x2f, 0xca, 0x0a, 0x4d, 0xd6, 0x22, 0x06, 0x9b, 0x18, 0x68, 0xc9, 0x34, 0x51, 0x41, 0xb4, 0xe1,
+	0x6d, 0x42, 0xe2, 0xc5, 0xba, 0xb1, 0x6f, 0x9d, 0xab, 0xc6, 0xbe, 0x96, 0xaf, 0xd3, 0xb5, 0x95,
+	0x90, 0xd0, 0x90, 0x40, 0x82, 0x87, 0x21, 0x1e, 0x87, 0x78, 0xe6, 0xc7, 0xd3, 0x1e, 0xf8, 0x23,
+	0xf6, 0x82, 0x34, 0x21, 0x24, 0x78, 0x2a, 0xd0, 0x3d, 0xec, 0x7f, 0xe0, 0x09, 0xf9, 0xda, 0x71,
+	0x63, 0x62, 0x3b, 0x6b, 0x08, 0xf0, 0xd2, 0xd8, 0xf7, 0x9e, 0xcf, 0xb9, 0x9f, 0xf3, 0xeb, 0x9e,
+	0x53, 0x43, 0x95, 0x13, 0x0d, 0xab, 0x5d, 0x4c, 0xcd, 0xc6, 0x3d, 0xcc, 0x0d, 0x85, 0x3b, 0xcc,
+	0xc6, 0x3a, 0x69, 0x6c, 0xad, 0x36, 0x9c, 0xed, 0xba, 0x65, 0x33, 0x87, 0x49, 0x8b, 0x81, 0x4c,
+	0x7d, 0x58, 0xa6, 0xbe, 0xb5, 0x5a, 0x2a, 0xab, 0x8c, 0x1b, 0x8c, 0x37, 0x3a, 0x98, 0xbb, 0x98,
+	0x0e, 0x71, 0xf0, 0x6a, 0x43, 0x65, 0xd4, 0xf4, 0xa0, 0xa5, 0x05, 0x7f, 0xdf, 0xe0, 0xba, 0xab,
+	0xd2, 0xe0, 0xba, 0xbf, 0x31, 0xaf, 0x33, 0x9d, 0x89, 0xc7, 0x86, 0xfb, 0xe4, 0xaf, 0x2e, 0x7a,
+	0xe2, 0x8a, 0xb7, 0xe1, 0xbd, 0xf8, 0x5b, 0x2f, 0xc5, 0x13, 0x0d, 0x91, 0xf2, 0xa4, 0x4f, 0x62,
+	0x83, 0x9a, 0xac, 0x21, 0xfe, 0x7a, 0x4b, 0xd5, 0x9f, 0x67, 0xe1, 0x7f, 0x6d, 0xae, 0xdf, 0x76,
+	0x98, 0x4d, 0x6e, 0xda, 0x58, 0xed, 0x91, 0x5b, 0x36, 0xd3, 0x6d, 0x6c, 0x48, 0x17, 0x21, 0xc3,
+	0x89, 0xa9, 0x11, 0xbb, 0x88, 0x96, 0x50, 0x2d, 0xd7, 0x2c, 0xfe, 0xf8, 0xfd, 0xca, 0xbc, 0x7f,
+	0xf8, 0x35, 0x4d, 0xb3, 0x09, 0xe7, 0xb7, 0x1d, 0x9b, 0x9a, 0xba, 0xec, 0xcb, 0x49, 0x12, 0xa4,
+	0xdd, 0x43, 0x8b, 0xb3, 0x4b, 0xa8, 0x56, 0x90, 0xc5, 0xb3, 0x74, 0x1f, 0x41, 0xde, 0x27, 0xa1,
+	0x6c, 0x10, 0x52, 0x4c, 0x2d, 0xa5, 0x6a, 0xf9, 0xb5, 0xc5, 0xba, 0xaf, 0xc8, 0xf5, 0x50, 0xdd,
+	0xf7, 0x50, 0xbd, 0xc5, 0xa8, 0xd9, 0xbc, 0xf1, 0x78, 0xaf, 0x32, 0xf3, 0xdd, 0xaf, 0x95, 0x9a,
+	0x4e, 0x9d, 0x6e, 0xbf, 0x53, 0x57, 0x99, 0xe1, 0x9b, 0xec, 0xff, 0xac, 0x70, 0x6d, 0xb3, 0xe1,
+	0xec, 0x58, 0x84, 0x0b, 0x00, 0x7f, 0xf8, 0xec, 0xd1, 0x72, 0xa1, 0x47, 0x74, 0xac, 0xee, 0x28,
+	0xae, 0x8f, 0xf9, 0x37, 0xcf, 0x1e, 0x2d, 0x23, 0x19, 0xfc, 0x53, 0x6f, 0x10, 0x22, 0xc9, 0x90,
+	0x35, 0x88, 0x83, 0x35, 0xec, 0xe0, 0x62, 0x7a, 0x09, 0xd5, 0xf2, 0x6b, 0x17, 0xeb, 0xb1, 0xd1,
+	0xab, 0x87, 0xdc, 0xd0, 0xf6, 0x71, 0xcd, 0xb4, 0xcb, 0x4b, 0x0e, 0xf4, 0xb8, 0xc6, 0x76, 0x31,
+	0xef, 0x16, 0xe7, 0x5c, 0xe7, 0xc8, 0xe2, 0xf9, 0x4a, 0xfe, 0xfe, 0xb3, 0x47, 0xcb, 0xbe, 0x37,
+	0xaa, 0x9f, 0x20, 0x38, 0x1b, 0xe9, 0x59, 0x99, 0x70, 0x8b, 0x99, 0x9c, 0x04, 0x2a, 0xd0, 0x81,
+	0x8a, 0x10, 0xd5, 0xd9, 0xe9, 0x50, 0xad, 0x3e, 0x48, 0xc1, 0x99, 0x36, 0xd7, 0x9b, 0x44, 0xa7,
+	0x66, 0x08, 0x71, 0xd7, 0xea, 0x31, 0xac, 0x4d, 0x16, 0x69, 0xc1, 0x7c, 0x76, 0x88, 0xf9, 0x79,
+	0x38, 0xda, 0x37, 0x77, 0xa9, 0x65, 0x11, 0x4d, 0xe1, 0x74, 0xd7, 0x0d, 0x35, 0xaa, 0xa5, 0xe5,
+	0xc2, 0x60, 0xf1, 0x36, 0xdd, 0x25, 0x23, 0xe9, 0x90, 0xfe, 0xaf, 0xd3, 0x61, 0x6e, 0x4a, 0xe9,
+	0x50, 0x81, 0xfc, 0xb0, 0xed, 0x19, 0x61, 0x3b, 0x1c, 0x58, 0x1e, 0xce, 0x8d, 0x0f, 0xe0, 0x7c,
+	0x42, 0x40, 0x82, 0x04, 0xb9, 0x00, 0xb9, 0xbe, 0x58, 0x51, 0xa8, 0x26, 0x62, 0x93, 0x6e, 0x16,
+	0xf6, 0xf7, 0x2a, 0x59, 0x4f, 0x6c, 0xfd, 0xba, 0x9c, 0xf5, 0xb6, 0xd7, 0x35, 0xe9, 0x45, 0x38,
+	0x49, 0xb6, 0x2d, 0x6a, 0x63, 0x87, 0x32, 0x53, 0xe9, 0x12, 0xaa, 0x77, 0x1d, 0x11, 0x9e, 0x94,
+	0x7c, 0xe2, 0x60, 0xe3, 0x2d, 0xb1, 0x5e, 0xfd, 0x0a, 0x89, 0x84, 0xb8, 0x66, 0x59, 0xc4, 0xd4,
+	0x42, 0x04, 0x5a, 0xdd, 0xbe, 0xb9, 0x39, 0x41, 0x42, 0x84, 0x98, 0xce, 0x26, 0x32, 0x9d, 0x87,
+	0x39, 0xd5, 0x3d, 0x45, 0xe4, 0x47, 0x41, 0xf6, 0x5e, 0xc2, 0xee, 0x79, 0x47, 0xb8, 0x27, 0x8e,
+	0x5e, 0xe0, 0x9e, 0xff, 0xc3, 0x31, 0x9b, 0xa8, 0x84, 0x6e, 0x11, 0x4d, 0xe9, 0xec, 0x38, 0x84,
+	0x7b, 0x3e, 0x92, 0x8f, 0x0e, 0x56, 0x9b, 0xee, 0x62, 0xf5, 0x53, 0x04, 0xe5, 0x36, 0xd7, 0x6f,
+	0x50, 0x13, 0xf7, 0xe8, 0x2e, 0x99, 0x4e, 0x05, 0x3c, 0xbf, 0xc1, 0x61, 0xd3, 0x5e, 0x87, 0x17,
+	0x92, 0xb9, 0x24, 0xdd, 0x0e, 0xd5, 0xaf, 0x53, 0x50, 0x6a, 0x73, 0x7d, 0xdd, 0xe4, 0x0e, 0x36,
+	0x1d, 0x8a, 0x1d, 0xd2, 0x62, 0x36, 0x69, 0x31, 0xd3, 0xb1, 0xb1, 0xea, 0x4c, 0x60, 0x46, 0x1d,
+	0xe6, 0xb0, 0x66, 0x50, 0xd3, 0xab, 0xe4, 0x04, 0x80, 0x27, 0x26, 0x9d, 0x87, 0x23, 0x2a, 0xd3,
+	0x88, 0x6b, 0xb4, 0x28, 0xef, 0x26, 0xec, 0xef, 0x55, 0x32, 0x2d, 0xa6, 0x91, 0xf5, 0xeb, 0x72,
+	0xc6, 0xdd, 0xf2, 0x22, 0xdc, 0xc3, 0x1d, 0xd2, 0x13, 0x77, 0x6d, 0x4e, 0xf6, 0x5e, 0xa4, 0x9b,
+	0x90, 0x32, 0xb8, 0x2e, 0x0a, 0xae, 0xd0, 0xbc, 0xfa, 0xc7, 0x5e, 0xe5, 0xf2, 0x50, 0x49, 0xb7,
+	0x18, 0x37, 0xde, 0xc3, 0xdc, 0x10, 0x5d, 0x4b, 0x6b, 0x6c, 0x8b, 0x5f, 0xbf, 0xac, 0x65, 0x7c,
+	0x6f, 0x60, 0x61, 0x9b, 0x70, 0x8e, 0x75, 0x22, 0xbb, 0x9a, 0x24, 0x0c, 0x73, 0x1b, 0x7d, 0x53,
+	0xe3, 0xc5, 0xcc, 0xb8, 0x4b, 0xe4, 0xe2, 0x61, 0x2f, 0x11, 0xd9, 0xd3, 0xec, 0xc6, 0x80, 0xe3,
+	0x9e, 0x53, 0x3c, 0xe2, 0x75, 0x34, 0xf7, 0x59, 0x5a, 0x80, 0x23, 0x1b, 0x74, 0x5b, 0x71, 0x6d,
+	0xc9, 0x2e, 0xa1, 0x5a, 0x56, 0xce, 0x6c, 0xd0, 0xed, 0x36, 0xd7, 0xc3, 0x71, 0xa6, 0x50, 0x8d,
+	0x0f, 0x54, 0x10, 0xe3, 0x16, 0x9c, 0x50, 0xfd, 0x35, 0x05, 0x7b, 0xfe, 0x1e, 0x1b, 0xba, 0xe3,
+	0x03, 0x84, 0xbf, 0x5c, 0xfd, 0x01, 0xc1, 0xe9, 0x36, 0xd7, 0xdb, 0x54, 0xb7, 0xff, 0x7e, 0x42,
+	0x0c, 0x05, 0x78, 0x36, 0x36, 0xc0, 0x7e, 0x28, 0x53, 0xd3, 0x0a, 0x65, 0xd8, 0x75, 0x44, 0x94,
+	0x6b, 0x84, 0x39, 0xd3, 0x75, 0xdb, 0x67, 0x48, 0x4c, 0x3e, 0xcd, 0x1e, 0x53, 0x37, 0xc3, 0x93,
+	0xcf, 0x25, 0xc8, 0xe1, 0xbe, 0xd3, 0x65, 0x36, 0x75, 0x76, 0xc6, 0xea, 0x3d, 0x10, 0x8d, 0xec,
+	0x8a, 0xa7, 0x21, 0x63, 0x13, 0xcc, 0x99, 0x29, 0xbc, 0x95, 0x93, 0xfd, 0xb7, 0x2b, 0xc7, 0x5c,
+	0x8b, 0x0f, 0xb0, 0xd5, 0x8a, 0x18, 0x16, 0x46, 0xc9, 0x0c, 0x6c, 0xae, 0xf6, 0x61, 0xa1, 0xcd,
+	0xf5, 0xbb, 0x66, 0xe7, 0x1f, 0xe5, 0x3b, 0xc2, 0xeb, 0x1c, 0x54, 0x62, 0x8e, 0x0d, 0x98, 0x3d,
+	0x44, 0x70, 0xdc, 0x95, 0xb1, 0x34, 0xec, 0x90, 0x5b, 0xd8, 0xc6, 0x06, 0x9f, 0x98, 0xd2, 0x9b,
+	0x90, 0xb1, 0x84, 0x06, 0x7f, 0xf8, 0x39, 0x97, 0xd0, 0x98, 0xbd, 0xa3, 0xfc, 0x4e, 0xec, 0xc3,
+	0x46, 0xf8, 0x2f, 0x7a, 0x6e, 0x1b, 0xe2, 0x16, 0xf0, 0xfe, 0x16, 0xc1, 0xb1, 0x36, 0xd7, 0x65,
+	0xe2, 0xd6, 0xfa, 0x9d, 0x6d, 0x77, 0x32, 0x98, 0x94, 0xf6, 0x29, 0x98, 0xd3, 0xec, 0x41, 0xcd,
+	0xe4, 0xe4, 0xb4, 0x66, 0xaf, 0x6b, 0xd2, 0x59, 0x00, 0xab, 0xdf, 0xe9, 0x51, 0x55, 0xd9, 0x24,
+	0x3b, 0x7e, 0xf8, 0x73, 0xde, 0xca, 0xdb, 0x64, 0x47, 0x3a, 0x03, 0x39, 0xca, 0x15, 0x9b, 0x6c,
+	0x11, 0xec, 0xdd, 0x94, 0x59, 0x39, 0x4b, 0xb9, 0x2c, 0xde, 0x47, 0xcc, 0x28, 0x8a, 0x12, 0x1f,
+	0xa2, 0x1a, 0x58, 0xf1, 0xbb, 0x97, 0xc6, 0x77, 0x98, 0x75, 0xd7, 0x9a, 0xc2, 0x00, 0x3f, 0x92,
+	0xc0, 0x7d, 0x48, 0xdb, 0xc4, 0x74, 0xfe, 0xbd, 0xc1, 0x5d, 0x1c, 0xf7, 0xd7, 0x79, 0xe0, 0x6c,
+	0xa4, 0x89, 0xc1, 0x85, 0x10, 0x39, 0xfd, 0xa0, 0xe8, 0xe9, 0x67, 0xed, 0xa7, 0x3c, 0xa4, 0xda,
+	0x5c, 0x97, 0x3e, 0x44, 0x20, 0x45, 0xfd, 0xdf, 0x93, 0x90, 0x72, 0x91, 0xf3, 0x7c, 0xe9, 0xb5,
+	0xc3, 0x22, 0x02, 0xde, 0x5f, 0x20, 0x28, 0xc6, 0x8e, 0xe5, 0x97, 0x92, 0xd5, 0xc6, 0xe1, 0x4a,
+	0x6f, 0x4c, 0x86, 0x0b, 0x91, 0x8a, 0x1d, 0x0d, 0xc7, 0x90, 0x8a, 0xc3, 0x8d, 0x23, 0x35, 0x76,
+	0xd6, 0xfb, 0x12, 0xc1, 0x99, 0xa4, 0x09, 0xee, 0x72, 0xb2, 0xfe, 0x04, 0x68, 0xe9, 0xda, 0xc4,
+	0xd0, 0x80, 0xdd, 0x03, 0x04, 0x0b, 0x71, 0x43, 0xd9, 0x2b, 0xc9, 0xea, 0x63, 0x60, 0xa5, 0xab,
+	0x13, 0xc1, 0x02, 0x46, 0x1f, 0x21, 0x38, 0x15, 0x35, 0x11, 0xac, 0x26, 0xab, 0x8d, 0x80, 0x94,
+	0x2e, 0x1f, 0x1a, 0x12, 0xb0, 0x70, 0x4b, 0x2c, 0xa2, 0xc1, 0x8e, 0x29, 0xb1, 0x51, 0xc4, 0xb8,
+	0x12, 0x8b, 0xef, 0x9b, 0xd2, 0xc7, 0x08, 0xe6, 0x23, 0xbb, 0xe6, 0x5a, 0xb2, 0xca, 0x28, 0x4c,
+	0xe9, 0xca, 0xe1, 0x31, 0x01, 0x11, 0x13, 0x0a, 0xa1, 0x16, 0xb9, 0x3c, 0x46, 0xd7, 0x90, 0x6c,
+	0x69, 0xed, 0xf9, 0x65, 0x83, 0xf3, 0x36, 0x21, 0x3f, 0xdc, 0xda, 0x2e, 0x24, 0xab, 0x18, 0x12,
+	0x2d, 0xad, 0x3e, 0xb7, 0x68, 0x28, 0xd0, 0x51, 0x2d, 0x28, 0x59, 0xd3, 0x28, 0x62, 0x5c, 0xa0,
+	0xe3, 0x7b, 0x40, 0xf3, 0xdd, 0xc7, 0xfb, 0x65, 0xf4, 0x64, 0xbf, 0x8c, 0x7e, 0xdb, 0x2f, 0xa3,
+	0xcf, 0x9f, 0x96, 0x67, 0x9e, 0x3c, 0x2d, 0xcf, 0xfc, 0xf2, 0xb4, 0x3c, 0xf3, 0xfe, 0xab, 0x43,
+	0x1d, 0xc9, 0xd5, 0x2e, 0xbe, 0x7c, 0xa9, 0xac, 0x27, 0x5e, 0x56, 0xbc, 0xaf, 0x67, 0xde, 0x98,
+	0xba, 0x32, 0xf8, 0x7e, 0x26, 0xda, 0x54, 0x27, 0x23, 0x24, 0x5f, 0xfe, 0x33, 0x00, 0x00, 0xff,
+	0xff, 0xbe, 0x9f, 0x5c, 0x2c, 0x0f, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// StoreOracleProgram stores an oracle program.
+	StoreOracleProgram(ctx context.Context, in *MsgStoreOracleProgram, opts ...grpc.CallOption) (*MsgStoreOracleProgramResponse, error)
+	// BeginOracleProgramUpload begins a chunked upload of an oracle program
+	// and escrows its storage fee.
+	BeginOracleProgramUpload(ctx context.Context, in *MsgBeginOracleProgramUpload, opts ...grpc.CallOption) (*MsgBeginOracleProgramUploadResponse, error)
+	// AppendOracleProgramChunk appends a chunk to a chunked upload.
+	AppendOracleProgramChunk(ctx context.Context, in *MsgAppendOracleProgramChunk, opts ...grpc.CallOption) (*MsgAppendOracleProgramChunkResponse, error)
+	// FinalizeOracleProgramUpload verifies and stores an oracle program
+	// uploaded in chunks.
+	FinalizeOracleProgramUpload(ctx context.Context, in *MsgFinalizeOracleProgramUpload, opts ...grpc.CallOption) (*MsgFinalizeOracleProgramUploadResponse, error)
+	// InstantiateCoreContract instantiates the Core Contract and registers its
+	// address.
+	InstantiateCoreContract(ctx context.Context, in *MsgInstantiateCoreContract, opts ...grpc.CallOption) (*MsgInstantiateCoreContractResponse, error)
+	// MigrateCoreContract migrates the registered Core Contract to a new code
+	// ID.
+	MigrateCoreContract(ctx context.Context, in *MsgMigrateCoreContract, opts ...grpc.CallOption) (*MsgMigrateCoreContractResponse, error)
+	// BlockOracleProgram blocks an oracle program from being used.
+	BlockOracleProgram(ctx context.Context, in *MsgBlockOracleProgram, opts ...grpc.CallOption) (*MsgBlockOracleProgramResponse, error)
+	// UnblockOracleProgram removes an oracle program from the blocklist.
+	UnblockOracleProgram(ctx context.Context, in *MsgUnblockOracleProgram, opts ...grpc.CallOption) (*MsgUnblockOracleProgramResponse, error)
+	// The UpdateParams method updates the module's parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// The RefundTxFee method is used by the Core Contract to refund tx fee.
+	RefundTxFee(ctx context.Context, in *MsgRefundTxFee, opts ...grpc.CallOption) (*MsgRefundTxFeeResponse, error)
+	// TopUpOracleProgram pays rent to extend the expiration of an oracle
+	// program.
+	TopUpOracleProgram(ctx context.Context, in *MsgTopUpOracleProgram, opts ...grpc.CallOption) (*MsgTopUpOracleProgramResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) StoreOracleProgram(ctx context.Context, in *MsgStoreOracleProgram, opts ...grpc.CallOption) (*MsgStoreOracleProgramResponse, error) {
+	out := new(MsgStoreOracleProgramResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/StoreOracleProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BeginOracleProgramUpload(ctx context.Context, in *MsgBeginOracleProgramUpload, opts ...grpc.CallOption) (*MsgBeginOracleProgramUploadResponse, error) {
+	out := new(MsgBeginOracleProgramUploadResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/BeginOracleProgramUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AppendOracleProgramChunk(ctx context.Context, in *MsgAppendOracleProgramChunk, opts ...grpc.CallOption) (*MsgAppendOracleProgramChunkResponse, error) {
+	out := new(MsgAppendOracleProgramChunkResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/AppendOracleProgramChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeOracleProgramUpload(ctx context.Context, in *MsgFinalizeOracleProgramUpload, opts ...grpc.CallOption) (*MsgFinalizeOracleProgramUploadResponse, error) {
+	out := new(MsgFinalizeOracleProgramUploadResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/FinalizeOracleProgramUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InstantiateCoreContract(ctx context.Context, in *MsgInstantiateCoreContract, opts ...grpc.CallOption) (*MsgInstantiateCoreContractResponse, error) {
+	out := new(MsgInstantiateCoreContractResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/InstantiateCoreContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateCoreContract(ctx context.Context, in *MsgMigrateCoreContract, opts ...grpc.CallOption) (*MsgMigrateCoreContractResponse, error) {
+	out := new(MsgMigrateCoreContractResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/MigrateCoreContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BlockOracleProgram(ctx context.Context, in *MsgBlockOracleProgram, opts ...grpc.CallOption) (*MsgBlockOracleProgramResponse, error) {
+	out := new(MsgBlockOracleProgramResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/BlockOracleProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnblockOracleProgram(ctx context.Context, in *MsgUnblockOracleProgram, opts ...grpc.CallOption) (*MsgUnblockOracleProgramResponse, error) {
+	out := new(MsgUnblockOracleProgramResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/UnblockOracleProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundTxFee(ctx context.Context, in *MsgRefundTxFee, opts ...grpc.CallOption) (*MsgRefundTxFeeResponse, error) {
+	out := new(MsgRefundTxFeeResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/RefundTxFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TopUpOracleProgram(ctx context.Context, in *MsgTopUpOracleProgram, opts ...grpc.CallOption) (*MsgTopUpOracleProgramResponse, error) {
+	out := new(MsgTopUpOracleProgramResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/TopUpOracleProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// StoreOracleProgram stores an oracle program.
+	StoreOracleProgram(context.Context, *MsgStoreOracleProgram) (*MsgStoreOracleProgramResponse, error)
+	// BeginOracleProgramUpload begins a chunked upload of an oracle program
+	// and escrows its storage fee.
+	BeginOracleProgramUpload(context.Context, *MsgBeginOracleProgramUpload) (*MsgBeginOracleProgramUploadResponse, error)
+	// AppendOracleProgramChunk appends a chunk to a chunked upload.
+	AppendOracleProgramChunk(context.Context, *MsgAppendOracleProgramChunk) (*MsgAppendOracleProgramChunkResponse, error)
+	// FinalizeOracleProgramUpload verifies and stores an oracle program
+	// uploaded in chunks.
+	FinalizeOracleProgramUpload(context.Context, *MsgFinalizeOracleProgramUpload) (*MsgFinalizeOracleProgramUploadResponse, error)
+	// InstantiateCoreContract instantiates the Core Contract and registers its
+	// address.
+	InstantiateCoreContract(context.Context, *MsgInstantiateCoreContract) (*MsgInstantiateCoreContractResponse, error)
+	// MigrateCoreContract migrates the registered Core Contract to a new code
+	// ID.
+	MigrateCoreContract(context.Context, *MsgMigrateCoreContract) (*MsgMigrateCoreContractResponse, error)
+	// BlockOracleProgram blocks an oracle program from being used.
+	BlockOracleProgram(context.Context, *MsgBlockOracleProgram) (*MsgBlockOracleProgramResponse, error)
+	// UnblockOracleProgram removes an oracle program from the blocklist.
+	UnblockOracleProgram(context.Context, *MsgUnblockOracleProgram) (*MsgUnblockOracleProgramResponse, error)
+	// The UpdateParams method updates the module's parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// The RefundTxFee method is used by the Core Contract to refund tx fee.
+	RefundTxFee(context.Context, *MsgRefundTxFee) (*MsgRefundTxFeeResponse, error)
+	// TopUpOracleProgram pays rent to extend the expiration of an oracle
+	// program.
+	TopUpOracleProgram(context.Context, *MsgTopUpOracleProgram) (*MsgTopUpOracleProgramResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) StoreOracleProgram(ctx context.Context, req *MsgStoreOracleProgram) (*MsgStoreOracleProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreOracleProgram not implemented")
+}
+func (*UnimplementedMsgServer) BeginOracleProgramUpload(ctx context.Context, req *MsgBeginOracleProgramUpload) (*MsgBeginOracleProgramUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginOracleProgramUpload not implemented")
+}
+func (*UnimplementedMsgServer) AppendOracleProgramChunk(ctx context.Context, req *MsgAppendOracleProgramChunk) (*MsgAppendOracleProgramChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendOracleProgramChunk not implemented")
+}
+func (*UnimplementedMsgServer) FinalizeOracleProgramUpload(ctx context.Context, req *MsgFinalizeOracleProgramUpload) (*MsgFinalizeOracleProgramUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeOracleProgramUpload not implemented")
+}
+func (*UnimplementedMsgServer) InstantiateCoreContract(ctx context.Context, req *MsgInstantiateCoreContract) (*MsgInstantiateCoreContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateCoreContract not implemented")
+}
+func (*UnimplementedMsgServer) MigrateCoreContract(ctx context.Context, req *MsgMigrateCoreContract) (*MsgMigrateCoreContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateCoreContract not implemented")
+}
+func (*UnimplementedMsgServer) BlockOracleProgram(ctx context.Context, req *MsgBlockOracleProgram) (*MsgBlockOracleProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockOracleProgram not implemented")
+}
+func (*UnimplementedMsgServer) UnblockOracleProgram(ctx context.Context, req *MsgUnblockOracleProgram) (*MsgUnblockOracleProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockOracleProgram not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RefundTxFee(ctx context.Context, req *MsgRefundTxFee) (*MsgRefundTxFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundTxFee not implemented")
+}
+func (*UnimplementedMsgServer) TopUpOracleProgram(ctx context.Context, req *MsgTopUpOracleProgram) (*MsgTopUpOracleProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpOracleProgram not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_StoreOracleProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreOracleProgram)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StoreOracleProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/StoreOracleProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StoreOracleProgram(ctx, req.(*MsgStoreOracleProgram))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BeginOracleProgramUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginOracleProgramUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginOracleProgramUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/BeginOracleProgramUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginOracleProgramUpload(ctx, req.(*MsgBeginOracleProgramUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AppendOracleProgramChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppendOracleProgramChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppendOracleProgramChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/AppendOracleProgramChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppendOracleProgramChunk(ctx, req.(*MsgAppendOracleProgramChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeOracleProgramUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeOracleProgramUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeOracleProgramUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/FinalizeOracleProgramUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeOracleProgramUpload(ctx, req.(*MsgFinalizeOracleProgramUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InstantiateCoreContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantiateCoreContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantiateCoreContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/InstantiateCoreContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantiateCoreContract(ctx, req.(*MsgInstantiateCoreContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateCoreContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateCoreContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateCoreContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/MigrateCoreContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateCoreContract(ctx, req.(*MsgMigrateCoreContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BlockOracleProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBlockOracleProgram)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BlockOracleProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/BlockOracleProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BlockOracleProgram(ctx, req.(*MsgBlockOracleProgram))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnblockOracleProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnblockOracleProgram)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnblockOracleProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/UnblockOracleProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnblockOracleProgram(ctx, req.(*MsgUnblockOracleProgram))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundTxFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundTxFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundTxFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/RefundTxFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundTxFee(ctx, req.(*MsgRefundTxFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUpOracleProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpOracleProgram)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpOracleProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/TopUpOracleProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpOracleProgram(ctx, req.(*MsgTopUpOracleProgram))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sedachain.wasm_storage.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StoreOracleProgram",
+			Handler:    _Msg_StoreOracleProgram_Handler,
+		},
+		{
+			MethodName: "BeginOracleProgramUpload",
+			Handler:    _Msg_BeginOracleProgramUpload_Handler,
+		},
+		{
+			MethodName: "AppendOracleProgramChunk",
+			Handler:    _Msg_AppendOracleProgramChunk_Handler,
+		},
+		{
+			MethodName: "FinalizeOracleProgramUpload",
+			Handler:    _Msg_FinalizeOracleProgramUpload_Handler,
+		},
+		{
+			MethodName: "InstantiateCoreContract",
+			Handler:    _Msg_InstantiateCoreContract_Handler,
+		},
+		{
+			MethodName: "MigrateCoreContract",
+			Handler:    _Msg_MigrateCoreContract_Handler,
+		},
+		{
+			MethodName: "BlockOracleProgram",
+			Handler:    _Msg_BlockOracleProgram_Handler,
+		},
+		{
+			MethodName: "UnblockOracleProgram",
+			Handler:    _Msg_UnblockOracleProgram_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RefundTxFee",
+			Handler:    _Msg_RefundTxFee_Handler,
+		},
+		{
+			MethodName: "TopUpOracleProgram",
+			Handler:    _Msg_TopUpOracleProgram_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sedachain/wasm_storage/v1/tx.proto",
+}
+
+func (m *MsgStoreOracleProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreOracleProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreOracleProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.StorageFee) > 0 {
+		for iNdEx := len(m.StorageFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Wasm) > 0 {
+		i -= len(m.Wasm)
+		copy(dAtA[i:], m.Wasm)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Wasm)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreOracleProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreOracleProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreOracleProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginOracleProgramUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginOracleProgramUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginOracleProgramUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ZippedSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ZippedSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.StorageFee) > 0 {
+		for iNdEx := len(m.StorageFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.UnzippedSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnzippedSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginOracleProgramUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginOracleProgramUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginOracleProgramUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UploadID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UploadID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppendOracleProgramChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppendOracleProgramChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppendOracleProgramChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UploadID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UploadID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppendOracleProgramChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppendOracleProgramChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppendOracleProgramChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceivedBytes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReceivedBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeOracleProgramUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeOracleProgramUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeOracleProgramUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UploadID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UploadID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeOracleProgramUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeOracleProgramUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeOracleProgramUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateCoreContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateCoreContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateCoreContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FixMsg {
+		i--
+		if m.FixMsg {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateCoreContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateCoreContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateCoreContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateCoreContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateCoreContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateCoreContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateCoreContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateCoreContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateCoreContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBlockOracleProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBlockOracleProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBlockOracleProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBlockOracleProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBlockOracleProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBlockOracleProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnblockOracleProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnblockOracleProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnblockOracleProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnblockOracleProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnblockOracleProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnblockOracleProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundTxFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundTxFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundTxFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReveal {
+		i--
+		if m.IsReveal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DrId) > 0 {
+		i -= len(m.DrId)
+		copy(dAtA[i:], m.DrId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DrId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundTxFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundTxFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundTxFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpOracleProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpOracleProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpOracleProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rent) > 0 {
+		for iNdEx := len(m.Rent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpOracleProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpOracleProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpOracleProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgStoreOracleProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Wasm)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StorageFee) > 0 {
+		for _, e := range m.StorageFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStoreOracleProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBeginOracleProgramUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnzippedSize != 0 {
+		n += 1 + sovTx(uint64(m.UnzippedSize))
+	}
+	if len(m.StorageFee) > 0 {
+		for _, e := range m.StorageFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ZippedSize != 0 {
+		n += 1 + sovTx(uint64(m.ZippedSize))
+	}
+	return n
+}
+
+func (m *MsgBeginOracleProgramUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UploadID != 0 {
+		n += 1 + sovTx(uint64(m.UploadID))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *MsgAppendOracleProgramChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UploadID != 0 {
+		n += 1 + sovTx(uint64(m.UploadID))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAppendOracleProgramChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReceivedBytes != 0 {
+		n += 1 + sovTx(uint64(m.ReceivedBytes))
+	}
+	return n
+}
+
+func (m *MsgFinalizeOracleProgramUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UploadID != 0 {
+		n += 1 + sovTx(uint64(m.UploadID))
+	}
+	return n
+}
+
+func (m *MsgFinalizeOracleProgramUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInstantiateCoreContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FixMsg {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgInstantiateCoreContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateCoreContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateCoreContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBlockOracleProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBlockOracleProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnblockOracleProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnblockOracleProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefundTxFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DrId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsReveal {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRefundTxFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTopUpOracleProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Rent) > 0 {
+		for _, e := range m.Rent {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTopUpOracleProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgStoreOracleProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreOracleProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreOracleProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wasm = append(m.Wasm[:0], dAtA[iNdEx:postIndex]...)
+			if m.Wasm == nil {
+				m.Wasm = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageFee = append(m.StorageFee, types.Coin{})
+			if err := m.StorageFee[len(m.StorageFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreOracleProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreOracleProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreOracleProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginOracleProgramUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginOracleProgramUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginOracleProgramUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnzippedSize", wireType)
+			}
+			m.UnzippedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnzippedSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageFee = append(m.StorageFee, types.Coin{})
+			if err := m.StorageFee[len(m.StorageFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZippedSize", wireType)
+			}
+			m.ZippedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZippedSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginOracleProgramUploadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginOracleProgramUploadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginOracleProgramUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
+			}
+			m.UploadID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppendOracleProgramChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppendOracleProgramChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppendOracleProgramChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
+			}
+			m.UploadID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppendOracleProgramChunkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppendOracleProgramChunkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppendOracleProgramChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedBytes", wireType)
+			}
+			m.ReceivedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeOracleProgramUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeOracleProgramUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeOracleProgramUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
+			}
+			m.UploadID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeOracleProgramUploadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeOracleProgramUploadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeOracleProgramUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateCoreContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateCoreContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateCoreContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixMsg", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FixMsg = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateCoreContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateCoreContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateCoreContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgStoreOracleProgram) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateCoreContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1287,10 +4573,10 @@ func (m *MsgStoreOracleProgram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreOracleProgram: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateCoreContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreOracleProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateCoreContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1326,10 +4612,10 @@ func (m *MsgStoreOracleProgram) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			var byteLen int
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1339,31 +4625,16 @@ func (m *MsgStoreOracleProgram) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Wasm = append(m.Wasm[:0], dAtA[iNdEx:postIndex]...)
-			if m.Wasm == nil {
-				m.Wasm = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1373,24 +4644,24 @@ func (m *MsgStoreOracleProgram) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageFee = append(m.StorageFee, types.Coin{})
-			if err := m.StorageFee[len(m.StorageFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1414,7 +4685,7 @@ func (m *MsgStoreOracleProgram) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStoreOracleProgramResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateCoreContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1437,15 +4708,15 @@ func (m *MsgStoreOracleProgramResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreOracleProgramResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateCoreContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreOracleProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateCoreContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1473,7 +4744,7 @@ func (m *MsgStoreOracleProgramResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1496,7 +4767,7 @@ func (m *MsgStoreOracleProgramResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantiateCoreContract) Unmarshal(dAtA []byte) error {
+func (m *MsgBlockOracleProgram) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1519,15 +4790,15 @@ func (m *MsgInstantiateCoreContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantiateCoreContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBlockOracleProgram: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantiateCoreContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBlockOracleProgram: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1555,11 +4826,11 @@ func (m *MsgInstantiateCoreContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1587,30 +4858,11 @@ func (m *MsgInstantiateCoreContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
-			}
-			m.CodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1638,81 +4890,113 @@ func (m *MsgInstantiateCoreContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBlockOracleProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBlockOracleProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBlockOracleProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funds = append(m.Funds, types.Coin{})
-			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnblockOracleProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnblockOracleProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnblockOracleProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1722,31 +5006,29 @@ func (m *MsgInstantiateCoreContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
-			if m.Salt == nil {
-				m.Salt = []byte{}
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FixMsg", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1756,12 +5038,24 @@ func (m *MsgInstantiateCoreContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FixMsg = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1783,7 +5077,7 @@ func (m *MsgInstantiateCoreContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantiateCoreContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnblockOracleProgramResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1806,44 +5100,12 @@ func (m *MsgInstantiateCoreContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantiateCoreContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnblockOracleProgramResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantiateCoreContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnblockOracleProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2246,6 +5508,223 @@ func (m *MsgRefundTxFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTopUpOracleProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpOracleProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpOracleProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rent = append(m.Rent, types.Coin{})
+			if err := m.Rent[len(m.Rent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTopUpOracleProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpOracleProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpOracleProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/hex"
 	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -36,3 +37,18 @@ func NewOracleProgram(bytecode []byte, addedAt time.Time) OracleProgram {
 		AddedAt:  addedAt,
 	}
 }
+
+// Summary returns the description of the oracle program without its
+// bytecode. The metadata is only included if it is not empty.
+func (p OracleProgram) Summary() OracleProgramSummary {
+	summary := OracleProgramSummary{
+		Hash:         hex.EncodeToString(p.Hash),
+		BytecodeSize: uint64(len(p.Bytecode)),
+		AddedAt:      p.AddedAt,
+	}
+	if p.Metadata != (OracleProgramMetadata{}) {
+		metadata := p.Metadata
+		summary.Metadata = &metadata
+	}
+	return summary
+}
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -32,6 +35,12 @@ type OracleProgram struct {
 	Hash     []byte    `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Bytecode []byte    `protobuf:"bytes,2,opt,name=bytecode,proto3" json:"bytecode,omitempty"`
 	AddedAt  time.Time `protobuf:"bytes,3,opt,name=added_at,json=addedAt,proto3,stdtime" json:"added_at"`
+	// Metadata is the optional descriptive information provided by the
+	// uploader of the oracle program.
+	Metadata OracleProgramMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata"`
+	// ExpirationHeight is the height at which the oracle program is pruned.
+	// Zero means the oracle program does not expire.
+	ExpirationHeight int64 `protobuf:"varint,5,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
 }
 
 func (m *OracleProgram) Reset()         { *m = OracleProgram{} }
@@ -88,28 +97,43 @@ func (m *OracleProgram) GetAddedAt() time.Time {
 	return time.Time{}
 }
 
-// Params to define the max wasm size allowed.
-type Params struct {
-	// MaxWasmSize specifies the maximum allowed size of an unzipped oracle
-	// program.
-	MaxWasmSize int64 `protobuf:"varint,1,opt,name=max_wasm_size,json=maxWasmSize,proto3" json:"max_wasm_size,omitempty"`
-	// WasmCostPerByte is the cost per unzipped byte of uploading an oracle
-	// program in aseda.
-	WasmCostPerByte uint64 `protobuf:"varint,2,opt,name=wasm_cost_per_byte,json=wasmCostPerByte,proto3" json:"wasm_cost_per_byte,omitempty"`
+func (m *OracleProgram) GetMetadata() OracleProgramMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return OracleProgramMetadata{}
 }
 
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
+func (m *OracleProgram) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+// OracleProgramSummary describes an oracle program without its bytecode.
+type OracleProgramSummary struct {
+	// Hash is the hex-encoded hash of the oracle program.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// BytecodeSize is the size of the unzipped bytecode in bytes.
+	BytecodeSize uint64    `protobuf:"varint,2,opt,name=bytecode_size,json=bytecodeSize,proto3" json:"bytecode_size,omitempty"`
+	AddedAt      time.Time `protobuf:"bytes,3,opt,name=added_at,json=addedAt,proto3,stdtime" json:"added_at"`
+	// Metadata is the metadata of the oracle program, if any was provided.
+	Metadata *OracleProgramMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *OracleProgramSummary) Reset()         { *m = OracleProgramSummary{} }
+func (m *OracleProgramSummary) String() string { return proto.CompactTextString(m) }
+func (*OracleProgramSummary) ProtoMessage()    {}
+func (*OracleProgramSummary) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9a4bda463450c942, []int{1}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *OracleProgramSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OracleProgramSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OracleProgramSummary.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -119,224 +143,2758 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *OracleProgramSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleProgramSummary.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *OracleProgramSummary) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *OracleProgramSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleProgramSummary.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_OracleProgramSummary proto.InternalMessageInfo
 
-func (m *Params) GetMaxWasmSize() int64 {
+func (m *OracleProgramSummary) GetHash() string {
 	if m != nil {
-		return m.MaxWasmSize
+		return m.Hash
 	}
-	return 0
+	return ""
 }
 
-func (m *Params) GetWasmCostPerByte() uint64 {
+func (m *OracleProgramSummary) GetBytecodeSize() uint64 {
 	if m != nil {
-		return m.WasmCostPerByte
+		return m.BytecodeSize
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*OracleProgram)(nil), "sedachain.wasm_storage.v1.OracleProgram")
-	proto.RegisterType((*Params)(nil), "sedachain.wasm_storage.v1.Params")
+func (m *OracleProgramSummary) GetAddedAt() time.Time {
+	if m != nil {
+		return m.AddedAt
+	}
+	return time.Time{}
 }
 
-func init() {
-	proto.RegisterFile("sedachain/wasm_storage/v1/wasm_storage.proto", fileDescriptor_9a4bda463450c942)
+func (m *OracleProgramSummary) GetMetadata() *OracleProgramMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
 }
 
-var fileDescriptor_9a4bda463450c942 = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0xc1, 0x4e, 0xc2, 0x40,
-	0x14, 0xec, 0x0a, 0x41, 0xb2, 0x48, 0x4c, 0x36, 0x1e, 0xb0, 0x87, 0x96, 0x70, 0x22, 0x51, 0xda,
-	0xa0, 0x07, 0x13, 0x2f, 0x46, 0xfc, 0x00, 0xb1, 0x9a, 0x98, 0x78, 0x69, 0x5e, 0xdb, 0x75, 0xdb,
-	0x84, 0x75, 0x9b, 0xdd, 0x05, 0x81, 0x93, 0x9f, 0xc0, 0x27, 0xf8, 0x39, 0x1c, 0x39, 0x7a, 0x52,
-	0x03, 0x17, 0x3f, 0xc3, 0x74, 0x1b, 0x48, 0xb8, 0xbd, 0xd9, 0x99, 0x97, 0x99, 0x7d, 0x83, 0xcf,
-	0x15, 0x4d, 0x20, 0x4e, 0x21, 0x7b, 0xf3, 0xdf, 0x41, 0xf1, 0x50, 0x69, 0x21, 0x81, 0x51, 0x7f,
-	0xd2, 0xdf, 0xc3, 0x5e, 0x2e, 0x85, 0x16, 0xe4, 0x74, 0xa7, 0xf6, 0xf6, 0xd8, 0x49, 0xdf, 0x3e,
-	0x61, 0x82, 0x09, 0xa3, 0xf2, 0x8b, 0xa9, 0x5c, 0xb0, 0x5d, 0x26, 0x04, 0x1b, 0x51, 0xdf, 0xa0,
-	0x68, 0xfc, 0xea, 0xeb, 0x8c, 0x53, 0xa5, 0x81, 0xe7, 0xa5, 0xa0, 0xf3, 0x81, 0x70, 0xf3, 0x5e,
-	0x42, 0x3c, 0xa2, 0x43, 0x29, 0x98, 0x04, 0x4e, 0x08, 0xae, 0xa6, 0xa0, 0xd2, 0x16, 0x6a, 0xa3,
-	0xee, 0x51, 0x60, 0x66, 0x62, 0xe3, 0x7a, 0x34, 0xd3, 0x34, 0x16, 0x09, 0x6d, 0x1d, 0x98, 0xf7,
-	0x1d, 0x26, 0x37, 0xb8, 0x0e, 0x49, 0x42, 0x93, 0x10, 0x74, 0xab, 0xd2, 0x46, 0xdd, 0xc6, 0x85,
-	0xed, 0x95, 0xae, 0xde, 0xd6, 0xd5, 0x7b, 0xda, 0xba, 0x0e, 0xea, 0xcb, 0x6f, 0xd7, 0x5a, 0xfc,
-	0xb8, 0x28, 0x38, 0x34, 0x5b, 0xb7, 0xba, 0x13, 0xe2, 0xda, 0x10, 0x24, 0x70, 0x45, 0x3a, 0xb8,
-	0xc9, 0x61, 0x1a, 0x96, 0x5f, 0xcb, 0xe6, 0xd4, 0x64, 0xa8, 0x04, 0x0d, 0x0e, 0xd3, 0x67, 0x50,
-	0xfc, 0x31, 0x9b, 0x53, 0x72, 0x86, 0x89, 0xe1, 0x63, 0xa1, 0x74, 0x98, 0x53, 0x19, 0x16, 0x41,
-	0x4c, 0xa8, 0x6a, 0x70, 0x5c, 0x30, 0x77, 0x42, 0xe9, 0x21, 0x95, 0x83, 0x99, 0xa6, 0xd7, 0xd5,
-	0xbf, 0x4f, 0x17, 0x0d, 0x1e, 0x96, 0x6b, 0x07, 0xad, 0xd6, 0x0e, 0xfa, 0x5d, 0x3b, 0x68, 0xb1,
-	0x71, 0xac, 0xd5, 0xc6, 0xb1, 0xbe, 0x36, 0x8e, 0xf5, 0x72, 0xc5, 0x32, 0x9d, 0x8e, 0x23, 0x2f,
-	0x16, 0xdc, 0x2f, 0x4e, 0x6b, 0x02, 0xc7, 0x62, 0x64, 0x40, 0xaf, 0xac, 0x65, 0x6a, 0x8a, 0xe8,
-	0x6d, 0x8b, 0xd1, 0xb3, 0x9c, 0xaa, 0xa8, 0x66, 0x94, 0x97, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xbe, 0x55, 0xd4, 0xc7, 0xbf, 0x01, 0x00, 0x00,
+// OracleProgramMetadata describes an oracle program. All fields are optional.
+type OracleProgramMetadata struct {
+	// Name is a human-readable name of the oracle program.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Version is the semantic version of the oracle program.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// SourceRepository is the URL of the source code repository.
+	SourceRepository string `protobuf:"bytes,3,opt,name=source_repository,json=sourceRepository,proto3" json:"source_repository,omitempty"`
+	// SourceCommit is the commit of the source code the program was built from.
+	SourceCommit string `protobuf:"bytes,4,opt,name=source_commit,json=sourceCommit,proto3" json:"source_commit,omitempty"`
+	// BuildHash is the hex-encoded hash of a reproducible build of the program.
+	BuildHash string `protobuf:"bytes,5,opt,name=build_hash,json=buildHash,proto3" json:"build_hash,omitempty"`
+	// Description describes what the oracle program does.
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// Owner is the address of the owner of the oracle program.
+	Owner string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *OracleProgramMetadata) Reset()         { *m = OracleProgramMetadata{} }
+func (m *OracleProgramMetadata) String() string { return proto.CompactTextString(m) }
+func (*OracleProgramMetadata) ProtoMessage()    {}
+func (*OracleProgramMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a4bda463450c942, []int{2}
+}
+func (m *OracleProgramMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleProgramMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleProgramMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *OracleProgramMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleProgramMetadata.Merge(m, src)
+}
+func (m *OracleProgramMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleProgramMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleProgramMetadata.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_OracleProgramMetadata proto.InternalMessageInfo
+
+func (m *OracleProgramMetadata) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *OracleProgramMetadata) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	if this.MaxWasmSize != that1.MaxWasmSize {
-		return false
+	return ""
+}
+
+func (m *OracleProgramMetadata) GetSourceRepository() string {
+	if m != nil {
+		return m.SourceRepository
 	}
-	if this.WasmCostPerByte != that1.WasmCostPerByte {
-		return false
+	return ""
+}
+
+func (m *OracleProgramMetadata) GetSourceCommit() string {
+	if m != nil {
+		return m.SourceCommit
 	}
-	return true
+	return ""
 }
-func (m *OracleProgram) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *OracleProgramMetadata) GetBuildHash() string {
+	if m != nil {
+		return m.BuildHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *OracleProgram) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OracleProgramMetadata) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *OracleProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt):])
-	if err1 != nil {
-		return 0, err1
+func (m *OracleProgramMetadata) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	i -= n1
-	i = encodeVarintWasmStorage(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Bytecode) > 0 {
-		i -= len(m.Bytecode)
-		copy(dAtA[i:], m.Bytecode)
-		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Bytecode)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+// CoreContractRegistryEntry records a Core Contract that was registered
+// through instantiation or migration.
+type CoreContractRegistryEntry struct {
+	// Address is the address of the Core Contract.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// CodeID is the code ID of the Core Contract at activation.
+	CodeID uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// ActivationHeight is the height at which the entry became active.
+	ActivationHeight int64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *CoreContractRegistryEntry) Reset()         { *m = CoreContractRegistryEntry{} }
+func (m *CoreContractRegistryEntry) String() string { return proto.CompactTextString(m) }
+func (*CoreContractRegistryEntry) ProtoMessage()    {}
+func (*CoreContractRegistryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a4bda463450c942, []int{3}
+}
+func (m *CoreContractRegistryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoreContractRegistryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoreContractRegistryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *CoreContractRegistryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoreContractRegistryEntry.Merge(m, src)
+}
+func (m *CoreContractRegistryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoreContractRegistryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoreContractRegistryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoreContractRegistryEntry proto.InternalMessageInfo
+
+func (m *CoreContractRegistryEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CoreContractRegistryEntry) GetCodeID() uint64 {
+	if m != nil {
+		return m.CodeID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CoreContractRegistryEntry) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WasmCostPerByte != 0 {
-		i = encodeVarintWasmStorage(dAtA, i, uint64(m.WasmCostPerByte))
-		i--
-		dAtA[i] = 0x10
+// BlockedOracleProgram is an oracle program hash blocked by governance from
+// being used.
+type BlockedOracleProgram struct {
+	// Hash is the hex-encoded hash of the blocked oracle program.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Reason describes why the oracle program was blocked.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// BlockedHeight is the height at which the oracle program was blocked.
+	BlockedHeight int64 `protobuf:"varint,3,opt,name=blocked_height,json=blockedHeight,proto3" json:"blocked_height,omitempty"`
+}
+
+func (m *BlockedOracleProgram) Reset()         { *m = BlockedOracleProgram{} }
+func (m *BlockedOracleProgram) String() string { return proto.CompactTextString(m) }
+func (*BlockedOracleProgram) ProtoMessage()    {}
+func (*BlockedOracleProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a4bda463450c942, []int{4}
+}
+func (m *BlockedOracleProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockedOracleProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockedOracleProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MaxWasmSize != 0 {
-		i = encodeVarintWasmStorage(dAtA, i, uint64(m.MaxWasmSize))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *BlockedOracleProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedOracleProgram.Merge(m, src)
+}
+func (m *BlockedOracleProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockedOracleProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedOracleProgram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockedOracleProgram proto.InternalMessageInfo
+
+func (m *BlockedOracleProgram) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintWasmStorage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWasmStorage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BlockedOracleProgram) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *OracleProgram) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BlockedOracleProgram) GetBlockedHeight() int64 {
+	if m != nil {
+		return m.BlockedHeight
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovWasmStorage(uint64(l))
+	return 0
+}
+
+// OracleProgramStats records how an oracle program has been used by the
+// tally module.
+type OracleProgramStats struct {
+	// Hash is the hash of the oracle program.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// ExecInvocations is the number of tallied data requests that used the
+	// program as their execution program.
+	ExecInvocations uint64 `protobuf:"varint,2,opt,name=exec_invocations,json=execInvocations,proto3" json:"exec_invocations,omitempty"`
+	// TallyInvocations is the number of times the program was executed as a
+	// tally program.
+	TallyInvocations uint64 `protobuf:"varint,3,opt,name=tally_invocations,json=tallyInvocations,proto3" json:"tally_invocations,omitempty"`
+	// LastUsedHeight is the height at which the program was last used.
+	LastUsedHeight int64 `protobuf:"varint,4,opt,name=last_used_height,json=lastUsedHeight,proto3" json:"last_used_height,omitempty"`
+	// TallyGasUsed is the cumulative gas used by the program in the tally VM.
+	TallyGasUsed uint64 `protobuf:"varint,5,opt,name=tally_gas_used,json=tallyGasUsed,proto3" json:"tally_gas_used,omitempty"`
+	// TallyExitCodes is the histogram of the exit codes of the tally program
+	// executions in ascending order of exit code.
+	TallyExitCodes []ExitCodeCount `protobuf:"bytes,6,rep,name=tally_exit_codes,json=tallyExitCodes,proto3" json:"tally_exit_codes"`
+}
+
+func (m *OracleProgramStats) Reset()         { *m = OracleProgramStats{} }
+func (m *OracleProgramStats) String() string { return proto.CompactTextString(m) }
+func (*OracleProgramStats) ProtoMessage()    {}
+func (*OracleProgramStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a4bda463450c942, []int{5}
+}
+func (m *OracleProgramStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleProgramStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleProgramStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Bytecode)
-	if l > 0 {
-		n += 1 + l + sovWasmStorage(uint64(l))
+}
+func (m *OracleProgramStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleProgramStats.Merge(m, src)
+}
+func (m *OracleProgramStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleProgramStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleProgramStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleProgramStats proto.InternalMessageInfo
+
+func (m *OracleProgramStats) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *OracleProgramStats) GetExecInvocations() uint64 {
+	if m != nil {
+		return m.ExecInvocations
+	}
+	return 0
+}
+
+func (m *OracleProgramStats) GetTallyInvocations() uint64 {
+	if m != nil {
+		return m.TallyInvocations
+	}
+	return 0
+}
+
+func (m *OracleProgramStats) GetLastUsedHeight() int64 {
+	if m != nil {
+		return m.LastUsedHeight
+	}
+	return 0
+}
+
+func (m *OracleProgramStats) GetTallyGasUsed() uint64 {
+	if m != nil {
+		return m.TallyGasUsed
+	}
+	return 0
+}
+
+func (m *OracleProgramStats) GetTallyExitCodes() []ExitCodeCount {
+	if m != nil {
+		return m.TallyExitCodes
+	}
+	return nil
+}
+
+// ExitCodeCount is the number of executions with a given exit code.
+type ExitCodeCount struct {
+	ExitCode uint32 `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Count    uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ExitCodeCount) Reset()         { *m = ExitCodeCount{} }
+func (m *ExitCodeCount) String() string { return proto.CompactTextString(m) }
+func (*ExitCodeCount) ProtoMessage()    {}
+func (*ExitCodeCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a4bda463450c942, []int{6}
+}
+func (m *ExitCodeCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitCodeCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitCodeCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExitCodeCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitCodeCount.Merge(m, src)
+}
+func (m *ExitCodeCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitCodeCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitCodeCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitCodeCount proto.InternalMessageInfo
+
+func (m *ExitCodeCount) GetExitCode() uint32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *ExitCodeCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// UploadSession is an in-progress chunked upload of an oracle program.
+type UploadSession struct {
+	// ID is the identifier of the upload session.
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Sender is the address that began the upload and receives the refund
+	// if the session expires.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hash is the declared hash of the unzipped oracle program.
+	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// UnzippedSize is the declared size of the unzipped oracle program in
+	// bytes.
+	UnzippedSize uint64 `protobuf:"varint,4,opt,name=unzipped_size,json=unzippedSize,proto3" json:"unzipped_size,omitempty"`
+	// StorageFee is the storage fee held in escrow until the upload is
+	// finalized or expires.
+	StorageFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=storage_fee,json=storageFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"storage_fee"`
+	// ReceivedBytes is the number of gzipped bytes uploaded so far.
+	ReceivedBytes uint64 `protobuf:"varint,6,opt,name=received_bytes,json=receivedBytes,proto3" json:"received_bytes,omitempty"`
+	// ChunkCount is the number of chunks uploaded so far.
+	ChunkCount uint32 `protobuf:"varint,7,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	// ExpirationHeight is the height at which the session expires and the
+	// storage fee is refunded.
+	ExpirationHeight int64 `protobuf:"varint,8,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// Metadata is the optional descriptive information of the oracle program.
+	Metadata OracleProgramMetadata `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata"`
+	// ZippedSize is the declared size of the gzip-compressed oracle program
+	// in bytes.
+	ZippedSize uint64 `protobuf:"varint,10,opt,name=zipped_size,json=zippedSize,proto3" json:"zipped_size,omitempty"`
+}
+
+func (m *UploadSession) Reset()         { *m = UploadSession{} }
+func (m *UploadSession) String() string { return proto.CompactTextString(m) }
+func (*UploadSession) ProtoMessage()    {}
+func (*UploadSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a4bda463450c942, []int{7}
+}
+func (m *UploadSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UploadSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UploadSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadSession.Merge(m, src)
+}
+func (m *UploadSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *UploadSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadSession proto.InternalMessageInfo
+
+func (m *UploadSession) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *UploadSession) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *UploadSession) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *UploadSession) GetUnzippedSize() uint64 {
+	if m != nil {
+		return m.UnzippedSize
+	}
+	return 0
+}
+
+func (m *UploadSession) GetStorageFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StorageFee
+	}
+	return nil
+}
+
+func (m *UploadSession) GetReceivedBytes() uint64 {
+	if m != nil {
+		return m.ReceivedBytes
+	}
+	return 0
+}
+
+func (m *UploadSession) GetChunkCount() uint32 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
+func (m *UploadSession) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func (m *UploadSession) GetMetadata() OracleProgramMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return OracleProgramMetadata{}
+}
+
+func (m *UploadSession) GetZippedSize() uint64 {
+	if m != nil {
+		return m.ZippedSize
+	}
+	return 0
+}
+
+// Params to define the max wasm size allowed.
+type Params struct {
+	// MaxWasmSize specifies the maximum allowed size of an unzipped oracle
+	// program.
+	MaxWasmSize int64 `protobuf:"varint,1,opt,name=max_wasm_size,json=maxWasmSize,proto3" json:"max_wasm_size,omitempty"`
+	// WasmCostPerByte is the cost per unzipped byte of uploading an oracle
+	// program in aseda.
+	WasmCostPerByte uint64 `protobuf:"varint,2,opt,name=wasm_cost_per_byte,json=wasmCostPerByte,proto3" json:"wasm_cost_per_byte,omitempty"`
+	// ProgramTTL is the number of blocks an oracle program is kept after it is
+	// stored or last used by the tally module. Zero disables expiration.
+	ProgramTTL int64 `protobuf:"varint,3,opt,name=program_ttl,json=programTtl,proto3" json:"program_ttl,omitempty"`
+	// RentPerBytePerBlock is the cost in aseda of extending the expiration of
+	// an oracle program by one block per unzipped byte.
+	RentPerBytePerBlock uint64 `protobuf:"varint,4,opt,name=rent_per_byte_per_block,json=rentPerBytePerBlock,proto3" json:"rent_per_byte_per_block,omitempty"`
+	// MaxPrunesPerBlock is the maximum number of expired oracle programs
+	// pruned and the maximum number of expired upload sessions removed at
+	// the end of a block.
+	MaxPrunesPerBlock uint32 `protobuf:"varint,5,opt,name=max_prunes_per_block,json=maxPrunesPerBlock,proto3" json:"max_prunes_per_block,omitempty"`
+	// UploadSessionTTL is the number of blocks a chunked upload session is kept
+	// before it expires. Zero disables chunked uploads.
+	UploadSessionTTL int64 `protobuf:"varint,6,opt,name=upload_session_ttl,json=uploadSessionTtl,proto3" json:"upload_session_ttl,omitempty"`
+	// ProgramGracePeriod is the number of blocks an expired oracle program is
+	// kept before it is pruned, so that data requests posted before it expired
+	// can still be tallied. It should exceed the data request timeout of the
+	// Core Contract.
+	ProgramGracePeriod int64 `protobuf:"varint,7,opt,name=program_grace_period,json=programGracePeriod,proto3" json:"program_grace_period,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a4bda463450c942, []int{8}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxWasmSize() int64 {
+	if m != nil {
+		return m.MaxWasmSize
+	}
+	return 0
+}
+
+func (m *Params) GetWasmCostPerByte() uint64 {
+	if m != nil {
+		return m.WasmCostPerByte
+	}
+	return 0
+}
+
+func (m *Params) GetProgramTTL() int64 {
+	if m != nil {
+		return m.ProgramTTL
+	}
+	return 0
+}
+
+func (m *Params) GetRentPerBytePerBlock() uint64 {
+	if m != nil {
+		return m.RentPerBytePerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrunesPerBlock() uint32 {
+	if m != nil {
+		return m.MaxPrunesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetUploadSessionTTL() int64 {
+	if m != nil {
+		return m.UploadSessionTTL
+	}
+	return 0
+}
+
+func (m *Params) GetProgramGracePeriod() int64 {
+	if m != nil {
+		return m.ProgramGracePeriod
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*OracleProgram)(nil), "sedachain.wasm_storage.v1.OracleProgram")
+	proto.RegisterType((*OracleProgramSummary)(nil), "sedachain.wasm_storage.v1.OracleProgramSummary")
+	proto.RegisterType((*OracleProgramMetadata)(nil), "sedachain.wasm_storage.v1.OracleProgramMetadata")
+	proto.RegisterType((*CoreContractRegistryEntry)(nil), "sedachain.wasm_storage.v1.CoreContractRegistryEntry")
+	proto.RegisterType((*BlockedOracleProgram)(nil), "sedachain.wasm_storage.v1.BlockedOracleProgram")
+	proto.RegisterType((*OracleProgramStats)(nil), "sedachain.wasm_storage.v1.OracleProgramStats")
+	proto.RegisterType((*ExitCodeCount)(nil), "sedachain.wasm_storage.v1.ExitCodeCount")
+	proto.RegisterType((*UploadSession)(nil), "sedachain.wasm_storage.v1.UploadSession")
+	proto.RegisterType((*Params)(nil), "sedachain.wasm_storage.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("sedachain/wasm_storage/v1/wasm_storage.proto", fileDescriptor_9a4bda463450c942)
+}
+
+var fileDescriptor_9a4bda463450c942 = []byte{
+	// 1179 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0xb7,
+	0x13, 0xb5, 0xfe, 0x58, 0xb6, 0x46, 0x96, 0x7f, 0x0a, 0x7f, 0x6a, 0x2a, 0xbb, 0xa8, 0x64, 0x28,
+	0x2d, 0xa0, 0x22, 0xb5, 0xe4, 0xa4, 0x05, 0x0a, 0xf4, 0x52, 0x44, 0x4a, 0x9a, 0x18, 0x48, 0x51,
+	0x77, 0xed, 0xa0, 0x45, 0x2f, 0x0b, 0x6a, 0x97, 0x91, 0x88, 0xec, 0x2e, 0x05, 0x92, 0x72, 0xa4,
+	0xdc, 0x7a, 0xeb, 0x31, 0x1f, 0xa1, 0xbd, 0xe6, 0xdc, 0x0f, 0x91, 0x63, 0xd0, 0x53, 0x4f, 0x4e,
+	0xa1, 0x5c, 0x8a, 0x02, 0xfd, 0x0e, 0x05, 0x87, 0x5c, 0x49, 0x0b, 0x38, 0xbe, 0x34, 0x27, 0x2d,
+	0xe7, 0x3d, 0x0e, 0x87, 0x6f, 0x1e, 0x49, 0xc1, 0xa7, 0x8a, 0x85, 0x34, 0x18, 0x53, 0x9e, 0xf4,
+	0x9e, 0x52, 0x15, 0xfb, 0x4a, 0x0b, 0x49, 0x47, 0xac, 0x77, 0x7e, 0x2b, 0x33, 0xee, 0x4e, 0xa4,
+	0xd0, 0x82, 0xec, 0x2d, 0xd9, 0xdd, 0x0c, 0x7a, 0x7e, 0x6b, 0xbf, 0x3e, 0x12, 0x23, 0x81, 0xac,
+	0x9e, 0xf9, 0xb2, 0x13, 0xf6, 0x5b, 0x23, 0x21, 0x46, 0x11, 0xeb, 0xe1, 0x68, 0x38, 0x7d, 0xdc,
+	0xd3, 0x3c, 0x66, 0x4a, 0xd3, 0x78, 0xe2, 0x08, 0x7b, 0x81, 0x50, 0xb1, 0x50, 0xbe, 0x9d, 0x69,
+	0x07, 0x0e, 0x6a, 0xda, 0x51, 0x6f, 0x48, 0x95, 0xa9, 0x67, 0xc8, 0x34, 0xbd, 0xd5, 0x0b, 0x04,
+	0x4f, 0x2c, 0xde, 0xfe, 0x29, 0x0f, 0xd5, 0x6f, 0x25, 0x0d, 0x22, 0x76, 0x22, 0xc5, 0x48, 0xd2,
+	0x98, 0x10, 0x28, 0x8e, 0xa9, 0x1a, 0x37, 0x72, 0x07, 0xb9, 0xce, 0x8e, 0x87, 0xdf, 0x64, 0x1f,
+	0xb6, 0x87, 0x73, 0xcd, 0x02, 0x11, 0xb2, 0x46, 0x1e, 0xe3, 0xcb, 0x31, 0xf9, 0x0a, 0xb6, 0x69,
+	0x18, 0xb2, 0xd0, 0xa7, 0xba, 0x51, 0x38, 0xc8, 0x75, 0x2a, 0xb7, 0xf7, 0xbb, 0xb6, 0xe0, 0x6e,
+	0x5a, 0x70, 0xf7, 0x2c, 0x2d, 0xb8, 0xbf, 0xfd, 0xf2, 0xa2, 0xb5, 0xf1, 0xfc, 0x75, 0x2b, 0xe7,
+	0x6d, 0xe1, 0xac, 0x3b, 0x9a, 0x78, 0xb0, 0x1d, 0x33, 0x4d, 0x43, 0xaa, 0x69, 0xa3, 0x88, 0x09,
+	0x8e, 0xba, 0x6f, 0x95, 0xa8, 0x9b, 0x29, 0xf6, 0x1b, 0x37, 0xaf, 0x5f, 0x34, 0x69, 0xbd, 0x65,
+	0x1e, 0x72, 0x13, 0xae, 0xb1, 0xd9, 0x84, 0x4b, 0xaa, 0xb9, 0x48, 0xfc, 0x31, 0xe3, 0xa3, 0xb1,
+	0x6e, 0x6c, 0x1e, 0xe4, 0x3a, 0x05, 0xaf, 0xb6, 0x02, 0x1e, 0x60, 0xbc, 0x7d, 0x91, 0x83, 0x7a,
+	0x26, 0xed, 0xe9, 0x34, 0x8e, 0xa9, 0x9c, 0x67, 0xa4, 0x28, 0x3b, 0x29, 0x6e, 0x40, 0x35, 0xdd,
+	0xba, 0xaf, 0xf8, 0x33, 0xab, 0x47, 0xd1, 0xdb, 0x49, 0x83, 0xa7, 0xfc, 0xd9, 0x3b, 0xd0, 0xe4,
+	0xe1, 0x7f, 0xd7, 0x64, 0xa5, 0x46, 0xfb, 0xe7, 0x3c, 0xbc, 0x77, 0x29, 0xc7, 0xec, 0x30, 0xa1,
+	0x31, 0x4b, 0x77, 0x68, 0xbe, 0x49, 0x03, 0xb6, 0xce, 0x99, 0x54, 0x5c, 0x24, 0xb8, 0xb7, 0xb2,
+	0x97, 0x0e, 0x8d, 0xaa, 0x4a, 0x4c, 0x65, 0xc0, 0x7c, 0xc9, 0x26, 0x42, 0x71, 0x2d, 0xe4, 0x1c,
+	0xf7, 0x57, 0xf6, 0x6a, 0x16, 0xf0, 0x96, 0x71, 0x23, 0x94, 0x23, 0x07, 0x22, 0x8e, 0xb9, 0xc6,
+	0x7d, 0x94, 0xbd, 0x1d, 0x1b, 0x1c, 0x60, 0x8c, 0x7c, 0x08, 0x30, 0x9c, 0xf2, 0x28, 0xf4, 0x51,
+	0xe7, 0x4d, 0x64, 0x94, 0x31, 0xf2, 0xc0, 0x88, 0x7d, 0x00, 0x95, 0x90, 0xa9, 0x40, 0xf2, 0x89,
+	0x69, 0x57, 0xa3, 0x84, 0xf8, 0x7a, 0x88, 0x74, 0x61, 0x53, 0x3c, 0x4d, 0x98, 0x6c, 0x6c, 0x19,
+	0xac, 0xdf, 0xf8, 0xfd, 0xb7, 0xc3, 0xba, 0x3b, 0x00, 0x77, 0xc2, 0x50, 0x32, 0xa5, 0x4e, 0xb5,
+	0xe4, 0xc9, 0xc8, 0xb3, 0xb4, 0xf6, 0xaf, 0x39, 0xd8, 0x1b, 0x08, 0xc9, 0x06, 0x22, 0xd1, 0x92,
+	0x06, 0xda, 0x63, 0x23, 0xae, 0xb4, 0x9c, 0xdf, 0x4b, 0xb4, 0x9c, 0x93, 0xdb, 0x60, 0x3a, 0x60,
+	0x66, 0x59, 0x45, 0xae, 0xc8, 0x97, 0x12, 0xc9, 0x0d, 0xd8, 0x42, 0x33, 0xf0, 0xd0, 0x5a, 0xa1,
+	0x0f, 0x8b, 0x8b, 0x56, 0x69, 0x20, 0x42, 0x76, 0x7c, 0xd7, 0x2b, 0x19, 0xe8, 0x38, 0x34, 0xca,
+	0xd1, 0x40, 0xf3, 0xf3, 0x8c, 0x1f, 0x0b, 0xd6, 0x8f, 0x2b, 0xc0, 0xf9, 0x91, 0x43, 0xbd, 0x1f,
+	0x89, 0xe0, 0x09, 0x0b, 0xdf, 0x7e, 0x32, 0x53, 0x3b, 0x5e, 0x87, 0x92, 0x64, 0x54, 0x2d, 0x7b,
+	0xe5, 0x46, 0xe4, 0x63, 0xd8, 0x1d, 0xda, 0x1c, 0xd9, 0xd5, 0xaa, 0x2e, 0xea, 0x96, 0x7a, 0x91,
+	0x07, 0x92, 0xb5, 0xbe, 0xa6, 0x5a, 0x5d, 0x7a, 0x07, 0x7c, 0x02, 0x35, 0x36, 0x63, 0x81, 0xcf,
+	0x93, 0x73, 0x11, 0x60, 0xb9, 0xca, 0x79, 0xff, 0x7f, 0x26, 0x7e, 0xbc, 0x0a, 0x9b, 0xdd, 0x6a,
+	0x1a, 0x45, 0xf3, 0x0c, 0xb7, 0x80, 0xdc, 0x1a, 0x02, 0xeb, 0xe4, 0x0e, 0xd4, 0x22, 0xaa, 0xb4,
+	0x3f, 0x55, 0xab, 0x5a, 0x8b, 0x58, 0xeb, 0xae, 0x89, 0x3f, 0x52, 0x69, 0xb1, 0xe4, 0x23, 0xd8,
+	0xb5, 0x69, 0x47, 0x54, 0x21, 0x1d, 0x0d, 0x53, 0xf4, 0x76, 0x30, 0x7a, 0x9f, 0x2a, 0xc3, 0x25,
+	0x3f, 0x80, 0x5d, 0xc3, 0x67, 0x33, 0xae, 0x7d, 0xa3, 0xbf, 0x6a, 0x94, 0x0e, 0x0a, 0x9d, 0xca,
+	0xed, 0xce, 0x15, 0x47, 0xe8, 0xde, 0x8c, 0x6b, 0xd3, 0xb3, 0x81, 0x98, 0x26, 0xda, 0x5d, 0x27,
+	0x76, 0xb5, 0x14, 0x51, 0xed, 0x3e, 0x54, 0x33, 0x34, 0xf2, 0x01, 0x94, 0x97, 0x8b, 0xa0, 0x56,
+	0x55, 0x6f, 0x9b, 0x39, 0x06, 0xa9, 0xc3, 0x66, 0x60, 0x58, 0x4e, 0x24, 0x3b, 0x68, 0xff, 0x5d,
+	0x80, 0xea, 0xa3, 0x49, 0x24, 0x68, 0x78, 0xca, 0x14, 0x1e, 0xaa, 0xeb, 0x90, 0xe7, 0x21, 0xce,
+	0x2e, 0xf6, 0x4b, 0x8b, 0x8b, 0x56, 0xfe, 0xf8, 0xae, 0x97, 0xe7, 0x21, 0x39, 0x82, 0x92, 0x62,
+	0x49, 0xc8, 0xa4, 0xed, 0xec, 0x15, 0x56, 0x74, 0xbc, 0x65, 0xd7, 0x0a, 0x6b, 0x5d, 0xbb, 0x01,
+	0xd5, 0x69, 0xf2, 0x8c, 0x4f, 0x26, 0x2c, 0xb4, 0xd7, 0x55, 0xd1, 0x4a, 0x96, 0x06, 0xf1, 0xba,
+	0x8a, 0xa0, 0xe2, 0xa4, 0xf0, 0x1f, 0x33, 0xd6, 0xd8, 0x44, 0xb5, 0xf6, 0xba, 0x6e, 0x31, 0xf3,
+	0x74, 0x74, 0xdd, 0xd3, 0xd1, 0x1d, 0x08, 0x9e, 0xf4, 0x8f, 0x8c, 0x3c, 0x2f, 0x5e, 0xb7, 0x3a,
+	0x23, 0xae, 0xc7, 0xd3, 0x61, 0x37, 0x10, 0xb1, 0x7b, 0x75, 0xdc, 0xcf, 0xa1, 0x0a, 0x9f, 0xf4,
+	0xf4, 0x7c, 0xc2, 0x14, 0x4e, 0x50, 0x1e, 0xb8, 0xfc, 0x5f, 0x33, 0x66, 0xac, 0x29, 0x59, 0xc0,
+	0xf8, 0x39, 0x0b, 0x7d, 0x73, 0x6b, 0x2a, 0x3c, 0xd7, 0x45, 0xaf, 0x9a, 0x46, 0xfb, 0x26, 0x48,
+	0x5a, 0x50, 0x09, 0xc6, 0xd3, 0xe4, 0x89, 0x6f, 0x55, 0xdc, 0x42, 0x79, 0x01, 0x43, 0x56, 0xfd,
+	0x4b, 0xef, 0xf8, 0xed, 0xcb, 0xef, 0xf8, 0xcc, 0x23, 0x53, 0x7e, 0x47, 0x8f, 0x4c, 0x0b, 0x2a,
+	0xeb, 0xca, 0x02, 0xee, 0x02, 0x56, 0xba, 0xb6, 0xff, 0xc9, 0x43, 0xe9, 0x84, 0x4a, 0x1a, 0x2b,
+	0xd2, 0x86, 0x6a, 0x4c, 0x67, 0xbe, 0x5d, 0xc8, 0xb0, 0x73, 0x58, 0x68, 0x25, 0xa6, 0xb3, 0xef,
+	0xa9, 0x8a, 0xb1, 0x0d, 0x37, 0x81, 0x20, 0x1e, 0x08, 0xa5, 0xfd, 0x09, 0x93, 0xa8, 0x4e, 0x7a,
+	0xc6, 0x0c, 0x32, 0x10, 0x4a, 0x9f, 0x30, 0x69, 0xf4, 0x21, 0x3d, 0xa8, 0x4c, 0x6c, 0x7d, 0xbe,
+	0xd6, 0x91, 0x3d, 0xdd, 0xfd, 0xdd, 0xc5, 0x45, 0x0b, 0x5c, 0xd9, 0x67, 0x67, 0x0f, 0x3d, 0x70,
+	0x94, 0x33, 0x1d, 0x91, 0xcf, 0xe1, 0x7d, 0xc9, 0x92, 0x55, 0x62, 0xfb, 0x61, 0x6e, 0x03, 0xe7,
+	0x89, 0xff, 0x1b, 0xd8, 0xa5, 0x37, 0x3f, 0x06, 0x22, 0x3d, 0xa8, 0x9b, 0xba, 0x27, 0x72, 0x9a,
+	0x30, 0xb5, 0x36, 0x65, 0x13, 0xdb, 0x71, 0x2d, 0xa6, 0xb3, 0x13, 0x84, 0x96, 0x13, 0xfa, 0x40,
+	0xa6, 0xe8, 0x6f, 0x5f, 0x59, 0x83, 0x63, 0x79, 0x25, 0x2c, 0xaf, 0xbe, 0xb8, 0x68, 0xd5, 0x32,
+	0xee, 0x37, 0x45, 0xd6, 0xa6, 0x99, 0x88, 0x8e, 0xc8, 0x11, 0xd4, 0xd3, 0xbd, 0x8d, 0x24, 0x0d,
+	0xb0, 0x54, 0x2e, 0x42, 0xf4, 0x40, 0xc1, 0x23, 0x0e, 0xbb, 0x6f, 0xa0, 0x13, 0x44, 0xbe, 0x2c,
+	0xfe, 0xf5, 0x4b, 0x2b, 0xd7, 0xff, 0xee, 0xe5, 0xa2, 0x99, 0x7b, 0xb5, 0x68, 0xe6, 0xfe, 0x5c,
+	0x34, 0x73, 0xcf, 0xdf, 0x34, 0x37, 0x5e, 0xbd, 0x69, 0x6e, 0xfc, 0xf1, 0xa6, 0xb9, 0xf1, 0xe3,
+	0x17, 0x6b, 0x4e, 0x35, 0x6d, 0xc7, 0x57, 0x38, 0x10, 0x11, 0x0e, 0x0e, 0xed, 0x5f, 0xb7, 0x19,
+	0xfe, 0x59, 0x3b, 0x4c, 0xff, 0xbc, 0xa1, 0x7d, 0x87, 0x25, 0x64, 0x7e, 0xf6, 0x6f, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0xa0, 0x70, 0x4e, 0x9c, 0xe3, 0x09, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxWasmSize != that1.MaxWasmSize {
+		return false
+	}
+	if this.WasmCostPerByte != that1.WasmCostPerByte {
+		return false
+	}
+	if this.ProgramTTL != that1.ProgramTTL {
+		return false
+	}
+	if this.RentPerBytePerBlock != that1.RentPerBytePerBlock {
+		return false
+	}
+	if this.MaxPrunesPerBlock != that1.MaxPrunesPerBlock {
+		return false
+	}
+	if this.UploadSessionTTL != that1.UploadSessionTTL {
+		return false
+	}
+	if this.ProgramGracePeriod != that1.ProgramGracePeriod {
+		return false
+	}
+	return true
+}
+func (m *OracleProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWasmStorage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintWasmStorage(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bytecode) > 0 {
+		i -= len(m.Bytecode)
+		copy(dAtA[i:], m.Bytecode)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Bytecode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleProgramSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleProgramSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleProgramSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWasmStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintWasmStorage(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.BytecodeSize != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.BytecodeSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleProgramMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleProgramMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleProgramMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BuildHash) > 0 {
+		i -= len(m.BuildHash)
+		copy(dAtA[i:], m.BuildHash)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.BuildHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourceCommit) > 0 {
+		i -= len(m.SourceCommit)
+		copy(dAtA[i:], m.SourceCommit)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.SourceCommit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceRepository) > 0 {
+		i -= len(m.SourceRepository)
+		copy(dAtA[i:], m.SourceRepository)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.SourceRepository)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoreContractRegistryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoreContractRegistryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoreContractRegistryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockedOracleProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockedOracleProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockedOracleProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockedHeight != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.BlockedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleProgramStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleProgramStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleProgramStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TallyExitCodes) > 0 {
+		for iNdEx := len(m.TallyExitCodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TallyExitCodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWasmStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.TallyGasUsed != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.TallyGasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastUsedHeight != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.LastUsedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TallyInvocations != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.TallyInvocations))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecInvocations != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.ExecInvocations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitCodeCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitCodeCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitCodeCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UploadSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ZippedSize != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.ZippedSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWasmStorage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ChunkCount != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ReceivedBytes != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.ReceivedBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.StorageFee) > 0 {
+		for iNdEx := len(m.StorageFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWasmStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.UnzippedSize != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.UnzippedSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProgramGracePeriod != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.ProgramGracePeriod))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UploadSessionTTL != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.UploadSessionTTL))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxPrunesPerBlock != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.MaxPrunesPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RentPerBytePerBlock != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.RentPerBytePerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProgramTTL != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.ProgramTTL))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WasmCostPerByte != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.WasmCostPerByte))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxWasmSize != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.MaxWasmSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWasmStorage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWasmStorage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OracleProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = len(m.Bytecode)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt)
+	n += 1 + l + sovWasmStorage(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovWasmStorage(uint64(l))
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovWasmStorage(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *OracleProgramSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	if m.BytecodeSize != 0 {
+		n += 1 + sovWasmStorage(uint64(m.BytecodeSize))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt)
+	n += 1 + l + sovWasmStorage(uint64(l))
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	return n
+}
+
+func (m *OracleProgramMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = len(m.SourceRepository)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = len(m.SourceCommit)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = len(m.BuildHash)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	return n
+}
+
+func (m *CoreContractRegistryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovWasmStorage(uint64(m.CodeID))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovWasmStorage(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *BlockedOracleProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	if m.BlockedHeight != 0 {
+		n += 1 + sovWasmStorage(uint64(m.BlockedHeight))
+	}
+	return n
+}
+
+func (m *OracleProgramStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	if m.ExecInvocations != 0 {
+		n += 1 + sovWasmStorage(uint64(m.ExecInvocations))
+	}
+	if m.TallyInvocations != 0 {
+		n += 1 + sovWasmStorage(uint64(m.TallyInvocations))
+	}
+	if m.LastUsedHeight != 0 {
+		n += 1 + sovWasmStorage(uint64(m.LastUsedHeight))
+	}
+	if m.TallyGasUsed != 0 {
+		n += 1 + sovWasmStorage(uint64(m.TallyGasUsed))
+	}
+	if len(m.TallyExitCodes) > 0 {
+		for _, e := range m.TallyExitCodes {
+			l = e.Size()
+			n += 1 + l + sovWasmStorage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExitCodeCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExitCode != 0 {
+		n += 1 + sovWasmStorage(uint64(m.ExitCode))
+	}
+	if m.Count != 0 {
+		n += 1 + sovWasmStorage(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *UploadSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovWasmStorage(uint64(m.ID))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	if m.UnzippedSize != 0 {
+		n += 1 + sovWasmStorage(uint64(m.UnzippedSize))
+	}
+	if len(m.StorageFee) > 0 {
+		for _, e := range m.StorageFee {
+			l = e.Size()
+			n += 1 + l + sovWasmStorage(uint64(l))
+		}
+	}
+	if m.ReceivedBytes != 0 {
+		n += 1 + sovWasmStorage(uint64(m.ReceivedBytes))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovWasmStorage(uint64(m.ChunkCount))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovWasmStorage(uint64(m.ExpirationHeight))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovWasmStorage(uint64(l))
+	if m.ZippedSize != 0 {
+		n += 1 + sovWasmStorage(uint64(m.ZippedSize))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxWasmSize != 0 {
+		n += 1 + sovWasmStorage(uint64(m.MaxWasmSize))
+	}
+	if m.WasmCostPerByte != 0 {
+		n += 1 + sovWasmStorage(uint64(m.WasmCostPerByte))
+	}
+	if m.ProgramTTL != 0 {
+		n += 1 + sovWasmStorage(uint64(m.ProgramTTL))
+	}
+	if m.RentPerBytePerBlock != 0 {
+		n += 1 + sovWasmStorage(uint64(m.RentPerBytePerBlock))
+	}
+	if m.MaxPrunesPerBlock != 0 {
+		n += 1 + sovWasmStorage(uint64(m.MaxPrunesPerBlock))
+	}
+	if m.UploadSessionTTL != 0 {
+		n += 1 + sovWasmStorage(uint64(m.UploadSessionTTL))
+	}
+	if m.ProgramGracePeriod != 0 {
+		n += 1 + sovWasmStorage(uint64(m.ProgramGracePeriod))
+	}
+	return n
+}
+
+func sovWasmStorage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWasmStorage(x uint64) (n int) {
+	return sovWasmStorage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OracleProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytecode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bytecode = append(m.Bytecode[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bytecode == nil {
+				m.Bytecode = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AddedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleProgramSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleProgramSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleProgramSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytecodeSize", wireType)
+			}
+			m.BytecodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytecodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AddedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &OracleProgramMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleProgramMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleProgramMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleProgramMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRepository", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRepository = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoreContractRegistryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreContractRegistryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreContractRegistryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockedOracleProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockedOracleProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockedOracleProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedHeight", wireType)
+			}
+			m.BlockedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleProgramStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleProgramStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleProgramStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecInvocations", wireType)
+			}
+			m.ExecInvocations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecInvocations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyInvocations", wireType)
+			}
+			m.TallyInvocations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyInvocations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedHeight", wireType)
+			}
+			m.LastUsedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyGasUsed", wireType)
+			}
+			m.TallyGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyExitCodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallyExitCodes = append(m.TallyExitCodes, ExitCodeCount{})
+			if err := m.TallyExitCodes[len(m.TallyExitCodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExitCodeCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExitCodeCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExitCodeCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt)
-	n += 1 + l + sovWasmStorage(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxWasmSize != 0 {
-		n += 1 + sovWasmStorage(uint64(m.MaxWasmSize))
-	}
-	if m.WasmCostPerByte != 0 {
-		n += 1 + sovWasmStorage(uint64(m.WasmCostPerByte))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovWasmStorage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozWasmStorage(x uint64) (n int) {
-	return sovWasmStorage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *OracleProgram) Unmarshal(dAtA []byte) error {
+func (m *UploadSession) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -359,13 +2917,64 @@ func (m *OracleProgram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleProgram: wiretype end group for non-group")
+			return fmt.Errorf("proto: UploadSession: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UploadSession: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
@@ -399,11 +3008,30 @@ func (m *OracleProgram) Unmarshal(dAtA []byte) error {
 				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnzippedSize", wireType)
+			}
+			m.UnzippedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnzippedSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytecode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageFee", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasmStorage
@@ -413,29 +3041,86 @@ func (m *OracleProgram) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWasmStorage
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWasmStorage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bytecode = append(m.Bytecode[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bytecode == nil {
-				m.Bytecode = []byte{}
+			m.StorageFee = append(m.StorageFee, types.Coin{})
+			if err := m.StorageFee[len(m.StorageFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedBytes", wireType)
+			}
+			m.ReceivedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+			}
+			m.ChunkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -462,10 +3147,29 @@ func (m *OracleProgram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AddedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZippedSize", wireType)
+			}
+			m.ZippedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZippedSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWasmStorage(dAtA[iNdEx:])
@@ -554,6 +3258,101 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramTTL", wireType)
+			}
+			m.ProgramTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProgramTTL |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentPerBytePerBlock", wireType)
+			}
+			m.RentPerBytePerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RentPerBytePerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunesPerBlock", wireType)
+			}
+			m.MaxPrunesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadSessionTTL", wireType)
+			}
+			m.UploadSessionTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadSessionTTL |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramGracePeriod", wireType)
+			}
+			m.ProgramGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProgramGracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWasmStorage(dAtA[iNdEx:])
@@ -0,0 +1,573 @@
+package types
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+const (
+	// MaxWasmMemoryPages is the maximum number of 64 KiB memory pages an
+	// oracle program may declare.
+	MaxWasmMemoryPages = 512
+	// MaxWasmTables is the maximum number of tables an oracle program may
+	// declare.
+	MaxWasmTables = 1
+	// MaxWasmTableSize is the maximum number of elements of a table.
+	MaxWasmTableSize = 10000
+	// MaxWasmFunctions is the maximum number of functions, including
+	// imported ones, an oracle program may declare.
+	MaxWasmFunctions = 20000
+
+	// OracleProgramEntryPoint is the function export called by the SEDA VM.
+	OracleProgramEntryPoint = "_start"
+	// OracleProgramMemoryExport is the memory export used by the SEDA VM.
+	OracleProgramMemoryExport = "memory"
+)
+
+// AllowedOracleProgramImports lists the host functions provided by the
+// SEDA VM, keyed by import module. The seda_v1 functions are those the VM
+// registers itself and the wasi_snapshot_preview1 functions are the full
+// WASI preview 1 interface, which the VM provides through WASIX.
+var AllowedOracleProgramImports = map[string]map[string]bool{
+	"seda_v1": {
+		"bn254_verify":       true,
+		"call_result_length": true,
+		"call_result_write":  true,
+		"execution_result":   true,
+		"http_fetch":         true,
+		"proxy_http_fetch":   true,
+		"secp256k1_verify":   true,
+	},
+	"wasi_snapshot_preview1": {
+		"args_get":                true,
+		"args_sizes_get":          true,
+		"clock_res_get":           true,
+		"clock_time_get":          true,
+		"environ_get":             true,
+		"environ_sizes_get":       true,
+		"fd_advise":               true,
+		"fd_allocate":             true,
+		"fd_close":                true,
+		"fd_datasync":             true,
+		"fd_fdstat_get":           true,
+		"fd_fdstat_set_flags":     true,
+		"fd_fdstat_set_rights":    true,
+		"fd_filestat_get":         true,
+		"fd_filestat_set_size":    true,
+		"fd_filestat_set_times":   true,
+		"fd_pread":                true,
+		"fd_prestat_dir_name":     true,
+		"fd_prestat_get":          true,
+		"fd_pwrite":               true,
+		"fd_read":                 true,
+		"fd_readdir":              true,
+		"fd_renumber":             true,
+		"fd_seek":                 true,
+		"fd_sync":                 true,
+		"fd_tell":                 true,
+		"fd_write":                true,
+		"path_create_directory":   true,
+		"path_filestat_get":       true,
+		"path_filestat_set_times": true,
+		"path_link":               true,
+		"path_open":               true,
+		"path_readlink":           true,
+		"path_remove_directory":   true,
+		"path_rename":             true,
+		"path_symlink":            true,
+		"path_unlink_file":        true,
+		"poll_oneoff":             true,
+		"proc_exit":               true,
+		"proc_raise":              true,
+		"random_get":              true,
+		"sched_yield":             true,
+		"sock_accept":             true,
+		"sock_recv":               true,
+		"sock_send":               true,
+		"sock_shutdown":           true,
+	},
+}
+
+var wasmMagic = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+// WebAssembly section IDs.
+const (
+	sectionCustom   = 0
+	sectionImport   = 2
+	sectionFunction = 3
+	sectionTable    = 4
+	sectionMemory   = 5
+	sectionExport   = 7
+	sectionCode     = 10
+	sectionMax      = 12
+)
+
+// WebAssembly external kinds.
+const (
+	externFunc   = 0
+	externTable  = 1
+	externMemory = 2
+	externGlobal = 3
+)
+
+// ValidateOracleProgramWasm statically validates the bytecode of an oracle
+// program against the requirements of the SEDA VM. It checks that the module
+// is well-formed, exports its entry point and memory, only imports host
+// functions that the VM provides, contains no SIMD, atomic or
+// shared memory instructions and stays within the memory, table and function
+// limits. Floating-point instructions are allowed, since the VM executes them
+// deterministically.
+func ValidateOracleProgramWasm(bytecode []byte) error {
+	if !bytes.HasPrefix(bytecode, wasmMagic) {
+		return ErrInvalidWasm.Wrap("invalid magic number or version")
+	}
+
+	r := &wasmReader{buf: bytecode, pos: len(wasmMagic)}
+	var (
+		numFuncs, numTables, numMemories   int
+		numDeclaredFuncs                   uint32
+		hasEntryPoint, hasMemory, codeSeen bool
+	)
+	for !r.done() {
+		id, err := r.byte()
+		if err != nil {
+			return err
+		}
+		section, err := r.subReader()
+		if err != nil {
+			return err
+		}
+		if id > sectionMax {
+			return ErrInvalidWasm.Wrapf("unknown section id %d", id)
+		}
+
+		switch id {
+		case sectionCustom:
+			continue
+		case sectionImport:
+			imports, err := section.importSection()
+			if err != nil {
+				return err
+			}
+			for _, imp := range imports {
+				if imp.kind == externFunc {
+					numFuncs++
+				}
+				hostFuncs, ok := AllowedOracleProgramImports[imp.module]
+				if !ok {
+					return ErrDisallowedWasmImport.Wrapf("module %s", imp.module)
+				}
+				if imp.kind != externFunc {
+					return ErrDisallowedWasmImport.Wrapf("%s.%s is not a function", imp.module, imp.name)
+				}
+				if !hostFuncs[imp.name] {
+					return ErrDisallowedWasmImport.Wrapf("%s.%s", imp.module, imp.name)
+				}
+			}
+
+		case sectionFunction:
+			count, err := section.u32()
+			if err != nil {
+				return err
+			}
+			for i := uint32(0); i < count; i++ {
+				if _, err := section.u32(); err != nil {
+					return err
+				}
+			}
+			numDeclaredFuncs = count
+			numFuncs += int(count)
+
+		case sectionTable:
+			count, err := section.u32()
+			if err != nil {
+				return err
+			}
+			for i := uint32(0); i < count; i++ {
+				if _, err := section.byte(); err != nil {
+					return err
+				}
+				minSize, maxSize, hasMax, err := section.limits()
+				if err != nil {
+					return err
+				}
+				if minSize > MaxWasmTableSize || (hasMax && maxSize > MaxWasmTableSize) {
+					return ErrWasmLimitExceeded.Wrapf("table size exceeds %d elements", MaxWasmTableSize)
+				}
+			}
+			numTables += int(count)
+
+		case sectionMemory:
+			count, err := section.u32()
+			if err != nil {
+				return err
+			}
+			for i := uint32(0); i < count; i++ {
+				minPages, maxPages, hasMax, err := section.limits()
+				if err != nil {
+					return err
+				}
+				if minPages > MaxWasmMemoryPages || (hasMax && maxPages > MaxWasmMemoryPages) {
+					return ErrWasmLimitExceeded.Wrapf("memory exceeds %d pages", MaxWasmMemoryPages)
+				}
+			}
+			numMemories += int(count)
+
+		case sectionExport:
+			count, err := section.u32()
+			if err != nil {
+				return err
+			}
+			for i := uint32(0); i < count; i++ {
+				name, err := section.name()
+				if err != nil {
+					return err
+				}
+				kind, err := section.byte()
+				if err != nil {
+					return err
+				}
+				if _, err := section.u32(); err != nil {
+					return err
+				}
+				switch {
+				case name == OracleProgramEntryPoint && kind == externFunc:
+					hasEntryPoint = true
+				case name == OracleProgramMemoryExport && kind == externMemory:
+					hasMemory = true
+				}
+			}
+
+		case sectionCode:
+			codeSeen = true
+			count, err := section.u32()
+			if err != nil {
+				return err
+			}
+			if count != numDeclaredFuncs {
+				return ErrInvalidWasm.Wrapf("function and code section counts differ: %d != %d", numDeclaredFuncs, count)
+			}
+			for i := uint32(0); i < count; i++ {
+				body, err := section.subReader()
+				if err != nil {
+					return err
+				}
+				if err := body.functionBody(); err != nil {
+					return err
+				}
+			}
+		default:
+			// Remaining sections do not affect validation.
+			continue
+		}
+
+		if !section.done() {
+			return ErrInvalidWasm.Wrapf("section %d has trailing bytes", id)
+		}
+	}
+
+	if !codeSeen && numDeclaredFuncs > 0 {
+		return ErrInvalidWasm.Wrap("missing code section")
+	}
+	if numFuncs > MaxWasmFunctions {
+		return ErrWasmLimitExceeded.Wrapf("%d functions exceed %d", numFuncs, MaxWasmFunctions)
+	}
+	if numTables > MaxWasmTables {
+		return ErrWasmLimitExceeded.Wrapf("%d tables exceed %d", numTables, MaxWasmTables)
+	}
+	if numMemories > 1 {
+		return ErrWasmLimitExceeded.Wrapf("%d memories exceed 1", numMemories)
+	}
+	if !hasEntryPoint {
+		return ErrMissingWasmExport.Wrapf("function %s", OracleProgramEntryPoint)
+	}
+	if !hasMemory {
+		return ErrMissingWasmExport.Wrapf("memory %s", OracleProgramMemoryExport)
+	}
+	return nil
+}
+
+type wasmImport struct {
+	module string
+	name   string
+	kind   byte
+}
+
+// wasmReader decodes the WebAssembly binary format.
+type wasmReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *wasmReader) done() bool {
+	return r.pos >= len(r.buf)
+}
+
+func (r *wasmReader) byte() (byte, error) {
+	if r.done() {
+		return 0, ErrInvalidWasm.Wrap("unexpected end of module")
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *wasmReader) skip(n int) error {
+	if n < 0 || len(r.buf)-r.pos < n {
+		return ErrInvalidWasm.Wrap("unexpected end of module")
+	}
+	r.pos += n
+	return nil
+}
+
+// leb reads an LEB128 encoded integer of at most the given number of bits
+// and returns its raw bits.
+func (r *wasmReader) leb(bits uint) (uint64, error) {
+	var result uint64
+	var shift uint
+	for {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+		if shift >= bits {
+			return 0, ErrInvalidWasm.Wrap("integer representation too long")
+		}
+		result |= uint64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			return result, nil
+		}
+	}
+}
+
+func (r *wasmReader) u32() (uint32, error) {
+	v, err := r.leb(32)
+	if err != nil {
+		return 0, err
+	}
+	if v > 0xffffffff {
+		return 0, ErrInvalidWasm.Wrap("integer too large")
+	}
+	return uint32(v), nil
+}
+
+func (r *wasmReader) name() (string, error) {
+	n, err := r.u32()
+	if err != nil {
+		return "", err
+	}
+	start := r.pos
+	if err := r.skip(int(n)); err != nil {
+		return "", err
+	}
+	name := r.buf[start:r.pos]
+	if !utf8.Valid(name) {
+		return "", ErrInvalidWasm.Wrap("invalid utf-8 name")
+	}
+	return string(name), nil
+}
+
+// subReader returns a reader over the next size-prefixed chunk and
+// advances past it.
+func (r *wasmReader) subReader() (*wasmReader, error) {
+	size, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	start := r.pos
+	if err := r.skip(int(size)); err != nil {
+		return nil, err
+	}
+	return &wasmReader{buf: r.buf[start:r.pos]}, nil
+}
+
+// limits reads memory or table limits. Shared and 64-bit memories are
+// rejected.
+func (r *wasmReader) limits() (minSize, maxSize uint32, hasMax bool, err error) {
+	flags, err := r.byte()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	switch flags {
+	case 0x00:
+	case 0x01:
+		hasMax = true
+	case 0x03:
+		return 0, 0, false, ErrNonDeterministicWasm.Wrap("shared memory")
+	default:
+		return 0, 0, false, ErrInvalidWasm.Wrapf("unsupported limits flags %#x", flags)
+	}
+	if minSize, err = r.u32(); err != nil {
+		return 0, 0, false, err
+	}
+	if hasMax {
+		if maxSize, err = r.u32(); err != nil {
+			return 0, 0, false, err
+		}
+	}
+	return minSize, maxSize, hasMax, nil
+}
+
+func (r *wasmReader) importSection() ([]wasmImport, error) {
+	count, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	imports := make([]wasmImport, 0, count)
+	for i := uint32(0); i < count; i++ {
+		var imp wasmImport
+		if imp.module, err = r.name(); err != nil {
+			return nil, err
+		}
+		if imp.name, err = r.name(); err != nil {
+			return nil, err
+		}
+		if imp.kind, err = r.byte(); err != nil {
+			return nil, err
+		}
+		switch imp.kind {
+		case externFunc:
+			_, err = r.u32()
+		case externTable:
+			if _, err = r.byte(); err == nil {
+				_, _, _, err = r.limits()
+			}
+		case externMemory:
+			_, _, _, err = r.limits()
+		case externGlobal:
+			err = r.skip(2)
+		default:
+			err = ErrInvalidWasm.Wrapf("unknown import kind %d", imp.kind)
+		}
+		if err != nil {
+			return nil, err
+		}
+		imports = append(imports, imp)
+	}
+	return imports, nil
+}
+
+// functionBody decodes the locals and instructions of a function body and
+// rejects instructions that are not deterministic in the SEDA VM.
+func (r *wasmReader) functionBody() error {
+	numLocalDecls, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < numLocalDecls; i++ {
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+		if _, err := r.byte(); err != nil {
+			return err
+		}
+	}
+
+	for !r.done() {
+		op, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if err := r.immediates(op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// immediates skips the immediate operands of the given opcode.
+func (r *wasmReader) immediates(op byte) error {
+	var err error
+	switch {
+	case op <= 0x01, op == 0x05, op == 0x0b, op == 0x0f, op == 0x1a, op == 0x1b:
+	case op >= 0x02 && op <= 0x04: // block, loop, if
+		err = r.blockType()
+	case op == 0x0c || op == 0x0d || op == 0x10 || op == 0xd2 ||
+		(op >= 0x20 && op <= 0x26): // branches, call, ref.func, variables, tables
+		_, err = r.u32()
+	case op == 0x0e: // br_table
+		var n uint32
+		if n, err = r.u32(); err == nil {
+			for i := uint32(0); i <= n && err == nil; i++ {
+				_, err = r.u32()
+			}
+		}
+	case op == 0x11: // call_indirect
+		if _, err = r.u32(); err == nil {
+			_, err = r.u32()
+		}
+	case op == 0x1c: // typed select
+		var n uint32
+		if n, err = r.u32(); err == nil {
+			err = r.skip(int(n))
+		}
+	case op >= 0x28 && op <= 0x3e: // memarg
+		if _, err = r.u32(); err == nil {
+			_, err = r.u32()
+		}
+	case op == 0x3f || op == 0x40 || op == 0xd0: // memory.size, memory.grow, ref.null
+		_, err = r.byte()
+	case op == 0x41: // i32.const
+		_, err = r.leb(35)
+	case op == 0x42: // i64.const
+		_, err = r.leb(70)
+	case op == 0x43: // f32.const
+		err = r.skip(4)
+	case op == 0x44: // f64.const
+		err = r.skip(8)
+	case op >= 0x45 && op <= 0xc4, op == 0xd1:
+	case op == 0xfc:
+		err = r.miscImmediates()
+	case op == 0xfd:
+		return ErrNonDeterministicWasm.Wrap("SIMD instruction")
+	case op == 0xfe:
+		return ErrNonDeterministicWasm.Wrap("atomic instruction")
+	default:
+		return ErrInvalidWasm.Wrapf("unsupported instruction %#x", op)
+	}
+	return err
+}
+
+func (r *wasmReader) blockType() error {
+	if r.done() {
+		return ErrInvalidWasm.Wrap("unexpected end of module")
+	}
+	switch r.buf[r.pos] {
+	case 0x40, 0x7f, 0x7e, 0x7d, 0x7c, 0x7b, 0x70, 0x6f:
+		r.pos++
+		return nil
+	default:
+		_, err := r.leb(35)
+		return err
+	}
+}
+
+// miscImmediates skips the immediates of 0xfc-prefixed instructions, which
+// include the saturating float-to-int truncations and bulk memory operations.
+func (r *wasmReader) miscImmediates() error {
+	subOp, err := r.u32()
+	if err != nil {
+		return err
+	}
+	switch {
+	case subOp <= 7: // saturating float-to-int truncations
+	case subOp == 8: // memory.init
+		if _, err = r.u32(); err == nil {
+			_, err = r.byte()
+		}
+	case subOp == 10: // memory.copy
+		err = r.skip(2)
+	case subOp == 11: // memory.fill
+		_, err = r.byte()
+	case subOp == 12 || subOp == 14: // table.init, table.copy
+		if _, err = r.u32(); err == nil {
+			_, err = r.u32()
+		}
+	case subOp == 9 || subOp == 13 || (subOp >= 15 && subOp <= 17): // data.drop, elem.drop, table.grow/size/fill
+		_, err = r.u32()
+	default:
+		return ErrInvalidWasm.Wrapf("unsupported instruction 0xfc %d", subOp)
+	}
+	return err
+}